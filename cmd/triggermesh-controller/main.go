@@ -22,7 +22,9 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/extensions/reconciler/function"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/dataweavetransformation"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/jqtransformation"
+	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/jsontoxmltransformation"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/synchronizer"
+	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/templatetransformation"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/transformation"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/xmltojsontransformation"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/xslttransformation"
@@ -57,6 +59,7 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/googlecloudstoragesource"
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/httppollersource"
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/ibmmqsource"
+	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/jirasource"
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/ocimetricssource"
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/salesforcesource"
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/slacksource"
@@ -89,6 +92,7 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/targets/reconciler/logzmetricstarget"
 	"github.com/triggermesh/triggermesh/pkg/targets/reconciler/logztarget"
 	"github.com/triggermesh/triggermesh/pkg/targets/reconciler/oracletarget"
+	"github.com/triggermesh/triggermesh/pkg/targets/reconciler/prometheustarget"
 	"github.com/triggermesh/triggermesh/pkg/targets/reconciler/salesforcetarget"
 	"github.com/triggermesh/triggermesh/pkg/targets/reconciler/sendgridtarget"
 	"github.com/triggermesh/triggermesh/pkg/targets/reconciler/slacktarget"
@@ -131,6 +135,7 @@ func main() {
 		googlecloudstoragesource.NewController,
 		httppollersource.NewController,
 		ibmmqsource.NewController,
+		jirasource.NewController,
 		ocimetricssource.NewController,
 		salesforcesource.NewController,
 		slacksource.NewController,
@@ -165,6 +170,7 @@ func main() {
 		logztarget.NewController,
 		logzmetricstarget.NewController,
 		oracletarget.NewController,
+		prometheustarget.NewController,
 		salesforcetarget.NewController,
 		sendgridtarget.NewController,
 		slacktarget.NewController,
@@ -175,7 +181,9 @@ func main() {
 		zendesktarget.NewController,
 		// flow
 		jqtransformation.NewController,
+		jsontoxmltransformation.NewController,
 		synchronizer.NewController,
+		templatetransformation.NewController,
 		transformation.NewController,
 		xmltojsontransformation.NewController,
 		xslttransformation.NewController,
@@ -0,0 +1,122 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/dryrun"
+)
+
+/* A command which evaluates a Filter, Splitter or Transformation against a
+   sample CloudEvent, and prints the resulting events without deploying the
+   component to a cluster.
+*/
+
+func main() {
+	if err := run(os.Args, os.Stdin, os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running command: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// run executes the command with the given arguments.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	cmdName := path.Base(args[0])
+
+	flags := flag.NewFlagSet(cmdName, flag.ExitOnError)
+	flags.SetOutput(stderr)
+
+	opts, err := readOpts(flags, args)
+	if err != nil {
+		return fmt.Errorf("reading options: %w", err)
+	}
+
+	manifest, err := readInput(*opts.manifest, stdin)
+	if err != nil {
+		return fmt.Errorf("reading manifest: %w", err)
+	}
+	obj, err := dryrun.Decode(manifest)
+	if err != nil {
+		return fmt.Errorf("decoding manifest: %w", err)
+	}
+
+	eventData, err := readInput(*opts.event, stdin)
+	if err != nil {
+		return fmt.Errorf("reading event: %w", err)
+	}
+	event := cloudevents.NewEvent()
+	if err := json.Unmarshal(eventData, &event); err != nil {
+		return fmt.Errorf("decoding event: %w", err)
+	}
+
+	res, err := dryrun.Run(obj, event)
+	if err != nil {
+		return fmt.Errorf("running component: %w", err)
+	}
+
+	enc := json.NewEncoder(stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(res)
+}
+
+// cmdOpts are the options that can be passed to the command.
+type cmdOpts struct {
+	// path of the file containing the component's manifest
+	manifest *string
+	// path of the file containing the sample event in JSON format
+	event *string
+}
+
+// readOpts parses and validates options from commmand-line flags.
+func readOpts(f *flag.FlagSet, args []string) (*cmdOpts, error) {
+	opts := &cmdOpts{}
+
+	opts.manifest = f.String("f", "",
+		"Path of the file containing the Filter, Splitter or Transformation manifest (- for stdin)")
+	opts.event = f.String("e", "",
+		"Path of the file containing the sample CloudEvent in JSON format (- for stdin)")
+
+	if err := f.Parse(args[1:]); err != nil {
+		return nil, err
+	}
+
+	if *opts.manifest == "" || *opts.event == "" {
+		return nil, fmt.Errorf("both a manifest (-f) and an event (-e) must be provided")
+	}
+	if *opts.manifest == "-" && *opts.event == "-" {
+		return nil, fmt.Errorf("the manifest and the event can not both be read from stdin")
+	}
+
+	return opts, nil
+}
+
+// readInput reads the contents of the file at the given path, or of stdin
+// when the path is "-".
+func readInput(p string, stdin io.Reader) ([]byte, error) {
+	if p == "-" {
+		return io.ReadAll(stdin)
+	}
+	return os.ReadFile(p)
+}
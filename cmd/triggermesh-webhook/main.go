@@ -27,18 +27,47 @@ import (
 	"knative.dev/pkg/webhook"
 	"knative.dev/pkg/webhook/certificates"
 	"knative.dev/pkg/webhook/resourcesemantics"
+	"knative.dev/pkg/webhook/resourcesemantics/conversion"
 	"knative.dev/pkg/webhook/resourcesemantics/defaulting"
 	"knative.dev/pkg/webhook/resourcesemantics/validation"
 
+	"github.com/triggermesh/triggermesh/pkg/apis/flow"
 	flowv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	flowv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1beta1"
+	"github.com/triggermesh/triggermesh/pkg/apis/routing"
 	routingv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	routingv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1beta1"
+	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	sourcesv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	"github.com/triggermesh/triggermesh/pkg/apis/targets"
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetsv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
 )
 
 var types = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
-	sourcesv1alpha1.SchemeGroupVersion.WithKind("CloudEventsSource"): &sourcesv1alpha1.CloudEventsSource{},
-	routingv1alpha1.SchemeGroupVersion.WithKind("Filter"):            &routingv1alpha1.Filter{},
-	flowv1alpha1.SchemeGroupVersion.WithKind("XSLTTransformation"):   &flowv1alpha1.XSLTTransformation{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AWSSQSSource"):               &sourcesv1alpha1.AWSSQSSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("CloudEventsSource"):          &sourcesv1alpha1.CloudEventsSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("HTTPPollerSource"):           &sourcesv1alpha1.HTTPPollerSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("WebhookSource"):              &sourcesv1alpha1.WebhookSource{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("AWSDynamoDBTarget"):          &targetsv1alpha1.AWSDynamoDBTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("GoogleCloudFirestoreTarget"): &targetsv1alpha1.GoogleCloudFirestoreTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("HTTPTarget"):                 &targetsv1alpha1.HTTPTarget{},
+	routingv1alpha1.SchemeGroupVersion.WithKind("Filter"):                     &routingv1alpha1.Filter{},
+	routingv1alpha1.SchemeGroupVersion.WithKind("Splitter"):                   &routingv1alpha1.Splitter{},
+	flowv1alpha1.SchemeGroupVersion.WithKind("JQTransformation"):              &flowv1alpha1.JQTransformation{},
+	flowv1alpha1.SchemeGroupVersion.WithKind("JSONToXMLTransformation"):       &flowv1alpha1.JSONToXMLTransformation{},
+	flowv1alpha1.SchemeGroupVersion.WithKind("Synchronizer"):                  &flowv1alpha1.Synchronizer{},
+	flowv1alpha1.SchemeGroupVersion.WithKind("TemplateTransformation"):        &flowv1alpha1.TemplateTransformation{},
+	flowv1alpha1.SchemeGroupVersion.WithKind("Transformation"):                &flowv1alpha1.Transformation{},
+	flowv1alpha1.SchemeGroupVersion.WithKind("XMLToJSONTransformation"):       &flowv1alpha1.XMLToJSONTransformation{},
+	flowv1alpha1.SchemeGroupVersion.WithKind("XSLTTransformation"):            &flowv1alpha1.XSLTTransformation{},
+
+	routingv1beta1.SchemeGroupVersion.WithKind("Filter"):        &routingv1beta1.Filter{},
+	routingv1beta1.SchemeGroupVersion.WithKind("Splitter"):      &routingv1beta1.Splitter{},
+	flowv1beta1.SchemeGroupVersion.WithKind("Transformation"):   &flowv1beta1.Transformation{},
+	sourcesv1beta1.SchemeGroupVersion.WithKind("WebhookSource"): &sourcesv1beta1.WebhookSource{},
+	targetsv1beta1.SchemeGroupVersion.WithKind("HTTPTarget"):    &targetsv1beta1.HTTPTarget{},
 }
 
 var callbacks = map[schema.GroupVersionKind]validation.Callback{}
@@ -90,6 +119,64 @@ func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher
 	)
 }
 
+// NewConversionController returns conversion webhook controller implementation.
+func NewConversionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	return conversion.NewConversionController(ctx,
+		// The path on which to serve the webhook.
+		"/resource-conversion",
+
+		// The resources to convert, keyed by GroupKind. v1alpha1 is the
+		// storage version and acts as the conversion hub.
+		map[schema.GroupKind]conversion.GroupKindConversion{
+			routingv1alpha1.Kind("Filter"): {
+				DefinitionName: routing.FilterResource.String(),
+				HubVersion:     routingv1alpha1.SchemeGroupVersion.Version,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					routingv1alpha1.SchemeGroupVersion.Version: &routingv1alpha1.Filter{},
+					routingv1beta1.SchemeGroupVersion.Version:  &routingv1beta1.Filter{},
+				},
+			},
+			routingv1alpha1.Kind("Splitter"): {
+				DefinitionName: routing.SplitterResource.String(),
+				HubVersion:     routingv1alpha1.SchemeGroupVersion.Version,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					routingv1alpha1.SchemeGroupVersion.Version: &routingv1alpha1.Splitter{},
+					routingv1beta1.SchemeGroupVersion.Version:  &routingv1beta1.Splitter{},
+				},
+			},
+			flowv1alpha1.Kind("Transformation"): {
+				DefinitionName: flow.TransformationResource.String(),
+				HubVersion:     flowv1alpha1.SchemeGroupVersion.Version,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					flowv1alpha1.SchemeGroupVersion.Version: &flowv1alpha1.Transformation{},
+					flowv1beta1.SchemeGroupVersion.Version:  &flowv1beta1.Transformation{},
+				},
+			},
+			sourcesv1alpha1.Kind("WebhookSource"): {
+				DefinitionName: sources.WebhookSourceResource.String(),
+				HubVersion:     sourcesv1alpha1.SchemeGroupVersion.Version,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					sourcesv1alpha1.SchemeGroupVersion.Version: &sourcesv1alpha1.WebhookSource{},
+					sourcesv1beta1.SchemeGroupVersion.Version:  &sourcesv1beta1.WebhookSource{},
+				},
+			},
+			targetsv1alpha1.Kind("HTTPTarget"): {
+				DefinitionName: targets.HTTPTargetResource.String(),
+				HubVersion:     targetsv1alpha1.SchemeGroupVersion.Version,
+				Zygotes: map[string]conversion.ConvertibleObject{
+					targetsv1alpha1.SchemeGroupVersion.Version: &targetsv1alpha1.HTTPTarget{},
+					targetsv1beta1.SchemeGroupVersion.Version:  &targetsv1beta1.HTTPTarget{},
+				},
+			},
+		},
+
+		// A function that infuses the context passed to ConvertTo/ConvertFrom/SetDefaults with custom metadata.
+		func(ctx context.Context) context.Context {
+			return ctx
+		},
+	)
+}
+
 func main() {
 	webhookName := webhook.NameFromEnv()
 
@@ -104,5 +191,6 @@ func main() {
 		certificates.NewController,
 		NewDefaultingAdmissionController,
 		NewValidationAdmissionController,
+		NewConversionController,
 	)
 }
@@ -11,6 +11,11 @@ API_GROUPS := sources/v1alpha1 targets/v1alpha1 flow/v1alpha1 extensions/v1alpha
 # generates e.g. "PKG/pkg/apis/sources/v1alpha1 PKG/pkg/apis/sources/v1alpha2"
 api-import-paths := $(foreach group,$(API_GROUPS),$(PKG)/pkg/apis/$(group))
 
+# List of API groups which are served but not stored, and converted to their
+# storage version by the conversion webhook. Only deepcopy methods are
+# generated for these.
+CONVERTIBLE_API_GROUPS := sources/v1beta1 targets/v1beta1 flow/v1beta1 routing/v1beta1
+
 generators := deepcopy client lister informer injection
 
 .PHONY: codegen $(generators)
@@ -24,6 +29,7 @@ space := $(null) $(null)
 
 # Additionally to $(API_GROUPS), generate deepcopy methods for selected shared Go types inside pkg/apis/common/...
 deepcopy: private api-import-paths += $(PKG)/pkg/apis/common/v1alpha1
+deepcopy: private api-import-paths += $(foreach group,$(CONVERTIBLE_API_GROUPS),$(PKG)/pkg/apis/$(group))
 deepcopy:
 	@echo "+ Generating deepcopy funcs for $(API_GROUPS)"
 	@go run k8s.io/code-generator/cmd/deepcopy-gen \
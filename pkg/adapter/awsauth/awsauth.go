@@ -0,0 +1,122 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package awsauth configures the authentication of adapters against AWS APIs.
+package awsauth
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// Config contains the AWS authentication parameters of an adapter, sourced
+// from the environment. It is meant to be embedded in an adapter's
+// EnvConfigAccessor.
+//
+// Static credentials (AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY) and web
+// identities (IAM Roles for Service Accounts) are not part of this struct
+// because the default credentials chain of the AWS SDK reads them directly
+// from the environment.
+type Config struct {
+	// IAM Role to assume, using the base credentials.
+	AssumeRoleARN         string      `envconfig:"AWS_ASSUME_ROLE_ARN"`
+	AssumeRoleExternalID  string      `envconfig:"AWS_ASSUME_ROLE_EXTERNAL_ID"`
+	AssumeRoleSessionName string      `envconfig:"AWS_ASSUME_ROLE_SESSION_NAME"`
+	AssumeRoleSessionTags SessionTags `envconfig:"AWS_ASSUME_ROLE_SESSION_TAGS"`
+
+	// Custom URL of the AWS API endpoint, e.g. for S3-compatible storages
+	// or local AWS environments.
+	EndpointURL string `envconfig:"AWS_ENDPOINT_URL"`
+}
+
+// NewSession returns an AWS session which authenticates using the
+// parameters of the Config. The given AWS configurations are applied to the
+// session in order, before those parameters.
+//
+// The custom endpoint URL only applies to the service clients created from
+// the returned session. IAM Roles are always assumed against the STS
+// endpoint resolved from the given AWS configurations.
+func (c *Config) NewSession(cfgs ...*aws.Config) (*session.Session, error) {
+	sess, err := session.NewSession(cfgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.AssumeRoleARN != "" {
+		creds := stscreds.NewCredentials(sess, c.AssumeRoleARN, func(p *stscreds.AssumeRoleProvider) {
+			if c.AssumeRoleExternalID != "" {
+				p.ExternalID = aws.String(c.AssumeRoleExternalID)
+			}
+			if c.AssumeRoleSessionName != "" {
+				p.RoleSessionName = c.AssumeRoleSessionName
+			}
+			p.Tags = c.AssumeRoleSessionTags.stsTags()
+		})
+
+		sess = sess.Copy(aws.NewConfig().WithCredentials(creds))
+	}
+
+	if c.EndpointURL != "" {
+		sess = sess.Copy(aws.NewConfig().
+			WithEndpoint(c.EndpointURL).
+			// custom endpoints rarely support virtual-hosted–style S3 URLs
+			WithS3ForcePathStyle(true),
+		)
+	}
+
+	return sess, nil
+}
+
+// SessionTags is a set of session tags to pass when assuming an IAM Role.
+// It is decoded from a JSON object.
+type SessionTags map[string]string
+
+// Decode implements envconfig.Decoder.
+func (t *SessionTags) Decode(v string) error {
+	if v == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(v), t)
+}
+
+// stsTags returns the session tags in the format expected by the STS API,
+// sorted by key.
+func (t SessionTags) stsTags() []*sts.Tag {
+	if len(t) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(t))
+	for k := range t {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tags := make([]*sts.Tag, 0, len(keys))
+	for _, k := range keys {
+		tags = append(tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(t[k]),
+		})
+	}
+
+	return tags
+}
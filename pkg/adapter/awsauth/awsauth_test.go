@@ -0,0 +1,174 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsauth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("AWS_ASSUME_ROLE_ARN", "arn:aws:iam::123456789012:role/test")
+	t.Setenv("AWS_ASSUME_ROLE_SESSION_TAGS", `{"b":"2","a":"1"}`)
+	t.Setenv("AWS_ENDPOINT_URL", "http://localhost:4566")
+
+	cfg := &Config{}
+	require.NoError(t, envconfig.Process("", cfg))
+
+	assert.Equal(t, "arn:aws:iam::123456789012:role/test", cfg.AssumeRoleARN)
+	assert.Equal(t, "http://localhost:4566", cfg.EndpointURL)
+
+	expectTags := []*sts.Tag{
+		{Key: aws.String("a"), Value: aws.String("1")},
+		{Key: aws.String("b"), Value: aws.String("2")},
+	}
+	assert.Equal(t, expectTags, cfg.AssumeRoleSessionTags.stsTags())
+}
+
+func TestNewSession(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "fake-id")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "fake-secret")
+
+	t.Run("static credentials", func(t *testing.T) {
+		cfg := &Config{}
+
+		sess, err := cfg.NewSession(aws.NewConfig().WithRegion("us-west-2"))
+		require.NoError(t, err)
+
+		creds, err := sess.Config.Credentials.Get()
+		require.NoError(t, err)
+		assert.Equal(t, "fake-id", creds.AccessKeyID)
+		assert.Nil(t, sess.Config.Endpoint)
+	})
+
+	t.Run("custom endpoint", func(t *testing.T) {
+		cfg := &Config{
+			EndpointURL: "http://localhost:4566",
+		}
+
+		sess, err := cfg.NewSession(aws.NewConfig().WithRegion("us-west-2"))
+		require.NoError(t, err)
+
+		assert.Equal(t, "http://localhost:4566", aws.StringValue(sess.Config.Endpoint))
+		assert.True(t, aws.BoolValue(sess.Config.S3ForcePathStyle))
+	})
+
+	t.Run("assumed role", func(t *testing.T) {
+		var reqForm url.Values
+
+		stsSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = r.ParseForm()
+			reqForm = r.PostForm
+			_, _ = w.Write([]byte(fakeAssumeRoleResponse))
+		}))
+		defer stsSrv.Close()
+
+		cfg := &Config{
+			AssumeRoleARN:         "arn:aws:iam::123456789012:role/test",
+			AssumeRoleExternalID:  "ext-id",
+			AssumeRoleSessionName: "my-target",
+			AssumeRoleSessionTags: SessionTags{"team": "a"},
+		}
+
+		sess, err := cfg.NewSession(aws.NewConfig().
+			WithRegion("us-west-2").
+			WithEndpointResolver(stsEndpointResolver(stsSrv.URL)),
+		)
+		require.NoError(t, err)
+
+		creds, err := sess.Config.Credentials.Get()
+		require.NoError(t, err)
+		assert.Equal(t, "assumed-id", creds.AccessKeyID)
+
+		assert.Equal(t, "AssumeRole", reqForm.Get("Action"))
+		assert.Equal(t, "arn:aws:iam::123456789012:role/test", reqForm.Get("RoleArn"))
+		assert.Equal(t, "ext-id", reqForm.Get("ExternalId"))
+		assert.Equal(t, "my-target", reqForm.Get("RoleSessionName"))
+		assert.Equal(t, "team", reqForm.Get("Tags.member.1.Key"))
+		assert.Equal(t, "a", reqForm.Get("Tags.member.1.Value"))
+	})
+
+	t.Run("assumed role with custom endpoint", func(t *testing.T) {
+		var stsRequests, svcRequests int
+
+		stsSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			stsRequests++
+			_, _ = w.Write([]byte(fakeAssumeRoleResponse))
+		}))
+		defer stsSrv.Close()
+
+		svcSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			svcRequests++
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer svcSrv.Close()
+
+		cfg := &Config{
+			AssumeRoleARN: "arn:aws:iam::123456789012:role/test",
+			EndpointURL:   svcSrv.URL,
+		}
+
+		sess, err := cfg.NewSession(aws.NewConfig().
+			WithRegion("us-west-2").
+			WithEndpointResolver(stsEndpointResolver(stsSrv.URL)),
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, svcSrv.URL, aws.StringValue(sess.Config.Endpoint))
+		assert.Equal(t, svcSrv.URL, sts.New(sess).Endpoint,
+			"Service clients should use the custom endpoint")
+
+		creds, err := sess.Config.Credentials.Get()
+		require.NoError(t, err)
+		assert.Equal(t, "assumed-id", creds.AccessKeyID)
+
+		assert.Equal(t, 1, stsRequests, "Role should be assumed against the STS endpoint")
+		assert.Zero(t, svcRequests, "Role should not be assumed against the custom endpoint")
+	})
+}
+
+// stsEndpointResolver returns an endpoints.Resolver which resolves the STS
+// endpoint to the given URL.
+func stsEndpointResolver(stsURL string) endpoints.Resolver {
+	return endpoints.ResolverFunc(func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		if service == sts.EndpointsID {
+			return endpoints.ResolvedEndpoint{URL: stsURL}, nil
+		}
+		return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+	})
+}
+
+const fakeAssumeRoleResponse = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>assumed-id</AccessKeyId>
+      <SecretAccessKey>assumed-secret</SecretAccessKey>
+      <SessionToken>assumed-token</SessionToken>
+      <Expiration>2100-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+</AssumeRoleResponse>`
@@ -95,6 +95,16 @@ func (cw *fileWatcher) Start(ctx context.Context) {
 						cw.logger.Warnf("Received a notification for a non watched file")
 					}
 
+					// Files projected from Kubernetes Secrets and ConfigMaps are
+					// symbolic links which get atomically replaced upon update,
+					// which removes the underlying watch. Watch the new target
+					// of the link to keep receiving notifications.
+					if ok && e.Op&fsnotify.Remove == fsnotify.Remove {
+						if err := cw.watcher.Add(e.Name); err != nil {
+							cw.logger.Errorw("Could not watch replaced file", zap.Error(err))
+						}
+					}
+
 					for _, cb := range cbs {
 						cb()
 					}
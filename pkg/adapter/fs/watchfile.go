@@ -0,0 +1,54 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fs
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+)
+
+// ContentCallback is called with the content of a watched file.
+type ContentCallback func(content []byte)
+
+// WatchContent reads the file at the given path and passes its content to
+// the callback, then keeps calling the callback with the updated content
+// every time the file changes.
+//
+// Leading and trailing white space is trimmed from the content, since files
+// projected from Kubernetes Secrets commonly end with a newline character.
+//
+// An error is returned if the file can't be read initially. Subsequent read
+// errors are logged, and the callback is not called.
+func WatchContent(fw FileWatcher, path string, cb ContentCallback, logger *zap.SugaredLogger) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading file %q: %w", path, err)
+	}
+	cb(bytes.TrimSpace(content))
+
+	return fw.Add(path, func() {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			logger.Errorw("Could not read watched file "+path, zap.Error(err))
+			return
+		}
+		cb(bytes.TrimSpace(content))
+	})
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fs_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/fs"
+	fakefs "github.com/triggermesh/triggermesh/pkg/adapter/fs/fake"
+)
+
+func TestWatchContent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("initial-token\n"), 0o600))
+
+	fw := fakefs.NewFileWatcher()
+
+	var content []byte
+	err := fs.WatchContent(fw, path, func(c []byte) { content = c }, zap.NewNop().Sugar())
+	require.NoError(t, err)
+	assert.Equal(t, "initial-token", string(content), "Initial content is trimmed")
+
+	require.NoError(t, os.WriteFile(path, []byte(" updated-token\r\n"), 0o600))
+	require.NoError(t, fw.DoCallback(path))
+	assert.Equal(t, "updated-token", string(content), "Updated content is trimmed")
+
+	require.NoError(t, os.Remove(path))
+	require.NoError(t, fw.DoCallback(path))
+	assert.Equal(t, "updated-token", string(content), "Callback isn't called on read errors")
+}
+
+func TestWatchContentMissingFile(t *testing.T) {
+	fw := fakefs.NewFileWatcher()
+
+	err := fs.WatchContent(fw, filepath.Join(t.TempDir(), "missing"), func([]byte) {
+		t.Error("Callback was called for a missing file")
+	}, zap.NewNop().Sugar())
+	assert.Error(t, err)
+}
@@ -0,0 +1,33 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "strconv"
+
+// AnnotationSecretsHotReload is an annotation which, when set to "true" on a
+// component instance, causes the Secrets referenced in the instance's spec to
+// be mounted as files inside the receive adapter's container instead of being
+// exposed as environment variables. Adapters which support it reload these
+// files when the Secrets are updated, without requiring a restart.
+const AnnotationSecretsHotReload = "triggermesh.io/secrets-hot-reload"
+
+// WantsSecretsHotReload returns whether the given component instance requests
+// its Secrets to be mounted as files which can be reloaded at runtime.
+func WantsSecretsHotReload(r Reconcilable) bool {
+	v, err := strconv.ParseBool(r.GetAnnotations()[AnnotationSecretsHotReload])
+	return err == nil && v
+}
@@ -81,6 +81,24 @@ func IsMultiTenant(r Reconcilable) bool {
 	return ok && mt.IsMultiTenant()
 }
 
+// AdapterClusterRoleConsumer is implemented by types whose receive adapter
+// may require the permissions of the "{kind}-adapter" ClusterRole, which are
+// otherwise only granted to multi-tenant adapters.
+type AdapterClusterRoleConsumer interface {
+	WantsAdapterClusterRole() bool
+}
+
+// WantsAdapterClusterRole returns whether the receive adapter of the given
+// component instance should be bound to the "{kind}-adapter" ClusterRole.
+func WantsAdapterClusterRole(r Reconcilable) bool {
+	if IsMultiTenant(r) {
+		return true
+	}
+
+	crConsumer, ok := r.(AdapterClusterRoleConsumer)
+	return ok && crConsumer.WantsAdapterClusterRole()
+}
+
 // ServiceAccountProvider is implemented by types which are able to influence
 // the shape of the ServiceAccount used by their own receive adapter.
 type ServiceAccountProvider interface {
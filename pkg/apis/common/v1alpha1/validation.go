@@ -0,0 +1,46 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate ensures exactly one of the fields of the ValueFromField is set.
+func (v *ValueFromField) Validate(_ context.Context) *apis.FieldError {
+	switch {
+	case v.Value != "" && v.ValueFromSecret != nil:
+		return apis.ErrMultipleOneOf("value", "valueFromSecret")
+	case v.Value == "" && v.ValueFromSecret == nil:
+		return apis.ErrMissingOneOf("value", "valueFromSecret")
+	}
+
+	if s := v.ValueFromSecret; s != nil {
+		var errs *apis.FieldError
+		if s.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("name"))
+		}
+		if s.Key == "" {
+			errs = errs.Also(apis.ErrMissingField("key"))
+		}
+		return errs.ViaField("valueFromSecret")
+	}
+
+	return nil
+}
@@ -36,12 +36,24 @@ var (
 		Resource: "jqtransformations",
 	}
 
+	// JSONToXMLTransformationResource respresents a JSON to XML transformation.
+	JSONToXMLTransformationResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "jsontoxmltransformations",
+	}
+
 	// SynchronizerResource respresents a Synchronizer.
 	SynchronizerResource = schema.GroupResource{
 		Group:    GroupName,
 		Resource: "synchronizers",
 	}
 
+	// TemplateTransformationResource respresents a Go template transformation.
+	TemplateTransformationResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "templatetransformations",
+	}
+
 	// TransformationResource respresents a Bumblebee transformation.
 	TransformationResource = schema.GroupResource{
 		Group:    GroupName,
@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	apis "github.com/triggermesh/triggermesh/pkg/apis"
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	cloudevents "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 	v1 "k8s.io/api/core/v1"
@@ -31,6 +32,11 @@ import (
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Correlation) DeepCopyInto(out *Correlation) {
 	*out = *in
+	if in.ClientKeyAttribute != nil {
+		in, out := &in.ClientKeyAttribute, &out.ClientKeyAttribute
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -168,6 +174,23 @@ func (in *EventOptions) DeepCopy() *EventOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JQArgument) DeepCopyInto(out *JQArgument) {
+	*out = *in
+	in.ValueFromField.DeepCopyInto(&out.ValueFromField)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JQArgument.
+func (in *JQArgument) DeepCopy() *JQArgument {
+	if in == nil {
+		return nil
+	}
+	out := new(JQArgument)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JQTransformation) DeepCopyInto(out *JQTransformation) {
 	*out = *in
@@ -232,6 +255,23 @@ func (in *JQTransformationList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JQTransformationSpec) DeepCopyInto(out *JQTransformationSpec) {
 	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]JQArgument, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(JQScope)
+		**out = **in
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = new(JQResultsMode)
+		**out = **in
+	}
 	if in.EventOptions != nil {
 		in, out := &in.EventOptions, &out.EventOptions
 		*out = new(EventOptions)
@@ -256,6 +296,124 @@ func (in *JQTransformationSpec) DeepCopy() *JQTransformationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONToXMLTransformation) DeepCopyInto(out *JSONToXMLTransformation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONToXMLTransformation.
+func (in *JSONToXMLTransformation) DeepCopy() *JSONToXMLTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONToXMLTransformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JSONToXMLTransformation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONToXMLTransformationList) DeepCopyInto(out *JSONToXMLTransformationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JSONToXMLTransformation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONToXMLTransformationList.
+func (in *JSONToXMLTransformationList) DeepCopy() *JSONToXMLTransformationList {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONToXMLTransformationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JSONToXMLTransformationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONToXMLTransformationSpec) DeepCopyInto(out *JSONToXMLTransformationSpec) {
+	*out = *in
+	if in.RootElement != nil {
+		in, out := &in.RootElement, &out.RootElement
+		*out = new(string)
+		**out = **in
+	}
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.NamespacePrefix != nil {
+		in, out := &in.NamespacePrefix, &out.NamespacePrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.ArrayItemElement != nil {
+		in, out := &in.ArrayItemElement, &out.ArrayItemElement
+		*out = new(string)
+		**out = **in
+	}
+	if in.AttributePrefix != nil {
+		in, out := &in.AttributePrefix, &out.AttributePrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.TextKey != nil {
+		in, out := &in.TextKey, &out.TextKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(commonv1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONToXMLTransformationSpec.
+func (in *JSONToXMLTransformationSpec) DeepCopy() *JSONToXMLTransformationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONToXMLTransformationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Path) DeepCopyInto(out *Path) {
 	*out = *in
@@ -275,6 +433,26 @@ func (in *Path) DeepCopy() *Path {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Response) DeepCopyInto(out *Response) {
 	*out = *in
+	if in.TimeoutAttribute != nil {
+		in, out := &in.TimeoutAttribute, &out.TimeoutAttribute
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxTimeout != nil {
+		in, out := &in.MaxTimeout, &out.MaxTimeout
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.AggregationCompletionAttribute != nil {
+		in, out := &in.AggregationCompletionAttribute, &out.AggregationCompletionAttribute
+		*out = new(string)
+		**out = **in
+	}
+	if in.ErrorTypes != nil {
+		in, out := &in.ErrorTypes, &out.ErrorTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -352,8 +530,8 @@ func (in *SynchronizerList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SynchronizerSpec) DeepCopyInto(out *SynchronizerSpec) {
 	*out = *in
-	out.CorrelationKey = in.CorrelationKey
-	out.Response = in.Response
+	in.CorrelationKey.DeepCopyInto(&out.CorrelationKey)
+	in.Response.DeepCopyInto(&out.Response)
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
@@ -373,6 +551,109 @@ func (in *SynchronizerSpec) DeepCopy() *SynchronizerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateTransformation) DeepCopyInto(out *TemplateTransformation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateTransformation.
+func (in *TemplateTransformation) DeepCopy() *TemplateTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateTransformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TemplateTransformation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateTransformationList) DeepCopyInto(out *TemplateTransformationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TemplateTransformation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateTransformationList.
+func (in *TemplateTransformationList) DeepCopy() *TemplateTransformationList {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateTransformationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TemplateTransformationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateTransformationSpec) DeepCopyInto(out *TemplateTransformationSpec) {
+	*out = *in
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InputFormat != nil {
+		in, out := &in.InputFormat, &out.InputFormat
+		*out = new(TemplateDataFormat)
+		**out = **in
+	}
+	if in.OutputContentType != nil {
+		in, out := &in.OutputContentType, &out.OutputContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(commonv1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateTransformationSpec.
+func (in *TemplateTransformationSpec) DeepCopy() *TemplateTransformationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateTransformationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Transform) DeepCopyInto(out *Transform) {
 	*out = *in
@@ -581,6 +862,31 @@ func (in *XMLToJSONTransformationList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *XMLToJSONTransformationSpec) DeepCopyInto(out *XMLToJSONTransformationSpec) {
 	*out = *in
+	if in.AttributePrefix != nil {
+		in, out := &in.AttributePrefix, &out.AttributePrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.TextKey != nil {
+		in, out := &in.TextKey, &out.TextKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.InferTypes != nil {
+		in, out := &in.InferTypes, &out.InferTypes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForceArray != nil {
+		in, out := &in.ForceArray, &out.ForceArray
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = new(XMLNamespaceMode)
+		**out = **in
+	}
 	if in.EventOptions != nil {
 		in, out := &in.EventOptions, &out.EventOptions
 		*out = new(EventOptions)
@@ -605,6 +911,54 @@ func (in *XMLToJSONTransformationSpec) DeepCopy() *XMLToJSONTransformationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XSLTNamedStylesheet) DeepCopyInto(out *XSLTNamedStylesheet) {
+	*out = *in
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.XSLT.DeepCopyInto(&out.XSLT)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XSLTNamedStylesheet.
+func (in *XSLTNamedStylesheet) DeepCopy() *XSLTNamedStylesheet {
+	if in == nil {
+		return nil
+	}
+	out := new(XSLTNamedStylesheet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XSLTParameter) DeepCopyInto(out *XSLTParameter) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.ValueFromAttribute != nil {
+		in, out := &in.ValueFromAttribute, &out.ValueFromAttribute
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XSLTParameter.
+func (in *XSLTParameter) DeepCopy() *XSLTParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(XSLTParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *XSLTTransformation) DeepCopyInto(out *XSLTTransformation) {
 	*out = *in
@@ -679,6 +1033,30 @@ func (in *XSLTTransformationSpec) DeepCopyInto(out *XSLTTransformationSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PerEventXSLTCacheSize != nil {
+		in, out := &in.PerEventXSLTCacheSize, &out.PerEventXSLTCacheSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.Stylesheets != nil {
+		in, out := &in.Stylesheets, &out.Stylesheets
+		*out = make([]XSLTNamedStylesheet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]XSLTParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Imports != nil {
+		in, out := &in.Imports, &out.Imports
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
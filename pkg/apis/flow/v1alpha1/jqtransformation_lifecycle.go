@@ -60,3 +60,15 @@ func (t *JQTransformation) GetSink() *duckv1.Destination {
 func (t *JQTransformation) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return t.Spec.AdapterOverrides
 }
+
+// JQVariables returns the names of the variables which are available to JQ
+// queries, given the names of their arguments. Values are expected to be
+// passed to queries in the same order.
+func JQVariables(argNames ...string) []string {
+	vars := make([]string, 0, len(argNames)+1)
+	vars = append(vars, JQContextVariable)
+	for _, n := range argNames {
+		vars = append(vars, "$"+n)
+	}
+	return vars
+}
@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
@@ -37,6 +39,9 @@ type JQTransformation struct {
 }
 
 var (
+	_ apis.Validatable = (*JQTransformation)(nil)
+	_ apis.Defaultable = (*JQTransformation)(nil)
+
 	_ v1alpha1.Reconcilable        = (*JQTransformation)(nil)
 	_ v1alpha1.AdapterConfigurable = (*JQTransformation)(nil)
 	_ v1alpha1.EventSender         = (*JQTransformation)(nil)
@@ -47,6 +52,27 @@ type JQTransformationSpec struct {
 	// The query that gets passed to the JQ library
 	Query string `json:"query"`
 
+	// Named arguments, exposed to the query as variables. For instance,
+	// an argument named "foo" is referenced as "$foo" in the query.
+	// +optional
+	Args []JQArgument `json:"args,omitempty"`
+
+	// Scope of the event which is passed as the input of the query, and
+	// expected as its output.
+	// With "data" (default), the query runs against the data of the event.
+	// With "event", the query runs against the whole event in the JSON
+	// format of structured CloudEvents, and its result may rewrite context
+	// attributes and extensions as well as the data.
+	// +optional
+	Scope *JQScope `json:"scope,omitempty"`
+
+	// Handling of multiple results yielded by the query.
+	// With "last" (default), only the last result is kept.
+	// With "array", all results are collected into a JSON array.
+	// With "split", one event is emitted per result. Requires a sink.
+	// +optional
+	Results *JQResultsMode `json:"results,omitempty"`
+
 	// EventOptions for targets
 	EventOptions *EventOptions `json:"eventOptions,omitempty"`
 
@@ -58,6 +84,39 @@ type JQTransformationSpec struct {
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// JQArgument is a named argument of a JQ query.
+type JQArgument struct {
+	// Name of the argument. Must be a valid JQ variable name, without
+	// the leading "$".
+	Name string `json:"name"`
+	// Value of the argument.
+	ValueFromField `json:",inline"`
+}
+
+// JQScope is the scope of the event which a JQ query runs against.
+type JQScope string
+
+// Scopes of JQ queries.
+const (
+	JQScopeData  JQScope = "data"
+	JQScopeEvent JQScope = "event"
+)
+
+// JQResultsMode determines the handling of multiple results yielded by a JQ
+// query.
+type JQResultsMode string
+
+// Modes of handling of JQ results.
+const (
+	JQResultsLast  JQResultsMode = "last"
+	JQResultsArray JQResultsMode = "array"
+	JQResultsSplit JQResultsMode = "split"
+)
+
+// JQContextVariable is the name of the JQ variable which exposes the
+// context attributes of the processed CloudEvent, including extensions.
+const JQContextVariable = "$context"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // JQTransformationList is a list of component instances.
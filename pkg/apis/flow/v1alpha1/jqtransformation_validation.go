@@ -0,0 +1,109 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/itchyny/gojq"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (t *JQTransformation) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *JQTransformationSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	argNames := make([]string, 0, len(s.Args))
+	seenArgs := make(map[string]struct{}, len(s.Args))
+	for i, a := range s.Args {
+		switch {
+		case !jqVariableNameRegexp.MatchString(a.Name):
+			errs = errs.Also(apis.ErrInvalidValue(a.Name, "name",
+				"must be a valid JQ variable name without the leading '$'").ViaFieldIndex("args", i))
+		case "$"+a.Name == JQContextVariable:
+			errs = errs.Also(apis.ErrInvalidValue(a.Name, "name",
+				"reserved for the context of the event").ViaFieldIndex("args", i))
+		default:
+			if _, dup := seenArgs[a.Name]; dup {
+				errs = errs.Also(apis.ErrInvalidValue(a.Name, "name",
+					"duplicate argument name").ViaFieldIndex("args", i))
+				break
+			}
+			seenArgs[a.Name] = struct{}{}
+			argNames = append(argNames, a.Name)
+		}
+
+		errs = errs.Also(a.ValueFromField.Validate(ctx).ViaFieldIndex("args", i))
+	}
+
+	if s.Query == "" {
+		errs = errs.Also(apis.ErrMissingField("query"))
+	} else if err := compileJQQuery(s.Query, argNames); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(s.Query, "query", err.Error()))
+	}
+
+	if sc := s.Scope; sc != nil && *sc != JQScopeData && *sc != JQScopeEvent {
+		errs = errs.Also(apis.ErrInvalidValue(*sc, "scope"))
+	}
+
+	if r := s.Results; r != nil {
+		switch *r {
+		case JQResultsLast:
+		case JQResultsArray:
+			if s.Scope != nil && *s.Scope == JQScopeEvent {
+				errs = errs.Also(apis.ErrGeneric("collecting results into an array is not supported in the event scope",
+					"results", "scope"))
+			}
+		case JQResultsSplit:
+			if s.Sink.Ref == nil && s.Sink.URI == nil {
+				errs = errs.Also(apis.ErrGeneric("splitting results into multiple events requires a sink",
+					"results", "sink"))
+			}
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*r, "results"))
+		}
+	}
+
+	if s.Sink.Ref != nil || s.Sink.URI != nil {
+		errs = errs.Also(s.Sink.Validate(ctx).ViaField("sink"))
+	}
+
+	return errs
+}
+
+// jqVariableNameRegexp matches valid names of JQ variables, without the
+// leading '$'.
+var jqVariableNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// compileJQQuery parses and compiles the given JQ query. Compiling catches
+// references to undefined functions or variables, which would otherwise only
+// surface when the adapter runs the query against an event.
+func compileJQQuery(q string, argNames []string) error {
+	query, err := gojq.Parse(q)
+	if err != nil {
+		return err
+	}
+	_, err = gojq.Compile(query, gojq.WithVariables(JQVariables(argNames...)))
+	return err
+}
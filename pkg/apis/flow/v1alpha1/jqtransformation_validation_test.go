@@ -0,0 +1,160 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestJQTransformationValidate(t *testing.T) {
+	scopeEvent := JQScopeEvent
+	resultsArray := JQResultsArray
+	resultsSplit := JQResultsSplit
+	resultsInvalid := JQResultsMode("first")
+
+	sink := duckv1.Destination{URI: apis.HTTP("sink.example.com")}
+
+	testCases := map[string]struct {
+		spec        JQTransformationSpec
+		expectError string
+	}{
+		"valid query": {
+			spec: JQTransformationSpec{Query: `.foo | {bar: .baz}`},
+		},
+		"missing query": {
+			spec:        JQTransformationSpec{Query: ""},
+			expectError: "missing field(s): spec.query",
+		},
+		"syntax error": {
+			spec:        JQTransformationSpec{Query: `.foo |`},
+			expectError: "invalid value: .foo |: spec.query\nunexpected token <EOF>",
+		},
+		"undefined function": {
+			spec:        JQTransformationSpec{Query: `.foo | nosuchfunc`},
+			expectError: "invalid value: .foo | nosuchfunc: spec.query\nfunction not defined: nosuchfunc/0",
+		},
+		"context and arguments": {
+			spec: JQTransformationSpec{
+				Query: `{type: $context.type, greeting: $greeting}`,
+				Args: []JQArgument{{
+					Name:           "greeting",
+					ValueFromField: ValueFromField{Value: "hello"},
+				}},
+			},
+		},
+		"undefined argument": {
+			spec:        JQTransformationSpec{Query: `$greeting`},
+			expectError: "invalid value: $greeting: spec.query\nvariable not defined: $greeting",
+		},
+		"invalid argument names": {
+			spec: JQTransformationSpec{
+				Query: `.`,
+				Args: []JQArgument{{
+					Name:           "1st",
+					ValueFromField: ValueFromField{Value: "a"},
+				}, {
+					Name:           "context",
+					ValueFromField: ValueFromField{Value: "b"},
+				}, {
+					Name:           "arg",
+					ValueFromField: ValueFromField{Value: "c"},
+				}, {
+					Name:           "arg",
+					ValueFromField: ValueFromField{Value: "d"},
+				}},
+			},
+			expectError: "invalid value: 1st: spec.args[0].name\n" +
+				"must be a valid JQ variable name without the leading '$'\n" +
+				"invalid value: arg: spec.args[3].name\n" +
+				"duplicate argument name\n" +
+				"invalid value: context: spec.args[1].name\n" +
+				"reserved for the context of the event",
+		},
+		"array results in event scope": {
+			spec: JQTransformationSpec{
+				Query:   `.`,
+				Scope:   &scopeEvent,
+				Results: &resultsArray,
+			},
+			expectError: "collecting results into an array is not supported in the event scope: spec.results, spec.scope",
+		},
+		"split results with sink": {
+			spec: JQTransformationSpec{
+				Query:      `.[]`,
+				Results:    &resultsSplit,
+				SourceSpec: duckv1.SourceSpec{Sink: sink},
+			},
+		},
+		"split results without sink": {
+			spec: JQTransformationSpec{
+				Query:   `.[]`,
+				Results: &resultsSplit,
+			},
+			expectError: "splitting results into multiple events requires a sink: spec.results, spec.sink",
+		},
+		"invalid results mode": {
+			spec: JQTransformationSpec{
+				Query:   `.`,
+				Results: &resultsInvalid,
+			},
+			expectError: "invalid value: first: spec.results",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			jq := &JQTransformation{Spec: tc.spec}
+
+			err := jq.Validate(context.Background())
+			if tc.expectError == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectError)
+		})
+	}
+}
+
+func TestTransformationValidate(t *testing.T) {
+	trn := &Transformation{
+		Spec: TransformationSpec{
+			Context: []Transform{{
+				Operation: "add",
+				Paths:     []Path{{Key: "type", Value: "io.test"}},
+			}},
+			Data: []Transform{{
+				Operation: "rename",
+				Paths:     []Path{{Key: "foo"}},
+			}, {
+				Operation: "delete",
+			}},
+		},
+	}
+
+	expectErr := (&apis.FieldError{}).Also(
+		apis.ErrInvalidValue("rename", "operation").ViaFieldIndex("data", 0),
+		apis.ErrMissingField("paths").ViaFieldIndex("data", 1),
+	).ViaField("spec")
+
+	assert.Equal(t, expectErr.Error(), trn.Validate(context.Background()).Error())
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// JSONToXMLTransformation is the schema for the event transformer.
+type JSONToXMLTransformation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JSONToXMLTransformationSpec `json:"spec,omitempty"`
+	Status v1alpha1.Status             `json:"status,omitempty"`
+}
+
+var (
+	_ apis.Validatable = (*JSONToXMLTransformation)(nil)
+
+	_ v1alpha1.Reconcilable        = (*JSONToXMLTransformation)(nil)
+	_ v1alpha1.AdapterConfigurable = (*JSONToXMLTransformation)(nil)
+	_ v1alpha1.EventSender         = (*JSONToXMLTransformation)(nil)
+)
+
+// JSONToXMLTransformationSpec defines the desired state of the component.
+type JSONToXMLTransformationSpec struct {
+	// Name of the root XML element. When not set, the key of JSON objects
+	// which have a single member is used as the root element, otherwise
+	// the data is wrapped in a "root" element.
+	// +optional
+	RootElement *string `json:"rootElement,omitempty"`
+
+	// Namespace URI declared on the root XML element.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Prefix associated with the namespace. When not set, the namespace is
+	// declared as the default namespace.
+	// +optional
+	NamespacePrefix *string `json:"namespacePrefix,omitempty"`
+
+	// Name of the XML elements wrapping the items of JSON arrays which are
+	// not the value of an object member, such as nested arrays. Defaults
+	// to "item".
+	// +optional
+	ArrayItemElement *string `json:"arrayItemElement,omitempty"`
+
+	// Prefix of the JSON keys converted to XML attributes. Defaults to "-".
+	// +optional
+	AttributePrefix *string `json:"attributePrefix,omitempty"`
+
+	// JSON key converted to the text content of XML elements. Defaults to
+	// "#content".
+	// +optional
+	TextKey *string `json:"textKey,omitempty"`
+
+	// EventOptions for targets
+	EventOptions *EventOptions `json:"eventOptions,omitempty"`
+
+	// Support sending to an event sink instead of replying.
+	duckv1.SourceSpec `json:",inline"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// JSONToXMLTransformationList is a list of component instances.
+type JSONToXMLTransformationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []JSONToXMLTransformation `json:"items"`
+}
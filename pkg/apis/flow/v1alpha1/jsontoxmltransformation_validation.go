@@ -0,0 +1,70 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/xmljson"
+)
+
+// Validate implements apis.Validatable
+func (t *JSONToXMLTransformation) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *JSONToXMLTransformationSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if n := s.RootElement; n != nil && !xmljson.IsValidName(*n) {
+		errs = errs.Also(apis.ErrInvalidValue(*n, "rootElement", "not a valid XML element name"))
+	}
+
+	if n := s.ArrayItemElement; n != nil && !xmljson.IsValidName(*n) {
+		errs = errs.Also(apis.ErrInvalidValue(*n, "arrayItemElement", "not a valid XML element name"))
+	}
+
+	if ns := s.Namespace; ns != nil {
+		if _, err := url.Parse(*ns); err != nil || *ns == "" {
+			errs = errs.Also(apis.ErrInvalidValue(*ns, "namespace", "not a valid URI"))
+		}
+	}
+
+	if p := s.NamespacePrefix; p != nil {
+		switch {
+		case s.Namespace == nil:
+			errs = errs.Also(apis.ErrGeneric("namespacePrefix requires a namespace", "namespacePrefix", "namespace"))
+		case !xmljson.IsValidName(*p) || strings.ContainsRune(*p, ':') || strings.HasPrefix(strings.ToLower(*p), "xml"):
+			errs = errs.Also(apis.ErrInvalidValue(*p, "namespacePrefix", "not a valid XML namespace prefix"))
+		}
+	}
+
+	if s.TextKey != nil && *s.TextKey == "" {
+		errs = errs.Also(apis.ErrInvalidValue(*s.TextKey, "textKey", "must not be empty"))
+	}
+
+	if s.Sink.Ref != nil || s.Sink.URI != nil {
+		errs = errs.Also(s.Sink.Validate(ctx).ViaField("sink"))
+	}
+
+	return errs
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"knative.dev/pkg/apis"
+)
+
+func TestJSONToXMLTransformationValidate(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	testCases := map[string]struct {
+		spec        JSONToXMLTransformationSpec
+		expectError *apis.FieldError
+	}{
+		"no options": {
+			spec: JSONToXMLTransformationSpec{},
+		},
+		"all options": {
+			spec: JSONToXMLTransformationSpec{
+				RootElement:      str("order"),
+				Namespace:        str("urn:example:orders"),
+				NamespacePrefix:  str("o"),
+				ArrayItemElement: str("entry"),
+				AttributePrefix:  str("@"),
+				TextKey:          str("_text"),
+			},
+		},
+		"invalid element names": {
+			spec: JSONToXMLTransformationSpec{
+				RootElement:      str("my order"),
+				ArrayItemElement: str("1st"),
+			},
+			expectError: apis.ErrInvalidValue("my order", "rootElement", "not a valid XML element name").Also(
+				apis.ErrInvalidValue("1st", "arrayItemElement", "not a valid XML element name")),
+		},
+		"empty namespace": {
+			spec: JSONToXMLTransformationSpec{
+				Namespace: str(""),
+			},
+			expectError: apis.ErrInvalidValue("", "namespace", "not a valid URI"),
+		},
+		"namespace prefix without namespace": {
+			spec: JSONToXMLTransformationSpec{
+				NamespacePrefix: str("o"),
+			},
+			expectError: apis.ErrGeneric("namespacePrefix requires a namespace", "namespacePrefix", "namespace"),
+		},
+		"reserved namespace prefix": {
+			spec: JSONToXMLTransformationSpec{
+				Namespace:       str("urn:example:orders"),
+				NamespacePrefix: str("xmlns"),
+			},
+			expectError: apis.ErrInvalidValue("xmlns", "namespacePrefix", "not a valid XML namespace prefix"),
+		},
+		"empty text key": {
+			spec: JSONToXMLTransformationSpec{
+				TextKey: str(""),
+			},
+			expectError: apis.ErrInvalidValue("", "textKey", "must not be empty"),
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trn := &JSONToXMLTransformation{Spec: tc.spec}
+
+			err := trn.Validate(context.Background())
+			if tc.expectError == nil {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectError.ViaField("spec").Error())
+		})
+	}
+}
@@ -51,8 +51,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&DataWeaveTransformationList{},
 		&JQTransformation{},
 		&JQTransformationList{},
+		&JSONToXMLTransformation{},
+		&JSONToXMLTransformationList{},
 		&Synchronizer{},
 		&SynchronizerList{},
+		&TemplateTransformation{},
+		&TemplateTransformationList{},
 		&Transformation{},
 		&TransformationList{},
 		&XMLToJSONTransformation{},
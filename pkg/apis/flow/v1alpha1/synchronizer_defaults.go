@@ -0,0 +1,32 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+)
+
+// DefaultCorrelationKeyLength is the length of the correlation keys generated
+// by a Synchronizer, unless specified otherwise.
+const DefaultCorrelationKeyLength = 24
+
+// SetDefaults implements apis.Defaultable
+func (s *Synchronizer) SetDefaults(ctx context.Context) {
+	if s != nil && s.Spec.CorrelationKey.Length == 0 {
+		s.Spec.CorrelationKey.Length = DefaultCorrelationKeyLength
+	}
+}
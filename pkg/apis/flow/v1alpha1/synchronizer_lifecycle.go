@@ -25,6 +25,13 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
+// Returned event types
+const (
+	// EventTypeSynchronizerError is the type of the events returned to
+	// clients when the backend replies with an error.
+	EventTypeSynchronizerError = "io.triggermesh.synchronizer.error"
+)
+
 // GetGroupVersionKind implements kmeta.OwnerRefable.
 func (*Synchronizer) GetGroupVersionKind() schema.GroupVersionKind {
 	return SchemeGroupVersion.WithKind("Synchronizer")
@@ -62,11 +62,40 @@ type SynchronizerSpec struct {
 type Correlation struct {
 	Attribute string `json:"attribute"`
 	Length    int    `json:"length"`
+
+	// Name of a CloudEvent extension through which clients can supply the
+	// correlation key of their requests. A correlation key is generated for
+	// requests that do not carry this extension.
+	// +optional
+	ClientKeyAttribute *string `json:"clientKeyAttribute,omitempty"`
 }
 
 // Response defines the response handling configuration.
 type Response struct {
 	Timeout apis.Duration `json:"timeout"`
+
+	// Name of a CloudEvent extension through which clients can set the
+	// response timeout of their requests, as a duration string.
+	// +optional
+	TimeoutAttribute *string `json:"timeoutAttribute,omitempty"`
+
+	// Maximum response timeout clients can request. Defaults to the
+	// response timeout.
+	// +optional
+	MaxTimeout *apis.Duration `json:"maxTimeout,omitempty"`
+
+	// Name of a CloudEvent extension marking the last of a series of
+	// partial responses. When set, responses are aggregated until one with
+	// this extension set to "true" is received, and are returned to the
+	// client as a single event. Partial responses are not streamed to the
+	// client, which receives nothing until the last response.
+	// +optional
+	AggregationCompletionAttribute *string `json:"aggregationCompletionAttribute,omitempty"`
+
+	// Types of the response events which denote an error. Responses with
+	// the "category" extension set to "error" are always treated as errors.
+	// +optional
+	ErrorTypes []string `json:"errorTypes,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
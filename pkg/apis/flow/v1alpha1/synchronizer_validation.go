@@ -0,0 +1,111 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (s *Synchronizer) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *SynchronizerSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	errs = errs.Also(s.CorrelationKey.validate().ViaField("correlationKey"))
+	errs = errs.Also(s.Response.validate(s.CorrelationKey.Attribute).ViaField("response"))
+
+	if s.Sink.Ref != nil || s.Sink.URI != nil {
+		errs = errs.Also(s.Sink.Validate(ctx).ViaField("sink"))
+	}
+
+	return errs
+}
+
+// validate verifies the correlation parameters.
+func (c *Correlation) validate() *apis.FieldError {
+	var errs *apis.FieldError
+
+	switch {
+	case c.Attribute == "":
+		errs = errs.Also(apis.ErrMissingField("attribute"))
+	case !ceAttributeNameRegexp.MatchString(c.Attribute):
+		errs = errs.Also(apis.ErrInvalidValue(c.Attribute, "attribute",
+			"CloudEvent attribute names must consist of lower-case letters or digits"))
+	}
+
+	if c.Length < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(c.Length, "length", "length must be positive"))
+	}
+
+	if a := c.ClientKeyAttribute; a != nil {
+		switch {
+		case !ceAttributeNameRegexp.MatchString(*a):
+			errs = errs.Also(apis.ErrInvalidValue(*a, "clientKeyAttribute",
+				"CloudEvent attribute names must consist of lower-case letters or digits"))
+		case *a == c.Attribute:
+			errs = errs.Also(apis.ErrInvalidValue(*a, "clientKeyAttribute",
+				"must differ from the correlation attribute"))
+		}
+	}
+
+	return errs
+}
+
+// validate verifies the response handling configuration.
+func (r *Response) validate(correlationAttr string) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if r.Timeout <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(r.Timeout.String(), "timeout", "timeout must be positive"))
+	}
+
+	if a := r.TimeoutAttribute; a != nil && !ceAttributeNameRegexp.MatchString(*a) {
+		errs = errs.Also(apis.ErrInvalidValue(*a, "timeoutAttribute",
+			"CloudEvent attribute names must consist of lower-case letters or digits"))
+	}
+
+	if m := r.MaxTimeout; m != nil && time.Duration(*m) < time.Duration(r.Timeout) {
+		errs = errs.Also(apis.ErrInvalidValue(m.String(), "maxTimeout",
+			"maximum timeout must not be lower than the response timeout"))
+	}
+
+	if a := r.AggregationCompletionAttribute; a != nil {
+		switch {
+		case !ceAttributeNameRegexp.MatchString(*a):
+			errs = errs.Also(apis.ErrInvalidValue(*a, "aggregationCompletionAttribute",
+				"CloudEvent attribute names must consist of lower-case letters or digits"))
+		case *a == correlationAttr:
+			errs = errs.Also(apis.ErrInvalidValue(*a, "aggregationCompletionAttribute",
+				"must differ from the correlation attribute"))
+		}
+	}
+
+	for i, t := range r.ErrorTypes {
+		if t == "" {
+			errs = errs.Also(apis.ErrInvalidArrayValue(t, "errorTypes", i))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestSynchronizerValidate(t *testing.T) {
+	duration := func(d time.Duration) *apis.Duration { dd := apis.Duration(d); return &dd }
+
+	testCases := map[string]struct {
+		correlation Correlation
+		response    Response
+		expectError string
+	}{
+		"minimal spec": {
+			correlation: Correlation{Attribute: "correlationid", Length: 24},
+			response:    Response{Timeout: apis.Duration(10 * time.Second)},
+		},
+		"all options": {
+			correlation: Correlation{
+				Attribute:          "correlationid",
+				ClientKeyAttribute: strPtr("requestid"),
+			},
+			response: Response{
+				Timeout:                        apis.Duration(10 * time.Second),
+				TimeoutAttribute:               strPtr("timeout"),
+				MaxTimeout:                     duration(time.Minute),
+				AggregationCompletionAttribute: strPtr("final"),
+				ErrorTypes:                     []string{"com.example.error"},
+			},
+		},
+		"missing fields": {
+			expectError: "invalid value: 0s: spec.response.timeout\ntimeout must be positive\n" +
+				"missing field(s): spec.correlationKey.attribute",
+		},
+		"invalid attributes": {
+			correlation: Correlation{
+				Attribute:          "correlationid",
+				ClientKeyAttribute: strPtr("correlationid"),
+			},
+			response: Response{
+				Timeout:                        apis.Duration(10 * time.Second),
+				TimeoutAttribute:               strPtr("Timeout"),
+				MaxTimeout:                     duration(time.Second),
+				AggregationCompletionAttribute: strPtr("correlationid"),
+				ErrorTypes:                     []string{""},
+			},
+			expectError: "invalid value: : spec.response.errorTypes[0]\n" +
+				"invalid value: 1s: spec.response.maxTimeout\n" +
+				"maximum timeout must not be lower than the response timeout\n" +
+				"invalid value: Timeout: spec.response.timeoutAttribute\n" +
+				"CloudEvent attribute names must consist of lower-case letters or digits\n" +
+				"invalid value: correlationid: spec.correlationKey.clientKeyAttribute, spec.response.aggregationCompletionAttribute\n" +
+				"must differ from the correlation attribute",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			s := &Synchronizer{Spec: SynchronizerSpec{
+				CorrelationKey: tc.correlation,
+				Response:       tc.response,
+			}}
+
+			err := s.Validate(context.Background())
+			if tc.expectError == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestSynchronizerSetDefaults(t *testing.T) {
+	s := &Synchronizer{}
+	s.SetDefaults(context.Background())
+	assert.Equal(t, DefaultCorrelationKeyLength, s.Spec.CorrelationKey.Length)
+
+	s = &Synchronizer{Spec: SynchronizerSpec{CorrelationKey: Correlation{Length: 8}}}
+	s.SetDefaults(context.Background())
+	assert.Equal(t, 8, s.Spec.CorrelationKey.Length)
+}
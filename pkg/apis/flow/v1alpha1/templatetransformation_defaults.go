@@ -0,0 +1,40 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+)
+
+// DefaultTemplateOutputContentType is the content type of the data rendered
+// by a TemplateTransformation, unless specified otherwise.
+const DefaultTemplateOutputContentType = "application/json"
+
+// SetDefaults implements apis.Defaultable
+func (t *TemplateTransformation) SetDefaults(ctx context.Context) {
+	if t != nil {
+		t.Spec.SetDefaults(ctx)
+	}
+}
+
+// SetDefaults implements apis.Defaultable
+func (s *TemplateTransformationSpec) SetDefaults(ctx context.Context) {
+	if s != nil && s.OutputContentType == nil {
+		ct := DefaultTemplateOutputContentType
+		s.OutputContentType = &ct
+	}
+}
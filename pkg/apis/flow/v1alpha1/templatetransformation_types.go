@@ -0,0 +1,100 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TemplateTransformation is the Schema for a Go template transformation.
+type TemplateTransformation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TemplateTransformationSpec `json:"spec"`
+	Status v1alpha1.Status            `json:"status,omitempty"`
+}
+
+// Check the interfaces TemplateTransformation should be implementing.
+var (
+	_ apis.Validatable = (*TemplateTransformation)(nil)
+	_ apis.Defaultable = (*TemplateTransformation)(nil)
+
+	_ v1alpha1.Reconcilable        = (*TemplateTransformation)(nil)
+	_ v1alpha1.AdapterConfigurable = (*TemplateTransformation)(nil)
+	_ v1alpha1.EventSender         = (*TemplateTransformation)(nil)
+)
+
+// TemplateTransformationSpec defines the desired state of the component.
+type TemplateTransformationSpec struct {
+	// Go template used to render the data of transformed events.
+	// The template is executed against an object with the fields "Data",
+	// which contains the parsed data of the incoming event, and "Context",
+	// which contains its context attributes and extensions.
+	Template *ValueFromField `json:"template"`
+
+	// Format of the data of incoming events. When not set, the format is
+	// inferred from the content type of each event.
+	// +optional
+	InputFormat *TemplateDataFormat `json:"inputFormat,omitempty"`
+
+	// Content type of the rendered data. Defaults to "application/json", in
+	// which case the rendered data must be valid JSON.
+	// +optional
+	OutputContentType *string `json:"outputContentType,omitempty"`
+
+	// EventOptions for targets
+	// +optional
+	EventOptions *EventOptions `json:"eventOptions,omitempty"`
+
+	// Support sending to an event sink instead of replying.
+	duckv1.SourceSpec `json:",inline"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// TemplateDataFormat is the format of event data parsed by a
+// TemplateTransformation.
+type TemplateDataFormat string
+
+// Formats of event data supported by TemplateTransformation.
+const (
+	TemplateDataFormatJSON TemplateDataFormat = "json"
+	TemplateDataFormatXML  TemplateDataFormat = "xml"
+	TemplateDataFormatCSV  TemplateDataFormat = "csv"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TemplateTransformationList is a list of component instances.
+type TemplateTransformationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []TemplateTransformation `json:"items"`
+}
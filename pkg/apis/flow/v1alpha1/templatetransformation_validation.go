@@ -0,0 +1,66 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"mime"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/templating"
+)
+
+// Validate implements apis.Validatable
+func (t *TemplateTransformation) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *TemplateTransformationSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if !s.Template.IsInformed() {
+		errs = errs.Also(apis.ErrMissingField("template"))
+	} else if err := s.Template.Validate(ctx); err != nil {
+		errs = errs.Also(err.ViaField("template"))
+	} else if s.Template.Value != "" {
+		if _, err := templating.Parse("template", s.Template.Value); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue("<template>", "value", err.Error()).ViaField("template"))
+		}
+	}
+
+	if f := s.InputFormat; f != nil {
+		switch *f {
+		case TemplateDataFormatJSON, TemplateDataFormatXML, TemplateDataFormatCSV:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*f, "inputFormat"))
+		}
+	}
+
+	if ct := s.OutputContentType; ct != nil {
+		if _, _, err := mime.ParseMediaType(*ct); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(*ct, "outputContentType", err.Error()))
+		}
+	}
+
+	if s.Sink.Ref != nil || s.Sink.URI != nil {
+		errs = errs.Also(s.Sink.Validate(ctx).ViaField("sink"))
+	}
+
+	return errs
+}
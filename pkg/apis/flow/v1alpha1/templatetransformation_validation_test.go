@@ -0,0 +1,132 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"knative.dev/pkg/apis"
+)
+
+func TestTemplateTransformationValidate(t *testing.T) {
+	inputFormat := func(f TemplateDataFormat) *TemplateDataFormat { return &f }
+	contentType := func(ct string) *string { return &ct }
+
+	testCases := map[string]struct {
+		spec        TemplateTransformationSpec
+		expectError *apis.FieldError
+	}{
+		"template informed": {
+			spec: TemplateTransformationSpec{
+				Template:          valueFromField(vffWithValue(`{"name": {{ toJson .Data.name }}}`)),
+				InputFormat:       inputFormat(TemplateDataFormatCSV),
+				OutputContentType: contentType("application/xml; charset=utf-8"),
+			},
+		},
+		"template from secret": {
+			spec: TemplateTransformationSpec{
+				Template: valueFromField(vffWithSecret(tName, tKey)),
+			},
+		},
+		"template missing": {
+			spec:        TemplateTransformationSpec{},
+			expectError: apis.ErrMissingField("template"),
+		},
+		"template informed wrong": {
+			spec: TemplateTransformationSpec{
+				Template: valueFromField(vffWithValue(tValue), vffWithSecret(tName, tKey)),
+			},
+			expectError: apis.ErrMultipleOneOf("value", "valueFromSecret", "valueFromConfigMap").ViaField("template"),
+		},
+		"template syntax error": {
+			spec: TemplateTransformationSpec{
+				Template: valueFromField(vffWithValue(`{{ .Data.name `)),
+			},
+			expectError: apis.ErrInvalidValue("<template>", "value",
+				`template: template:1: unclosed action`).ViaField("template"),
+		},
+		"template undefined function": {
+			spec: TemplateTransformationSpec{
+				Template: valueFromField(vffWithValue(`{{ nosuchfunc .Data }}`)),
+			},
+			expectError: apis.ErrInvalidValue("<template>", "value",
+				`template: template:1: function "nosuchfunc" not defined`).ViaField("template"),
+		},
+		"invalid input format": {
+			spec: TemplateTransformationSpec{
+				Template:    valueFromField(vffWithValue(`{{ toJson .Data }}`)),
+				InputFormat: inputFormat("yaml"),
+			},
+			expectError: apis.ErrInvalidValue("yaml", "inputFormat"),
+		},
+		"invalid output content type": {
+			spec: TemplateTransformationSpec{
+				Template:          valueFromField(vffWithValue(`{{ toJson .Data }}`)),
+				OutputContentType: contentType("application/"),
+			},
+			expectError: apis.ErrInvalidValue("application/", "outputContentType", "mime: expected token after slash"),
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trn := &TemplateTransformation{Spec: tc.spec}
+
+			err := trn.Validate(context.Background())
+			if tc.expectError == nil {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectError.ViaField("spec").Error())
+		})
+	}
+}
+
+func TestTemplateTransformationSetDefaults(t *testing.T) {
+	xml := "application/xml"
+
+	testCases := map[string]struct {
+		trn       *TemplateTransformation
+		defaulted *TemplateTransformation
+	}{
+		"output content type set, needs no defaulting": {
+			trn:       &TemplateTransformation{Spec: TemplateTransformationSpec{OutputContentType: &xml}},
+			defaulted: &TemplateTransformation{Spec: TemplateTransformationSpec{OutputContentType: &xml}},
+		},
+		"output content type not set, needs defaulting": {
+			trn: &TemplateTransformation{},
+			defaulted: &TemplateTransformation{Spec: TemplateTransformationSpec{
+				OutputContentType: func() *string { ct := DefaultTemplateOutputContentType; return &ct }(),
+			}},
+		},
+		"nil does not defaulting": {
+			trn:       nil,
+			defaulted: nil,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			tc.trn.SetDefaults(context.Background())
+			assert.Equal(t, tc.defaulted, tc.trn)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1beta1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *Transformation) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1beta1.Transformation:
+		sink.ObjectMeta = t.ObjectMeta
+		sink.Spec = v1beta1.TransformationSpec{
+			Context:          transformsToV1beta1(t.Spec.Context),
+			Data:             transformsToV1beta1(t.Spec.Data),
+			SourceSpec:       t.Spec.SourceSpec,
+			AdapterOverrides: t.Spec.AdapterOverrides,
+		}
+		sink.Status = t.Status
+		return nil
+
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *Transformation) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1beta1.Transformation:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec = TransformationSpec{
+			Context:          transformsFromV1beta1(source.Spec.Context),
+			Data:             transformsFromV1beta1(source.Spec.Data),
+			SourceSpec:       source.Spec.SourceSpec,
+			AdapterOverrides: source.Spec.AdapterOverrides,
+		}
+		t.Status = source.Status
+		return nil
+
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+func transformsToV1beta1(ts []Transform) []v1beta1.Transform {
+	if ts == nil {
+		return nil
+	}
+
+	out := make([]v1beta1.Transform, len(ts))
+	for i, t := range ts {
+		out[i].Operation = t.Operation
+		if t.Paths != nil {
+			out[i].Paths = make([]v1beta1.Path, len(t.Paths))
+			for j, p := range t.Paths {
+				out[i].Paths[j] = v1beta1.Path(p)
+			}
+		}
+	}
+
+	return out
+}
+
+func transformsFromV1beta1(ts []v1beta1.Transform) []Transform {
+	if ts == nil {
+		return nil
+	}
+
+	out := make([]Transform, len(ts))
+	for i, t := range ts {
+		out[i].Operation = t.Operation
+		if t.Paths != nil {
+			out[i].Paths = make([]Path, len(t.Paths))
+			for j, p := range t.Paths {
+				out[i].Paths[j] = Path(p)
+			}
+		}
+	}
+
+	return out
+}
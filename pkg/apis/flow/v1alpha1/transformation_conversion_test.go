@@ -0,0 +1,63 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1beta1"
+)
+
+func TestTransformationConversionRoundTrip(t *testing.T) {
+	testCases := map[string]*Transformation{
+		"context and data": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "test"},
+			Spec: TransformationSpec{
+				Context: []Transform{{
+					Operation: "add",
+					Paths:     []Path{{Key: "type", Value: "io.test.transformed"}},
+				}},
+				Data: []Transform{{
+					Operation: "delete",
+					Paths:     []Path{{Key: "secret"}},
+				}},
+			},
+		},
+		"empty": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "test"},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			beta := &v1beta1.Transformation{}
+			require.NoError(t, tc.ConvertTo(context.Background(), beta))
+
+			got := &Transformation{}
+			require.NoError(t, got.ConvertFrom(context.Background(), beta))
+
+			assert.Equal(t, tc, got)
+		})
+	}
+}
@@ -41,6 +41,7 @@ type Transformation struct {
 var (
 	_ apis.Validatable = (*Transformation)(nil)
 	_ apis.Defaultable = (*Transformation)(nil)
+	_ apis.Convertible = (*Transformation)(nil)
 
 	_ v1alpha1.Reconcilable        = (*Transformation)(nil)
 	_ v1alpha1.AdapterConfigurable = (*Transformation)(nil)
@@ -29,5 +29,33 @@ func (t *Transformation) Validate(ctx context.Context) *apis.FieldError {
 
 // Validate implements apis.Validatable
 func (ts *TransformationSpec) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	var errs *apis.FieldError
+
+	for i, tr := range ts.Context {
+		errs = errs.Also(tr.Validate(ctx).ViaFieldIndex("context", i))
+	}
+	for i, tr := range ts.Data {
+		errs = errs.Also(tr.Validate(ctx).ViaFieldIndex("data", i))
+	}
+
+	return errs
+}
+
+// Validate implements apis.Validatable
+func (t *Transform) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	switch t.Operation {
+	case "":
+		errs = errs.Also(apis.ErrMissingField("operation"))
+	case "add", "delete", "shift", "store", "parse":
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(t.Operation, "operation"))
+	}
+
+	if len(t.Paths) == 0 {
+		errs = errs.Also(apis.ErrMissingField("paths"))
+	}
+
+	return errs
 }
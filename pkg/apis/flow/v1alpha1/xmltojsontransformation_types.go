@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
@@ -37,6 +39,8 @@ type XMLToJSONTransformation struct {
 }
 
 var (
+	_ apis.Validatable = (*XMLToJSONTransformation)(nil)
+
 	_ v1alpha1.Reconcilable        = (*XMLToJSONTransformation)(nil)
 	_ v1alpha1.AdapterConfigurable = (*XMLToJSONTransformation)(nil)
 	_ v1alpha1.EventSender         = (*XMLToJSONTransformation)(nil)
@@ -44,6 +48,29 @@ var (
 
 // XMLToJSONTransformationSpec defines the desired state of the component.
 type XMLToJSONTransformationSpec struct {
+	// Prefix of the JSON keys of XML attributes. Defaults to "-".
+	// +optional
+	AttributePrefix *string `json:"attributePrefix,omitempty"`
+
+	// JSON key of the text content of XML elements which also have
+	// attributes or child elements. Defaults to "#content".
+	// +optional
+	TextKey *string `json:"textKey,omitempty"`
+
+	// Whether to convert numeric and boolean text to JSON numbers and
+	// booleans instead of strings.
+	// +optional
+	InferTypes *bool `json:"inferTypes,omitempty"`
+
+	// Names of XML elements which are always converted to JSON arrays,
+	// even when they occur only once.
+	// +optional
+	ForceArray []string `json:"forceArray,omitempty"`
+
+	// Handling of XML namespaces. Defaults to "local".
+	// +optional
+	Namespaces *XMLNamespaceMode `json:"namespaces,omitempty"`
+
 	// EventOptions for targets
 	EventOptions *EventOptions `json:"eventOptions,omitempty"`
 
@@ -55,6 +82,21 @@ type XMLToJSONTransformationSpec struct {
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// XMLNamespaceMode determines how XML namespaces are reflected in JSON keys.
+type XMLNamespaceMode string
+
+// Namespace modes supported by XMLToJSONTransformation.
+const (
+	// XMLNamespaceLocal uses local names and keeps namespace declarations
+	// as attributes.
+	XMLNamespaceLocal XMLNamespaceMode = "local"
+	// XMLNamespacePrefixed uses names qualified with their namespace
+	// prefix and keeps namespace declarations as attributes.
+	XMLNamespacePrefixed XMLNamespaceMode = "prefixed"
+	// XMLNamespaceStrip uses local names and drops namespace declarations.
+	XMLNamespaceStrip XMLNamespaceMode = "strip"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // XMLToJSONTransformationList is a list of component instances.
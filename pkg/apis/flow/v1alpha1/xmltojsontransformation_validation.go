@@ -0,0 +1,59 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/xmljson"
+)
+
+// Validate implements apis.Validatable
+func (t *XMLToJSONTransformation) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *XMLToJSONTransformationSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.TextKey != nil && *s.TextKey == "" {
+		errs = errs.Also(apis.ErrInvalidValue(*s.TextKey, "textKey", "must not be empty"))
+	}
+
+	for i, name := range s.ForceArray {
+		if !xmljson.IsValidName(name) {
+			errs = errs.Also(apis.ErrInvalidArrayValue(name, "forceArray", i))
+		}
+	}
+
+	if ns := s.Namespaces; ns != nil {
+		switch *ns {
+		case XMLNamespaceLocal, XMLNamespacePrefixed, XMLNamespaceStrip:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*ns, "namespaces"))
+		}
+	}
+
+	if s.Sink.Ref != nil || s.Sink.URI != nil {
+		errs = errs.Also(s.Sink.Validate(ctx).ViaField("sink"))
+	}
+
+	return errs
+}
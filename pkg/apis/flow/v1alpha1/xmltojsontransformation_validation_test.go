@@ -0,0 +1,80 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"knative.dev/pkg/apis"
+)
+
+func TestXMLToJSONTransformationValidate(t *testing.T) {
+	str := func(s string) *string { return &s }
+	nsMode := func(m XMLNamespaceMode) *XMLNamespaceMode { return &m }
+
+	testCases := map[string]struct {
+		spec        XMLToJSONTransformationSpec
+		expectError *apis.FieldError
+	}{
+		"no options": {
+			spec: XMLToJSONTransformationSpec{},
+		},
+		"all options": {
+			spec: XMLToJSONTransformationSpec{
+				AttributePrefix: str(""),
+				TextKey:         str("_text"),
+				InferTypes:      func() *bool { b := true; return &b }(),
+				ForceArray:      []string{"item", "ns:entry"},
+				Namespaces:      nsMode(XMLNamespacePrefixed),
+			},
+		},
+		"empty text key": {
+			spec: XMLToJSONTransformationSpec{
+				TextKey: str(""),
+			},
+			expectError: apis.ErrInvalidValue("", "textKey", "must not be empty"),
+		},
+		"invalid forced array element": {
+			spec: XMLToJSONTransformationSpec{
+				ForceArray: []string{"item", "1st"},
+			},
+			expectError: apis.ErrInvalidArrayValue("1st", "forceArray", 1),
+		},
+		"invalid namespace mode": {
+			spec: XMLToJSONTransformationSpec{
+				Namespaces: nsMode("keep"),
+			},
+			expectError: apis.ErrInvalidValue("keep", "namespaces"),
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trn := &XMLToJSONTransformation{Spec: tc.spec}
+
+			err := trn.Validate(context.Background())
+			if tc.expectError == nil {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectError.ViaField("spec").Error())
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"knative.dev/pkg/apis"
@@ -59,6 +60,26 @@ type XSLTTransformationSpec struct {
 	// +optional
 	AllowPerEventXSLT *bool `json:"allowPerEventXSLT,omitempty"`
 
+	// Maximum number of compiled per-event XSLT documents kept in memory
+	// for reuse. Set to 0 to compile per-event XSLT documents at each event.
+	// +optional
+	PerEventXSLTCacheSize *int `json:"perEventXSLTCacheSize,omitempty"`
+
+	// Named stylesheets used instead of the default XSLT to transform
+	// events of given types.
+	// +optional
+	Stylesheets []XSLTNamedStylesheet `json:"stylesheets,omitempty"`
+
+	// Parameters passed to stylesheets at each transformation.
+	// +optional
+	Parameters []XSLTParameter `json:"parameters,omitempty"`
+
+	// ConfigMaps containing documents which can be referenced by the
+	// xsl:import and xsl:include elements of stylesheets, at the relative
+	// location "<configmap name>/<key>".
+	// +optional
+	Imports []corev1.LocalObjectReference `json:"imports,omitempty"`
+
 	// Support sending to an event sink instead of replying.
 	duckv1.SourceSpec `json:",inline"`
 
@@ -67,6 +88,36 @@ type XSLTTransformationSpec struct {
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// XSLTNamedStylesheet is a stylesheet used to transform events of given types.
+type XSLTNamedStylesheet struct {
+	// Name of the stylesheet.
+	Name string `json:"name"`
+
+	// Types of the events transformed using this stylesheet.
+	EventTypes []string `json:"eventTypes"`
+
+	// XSLT document.
+	XSLT ValueFromField `json:"xslt"`
+}
+
+// XSLTParameter is a top-level parameter passed to stylesheets, either
+// static or populated from incoming events.
+type XSLTParameter struct {
+	// Name of the xsl:param element.
+	Name string `json:"name"`
+
+	// Static value of the parameter.
+	// +optional
+	Value *string `json:"value,omitempty"`
+
+	// Name of the CloudEvent context attribute or extension which value is
+	// passed to the parameter. The parameter is not passed for events
+	// which don't have that attribute, in which case the default value
+	// declared in the stylesheet applies.
+	// +optional
+	ValueFromAttribute *string `json:"valueFromAttribute,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // XSLTTransformationList is a list of component instances.
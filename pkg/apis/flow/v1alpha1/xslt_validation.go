@@ -18,10 +18,19 @@ package v1alpha1
 
 import (
 	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"knative.dev/pkg/apis"
 )
 
+// xslNamespace is the namespace of XSLT elements and attributes.
+const xslNamespace = "http://www.w3.org/1999/XSL/Transform"
+
 // Validate implements apis.Validatable
 func (t *XSLTTransformation) Validate(ctx context.Context) *apis.FieldError {
 	return t.Spec.Validate(ctx).ViaField("spec")
@@ -31,13 +40,181 @@ func (t *XSLTTransformation) Validate(ctx context.Context) *apis.FieldError {
 func (s *XSLTTransformationSpec) Validate(ctx context.Context) *apis.FieldError {
 	var errs *apis.FieldError
 
-	if (s.AllowPerEventXSLT == nil || !*s.AllowPerEventXSLT) && !s.XSLT.IsInformed() {
-		errs = errs.Also(apis.ErrGeneric("when XSLT is empty, per event XSLT must be allowed", "allowPerEventXSLT", "xslt"))
+	if (s.AllowPerEventXSLT == nil || !*s.AllowPerEventXSLT) && !s.XSLT.IsInformed() && len(s.Stylesheets) == 0 {
+		errs = errs.Also(apis.ErrGeneric("when XSLT is empty, per event XSLT must be allowed or stylesheets must be set",
+			"allowPerEventXSLT", "xslt", "stylesheets"))
 	}
 
 	if err := s.XSLT.Validate(ctx); err != nil {
 		errs = errs.Also(err.ViaField("XSLT"))
+	} else if s.XSLT != nil && s.XSLT.Value != "" {
+		if err := validateStylesheet(s.XSLT.Value); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue("<xslt>", "value", err.Error()).ViaField("XSLT"))
+		}
+	}
+
+	if size := s.PerEventXSLTCacheSize; size != nil && *size < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*size, "perEventXSLTCacheSize", "must not be negative"))
+	}
+
+	errs = errs.Also(validateNamedStylesheets(ctx, s.Stylesheets))
+	errs = errs.Also(validateXSLTParameters(s.Parameters))
+
+	names := make(map[string]struct{}, len(s.Imports))
+	for i, ref := range s.Imports {
+		if ref.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("name").ViaFieldIndex("imports", i))
+			continue
+		}
+		if _, dup := names[ref.Name]; dup {
+			errs = errs.Also(apis.ErrInvalidValue(ref.Name, "name", "duplicate ConfigMap name").ViaFieldIndex("imports", i))
+		}
+		names[ref.Name] = struct{}{}
+	}
+
+	return errs
+}
+
+// validateNamedStylesheets ensures stylesheets have unique names, and that
+// each event type is associated to a single stylesheet.
+func validateNamedStylesheets(ctx context.Context, stylesheets []XSLTNamedStylesheet) *apis.FieldError {
+	var errs *apis.FieldError
+
+	names := make(map[string]struct{}, len(stylesheets))
+	eventTypes := make(map[string]string)
+
+	for i, st := range stylesheets {
+		var stErrs *apis.FieldError
+
+		switch _, dup := names[st.Name]; {
+		case st.Name == "":
+			stErrs = stErrs.Also(apis.ErrMissingField("name"))
+		case !xsltStylesheetNameRegexp.MatchString(st.Name):
+			stErrs = stErrs.Also(apis.ErrInvalidValue(st.Name, "name",
+				"must consist of alphanumeric characters or '_', and must not start with a digit"))
+		case dup:
+			stErrs = stErrs.Also(apis.ErrInvalidValue(st.Name, "name", "duplicate stylesheet name"))
+		}
+		names[st.Name] = struct{}{}
+
+		if len(st.EventTypes) == 0 {
+			stErrs = stErrs.Also(apis.ErrMissingField("eventTypes"))
+		}
+		for j, typ := range st.EventTypes {
+			if other, dup := eventTypes[typ]; dup {
+				err := apis.ErrInvalidArrayValue(typ, "eventTypes", j)
+				err.Details = "event type already handled by stylesheet " + strconv.Quote(other)
+				stErrs = stErrs.Also(err)
+				continue
+			}
+			eventTypes[typ] = st.Name
+		}
+
+		if !st.XSLT.IsInformed() {
+			stErrs = stErrs.Also(apis.ErrMissingField("xslt"))
+		} else if err := st.XSLT.Validate(ctx); err != nil {
+			stErrs = stErrs.Also(err.ViaField("xslt"))
+		} else if st.XSLT.Value != "" {
+			if err := validateStylesheet(st.XSLT.Value); err != nil {
+				stErrs = stErrs.Also(apis.ErrInvalidValue("<xslt>", "value", err.Error()).ViaField("xslt"))
+			}
+		}
+
+		errs = errs.Also(stErrs.ViaFieldIndex("stylesheets", i))
+	}
+
+	return errs
+}
+
+// validateXSLTParameters ensures parameters have unique names and a single
+// source for their value.
+func validateXSLTParameters(params []XSLTParameter) *apis.FieldError {
+	var errs *apis.FieldError
+
+	names := make(map[string]struct{}, len(params))
+
+	for i, p := range params {
+		var pErrs *apis.FieldError
+
+		switch _, dup := names[p.Name]; {
+		case p.Name == "":
+			pErrs = pErrs.Also(apis.ErrMissingField("name"))
+		case !xsltParamNameRegexp.MatchString(p.Name):
+			pErrs = pErrs.Also(apis.ErrInvalidValue(p.Name, "name", "not a valid XSLT parameter name"))
+		case dup:
+			pErrs = pErrs.Also(apis.ErrInvalidValue(p.Name, "name", "duplicate parameter name"))
+		}
+		names[p.Name] = struct{}{}
+
+		switch {
+		case p.Value != nil && p.ValueFromAttribute != nil:
+			pErrs = pErrs.Also(apis.ErrMultipleOneOf("value", "valueFromAttribute"))
+		case p.Value == nil && p.ValueFromAttribute == nil:
+			pErrs = pErrs.Also(apis.ErrMissingOneOf("value", "valueFromAttribute"))
+		case p.ValueFromAttribute != nil && !ceAttributeNameRegexp.MatchString(*p.ValueFromAttribute):
+			pErrs = pErrs.Also(apis.ErrInvalidValue(*p.ValueFromAttribute, "valueFromAttribute",
+				"not a valid CloudEvent attribute name"))
+		}
+
+		errs = errs.Also(pErrs.ViaFieldIndex("parameters", i))
 	}
 
 	return errs
 }
+
+// xsltStylesheetNameRegexp matches valid names of stylesheets. Names are used
+// in the names of the adapter's environment variables.
+var xsltStylesheetNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// xsltParamNameRegexp matches valid names of XSLT parameters.
+var xsltParamNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_.-]*$`)
+
+// ceAttributeNameRegexp matches valid names of CloudEvent context attributes.
+var ceAttributeNameRegexp = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// validateStylesheet ensures the given document is well-formed XML, and that
+// its root element is either an XSLT stylesheet or a literal result element
+// (simplified stylesheet).
+//
+// Stylesheets are compiled by libxslt inside the adapter, which can not be
+// linked into the webhook, so this check is limited to the structure of the
+// document and doesn't catch errors such as invalid XPath expressions.
+func validateStylesheet(doc string) error {
+	dec := xml.NewDecoder(strings.NewReader(doc))
+
+	var root *xml.StartElement
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if se, ok := tok.(xml.StartElement); ok && root == nil {
+			se := se.Copy()
+			root = &se
+		}
+	}
+
+	switch {
+	case root == nil:
+		return errors.New("document has no root element")
+
+	case root.Name.Space == xslNamespace:
+		if root.Name.Local != "stylesheet" && root.Name.Local != "transform" {
+			return errors.New("root element must be xsl:stylesheet or xsl:transform")
+		}
+
+	default:
+		for _, attr := range root.Attr {
+			if attr.Name.Space == xslNamespace && attr.Name.Local == "version" {
+				return nil
+			}
+		}
+		return errors.New("root element is neither an XSLT stylesheet nor a literal result element with an xsl:version attribute")
+	}
+
+	return nil
+}
@@ -21,13 +21,28 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	"knative.dev/pkg/apis"
 )
 
 var (
 	errs                      = &apis.FieldError{}
-	errXSLTAndOrAllowOverride = errs.Also(apis.ErrGeneric("when XSLT is empty, per event XSLT must be allowed", "allowPerEventXSLT", "xslt").ViaField("spec"))
+	errXSLTAndOrAllowOverride = errs.Also(apis.ErrGeneric("when XSLT is empty, per event XSLT must be allowed or stylesheets must be set", "allowPerEventXSLT", "xslt", "stylesheets").ViaField("spec"))
 	errXSLTTooMany            = errs.Also(apis.ErrMultipleOneOf("value", "valueFromSecret", "valueFromConfigMap").ViaField("XSLT").ViaField("spec"))
+	errXSLTNotWellFormed      = errs.Also(apis.ErrInvalidValue("<xslt>", "value", "XML syntax error on line 3: element <template> closed by </stylesheet>").ViaField("XSLT").ViaField("spec"))
+	errXSLTNotStylesheet      = errs.Also(apis.ErrInvalidValue("<xslt>", "value", "root element is neither an XSLT stylesheet nor a literal result element with an xsl:version attribute").ViaField("XSLT").ViaField("spec"))
+)
+
+const (
+	tStylesheet = `<xsl:stylesheet version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform">
+<xsl:template match="/"><out/></xsl:template>
+</xsl:stylesheet>`
+
+	tSimplifiedStylesheet = `<out xsl:version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform"/>`
+
+	tMalformedStylesheet = `<xsl:stylesheet version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform">
+<xsl:template match="/">
+</xsl:stylesheet>`
 )
 
 func TestXSLTTransformationValidate(t *testing.T) {
@@ -36,16 +51,28 @@ func TestXSLTTransformationValidate(t *testing.T) {
 		expectError *apis.FieldError
 	}{
 		"XSLT informed": {
-			xslt:        xsltTransform(xsltWithXSLT(valueFromField(vffWithValue(tValue)))),
+			xslt:        xsltTransform(xsltWithXSLT(valueFromField(vffWithValue(tStylesheet)))),
 			expectError: nil,
 		},
+		"XSLT simplified stylesheet": {
+			xslt:        xsltTransform(xsltWithXSLT(valueFromField(vffWithValue(tSimplifiedStylesheet)))),
+			expectError: nil,
+		},
+		"XSLT not well-formed": {
+			xslt:        xsltTransform(xsltWithXSLT(valueFromField(vffWithValue(tMalformedStylesheet)))),
+			expectError: errXSLTNotWellFormed,
+		},
+		"XSLT not a stylesheet": {
+			xslt:        xsltTransform(xsltWithXSLT(valueFromField(vffWithValue("<root/>")))),
+			expectError: errXSLTNotStylesheet,
+		},
 		"AllowOverride true": {
 			xslt:        xsltTransform(xsltWithAllowEventXSLT(true)),
 			expectError: nil,
 		},
 		"XSLT and AllowOverride true": {
 			xslt: xsltTransform(
-				xsltWithXSLT(valueFromField(vffWithValue(tValue))),
+				xsltWithXSLT(valueFromField(vffWithValue(tStylesheet))),
 				xsltWithAllowEventXSLT(true)),
 			expectError: nil,
 		},
@@ -65,6 +92,76 @@ func TestXSLTTransformationValidate(t *testing.T) {
 			expectError: errXSLTAndOrAllowOverride,
 		},
 
+		"Stylesheets without default XSLT": {
+			xslt: xsltTransform(xsltWithStylesheets(
+				XSLTNamedStylesheet{Name: "orders", EventTypes: []string{"order"}, XSLT: *valueFromField(vffWithValue(tStylesheet))},
+				XSLTNamedStylesheet{Name: "invoices", EventTypes: []string{"invoice"}, XSLT: *valueFromField(vffWithSecret(tName, tKey))},
+			)),
+			expectError: nil,
+		},
+		"Stylesheets invalid": {
+			xslt: xsltTransform(
+				xsltWithXSLT(valueFromField(vffWithValue(tStylesheet))),
+				xsltWithStylesheets(
+					XSLTNamedStylesheet{Name: "orders", EventTypes: []string{"order"}, XSLT: *valueFromField(vffWithValue(tStylesheet))},
+					XSLTNamedStylesheet{Name: "orders", EventTypes: []string{"order"}, XSLT: *valueFromField(vffWithValue("<root/>"))},
+					XSLTNamedStylesheet{Name: "my-invoices"},
+				)),
+			expectError: func() *apis.FieldError {
+				dupType := apis.ErrInvalidArrayValue("order", "eventTypes", 0)
+				dupType.Details = `event type already handled by stylesheet "orders"`
+				return errs.Also(
+					apis.ErrInvalidValue("orders", "name", "duplicate stylesheet name").ViaFieldIndex("stylesheets", 1),
+					dupType.ViaFieldIndex("stylesheets", 1),
+					apis.ErrInvalidValue("<xslt>", "value", "root element is neither an XSLT stylesheet nor a literal result element with an xsl:version attribute").
+						ViaField("xslt").ViaFieldIndex("stylesheets", 1),
+					apis.ErrInvalidValue("my-invoices", "name",
+						"must consist of alphanumeric characters or '_', and must not start with a digit").ViaFieldIndex("stylesheets", 2),
+					apis.ErrMissingField("eventTypes").ViaFieldIndex("stylesheets", 2),
+					apis.ErrMissingField("xslt").ViaFieldIndex("stylesheets", 2),
+				).ViaField("spec")
+			}(),
+		},
+		"Parameters informed": {
+			xslt: xsltTransform(
+				xsltWithXSLT(valueFromField(vffWithValue(tStylesheet))),
+				xsltWithParameters(
+					XSLTParameter{Name: "env", Value: strPtr("prod")},
+					XSLTParameter{Name: "source", ValueFromAttribute: strPtr("source")},
+				)),
+			expectError: nil,
+		},
+		"Parameters invalid": {
+			xslt: xsltTransform(
+				xsltWithXSLT(valueFromField(vffWithValue(tStylesheet))),
+				xsltWithParameters(
+					XSLTParameter{Name: "env", Value: strPtr("prod"), ValueFromAttribute: strPtr("source")},
+					XSLTParameter{Name: "env"},
+					XSLTParameter{Name: "1st", ValueFromAttribute: strPtr("Source")},
+				)),
+			expectError: errs.Also(
+				apis.ErrMultipleOneOf("value", "valueFromAttribute").ViaFieldIndex("parameters", 0),
+				apis.ErrInvalidValue("env", "name", "duplicate parameter name").ViaFieldIndex("parameters", 1),
+				apis.ErrMissingOneOf("value", "valueFromAttribute").ViaFieldIndex("parameters", 1),
+				apis.ErrInvalidValue("1st", "name", "not a valid XSLT parameter name").ViaFieldIndex("parameters", 2),
+				apis.ErrInvalidValue("Source", "valueFromAttribute", "not a valid CloudEvent attribute name").ViaFieldIndex("parameters", 2),
+			).ViaField("spec"),
+		},
+		"Imports and cache size invalid": {
+			xslt: xsltTransform(
+				xsltWithAllowEventXSLT(true),
+				func(xslt *XSLTTransformation) {
+					size := -1
+					xslt.Spec.PerEventXSLTCacheSize = &size
+					xslt.Spec.Imports = []corev1.LocalObjectReference{{Name: "common"}, {Name: "common"}, {}}
+				}),
+			expectError: errs.Also(
+				apis.ErrInvalidValue(-1, "perEventXSLTCacheSize", "must not be negative"),
+				apis.ErrInvalidValue("common", "name", "duplicate ConfigMap name").ViaFieldIndex("imports", 1),
+				apis.ErrMissingField("name").ViaFieldIndex("imports", 2),
+			).ViaField("spec"),
+		},
+
 		"XSLT informed wrong": {
 			xslt: xsltTransform(xsltWithXSLT(
 				valueFromField(
@@ -78,7 +175,12 @@ func TestXSLTTransformationValidate(t *testing.T) {
 	for name, tc := range testCases {
 		//nolint:scopelint
 		t.Run(name, func(t *testing.T) {
-			assert.Equal(t, tc.expectError, tc.xslt.Validate(context.Background()))
+			err := tc.xslt.Validate(context.Background())
+			if tc.expectError == nil {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectError.Error())
 		})
 	}
 }
@@ -106,3 +208,19 @@ func xsltWithAllowEventXSLT(allowEventXSLT bool) xsltTransformOption {
 		xslt.Spec.AllowPerEventXSLT = &allowEventXSLT
 	}
 }
+
+func xsltWithStylesheets(stylesheets ...XSLTNamedStylesheet) xsltTransformOption {
+	return func(xslt *XSLTTransformation) {
+		xslt.Spec.Stylesheets = stylesheets
+	}
+}
+
+func xsltWithParameters(params ...XSLTParameter) xsltTransformOption {
+	return func(xslt *XSLTTransformation) {
+		xslt.Spec.Parameters = params
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (t *Transformation) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (ts *TransformationSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	for i, tr := range ts.Context {
+		errs = errs.Also(tr.Validate(ctx).ViaFieldIndex("context", i))
+	}
+	for i, tr := range ts.Data {
+		errs = errs.Also(tr.Validate(ctx).ViaFieldIndex("data", i))
+	}
+
+	return errs
+}
+
+// Validate implements apis.Validatable
+func (t *Transform) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	switch t.Operation {
+	case "":
+		errs = errs.Also(apis.ErrMissingField("operation"))
+	case "add", "delete", "shift", "store", "parse":
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(t.Operation, "operation"))
+	}
+
+	if len(t.Paths) == 0 {
+		errs = errs.Also(apis.ErrMissingField("paths"))
+	}
+
+	return errs
+}
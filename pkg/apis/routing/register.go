@@ -16,7 +16,23 @@ limitations under the License.
 
 package routing
 
+import "k8s.io/apimachinery/pkg/runtime/schema"
+
 const (
 	// GroupName is the name of the API group this package's resources belong to.
 	GroupName = "routing.triggermesh.io"
 )
+
+var (
+	// FilterResource respresents a content-based events filter.
+	FilterResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "filters",
+	}
+
+	// SplitterResource respresents an events splitter.
+	SplitterResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "splitters",
+	}
+)
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1beta1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (f *Filter) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1beta1.Filter:
+		sink.ObjectMeta = f.ObjectMeta
+		sink.Spec = v1beta1.FilterSpec{
+			Expression:       f.Spec.Expression,
+			Sink:             destinationToV1beta1(f.Spec.Sink),
+			AdapterOverrides: f.Spec.AdapterOverrides,
+		}
+		sink.Status = f.Status
+		return nil
+
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (f *Filter) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1beta1.Filter:
+		f.ObjectMeta = source.ObjectMeta
+		f.Spec = FilterSpec{
+			Expression:       source.Spec.Expression,
+			Sink:             destinationFromV1beta1(source.Spec.Sink),
+			AdapterOverrides: source.Spec.AdapterOverrides,
+		}
+		f.Status = source.Status
+		return nil
+
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// destinationToV1beta1 converts an optional Destination to the required
+// Destination used in v1beta1 specs.
+func destinationToV1beta1(d *duckv1.Destination) duckv1.Destination {
+	if d == nil {
+		return duckv1.Destination{}
+	}
+	return *d
+}
+
+// destinationFromV1beta1 converts a Destination from a v1beta1 spec to an
+// optional Destination.
+func destinationFromV1beta1(d duckv1.Destination) *duckv1.Destination {
+	if d.Ref == nil && d.URI == nil {
+		return nil
+	}
+	return &d
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1beta1"
+)
+
+func TestFilterConversionRoundTrip(t *testing.T) {
+	testCases := map[string]*Filter{
+		"with sink": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "test"},
+			Spec: FilterSpec{
+				Expression: `$type.(string) == "io.test.event"`,
+				Sink:       &duckv1.Destination{URI: apis.HTTP("example.com")},
+			},
+		},
+		"without sink": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "test"},
+			Spec: FilterSpec{
+				Expression: `$type.(string) == "io.test.event"`,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			beta := &v1beta1.Filter{}
+			require.NoError(t, tc.ConvertTo(context.Background(), beta))
+
+			got := &Filter{}
+			require.NoError(t, got.ConvertFrom(context.Background(), beta))
+
+			assert.Equal(t, tc, got)
+		})
+	}
+}
+
+func TestSplitterConversionRoundTrip(t *testing.T) {
+	in := &Splitter{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "test"},
+		Spec: SplitterSpec{
+			Path: "items",
+			CEContext: CloudEventContext{
+				Type:       "io.test.item",
+				Source:     "test/{.id}",
+				Extensions: map[string]string{"ext": "val"},
+			},
+			Sink: &duckv1.Destination{URI: apis.HTTP("example.com")},
+		},
+	}
+
+	beta := &v1beta1.Splitter{}
+	require.NoError(t, in.ConvertTo(context.Background(), beta))
+
+	got := &Splitter{}
+	require.NoError(t, got.ConvertFrom(context.Background(), beta))
+
+	assert.Equal(t, in, got)
+}
@@ -42,6 +42,7 @@ type Filter struct {
 var (
 	_ apis.Validatable = (*Filter)(nil)
 	_ apis.Defaultable = (*Filter)(nil)
+	_ apis.Convertible = (*Filter)(nil)
 
 	_ v1alpha1.Reconcilable        = (*Filter)(nil)
 	_ v1alpha1.AdapterConfigurable = (*Filter)(nil)
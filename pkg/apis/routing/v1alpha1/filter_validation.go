@@ -18,7 +18,6 @@ package v1alpha1
 
 import (
 	"context"
-	"fmt"
 
 	"knative.dev/pkg/apis"
 
@@ -32,14 +31,19 @@ func (f *Filter) Validate(ctx context.Context) *apis.FieldError {
 
 // Validate implements apis.Validatable
 func (fs *FilterSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
 	if fs.Expression == "" {
-		return apis.ErrMissingField("Expression")
+		errs = errs.Also(apis.ErrMissingField("expression"))
+	} else if _, err := cel.CompileExpression(fs.Expression); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(fs.Expression, "expression", "cannot compile expression: "+err.Error()))
 	}
+
 	if fs.Sink == nil {
-		return apis.ErrMissingField("Sink")
+		errs = errs.Also(apis.ErrMissingField("sink"))
+	} else {
+		errs = errs.Also(fs.Sink.Validate(ctx).ViaField("sink"))
 	}
-	if _, err := cel.CompileExpression(fs.Expression); err != nil {
-		return apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "Expression")
-	}
-	return nil
+
+	return errs
 }
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestFilterValidate(t *testing.T) {
+	sink := &duckv1.Destination{URI: apis.HTTP("example.com")}
+
+	testCases := map[string]struct {
+		spec              FilterSpec
+		expectErrContains string
+	}{
+		"valid spec": {
+			spec: FilterSpec{
+				Expression: `$type.(string) == "io.test.event"`,
+				Sink:       sink,
+			},
+		},
+		"missing fields": {
+			spec:              FilterSpec{},
+			expectErrContains: "missing field(s): spec.expression, spec.sink",
+		},
+		"invalid expression": {
+			spec: FilterSpec{
+				Expression: `$type.(string) ==`,
+				Sink:       sink,
+			},
+			expectErrContains: "invalid value: $type.(string) ==: spec.expression\ncannot compile expression",
+		},
+		"invalid sink": {
+			spec: FilterSpec{
+				Expression: `$type.(string) == "io.test.event"`,
+				Sink:       &duckv1.Destination{},
+			},
+			expectErrContains: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			f := &Filter{Spec: tc.spec}
+
+			err := f.Validate(context.Background())
+
+			if tc.expectErrContains == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.expectErrContains)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1beta1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *Splitter) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1beta1.Splitter:
+		sink.ObjectMeta = s.ObjectMeta
+		sink.Spec = v1beta1.SplitterSpec{
+			Path: s.Spec.Path,
+			CEContext: v1beta1.CloudEventContext{
+				Type:       s.Spec.CEContext.Type,
+				Source:     s.Spec.CEContext.Source,
+				Extensions: s.Spec.CEContext.Extensions,
+			},
+			Sink:             destinationToV1beta1(s.Spec.Sink),
+			AdapterOverrides: s.Spec.AdapterOverrides,
+		}
+		sink.Status = s.Status
+		return nil
+
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *Splitter) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1beta1.Splitter:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec = SplitterSpec{
+			Path: source.Spec.Path,
+			CEContext: CloudEventContext{
+				Type:       source.Spec.CEContext.Type,
+				Source:     source.Spec.CEContext.Source,
+				Extensions: source.Spec.CEContext.Extensions,
+			},
+			Sink:             destinationFromV1beta1(source.Spec.Sink),
+			AdapterOverrides: source.Spec.AdapterOverrides,
+		}
+		s.Status = source.Status
+		return nil
+
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
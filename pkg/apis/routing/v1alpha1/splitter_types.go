@@ -42,6 +42,7 @@ type Splitter struct {
 var (
 	_ apis.Validatable = (*Splitter)(nil)
 	_ apis.Defaultable = (*Splitter)(nil)
+	_ apis.Convertible = (*Splitter)(nil)
 
 	_ v1alpha1.Reconcilable        = (*Splitter)(nil)
 	_ v1alpha1.AdapterConfigurable = (*Splitter)(nil)
@@ -29,5 +29,23 @@ func (s *Splitter) Validate(ctx context.Context) *apis.FieldError {
 
 // Validate implements apis.Validatable
 func (ss *SplitterSpec) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	var errs *apis.FieldError
+
+	// The path isn't validated: GJSON accepts any path and returns no result
+	// when a path doesn't match. An empty path selects the root of the data.
+
+	if ss.CEContext.Type == "" {
+		errs = errs.Also(apis.ErrMissingField("ceContext.type"))
+	}
+	if ss.CEContext.Source == "" {
+		errs = errs.Also(apis.ErrMissingField("ceContext.source"))
+	}
+
+	if ss.Sink == nil {
+		errs = errs.Also(apis.ErrMissingField("sink"))
+	} else {
+		errs = errs.Also(ss.Sink.Validate(ctx).ViaField("sink"))
+	}
+
+	return errs
 }
@@ -0,0 +1,74 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestSplitterValidate(t *testing.T) {
+	ceContext := CloudEventContext{
+		Type:   "io.test.item",
+		Source: "test",
+	}
+	sink := &duckv1.Destination{URI: apis.HTTP("example.com")}
+
+	testCases := map[string]struct {
+		spec        SplitterSpec
+		expectError string
+	}{
+		"valid spec, root path": {
+			spec: SplitterSpec{CEContext: ceContext, Sink: sink},
+		},
+		"valid spec, nested path": {
+			spec: SplitterSpec{Path: "items.#(kind==\"a.b\")#.name", CEContext: ceContext, Sink: sink},
+		},
+		"valid spec, escaped dot": {
+			spec: SplitterSpec{Path: `items.some\.key`, CEContext: ceContext, Sink: sink},
+		},
+		"valid spec, JSON Lines": {
+			spec: SplitterSpec{Path: "..#.name", CEContext: ceContext, Sink: sink},
+		},
+		"valid spec, brackets in quoted query value": {
+			spec: SplitterSpec{Path: `items.#(last==")")#`, CEContext: ceContext, Sink: sink},
+		},
+		"missing context attributes and sink": {
+			spec:        SplitterSpec{},
+			expectError: "missing field(s): spec.ceContext.source, spec.ceContext.type, spec.sink",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			s := &Splitter{Spec: tc.spec}
+
+			err := s.Validate(context.Background())
+			if tc.expectError == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectError)
+		})
+	}
+}
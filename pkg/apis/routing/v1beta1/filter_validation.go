@@ -0,0 +1,44 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+)
+
+// Validate implements apis.Validatable
+func (f *Filter) Validate(ctx context.Context) *apis.FieldError {
+	return f.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (fs *FilterSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if fs.Expression == "" {
+		errs = errs.Also(apis.ErrMissingField("expression"))
+	} else if _, err := cel.CompileExpression(fs.Expression); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "expression"))
+	}
+
+	return errs.Also(fs.Sink.Validate(ctx).ViaField("sink"))
+}
@@ -207,6 +207,12 @@ var (
 		Resource: "ibmmqsources",
 	}
 
+	// JiraSourceResource represents an event source for Jira.
+	JiraSourceResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "jirasources",
+	}
+
 	// OCIMetricsSourceResource represents an event source for OCI Metrics.
 	OCIMetricsSourceResource = schema.GroupResource{
 		Group:    GroupName,
@@ -0,0 +1,51 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	pkgapis "knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (a *AWSAuth) Validate(ctx context.Context) *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
+
+	if c := a.Credentials; c != nil {
+		errs = errs.Also(
+			c.AccessKeyID.Validate(ctx).ViaField("credentials", "accessKeyID"),
+			c.SecretAccessKey.Validate(ctx).ViaField("credentials", "secretAccessKey"),
+		)
+	}
+
+	if a.EksIAMRole != nil {
+		errs = errs.Also(apis.ValidateARN(*a.EksIAMRole, "iamRole", "iam"))
+	}
+
+	return errs
+}
+
+// Validate implements apis.Validatable
+func (e *AWSEndpoint) Validate(ctx context.Context) *pkgapis.FieldError {
+	if e.URL == nil {
+		return nil
+	}
+	return apis.ValidateURL(e.URL, "url", "http", "https")
+}
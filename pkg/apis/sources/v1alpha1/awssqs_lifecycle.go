@@ -61,9 +61,15 @@ const (
 
 // GetEventTypes implements EventSource.
 func (s *AWSSQSSource) GetEventTypes() []string {
-	return []string{
+	eventTypes := []string{
 		AWSEventType(s.Spec.ARN.Service, AWSSQSGenericEventType),
 	}
+
+	if mp := s.Spec.MessageProcessor; mp != nil && *mp == "sns" {
+		eventTypes = append(eventTypes, AWSEventType("sns", AWSSNSGenericEventType))
+	}
+
+	return eventTypes
 }
 
 // AsEventSource implements EventSource.
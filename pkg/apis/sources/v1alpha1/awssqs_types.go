@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgapis "knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/triggermesh/triggermesh/pkg/apis"
@@ -39,6 +40,9 @@ type AWSSQSSource struct {
 
 // Check the interfaces the event source should be implementing.
 var (
+	_ pkgapis.Validatable = (*AWSSQSSource)(nil)
+	_ pkgapis.Defaultable = (*AWSSQSSource)(nil)
+
 	_ v1alpha1.Reconcilable           = (*AWSSQSSource)(nil)
 	_ v1alpha1.AdapterConfigurable    = (*AWSSQSSource)(nil)
 	_ v1alpha1.EventSource            = (*AWSSQSSource)(nil)
@@ -59,7 +63,11 @@ type AWSSQSSourceSpec struct {
 	ReceiveOptions *AWSSQSSourceReceiveOptions `json:"receiveOptions,omitempty"`
 
 	// Name of the message processor to use for converting SQS messages to CloudEvents.
-	// Supported values are "default" and "s3".
+	// Supported values are "default", "s3", "sns" and "cloudevents".
+	//
+	// The "sns" processor unwraps notifications from Amazon SNS topics
+	// subscribed to the queue, and the "cloudevents" processor passes through
+	// messages which body is a CloudEvent in structured content mode.
 	// +optional
 	MessageProcessor *string `json:"messageProcessor,omitempty"`
 
@@ -89,6 +97,30 @@ type AWSSQSSourceReceiveOptions struct {
 	//
 	// +optional
 	VisibilityTimeout *apis.Duration `json:"visibilityTimeout,omitempty"`
+
+	// Base delay before a message which could not be delivered to the sink
+	// becomes visible again in the queue. The delay doubles with each
+	// subsequent delivery attempt, up to the maximum of 12 hours allowed by
+	// Amazon SQS.
+	// Expressed as a duration string, which format is documented at https://pkg.go.dev/time#ParseDuration.
+	//
+	// If not defined, undelivered messages become visible again once their
+	// visibility timeout expires.
+	//
+	// +optional
+	RetryBackoff *apis.Duration `json:"retryBackoff,omitempty"`
+
+	// Number of times a message can be received before it is deleted from
+	// the queue without having been delivered to the sink.
+	//
+	// This option is ignored if the queue has a redrive policy, in which case
+	// Amazon SQS moves such messages to the dead-letter queue after the
+	// policy's own maximum receive count is exceeded.
+	//
+	// If not defined, undelivered messages are retried until they expire.
+	//
+	// +optional
+	MaxReceiveCount *int `json:"maxReceiveCount,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
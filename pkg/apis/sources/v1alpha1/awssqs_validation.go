@@ -0,0 +1,74 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	pkgapis "knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (s *AWSSQSSource) Validate(ctx context.Context) *pkgapis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *AWSSQSSourceSpec) Validate(ctx context.Context) *pkgapis.FieldError {
+	errs := apis.ValidateARN(s.ARN, "arn", "sqs")
+
+	if ro := s.ReceiveOptions; ro != nil {
+		errs = errs.Also(ro.Validate(ctx).ViaField("receiveOptions"))
+	}
+
+	if mp := s.MessageProcessor; mp != nil {
+		switch *mp {
+		case "default", "s3", "sns", "cloudevents":
+		default:
+			errs = errs.Also(pkgapis.ErrInvalidValue(*mp, "messageProcessor"))
+		}
+	}
+
+	errs = errs.Also(s.Auth.Validate(ctx).ViaField("auth"))
+
+	if s.Endpoint != nil {
+		errs = errs.Also(s.Endpoint.Validate(ctx).ViaField("endpoint"))
+	}
+
+	return errs.Also(s.Sink.Validate(ctx).ViaField("sink"))
+}
+
+// Validate implements apis.Validatable
+func (o *AWSSQSSourceReceiveOptions) Validate(ctx context.Context) *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
+
+	if o.VisibilityTimeout != nil {
+		errs = errs.Also(apis.ValidateDuration(*o.VisibilityTimeout, "visibilityTimeout"))
+	}
+
+	if o.RetryBackoff != nil {
+		errs = errs.Also(apis.ValidateDuration(*o.RetryBackoff, "retryBackoff"))
+	}
+
+	if mrc := o.MaxReceiveCount; mrc != nil && *mrc < 1 {
+		errs = errs.Also(pkgapis.ErrInvalidValue(*mrc, "maxReceiveCount"))
+	}
+
+	return errs
+}
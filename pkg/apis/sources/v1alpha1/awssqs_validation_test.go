@@ -0,0 +1,117 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pkgapis "knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestAWSSQSSourceValidate(t *testing.T) {
+	sink := duckv1.SourceSpec{
+		Sink: duckv1.Destination{URI: pkgapis.HTTP("example.com")},
+	}
+
+	queueARN := apis.ARN{
+		Partition: "aws",
+		Service:   "sqs",
+		Region:    "us-test-0",
+		AccountID: "123456789012",
+		Resource:  "my-queue",
+	}
+
+	testCases := map[string]struct {
+		spec        AWSSQSSourceSpec
+		expectError string
+	}{
+		"valid spec": {
+			spec: AWSSQSSourceSpec{SourceSpec: sink, ARN: queueARN},
+		},
+		"valid spec with message processor": {
+			spec: AWSSQSSourceSpec{SourceSpec: sink, ARN: queueARN, MessageProcessor: strPtr("sns")},
+		},
+		"ARN of another service": {
+			spec: AWSSQSSourceSpec{
+				SourceSpec: sink,
+				ARN: apis.ARN{
+					Partition: "aws",
+					Service:   "sns",
+					Region:    "us-test-0",
+					AccountID: "123456789012",
+					Resource:  "my-topic",
+				},
+			},
+			expectError: "invalid value: arn:aws:sns:us-test-0:123456789012:my-topic: spec.arn\n" +
+				"ARN must refer to a resource of one of the AWS services: sqs",
+		},
+		"invalid options": {
+			spec: AWSSQSSourceSpec{
+				SourceSpec: sink,
+				ARN:        queueARN,
+				ReceiveOptions: &AWSSQSSourceReceiveOptions{
+					VisibilityTimeout: durationPtr(-time.Second),
+					RetryBackoff:      durationPtr(0),
+					MaxReceiveCount:   intPtr(0),
+				},
+				MessageProcessor: strPtr("kinesis"),
+				Endpoint: &AWSEndpoint{
+					URL: &pkgapis.URL{Path: "/relative"},
+				},
+			},
+			expectError: "invalid value: -1s: spec.receiveOptions.visibilityTimeout\nduration must be positive\n" +
+				"invalid value: /relative: spec.endpoint.url\nURL must be absolute\n" +
+				"invalid value: 0: spec.receiveOptions.maxReceiveCount\n" +
+				"invalid value: 0s: spec.receiveOptions.retryBackoff\nduration must be positive\n" +
+				"invalid value: kinesis: spec.messageProcessor",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AWSSQSSource{Spec: tc.spec}
+
+			err := src.Validate(context.Background())
+			if tc.expectError == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectError)
+		})
+	}
+}
+
+func durationPtr(d time.Duration) *apis.Duration {
+	ad := apis.Duration(d)
+	return &ad
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int {
+	return &i
+}
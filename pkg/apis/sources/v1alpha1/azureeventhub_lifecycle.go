@@ -75,3 +75,11 @@ func (s *AzureEventHubSource) GetEventTypes() []string {
 func (s *AzureEventHubSource) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return s.Spec.AdapterOverrides
 }
+
+// WantsAdapterClusterRole implements AdapterClusterRoleConsumer.
+func (s *AzureEventHubSource) WantsAdapterClusterRole() bool {
+	// Persisting partition leases and offset checkpoints to a ConfigMap
+	// requires write access to the Kubernetes API.
+	cs := s.Spec.CheckpointStore
+	return cs != nil && cs.ConfigMap != nil
+}
@@ -42,6 +42,8 @@ var (
 	_ v1alpha1.AdapterConfigurable = (*AzureEventHubSource)(nil)
 	_ v1alpha1.EventSource         = (*AzureEventHubSource)(nil)
 	_ v1alpha1.EventSender         = (*AzureEventHubSource)(nil)
+
+	_ v1alpha1.AdapterClusterRoleConsumer = (*AzureEventHubSource)(nil)
 )
 
 // AzureEventHubSourceSpec defines the desired state of the event source.
@@ -57,11 +59,74 @@ type AzureEventHubSourceSpec struct {
 	// Authentication method to interact with the Azure Event Hubs API.
 	Auth AzureAuth `json:"auth"`
 
+	// Name of the consumer group to receive events from. Defaults to
+	// "$Default".
+	// +optional
+	ConsumerGroup *string `json:"consumerGroup,omitempty"`
+
+	// Position in the stream of each partition from which events start
+	// being received when no checkpoint exists for that partition.
+	// Defaults to "latest".
+	// +optional
+	StartPosition *AzureEventHubStartPosition `json:"startPosition,omitempty"`
+
+	// Store in which partition leases and offset checkpoints are persisted.
+	// When set, partitions are balanced across all replicas of the
+	// adapter, and receiving resumes from the last checkpoint after a
+	// restart. When unset, every replica receives events from all
+	// partitions.
+	// +optional
+	CheckpointStore *AzureEventHubCheckpointStore `json:"checkpointStore,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// AzureEventHubStartPosition is a position in the stream of an Event Hubs
+// partition.
+type AzureEventHubStartPosition string
+
+// Supported start positions.
+const (
+	// Receive only events enqueued after the receiver was started.
+	AzureEventHubStartPositionLatest AzureEventHubStartPosition = "latest"
+	// Receive all events retained in the partition.
+	AzureEventHubStartPositionEarliest AzureEventHubStartPosition = "earliest"
+)
+
+// AzureEventHubCheckpointStore contains the parameters of a store for
+// partition leases and offset checkpoints. Exactly one store must be set.
+type AzureEventHubCheckpointStore struct {
+	// Azure Blob Storage container, or container of any service compatible
+	// with the Azure Blob Storage API.
+	// +optional
+	Blob *AzureEventHubBlobCheckpointStore `json:"blob,omitempty"`
+
+	// Kubernetes ConfigMap.
+	// +optional
+	ConfigMap *AzureEventHubConfigMapCheckpointStore `json:"configMap,omitempty"`
+}
+
+// AzureEventHubBlobCheckpointStore references a Blob container in which
+// partition leases and offset checkpoints are persisted.
+type AzureEventHubBlobCheckpointStore struct {
+	// Connection string of the storage account.
+	ConnectionString v1alpha1.ValueFromField `json:"connectionString"`
+
+	// Name of the container. It is created by the adapter if it doesn't
+	// exist.
+	Container string `json:"container"`
+}
+
+// AzureEventHubConfigMapCheckpointStore references a ConfigMap in which
+// partition leases and offset checkpoints are persisted.
+type AzureEventHubConfigMapCheckpointStore struct {
+	// Name of the ConfigMap. It is created by the adapter if it doesn't
+	// exist.
+	Name string `json:"name"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // AzureEventHubSourceList contains a list of event sources.
@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -54,6 +55,10 @@ type AzureServiceBusQueueSourceSpec struct {
 	// - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/queues/{queueName}
 	QueueID AzureResourceID `json:"queueID"`
 
+	// Options that control the behavior of message receivers.
+	// +optional
+	ReceiveOptions *AzureServiceBusReceiveOptions `json:"receiveOptions,omitempty"`
+
 	// Authentication method to interact with Azure Service Bus.
 	Auth AzureAuth `json:"auth"`
 
@@ -70,3 +75,48 @@ type AzureServiceBusQueueSourceList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []AzureServiceBusQueueSource `json:"items"`
 }
+
+// AzureServiceBusReceiveOptions defines options that control the behavior of
+// Azure Service Bus message receivers.
+type AzureServiceBusReceiveOptions struct {
+	// Maximum number of messages which are delivered to the sink
+	// concurrently. When sessions are enabled, this is the maximum number of
+	// sessions which are processed concurrently, while the messages of a
+	// given session are always delivered in order.
+	//
+	// If not defined, messages are delivered one at a time.
+	//
+	// +optional
+	MaxConcurrency *int `json:"maxConcurrency,omitempty"`
+
+	// Base delay before a message which could not be delivered to the sink
+	// is abandoned and becomes available again in the Service Bus entity.
+	// The delay doubles with each subsequent delivery attempt, up to a
+	// maximum of 5 minutes.
+	// Expressed as a duration string, which format is documented at https://pkg.go.dev/time#ParseDuration.
+	//
+	// If not defined, undelivered messages are abandoned immediately.
+	//
+	// +optional
+	RetryBackoff *apis.Duration `json:"retryBackoff,omitempty"`
+
+	// Number of delivery attempts after which a message which could not be
+	// delivered to the sink is moved to the dead-letter sub-queue of the
+	// Service Bus entity.
+	//
+	// If not defined, Service Bus moves such messages to the dead-letter
+	// sub-queue after the entity's own maximum delivery count is exceeded.
+	//
+	// +optional
+	MaxDeliveryAttempts *int `json:"maxDeliveryAttempts,omitempty"`
+
+	// Whether the Service Bus entity requires sessions. Messages which
+	// belong to the same session are delivered to the sink in order.
+	//
+	// For the AzureServiceBusTopicSource, this option only takes effect when
+	// the Subscription to the Topic is created, and can not be changed
+	// afterwards.
+	//
+	// +optional
+	Sessions *bool `json:"sessions,omitempty"`
+}
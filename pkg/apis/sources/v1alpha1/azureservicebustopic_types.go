@@ -54,6 +54,10 @@ type AzureServiceBusTopicSourceSpec struct {
 	// - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}
 	TopicID AzureResourceID `json:"topicID"`
 
+	// Options that control the behavior of message receivers.
+	// +optional
+	ReceiveOptions *AzureServiceBusReceiveOptions `json:"receiveOptions,omitempty"`
+
 	// Authentication method to interact with the Azure REST API.
 	// This event source only supports the ServicePrincipal authentication.
 	Auth AzureAuth `json:"auth"`
@@ -18,8 +18,10 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgapis "knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -64,12 +66,97 @@ type CloudEventsSourceSpec struct {
 // HTTPCredentials to be used when receiving requests.
 type HTTPCredentials struct {
 	BasicAuths []HTTPBasicAuth `json:"basicAuths,omitempty"`
+
+	// API keys accepted in the X-API-Key header of requests.
+	// +optional
+	APIKeys []HTTPAPIKey `json:"apiKeys,omitempty"`
+
+	// Bearer JSON Web Tokens (JWT) accepted in the Authorization header of
+	// requests.
+	// +optional
+	Tokens *HTTPTokenAuth `json:"tokens,omitempty"`
 }
 
 // HTTPBasicAuth credentials.
 type HTTPBasicAuth struct {
 	Username string                  `json:"username"`
 	Password v1alpha1.ValueFromField `json:"password"`
+
+	// Policy applied to requests authenticated with these credentials.
+	// +optional
+	Policy *CallerPolicy `json:"policy,omitempty"`
+}
+
+// HTTPAPIKey credentials.
+type HTTPAPIKey struct {
+	// Name of the caller owning the key.
+	Name string                  `json:"name"`
+	Key  v1alpha1.ValueFromField `json:"key"`
+
+	// Policy applied to requests authenticated with this key.
+	// +optional
+	Policy *CallerPolicy `json:"policy,omitempty"`
+}
+
+// HTTPTokenAuth contains the parameters used to validate bearer JSON Web
+// Tokens (JWT). Exactly one of JWKS or JWKSURL must be set. Tokens must carry
+// an "exp" claim.
+type HTTPTokenAuth struct {
+	// JSON Web Key Set (JWKS) containing the public keys used to verify the
+	// signature of tokens.
+	// +optional
+	JWKS *v1alpha1.ValueFromField `json:"jwks,omitempty"`
+
+	// URL from which the JSON Web Key Set (JWKS) containing the public keys
+	// used to verify the signature of tokens is retrieved.
+	// +optional
+	JWKSURL *pkgapis.URL `json:"jwksURL,omitempty"`
+
+	// Expected value of the "iss" claim of tokens.
+	// +optional
+	Issuer *string `json:"issuer,omitempty"`
+
+	// Expected value of the "aud" claim of tokens.
+	// +optional
+	Audience *string `json:"audience,omitempty"`
+
+	// Name of the claim which identifies the caller. Defaults to "sub".
+	// +optional
+	CallerClaim *string `json:"callerClaim,omitempty"`
+
+	// Policies applied to requests authenticated with a token, by caller.
+	// Callers which are not listed here are subject to no policy.
+	// +optional
+	Callers []HTTPTokenCaller `json:"callers,omitempty"`
+}
+
+// HTTPTokenCaller is a caller authenticated with a bearer token.
+type HTTPTokenCaller struct {
+	// Value of the caller claim which identifies the caller.
+	Name   string       `json:"name"`
+	Policy CallerPolicy `json:"policy"`
+}
+
+// CallerPolicy restricts the events an authenticated caller may send.
+type CallerPolicy struct {
+	// RateLimiter for incoming events from this caller, per adapter instance.
+	// +optional
+	RateLimiter *RateLimiter `json:"rateLimiter,omitempty"`
+
+	// Quota of events which can be accepted from this caller over a period
+	// of time, per adapter instance.
+	// +optional
+	Quota *Quota `json:"quota,omitempty"`
+
+	// Event types this caller is allowed to send. A trailing '*' matches any
+	// suffix. All types are allowed when empty.
+	// +optional
+	AllowedTypes []string `json:"allowedTypes,omitempty"`
+
+	// Event sources this caller is allowed to send. A trailing '*' matches
+	// any suffix. All sources are allowed when empty.
+	// +optional
+	AllowedSources []string `json:"allowedSources,omitempty"`
 }
 
 // RateLimiter parameters.
@@ -79,6 +166,16 @@ type RateLimiter struct {
 	RequestsPerSecond int `json:"requestsPerSecond"`
 }
 
+// Quota parameters.
+type Quota struct {
+	// Maximum number of events accepted during each period.
+	Events int `json:"events"`
+
+	// Duration of the period after which the quota is replenished.
+	// Expressed as a duration string, which format is documented at https://pkg.go.dev/time#ParseDuration.
+	Period apis.Duration `json:"period"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // CloudEventsSourceList contains a list of event sources.
@@ -19,17 +19,20 @@ package v1alpha1
 import (
 	"context"
 	"encoding/json"
+	"strings"
 
-	"knative.dev/pkg/apis"
+	pkgapis "knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
 )
 
 // Validate implements apis.Validatable
-func (s *CloudEventsSource) Validate(ctx context.Context) *apis.FieldError {
+func (s *CloudEventsSource) Validate(ctx context.Context) *pkgapis.FieldError {
 	return s.Spec.Validate(ctx).ViaField("spec")
 }
 
 // Validate CloudEventsSource spec
-func (s *CloudEventsSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+func (s *CloudEventsSourceSpec) Validate(ctx context.Context) *pkgapis.FieldError {
 	if s.Credentials == nil {
 		return nil
 	}
@@ -37,15 +40,134 @@ func (s *CloudEventsSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 	return s.Credentials.Validate(ctx).ViaField("credentials")
 }
 
-func (c *HTTPCredentials) Validate(ctx context.Context) *apis.FieldError {
-	var errs *apis.FieldError
+func (c *HTTPCredentials) Validate(ctx context.Context) *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
 
 	if len(c.BasicAuths) != 0 {
 		if _, err := json.Marshal(c.BasicAuths); err != nil {
-			errs = errs.Also(apis.ErrInvalidValue(
+			errs = errs.Also(pkgapis.ErrInvalidValue(
 				"basic authentication parameter cannot be marshaled into JSON", "basicAuths", err.Error()))
 		}
 	}
 
+	for i, ba := range c.BasicAuths {
+		errs = errs.Also(ba.Validate(ctx).ViaFieldIndex("basicAuths", i))
+	}
+
+	names := make(map[string]struct{}, len(c.APIKeys))
+	for i, k := range c.APIKeys {
+		if _, dup := names[k.Name]; dup {
+			errs = errs.Also(pkgapis.ErrMultipleOneOf("name").ViaFieldIndex("apiKeys", i))
+		}
+		names[k.Name] = struct{}{}
+
+		errs = errs.Also(k.Validate(ctx).ViaFieldIndex("apiKeys", i))
+	}
+
+	if c.Tokens != nil {
+		errs = errs.Also(c.Tokens.Validate(ctx).ViaField("tokens"))
+	}
+
+	return errs
+}
+
+// Validate basic authentication credentials.
+func (a *HTTPBasicAuth) Validate(ctx context.Context) *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
+
+	if a.Username == "" {
+		errs = errs.Also(pkgapis.ErrMissingField("username"))
+	}
+	errs = errs.Also(a.Password.Validate(ctx).ViaField("password"))
+
+	if a.Policy != nil {
+		errs = errs.Also(a.Policy.Validate(ctx).ViaField("policy"))
+	}
+
+	return errs
+}
+
+// Validate API key credentials.
+func (k *HTTPAPIKey) Validate(ctx context.Context) *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
+
+	if k.Name == "" {
+		errs = errs.Also(pkgapis.ErrMissingField("name"))
+	}
+	errs = errs.Also(k.Key.Validate(ctx).ViaField("key"))
+
+	if k.Policy != nil {
+		errs = errs.Also(k.Policy.Validate(ctx).ViaField("policy"))
+	}
+
+	return errs
+}
+
+// Validate token authentication parameters.
+func (t *HTTPTokenAuth) Validate(ctx context.Context) *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
+
+	switch {
+	case t.JWKS == nil && t.JWKSURL == nil:
+		errs = errs.Also(pkgapis.ErrMissingOneOf("jwks", "jwksURL"))
+	case t.JWKS != nil && t.JWKSURL != nil:
+		errs = errs.Also(pkgapis.ErrMultipleOneOf("jwks", "jwksURL"))
+	case t.JWKS != nil:
+		errs = errs.Also(t.JWKS.Validate(ctx).ViaField("jwks"))
+	default:
+		errs = errs.Also(apis.ValidateURL(t.JWKSURL, "jwksURL", "http", "https"))
+	}
+
+	if t.CallerClaim != nil && *t.CallerClaim == "" {
+		errs = errs.Also(pkgapis.ErrInvalidValue(*t.CallerClaim, "callerClaim"))
+	}
+
+	names := make(map[string]struct{}, len(t.Callers))
+	for i, c := range t.Callers {
+		if c.Name == "" {
+			errs = errs.Also(pkgapis.ErrMissingField("name").ViaFieldIndex("callers", i))
+		} else if _, dup := names[c.Name]; dup {
+			errs = errs.Also(pkgapis.ErrMultipleOneOf("name").ViaFieldIndex("callers", i))
+		}
+		names[c.Name] = struct{}{}
+
+		errs = errs.Also(c.Policy.Validate(ctx).ViaField("policy").ViaFieldIndex("callers", i))
+	}
+
+	return errs
+}
+
+// Validate a caller policy.
+func (p *CallerPolicy) Validate(_ context.Context) *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
+
+	if rl := p.RateLimiter; rl != nil && rl.RequestsPerSecond <= 0 {
+		errs = errs.Also(pkgapis.ErrInvalidValue(rl.RequestsPerSecond, "requestsPerSecond").ViaField("rateLimiter"))
+	}
+
+	if q := p.Quota; q != nil {
+		if q.Events <= 0 {
+			errs = errs.Also(pkgapis.ErrInvalidValue(q.Events, "events").ViaField("quota"))
+		}
+		errs = errs.Also(apis.ValidateDuration(q.Period, "period").ViaField("quota"))
+	}
+
+	errs = errs.Also(validateAttributePatterns(p.AllowedTypes, "allowedTypes"))
+	errs = errs.Also(validateAttributePatterns(p.AllowedSources, "allowedSources"))
+
+	return errs
+}
+
+// validateAttributePatterns verifies that the given CloudEvent attribute
+// patterns are not empty, and only contain a wildcard as their last character.
+func validateAttributePatterns(patterns []string, fieldPath string) *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
+
+	for i, p := range patterns {
+		if p == "" || strings.Contains(strings.TrimSuffix(p, "*"), "*") {
+			errs = errs.Also(pkgapis.ErrInvalidArrayValue(p, fieldPath, i))
+		}
+	}
+
 	return errs
 }
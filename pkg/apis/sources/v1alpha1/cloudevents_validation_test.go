@@ -0,0 +1,97 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	pkgapis "knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestCloudEventsSourceValidate(t *testing.T) {
+	secret := v1alpha1.ValueFromField{
+		ValueFromSecret: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "creds"},
+			Key:                  "key",
+		},
+	}
+
+	t.Run("valid spec", func(t *testing.T) {
+		src := &CloudEventsSource{Spec: CloudEventsSourceSpec{
+			Credentials: &HTTPCredentials{
+				BasicAuths: []HTTPBasicAuth{{Username: "user", Password: secret}},
+				APIKeys: []HTTPAPIKey{{
+					Name: "partner-a",
+					Key:  secret,
+					Policy: &CallerPolicy{
+						RateLimiter:  &RateLimiter{RequestsPerSecond: 10},
+						Quota:        &Quota{Events: 1000, Period: apis.Duration(24 * time.Hour)},
+						AllowedTypes: []string{"com.partner-a.*"},
+					},
+				}},
+				Tokens: &HTTPTokenAuth{
+					JWKSURL: pkgapis.HTTP("idp.example.com"),
+					Callers: []HTTPTokenCaller{{
+						Name:   "partner-b",
+						Policy: CallerPolicy{AllowedSources: []string{"partner-b"}},
+					}},
+				},
+			},
+		}}
+
+		assert.Nil(t, src.Validate(context.Background()))
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		src := &CloudEventsSource{Spec: CloudEventsSourceSpec{
+			Credentials: &HTTPCredentials{
+				BasicAuths: []HTTPBasicAuth{{Password: secret}},
+				APIKeys: []HTTPAPIKey{
+					{Name: "partner", Key: secret},
+					{Name: "partner", Key: secret, Policy: &CallerPolicy{
+						Quota:        &Quota{Events: 0, Period: apis.Duration(-time.Hour)},
+						AllowedTypes: []string{"com.*.created"},
+					}},
+				},
+				Tokens: &HTTPTokenAuth{
+					Callers: []HTTPTokenCaller{{
+						Policy: CallerPolicy{RateLimiter: &RateLimiter{}},
+					}},
+				},
+			},
+		}}
+
+		err := src.Validate(context.Background())
+		assert.EqualError(t, err,
+			"expected exactly one, got both: spec.credentials.apiKeys[1].name\n"+
+				"expected exactly one, got neither: spec.credentials.tokens.jwks, spec.credentials.tokens.jwksURL\n"+
+				"invalid value: -1h0m0s: spec.credentials.apiKeys[1].policy.quota.period\n"+
+				"duration must be positive\n"+
+				"invalid value: 0: spec.credentials.apiKeys[1].policy.quota.events, "+
+				"spec.credentials.tokens.callers[0].policy.rateLimiter.requestsPerSecond\n"+
+				"invalid value: com.*.created: spec.credentials.apiKeys[1].policy.allowedTypes[0]\n"+
+				"missing field(s): spec.credentials.basicAuths[0].username, spec.credentials.tokens.callers[0].name")
+	})
+}
@@ -1367,6 +1367,16 @@ func (in *AWSSQSSourceReceiveOptions) DeepCopyInto(out *AWSSQSSourceReceiveOptio
 		*out = new(apis.Duration)
 		**out = **in
 	}
+	if in.RetryBackoff != nil {
+		in, out := &in.RetryBackoff, &out.RetryBackoff
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.MaxReceiveCount != nil {
+		in, out := &in.MaxReceiveCount, &out.MaxReceiveCount
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
@@ -1884,6 +1894,65 @@ func (in *AzureEventGridSourceStatus) DeepCopy() *AzureEventGridSourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventHubBlobCheckpointStore) DeepCopyInto(out *AzureEventHubBlobCheckpointStore) {
+	*out = *in
+	in.ConnectionString.DeepCopyInto(&out.ConnectionString)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventHubBlobCheckpointStore.
+func (in *AzureEventHubBlobCheckpointStore) DeepCopy() *AzureEventHubBlobCheckpointStore {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventHubBlobCheckpointStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventHubCheckpointStore) DeepCopyInto(out *AzureEventHubCheckpointStore) {
+	*out = *in
+	if in.Blob != nil {
+		in, out := &in.Blob, &out.Blob
+		*out = new(AzureEventHubBlobCheckpointStore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(AzureEventHubConfigMapCheckpointStore)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventHubCheckpointStore.
+func (in *AzureEventHubCheckpointStore) DeepCopy() *AzureEventHubCheckpointStore {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventHubCheckpointStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventHubConfigMapCheckpointStore) DeepCopyInto(out *AzureEventHubConfigMapCheckpointStore) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventHubConfigMapCheckpointStore.
+func (in *AzureEventHubConfigMapCheckpointStore) DeepCopy() *AzureEventHubConfigMapCheckpointStore {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventHubConfigMapCheckpointStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureEventHubSource) DeepCopyInto(out *AzureEventHubSource) {
 	*out = *in
@@ -1951,6 +2020,21 @@ func (in *AzureEventHubSourceSpec) DeepCopyInto(out *AzureEventHubSourceSpec) {
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	out.EventHubID = in.EventHubID
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.ConsumerGroup != nil {
+		in, out := &in.ConsumerGroup, &out.ConsumerGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartPosition != nil {
+		in, out := &in.StartPosition, &out.StartPosition
+		*out = new(AzureEventHubStartPosition)
+		**out = **in
+	}
+	if in.CheckpointStore != nil {
+		in, out := &in.CheckpointStore, &out.CheckpointStore
+		*out = new(AzureEventHubCheckpointStore)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -2238,6 +2322,11 @@ func (in *AzureServiceBusQueueSourceSpec) DeepCopyInto(out *AzureServiceBusQueue
 	*out = *in
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	out.QueueID = in.QueueID
+	if in.ReceiveOptions != nil {
+		in, out := &in.ReceiveOptions, &out.ReceiveOptions
+		*out = new(AzureServiceBusReceiveOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Auth.DeepCopyInto(&out.Auth)
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
@@ -2257,6 +2346,42 @@ func (in *AzureServiceBusQueueSourceSpec) DeepCopy() *AzureServiceBusQueueSource
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusReceiveOptions) DeepCopyInto(out *AzureServiceBusReceiveOptions) {
+	*out = *in
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int)
+		**out = **in
+	}
+	if in.RetryBackoff != nil {
+		in, out := &in.RetryBackoff, &out.RetryBackoff
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.MaxDeliveryAttempts != nil {
+		in, out := &in.MaxDeliveryAttempts, &out.MaxDeliveryAttempts
+		*out = new(int)
+		**out = **in
+	}
+	if in.Sessions != nil {
+		in, out := &in.Sessions, &out.Sessions
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusReceiveOptions.
+func (in *AzureServiceBusReceiveOptions) DeepCopy() *AzureServiceBusReceiveOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusReceiveOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureServiceBusTopicSource) DeepCopyInto(out *AzureServiceBusTopicSource) {
 	*out = *in
@@ -2323,6 +2448,11 @@ func (in *AzureServiceBusTopicSourceSpec) DeepCopyInto(out *AzureServiceBusTopic
 	*out = *in
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	out.TopicID = in.TopicID
+	if in.ReceiveOptions != nil {
+		in, out := &in.ReceiveOptions, &out.ReceiveOptions
+		*out = new(AzureServiceBusReceiveOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Auth.DeepCopyInto(&out.Auth)
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
@@ -2383,6 +2513,42 @@ func (in *AzureServicePrincipal) DeepCopy() *AzureServicePrincipal {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CallerPolicy) DeepCopyInto(out *CallerPolicy) {
+	*out = *in
+	if in.RateLimiter != nil {
+		in, out := &in.RateLimiter, &out.RateLimiter
+		*out = new(RateLimiter)
+		**out = **in
+	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(Quota)
+		**out = **in
+	}
+	if in.AllowedTypes != nil {
+		in, out := &in.AllowedTypes, &out.AllowedTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedSources != nil {
+		in, out := &in.AllowedSources, &out.AllowedSources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CallerPolicy.
+func (in *CallerPolicy) DeepCopy() *CallerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CallerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudEventsSource) DeepCopyInto(out *CloudEventsSource) {
 	*out = *in
@@ -3272,10 +3438,37 @@ func (in *GoogleCloudStorageSourceStatus) DeepCopy() *GoogleCloudStorageSourceSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPAPIKey) DeepCopyInto(out *HTTPAPIKey) {
+	*out = *in
+	in.Key.DeepCopyInto(&out.Key)
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(CallerPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPAPIKey.
+func (in *HTTPAPIKey) DeepCopy() *HTTPAPIKey {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPAPIKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPBasicAuth) DeepCopyInto(out *HTTPBasicAuth) {
 	*out = *in
 	in.Password.DeepCopyInto(&out.Password)
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(CallerPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3299,6 +3492,18 @@ func (in *HTTPCredentials) DeepCopyInto(out *HTTPCredentials) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.APIKeys != nil {
+		in, out := &in.APIKeys, &out.APIKeys
+		*out = make([]HTTPAPIKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tokens != nil {
+		in, out := &in.Tokens, &out.Tokens
+		*out = new(HTTPTokenAuth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3428,6 +3633,71 @@ func (in *HTTPPollerSourceSpec) DeepCopy() *HTTPPollerSourceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTokenAuth) DeepCopyInto(out *HTTPTokenAuth) {
+	*out = *in
+	if in.JWKS != nil {
+		in, out := &in.JWKS, &out.JWKS
+		*out = new(commonv1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JWKSURL != nil {
+		in, out := &in.JWKSURL, &out.JWKSURL
+		*out = new(pkgapis.URL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Issuer != nil {
+		in, out := &in.Issuer, &out.Issuer
+		*out = new(string)
+		**out = **in
+	}
+	if in.Audience != nil {
+		in, out := &in.Audience, &out.Audience
+		*out = new(string)
+		**out = **in
+	}
+	if in.CallerClaim != nil {
+		in, out := &in.CallerClaim, &out.CallerClaim
+		*out = new(string)
+		**out = **in
+	}
+	if in.Callers != nil {
+		in, out := &in.Callers, &out.Callers
+		*out = make([]HTTPTokenCaller, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTokenAuth.
+func (in *HTTPTokenAuth) DeepCopy() *HTTPTokenAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTokenAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTokenCaller) DeepCopyInto(out *HTTPTokenCaller) {
+	*out = *in
+	in.Policy.DeepCopyInto(&out.Policy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTokenCaller.
+func (in *HTTPTokenCaller) DeepCopy() *HTTPTokenCaller {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTokenCaller)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMMQSource) DeepCopyInto(out *IBMMQSource) {
 	*out = *in
@@ -3513,6 +3783,99 @@ func (in *IBMMQSourceSpec) DeepCopy() *IBMMQSourceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraSource) DeepCopyInto(out *JiraSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraSource.
+func (in *JiraSource) DeepCopy() *JiraSource {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JiraSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraSourceList) DeepCopyInto(out *JiraSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JiraSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraSourceList.
+func (in *JiraSourceList) DeepCopy() *JiraSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JiraSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraSourceSpec) DeepCopyInto(out *JiraSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.WebhookSecret != nil {
+		in, out := &in.WebhookSecret, &out.WebhookSecret
+		*out = new(commonv1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(commonv1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraSourceSpec.
+func (in *JiraSourceSpec) DeepCopy() *JiraSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Keystore) DeepCopyInto(out *Keystore) {
 	*out = *in
@@ -3672,6 +4035,22 @@ func (in *OCIMetricsSourceSpec) DeepCopy() *OCIMetricsSourceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Quota) DeepCopyInto(out *Quota) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Quota.
+func (in *Quota) DeepCopy() *Quota {
+	if in == nil {
+		return nil
+	}
+	out := new(Quota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimiter) DeepCopyInto(out *RateLimiter) {
 	*out = *in
@@ -3705,6 +4084,43 @@ func (in *SalesforceAuth) DeepCopy() *SalesforceAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesforceReplayStore) DeepCopyInto(out *SalesforceReplayStore) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(SalesforceReplayStoreConfigMap)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesforceReplayStore.
+func (in *SalesforceReplayStore) DeepCopy() *SalesforceReplayStore {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesforceReplayStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesforceReplayStoreConfigMap) DeepCopyInto(out *SalesforceReplayStoreConfigMap) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesforceReplayStoreConfigMap.
+func (in *SalesforceReplayStoreConfigMap) DeepCopy() *SalesforceReplayStoreConfigMap {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesforceReplayStoreConfigMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SalesforceSource) DeepCopyInto(out *SalesforceSource) {
 	*out = *in
@@ -3776,7 +4192,23 @@ func (in *SalesforceSourceSpec) DeepCopyInto(out *SalesforceSourceSpec) {
 		*out = new(string)
 		**out = **in
 	}
-	in.Subscription.DeepCopyInto(&out.Subscription)
+	if in.Subscription != nil {
+		in, out := &in.Subscription, &out.Subscription
+		*out = new(SalesforceSubscription)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subscriptions != nil {
+		in, out := &in.Subscriptions, &out.Subscriptions
+		*out = make([]SalesforceSubscription, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReplayStore != nil {
+		in, out := &in.ReplayStore, &out.ReplayStore
+		*out = new(SalesforceReplayStore)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -3996,6 +4428,11 @@ func (in *TwilioSourceList) DeepCopyObject() runtime.Object {
 func (in *TwilioSourceSpec) DeepCopyInto(out *TwilioSourceSpec) {
 	*out = *in
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.AuthToken != nil {
+		in, out := &in.AuthToken, &out.AuthToken
+		*out = new(commonv1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -41,6 +41,9 @@ type HTTPPollerSource struct {
 
 // Check the interfaces the event source should be implementing.
 var (
+	_ pkgapis.Validatable = (*HTTPPollerSource)(nil)
+	_ pkgapis.Defaultable = (*HTTPPollerSource)(nil)
+
 	_ v1alpha1.Reconcilable        = (*HTTPPollerSource)(nil)
 	_ v1alpha1.AdapterConfigurable = (*HTTPPollerSource)(nil)
 	_ v1alpha1.EventSource         = (*HTTPPollerSource)(nil)
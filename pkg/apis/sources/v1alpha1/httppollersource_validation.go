@@ -0,0 +1,62 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"net/http"
+
+	pkgapis "knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (s *HTTPPollerSource) Validate(ctx context.Context) *pkgapis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *HTTPPollerSourceSpec) Validate(ctx context.Context) *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
+
+	if s.EventType == "" {
+		errs = errs.Also(pkgapis.ErrMissingField("eventType"))
+	}
+
+	errs = errs.Also(apis.ValidateURL(&s.Endpoint, "endpoint", "http", "https"))
+
+	switch s.Method {
+	case "":
+		errs = errs.Also(pkgapis.ErrMissingField("method"))
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+		http.MethodPatch, http.MethodDelete, http.MethodOptions:
+	default:
+		errs = errs.Also(pkgapis.ErrInvalidValue(s.Method, "method"))
+	}
+
+	if s.BasicAuthPassword != nil {
+		if s.BasicAuthUsername == nil || *s.BasicAuthUsername == "" {
+			errs = errs.Also(pkgapis.ErrMissingField("basicAuthUsername"))
+		}
+		errs = errs.Also(s.BasicAuthPassword.Validate(ctx).ViaField("basicAuthPassword"))
+	}
+
+	errs = errs.Also(apis.ValidateDuration(s.Interval, "interval"))
+
+	return errs.Also(s.Sink.Validate(ctx).ViaField("sink"))
+}
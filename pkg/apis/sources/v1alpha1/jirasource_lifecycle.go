@@ -0,0 +1,90 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	pkgapis "knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// GetGroupVersionKind implements kmeta.OwnerRefable.
+func (*JiraSource) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("JiraSource")
+}
+
+// GetConditionSet implements duckv1.KRShaped.
+func (*JiraSource) GetConditionSet() pkgapis.ConditionSet {
+	return v1alpha1.EventSenderConditionSet
+}
+
+// GetStatus implements duckv1.KRShaped.
+func (s *JiraSource) GetStatus() *duckv1.Status {
+	return &s.Status.Status
+}
+
+// GetSink implements EventSender.
+func (s *JiraSource) GetSink() *duckv1.Destination {
+	return &s.Spec.Sink
+}
+
+// GetStatusManager implements Reconcilable.
+func (s *JiraSource) GetStatusManager() *v1alpha1.StatusManager {
+	return &v1alpha1.StatusManager{
+		ConditionSet: s.GetConditionSet(),
+		Status:       &s.Status,
+	}
+}
+
+// AsEventSource implements EventSource.
+func (s *JiraSource) AsEventSource() string {
+	return "jira/" + s.Namespace + "/" + s.Name
+}
+
+// Supported event types
+const (
+	JiraIssueCreatedEventType   = "com.atlassian.jira.issue.created"
+	JiraIssueUpdatedEventType   = "com.atlassian.jira.issue.updated"
+	JiraIssueDeletedEventType   = "com.atlassian.jira.issue.deleted"
+	JiraCommentCreatedEventType = "com.atlassian.jira.comment.created"
+	JiraCommentUpdatedEventType = "com.atlassian.jira.comment.updated"
+	JiraCommentDeletedEventType = "com.atlassian.jira.comment.deleted"
+	// JiraGenericEventType is the type of webhook events which do not have a
+	// dedicated event type, such as worklog or project events.
+	JiraGenericEventType = "com.atlassian.jira.events"
+)
+
+// GetEventTypes implements EventSource.
+func (*JiraSource) GetEventTypes() []string {
+	return []string{
+		JiraIssueCreatedEventType,
+		JiraIssueUpdatedEventType,
+		JiraIssueDeletedEventType,
+		JiraCommentCreatedEventType,
+		JiraCommentUpdatedEventType,
+		JiraCommentDeletedEventType,
+		JiraGenericEventType,
+	}
+}
+
+// GetAdapterOverrides implements AdapterConfigurable.
+func (s *JiraSource) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
+	return s.Spec.AdapterOverrides
+}
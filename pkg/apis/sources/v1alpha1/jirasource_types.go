@@ -0,0 +1,79 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// JiraSource is the schema for the event source.
+type JiraSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JiraSourceSpec  `json:"spec,omitempty"`
+	Status v1alpha1.Status `json:"status,omitempty"`
+}
+
+// Check the interfaces the event source should be implementing.
+var (
+	_ v1alpha1.Reconcilable        = (*JiraSource)(nil)
+	_ v1alpha1.AdapterConfigurable = (*JiraSource)(nil)
+	_ v1alpha1.EventSource         = (*JiraSource)(nil)
+	_ v1alpha1.EventSender         = (*JiraSource)(nil)
+)
+
+// JiraSourceSpec defines the desired state of the event source.
+type JiraSourceSpec struct {
+	// inherits duck/v1 SourceSpec, which currently provides:
+	// * Sink - a reference to an object that will resolve to a domain name or
+	//   a URI directly to use as the sink.
+	// * CloudEventOverrides - defines overrides to control the output format
+	//   and modifications of the event sent to the sink.
+	duckv1.SourceSpec `json:",inline"`
+
+	// WebhookSecret can be set to the secret of the Jira webhook to
+	// authenticate its requests.
+	// See: https://developer.atlassian.com/cloud/jira/platform/webhooks/#secure-admin-webhooks
+	// +optional
+	WebhookSecret *v1alpha1.ValueFromField `json:"webhookSecret,omitempty"`
+
+	// Events is the list of webhook events, such as "jira:issue_updated",
+	// which are forwarded to the sink. All events are forwarded when empty.
+	// +optional
+	Events []string `json:"events,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// JiraSourceList contains a list of event sources.
+type JiraSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JiraSource `json:"items"`
+}
@@ -55,11 +55,18 @@ func (s *SalesforceSource) GetStatusManager() *v1alpha1.StatusManager {
 
 // AsEventSource implements EventSource.
 func (s *SalesforceSource) AsEventSource() string {
-	channel := s.Spec.Subscription.Channel
-	if channel[0] != '/' {
+	const sourcePrefix = "io.triggermesh.salesforce"
+
+	subs := s.Spec.GetSubscriptions()
+	if len(subs) != 1 {
+		return sourcePrefix
+	}
+
+	channel := subs[0].Channel
+	if channel == "" || channel[0] != '/' {
 		channel = "/" + channel
 	}
-	return "io.triggermesh.salesforce" + channel
+	return sourcePrefix + channel
 }
 
 // GetEventTypes returns the event types generated by the source.
@@ -71,3 +78,20 @@ func (s *SalesforceSource) GetEventTypes() []string {
 func (s *SalesforceSource) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return s.Spec.AdapterOverrides
 }
+
+// WantsAdapterClusterRole implements AdapterClusterRoleConsumer.
+func (s *SalesforceSource) WantsAdapterClusterRole() bool {
+	// Persisting replay IDs to a ConfigMap requires write access to the
+	// Kubernetes API.
+	rs := s.Spec.ReplayStore
+	return rs != nil && rs.ConfigMap != nil
+}
+
+// GetSubscriptions returns all the channel subscriptions of the source.
+func (s *SalesforceSourceSpec) GetSubscriptions() []SalesforceSubscription {
+	subs := make([]SalesforceSubscription, 0, len(s.Subscriptions)+1)
+	if s.Subscription != nil {
+		subs = append(subs, *s.Subscription)
+	}
+	return append(subs, s.Subscriptions...)
+}
@@ -42,6 +42,8 @@ var (
 	_ v1alpha1.AdapterConfigurable = (*SalesforceSource)(nil)
 	_ v1alpha1.EventSource         = (*SalesforceSource)(nil)
 	_ v1alpha1.EventSender         = (*SalesforceSource)(nil)
+
+	_ v1alpha1.AdapterClusterRoleConsumer = (*SalesforceSource)(nil)
 )
 
 // SalesforceSourceSpec defines the desired state of the event source.
@@ -55,8 +57,20 @@ type SalesforceSourceSpec struct {
 	// +optional
 	APIVersion *string `json:"apiVersion"`
 
-	// Subscription to a Salesforce channel
-	Subscription SalesforceSubscription `json:"subscription"`
+	// Subscription to a Salesforce channel.
+	// Superseded by Subscriptions.
+	// +optional
+	Subscription *SalesforceSubscription `json:"subscription,omitempty"`
+
+	// Subscriptions to Salesforce channels.
+	// +optional
+	Subscriptions []SalesforceSubscription `json:"subscriptions,omitempty"`
+
+	// Store in which the replay ID of the last processed event of each
+	// channel is persisted, so that subscriptions resume from that event
+	// after a restart of the adapter.
+	// +optional
+	ReplayStore *SalesforceReplayStore `json:"replayStore,omitempty"`
 
 	// Adapter spec overrides parameters.
 	// +optional
@@ -69,6 +83,18 @@ type SalesforceSubscription struct {
 	ReplayID *int   `json:"replayID,omitempty"`
 }
 
+// SalesforceReplayStore contains the parameters of a store for replay IDs.
+type SalesforceReplayStore struct {
+	// ConfigMap in which replay IDs are persisted. It is created by the
+	// adapter if it doesn't exist.
+	ConfigMap *SalesforceReplayStoreConfigMap `json:"configMap,omitempty"`
+}
+
+// SalesforceReplayStoreConfigMap references a ConfigMap which persists replay IDs.
+type SalesforceReplayStoreConfigMap struct {
+	Name string `json:"name"`
+}
+
 // SalesforceAuth contains Salesforce credentials.
 type SalesforceAuth struct {
 	ClientID string                  `json:"clientID"`
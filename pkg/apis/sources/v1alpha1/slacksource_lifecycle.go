@@ -60,13 +60,15 @@ func (s *SlackSource) AsEventSource() string {
 
 // Supported event types
 const (
-	SlackGenericEventType = "com.slack.events"
+	SlackGenericEventType     = "com.slack.events"
+	SlackInteractionEventType = "com.slack.interactivity"
 )
 
 // GetEventTypes implements EventSource.
 func (*SlackSource) GetEventTypes() []string {
 	return []string{
 		SlackGenericEventType,
+		SlackInteractionEventType,
 	}
 }
 
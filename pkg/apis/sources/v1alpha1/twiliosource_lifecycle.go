@@ -67,12 +67,14 @@ func TwilioSourceName(namespace, name string) string {
 // Supported event types.
 const (
 	TwilioSourceGenericEventType = "com.triggermesh.twilio.sms"
+	TwilioSourceStatusEventType  = "com.triggermesh.twilio.sms.status"
 )
 
 // GetEventTypes implements EventSource.
 func (s *TwilioSource) GetEventTypes() []string {
 	return []string{
 		TwilioSourceGenericEventType,
+		TwilioSourceStatusEventType,
 	}
 }
 
@@ -48,6 +48,11 @@ var (
 type TwilioSourceSpec struct {
 	duckv1.SourceSpec `json:",inline"`
 
+	// AuthToken of the Twilio account, used to verify the signature of
+	// requests sent by Twilio. Requests aren't verified when unset.
+	// +optional
+	AuthToken *v1alpha1.ValueFromField `json:"authToken,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+)
+
+var _ apis.Convertible = (*WebhookSource)(nil)
+
+// ConvertTo implements apis.Convertible.
+func (s *WebhookSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1beta1.WebhookSource:
+		sink.ObjectMeta = s.ObjectMeta
+		sink.Spec = v1beta1.WebhookSourceSpec{
+			SourceSpec:       s.Spec.SourceSpec,
+			EventType:        s.Spec.EventType,
+			EventSource:      s.Spec.EventSource,
+			CORSAllowOrigin:  s.Spec.CORSAllowOrigin,
+			AdapterOverrides: s.Spec.AdapterOverrides,
+		}
+
+		if s.Spec.BasicAuthUsername != nil || s.Spec.BasicAuthPassword != nil {
+			sink.Spec.BasicAuth = &v1beta1.HTTPBasicAuth{}
+			if u := s.Spec.BasicAuthUsername; u != nil {
+				sink.Spec.BasicAuth.Username = *u
+			}
+			if p := s.Spec.BasicAuthPassword; p != nil {
+				sink.Spec.BasicAuth.Password = *p
+			}
+		}
+
+		sink.Status = s.Status
+		return nil
+
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *WebhookSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1beta1.WebhookSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec = WebhookSourceSpec{
+			SourceSpec:       source.Spec.SourceSpec,
+			EventType:        source.Spec.EventType,
+			EventSource:      source.Spec.EventSource,
+			CORSAllowOrigin:  source.Spec.CORSAllowOrigin,
+			AdapterOverrides: source.Spec.AdapterOverrides,
+		}
+
+		if ba := source.Spec.BasicAuth; ba != nil {
+			if ba.Username != "" {
+				u := ba.Username
+				s.Spec.BasicAuthUsername = &u
+			}
+			if ba.Password != (v1alpha1.ValueFromField{}) {
+				p := ba.Password
+				s.Spec.BasicAuthPassword = &p
+			}
+		}
+
+		s.Status = source.Status
+		return nil
+
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+)
+
+func TestWebhookSourceConversionRoundTrip(t *testing.T) {
+	user := "user"
+
+	testCases := map[string]*WebhookSource{
+		"no authentication": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "test"},
+			Spec: WebhookSourceSpec{
+				SourceSpec: duckv1.SourceSpec{
+					Sink: duckv1.Destination{URI: apis.HTTP("example.com")},
+				},
+				EventType: "io.test.event",
+			},
+		},
+		"basic authentication": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "test"},
+			Spec: WebhookSourceSpec{
+				SourceSpec: duckv1.SourceSpec{
+					Sink: duckv1.Destination{URI: apis.HTTP("example.com")},
+				},
+				EventType:         "io.test.event",
+				BasicAuthUsername: &user,
+				BasicAuthPassword: &v1alpha1.ValueFromField{Value: "pass"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			beta := &v1beta1.WebhookSource{}
+			require.NoError(t, tc.ConvertTo(context.Background(), beta))
+
+			got := &WebhookSource{}
+			require.NoError(t, got.ConvertFrom(context.Background(), beta))
+
+			assert.Equal(t, tc, got)
+		})
+	}
+}
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgapis "knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
@@ -38,6 +39,9 @@ type WebhookSource struct {
 
 // Check the interfaces the event source should be implementing.
 var (
+	_ pkgapis.Validatable = (*WebhookSource)(nil)
+	_ pkgapis.Defaultable = (*WebhookSource)(nil)
+
 	_ v1alpha1.Reconcilable        = (*WebhookSource)(nil)
 	_ v1alpha1.AdapterConfigurable = (*WebhookSource)(nil)
 	_ v1alpha1.EventSource         = (*WebhookSource)(nil)
@@ -63,10 +67,12 @@ type WebhookSourceSpec struct {
 	EventSource *string `json:"eventSource,omitempty"`
 
 	// User name HTTP clients must set to authenticate with the webhook using HTTP Basic authentication.
+	// Deprecated in favour of basicAuth.username in v1beta1.
 	// +optional
 	BasicAuthUsername *string `json:"basicAuthUsername,omitempty"`
 
 	// Password HTTP clients must set to authenticate with the webhook using HTTP Basic authentication.
+	// Deprecated in favour of basicAuth.password in v1beta1.
 	// +optional
 	BasicAuthPassword *v1alpha1.ValueFromField `json:"basicAuthPassword,omitempty"`
 
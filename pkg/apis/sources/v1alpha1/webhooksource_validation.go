@@ -0,0 +1,58 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	pkgapis "knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (s *WebhookSource) Validate(ctx context.Context) *pkgapis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *WebhookSourceSpec) Validate(ctx context.Context) *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
+
+	if s.EventType == "" {
+		errs = errs.Also(pkgapis.ErrMissingField("eventType"))
+	}
+
+	if s.BasicAuthUsername != nil || s.BasicAuthPassword != nil {
+		if s.BasicAuthUsername == nil || *s.BasicAuthUsername == "" {
+			errs = errs.Also(pkgapis.ErrMissingField("basicAuthUsername"))
+		} else {
+			errs = errs.Also(apis.WarnDeprecatedField("basicAuthUsername", "basicAuth.username in v1beta1"))
+		}
+
+		if s.BasicAuthPassword == nil {
+			errs = errs.Also(pkgapis.ErrMissingField("basicAuthPassword"))
+		} else {
+			errs = errs.Also(
+				s.BasicAuthPassword.Validate(ctx).ViaField("basicAuthPassword"),
+				apis.WarnDeprecatedField("basicAuthPassword", "basicAuth.password in v1beta1"),
+			)
+		}
+	}
+
+	return errs.Also(s.Sink.Validate(ctx).ViaField("sink"))
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pkgapis "knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestWebhookSourceValidate(t *testing.T) {
+	sink := duckv1.SourceSpec{
+		Sink: duckv1.Destination{URI: pkgapis.HTTP("example.com")},
+	}
+
+	t.Run("valid spec", func(t *testing.T) {
+		src := &WebhookSource{Spec: WebhookSourceSpec{SourceSpec: sink, EventType: "io.test.event"}}
+		assert.Nil(t, src.Validate(context.Background()))
+	})
+
+	t.Run("deprecated basic auth fields", func(t *testing.T) {
+		src := &WebhookSource{Spec: WebhookSourceSpec{
+			SourceSpec:        sink,
+			EventType:         "io.test.event",
+			BasicAuthUsername: strPtr("user"),
+			BasicAuthPassword: &v1alpha1.ValueFromField{Value: "pass"},
+		}}
+
+		err := src.Validate(context.Background())
+		assert.Nil(t, err.Filter(pkgapis.ErrorLevel))
+		assert.EqualError(t, err.Filter(pkgapis.WarningLevel),
+			"field is deprecated, use basicAuth.password in v1beta1 instead: spec.basicAuthPassword\n"+
+				"field is deprecated, use basicAuth.username in v1beta1 instead: spec.basicAuthUsername")
+	})
+
+	t.Run("incomplete basic auth", func(t *testing.T) {
+		src := &WebhookSource{Spec: WebhookSourceSpec{
+			SourceSpec:        sink,
+			BasicAuthPassword: &v1alpha1.ValueFromField{},
+		}}
+
+		err := src.Validate(context.Background()).Filter(pkgapis.ErrorLevel)
+		assert.EqualError(t, err,
+			"expected exactly one, got neither: spec.basicAuthPassword.value, spec.basicAuthPassword.valueFromSecret\n"+
+				"missing field(s): spec.basicAuthUsername, spec.eventType")
+	})
+}
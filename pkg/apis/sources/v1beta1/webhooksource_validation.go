@@ -0,0 +1,46 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (s *WebhookSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *WebhookSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.EventType == "" {
+		errs = errs.Also(apis.ErrMissingField("eventType"))
+	}
+
+	if ba := s.BasicAuth; ba != nil {
+		if ba.Username == "" {
+			errs = errs.Also(apis.ErrMissingField("basicAuth.username"))
+		}
+		errs = errs.Also(ba.Password.Validate(ctx).ViaField("basicAuth", "password"))
+	}
+
+	return errs.Also(s.Sink.Validate(ctx).ViaField("sink"))
+}
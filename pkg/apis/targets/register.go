@@ -157,6 +157,11 @@ var (
 		Group:    GroupName,
 		Resource: "oracletargets",
 	}
+	// PrometheusTargetResource respresents an event target for Prometheus.
+	PrometheusTargetResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "prometheustargets",
+	}
 	// SalesforceTargetResource respresents an event target for Salesforce.
 	SalesforceTargetResource = schema.GroupResource{
 		Group:    GroupName,
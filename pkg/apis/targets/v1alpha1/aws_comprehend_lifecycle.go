@@ -25,6 +25,7 @@ import (
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
 // Returned event types
@@ -73,3 +74,13 @@ func (t *AWSComprehendTarget) AsEventSource() string {
 func (t *AWSComprehendTarget) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return t.Spec.AdapterOverrides
 }
+
+// WantsOwnServiceAccount implements ServiceAccountProvider.
+func (t *AWSComprehendTarget) WantsOwnServiceAccount() bool {
+	return t.Spec.Auth.wantsOwnServiceAccount()
+}
+
+// ServiceAccountOptions implements ServiceAccountProvider.
+func (t *AWSComprehendTarget) ServiceAccountOptions() []resource.ServiceAccountOption {
+	return t.Spec.Auth.serviceAccountOptions()
+}
@@ -37,17 +37,22 @@ type AWSComprehendTarget struct {
 
 // Check the interfaces the event target should be implementing.
 var (
-	_ v1alpha1.Reconcilable        = (*AWSComprehendTarget)(nil)
-	_ v1alpha1.AdapterConfigurable = (*AWSComprehendTarget)(nil)
-	_ v1alpha1.EventSource         = (*AWSComprehendTarget)(nil)
+	_ v1alpha1.Reconcilable           = (*AWSComprehendTarget)(nil)
+	_ v1alpha1.AdapterConfigurable    = (*AWSComprehendTarget)(nil)
+	_ v1alpha1.ServiceAccountProvider = (*AWSComprehendTarget)(nil)
+	_ v1alpha1.EventSource            = (*AWSComprehendTarget)(nil)
 )
 
 // AWSComprehendTargetSpec defines the desired state of the event target.
 type AWSComprehendTargetSpec struct {
 	// AWS account Key.
+	// Superseded by Auth.
+	// +optional
 	AWSApiKey SecretValueFromSource `json:"awsApiKey"`
 
 	// AWS account secret key.
+	// Superseded by Auth.
+	// +optional
 	AWSApiSecret SecretValueFromSource `json:"awsApiSecret"`
 
 	// Region to use for calling into Comprehend API.
@@ -59,6 +64,15 @@ type AWSComprehendTargetSpec struct {
 	// Language code to use to interact with Comprehend. The supported list can be found at: https://docs.aws.amazon.com/comprehend/latest/dg/supported-languages.html
 	Language string `json:"language"`
 
+	// Authentication method to interact with the AWS API.
+	// Takes precedence over AWSApiKey and AWSApiSecret.
+	// +optional
+	Auth *AWSAuth `json:"auth,omitempty"`
+
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *AWSEndpoint `json:"endpoint,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
@@ -23,12 +23,15 @@ import (
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
 // Returned event types
 const (
 	// EventTypeAWSDynamoDBResult contains the result of the processing of an S3 event.
 	EventTypeAWSDynamoDBResult = "io.triggermesh.targets.aws.dynamodb.result"
+	// EventTypeAWSDynamoDBItem contains an item read from a DynamoDB table.
+	EventTypeAWSDynamoDBItem = "io.triggermesh.targets.aws.dynamodb.item"
 )
 
 // GetGroupVersionKind implements kmeta.OwnerRefable.
@@ -58,6 +61,7 @@ func (t *AWSDynamoDBTarget) GetStatusManager() *v1alpha1.StatusManager {
 func (*AWSDynamoDBTarget) GetEventTypes() []string {
 	return []string{
 		EventTypeAWSDynamoDBResult,
+		EventTypeAWSDynamoDBItem,
 	}
 }
 
@@ -70,3 +74,13 @@ func (t *AWSDynamoDBTarget) AsEventSource() string {
 func (t *AWSDynamoDBTarget) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return t.Spec.AdapterOverrides
 }
+
+// WantsOwnServiceAccount implements ServiceAccountProvider.
+func (t *AWSDynamoDBTarget) WantsOwnServiceAccount() bool {
+	return t.Spec.Auth.wantsOwnServiceAccount()
+}
+
+// ServiceAccountOptions implements ServiceAccountProvider.
+func (t *AWSDynamoDBTarget) ServiceAccountOptions() []resource.ServiceAccountOption {
+	return t.Spec.Auth.serviceAccountOptions()
+}
@@ -37,23 +37,43 @@ type AWSDynamoDBTarget struct {
 
 // Check the interfaces the event target should be implementing.
 var (
-	_ v1alpha1.Reconcilable        = (*AWSDynamoDBTarget)(nil)
-	_ v1alpha1.AdapterConfigurable = (*AWSDynamoDBTarget)(nil)
-	_ v1alpha1.EventSource         = (*AWSDynamoDBTarget)(nil)
+	_ v1alpha1.Reconcilable           = (*AWSDynamoDBTarget)(nil)
+	_ v1alpha1.AdapterConfigurable    = (*AWSDynamoDBTarget)(nil)
+	_ v1alpha1.ServiceAccountProvider = (*AWSDynamoDBTarget)(nil)
+	_ v1alpha1.EventSource            = (*AWSDynamoDBTarget)(nil)
 )
 
 // AWSDynamoDBTargetSpec defines the desired state of the event target.
 type AWSDynamoDBTargetSpec struct {
 	// AWS account Key
+	// Superseded by Auth.
+	// +optional
 	AWSApiKey SecretValueFromSource `json:"awsApiKey"`
 
 	// AWS account secret key
+	// Superseded by Auth.
+	// +optional
 	AWSApiSecret SecretValueFromSource `json:"awsApiSecret"`
 
 	// Table ARN
 	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazondynamodb.html#amazondynamodb-resources-for-iam-policies
 	ARN string `json:"arn"`
 
+	// Operations performed against the table, selected by the type of the
+	// incoming event. Events whose type does not match any operation are
+	// written to the table as items.
+	// +optional
+	Operations []AWSDynamoDBOperation `json:"operations,omitempty"`
+
+	// Authentication method to interact with the AWS API.
+	// Takes precedence over AWSApiKey and AWSApiSecret.
+	// +optional
+	Auth *AWSAuth `json:"auth,omitempty"`
+
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *AWSEndpoint `json:"endpoint,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
@@ -68,3 +88,62 @@ type AWSDynamoDBTargetList struct {
 
 	Items []AWSDynamoDBTarget `json:"items"`
 }
+
+// AWSDynamoDBOperation is an operation performed on a DynamoDB item upon
+// reception of events of a given type.
+type AWSDynamoDBOperation struct {
+	// Type of the events this operation is performed for.
+	EventType string `json:"eventType"`
+
+	// Operation to perform on the item.
+	Operation AWSDynamoDBOperationType `json:"operation"`
+
+	// Attributes composing the primary key of the item, and the location
+	// of their values in the incoming events.
+	Key []AWSDynamoDBAttribute `json:"key"`
+
+	// Paths of the item attributes written by "update" and "upsert"
+	// operations, in dot notation. Attributes that are listed but absent
+	// from the event data are removed from the item. When empty, all top-level fields of the
+	// event data are written.
+	// +optional
+	UpdateAttributes []string `json:"updateAttributes,omitempty"`
+
+	// Condition expression that must be satisfied for "put", "update",
+	// "upsert" and "delete" operations to be applied.
+	// https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/Expressions.ConditionExpressions.html
+	// +optional
+	ConditionExpression *string `json:"conditionExpression,omitempty"`
+
+	// Values of the placeholders (e.g. ":status") used in the condition
+	// expression, and the location of these values in the incoming events.
+	// +optional
+	ConditionValues []AWSDynamoDBAttribute `json:"conditionValues,omitempty"`
+}
+
+// AWSDynamoDBOperationType is the type of an operation performed on a
+// DynamoDB item.
+type AWSDynamoDBOperationType string
+
+// Supported DynamoDB operations.
+const (
+	// Write the event data as the item, replacing any existing item.
+	AWSDynamoDBOperationPut AWSDynamoDBOperationType = "put"
+	// Write attributes of the event data to an existing item.
+	AWSDynamoDBOperationUpdate AWSDynamoDBOperationType = "update"
+	// Write attributes of the event data to an item, creating it if necessary.
+	AWSDynamoDBOperationUpsert AWSDynamoDBOperationType = "upsert"
+	// Delete the item.
+	AWSDynamoDBOperationDelete AWSDynamoDBOperationType = "delete"
+	// Reply with the content of the item.
+	AWSDynamoDBOperationGet AWSDynamoDBOperationType = "get"
+)
+
+// AWSDynamoDBAttribute associates a DynamoDB attribute name or expression
+// placeholder with a value read from incoming events.
+type AWSDynamoDBAttribute struct {
+	// Name of the attribute or placeholder.
+	Name string `json:"name"`
+	// Location of the value in the incoming events.
+	ValueFrom EventValueSelector `json:"valueFrom"`
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"knative.dev/pkg/apis"
+)
+
+// Prefix of the expression placeholders generated by the target's adapter,
+// which can not be used in condition expressions.
+const awsDynamoDBReservedPlaceholderPrefix = ":tm"
+
+// awsDynamoDBPlaceholderRegexp matches valid expression attribute value
+// placeholders.
+var awsDynamoDBPlaceholderRegexp = regexp.MustCompile(`^:[A-Za-z0-9]+$`)
+
+// Validate implements apis.Validatable
+func (t *AWSDynamoDBTarget) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *AWSDynamoDBTargetSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	eventTypes := make(map[string]struct{}, len(s.Operations))
+
+	for i := range s.Operations {
+		op := &s.Operations[i]
+
+		if _, ok := eventTypes[op.EventType]; ok {
+			errs = errs.Also(apis.ErrGeneric("duplicate event type "+op.EventType, "eventType").ViaFieldIndex("operations", i))
+		}
+		eventTypes[op.EventType] = struct{}{}
+
+		errs = errs.Also(op.validate().ViaFieldIndex("operations", i))
+	}
+
+	return errs
+}
+
+// validate verifies the configuration of a single operation.
+func (o *AWSDynamoDBOperation) validate() *apis.FieldError {
+	var errs *apis.FieldError
+
+	if o.EventType == "" {
+		errs = errs.Also(apis.ErrMissingField("eventType"))
+	}
+
+	switch o.Operation {
+	case "":
+		errs = errs.Also(apis.ErrMissingField("operation"))
+	case AWSDynamoDBOperationPut,
+		AWSDynamoDBOperationUpdate,
+		AWSDynamoDBOperationUpsert,
+		AWSDynamoDBOperationDelete,
+		AWSDynamoDBOperationGet:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(o.Operation, "operation"))
+	}
+
+	// a DynamoDB primary key is composed of a partition key and an
+	// optional sort key
+	keyNames := make(map[string]struct{}, len(o.Key))
+	switch l := len(o.Key); {
+	case l == 0:
+		errs = errs.Also(apis.ErrMissingField("key"))
+	case l > 2:
+		errs = errs.Also(apis.ErrOutOfBoundsValue(l, 1, 2, "key"))
+	}
+	for i := range o.Key {
+		k := &o.Key[i]
+		if _, ok := keyNames[k.Name]; ok {
+			errs = errs.Also(apis.ErrGeneric("duplicate key attribute "+k.Name, "name").ViaFieldIndex("key", i))
+		}
+		keyNames[k.Name] = struct{}{}
+
+		errs = errs.Also(k.validate().ViaFieldIndex("key", i))
+	}
+
+	if len(o.UpdateAttributes) > 0 {
+		switch o.Operation {
+		case AWSDynamoDBOperationUpdate, AWSDynamoDBOperationUpsert:
+			for i, a := range o.UpdateAttributes {
+				_, isKey := keyNames[a]
+				if isKey || !isValidFieldPath(a) {
+					errs = errs.Also(apis.ErrInvalidArrayValue(a, "updateAttributes", i))
+				}
+			}
+		default:
+			errs = errs.Also(apis.ErrDisallowedFields("updateAttributes"))
+		}
+	}
+
+	if o.ConditionExpression != nil {
+		switch {
+		case o.Operation == AWSDynamoDBOperationGet:
+			errs = errs.Also(apis.ErrDisallowedFields("conditionExpression"))
+		case *o.ConditionExpression == "":
+			errs = errs.Also(apis.ErrInvalidValue(*o.ConditionExpression, "conditionExpression",
+				"condition expression can not be empty"))
+		}
+	}
+
+	if len(o.ConditionValues) > 0 && o.ConditionExpression == nil {
+		errs = errs.Also(apis.ErrGeneric("conditionValues requires a conditionExpression", "conditionValues"))
+	}
+
+	placeholders := make(map[string]struct{}, len(o.ConditionValues))
+	for i := range o.ConditionValues {
+		v := &o.ConditionValues[i]
+
+		switch {
+		case !awsDynamoDBPlaceholderRegexp.MatchString(v.Name):
+			errs = errs.Also(apis.ErrInvalidValue(v.Name, "name",
+				"placeholders must start with ':' followed by alphanumeric characters").ViaFieldIndex("conditionValues", i))
+		case strings.HasPrefix(v.Name, awsDynamoDBReservedPlaceholderPrefix):
+			errs = errs.Also(apis.ErrInvalidValue(v.Name, "name",
+				"placeholders starting with "+awsDynamoDBReservedPlaceholderPrefix+" are reserved").ViaFieldIndex("conditionValues", i))
+		}
+
+		if _, ok := placeholders[v.Name]; ok {
+			errs = errs.Also(apis.ErrGeneric("duplicate placeholder "+v.Name, "name").ViaFieldIndex("conditionValues", i))
+		}
+		placeholders[v.Name] = struct{}{}
+
+		errs = errs.Also(v.ValueFrom.validate().ViaField("valueFrom").ViaFieldIndex("conditionValues", i))
+	}
+
+	return errs
+}
+
+// validate verifies the configuration of a key attribute.
+func (a *AWSDynamoDBAttribute) validate() *apis.FieldError {
+	var errs *apis.FieldError
+
+	if a.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+
+	return errs.Also(a.ValueFrom.validate().ViaField("valueFrom"))
+}
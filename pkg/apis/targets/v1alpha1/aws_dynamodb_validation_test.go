@@ -0,0 +1,133 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAWSDynamoDBTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		ops         []AWSDynamoDBOperation
+		expectError string
+	}{
+		"no operations": {},
+		"valid operations": {
+			ops: []AWSDynamoDBOperation{{
+				EventType: "order.created",
+				Operation: AWSDynamoDBOperationPut,
+				Key: []AWSDynamoDBAttribute{
+					{Name: "customer", ValueFrom: EventValueSelector{JSONPath: strPtr("$.customer.id")}},
+					{Name: "order", ValueFrom: EventValueSelector{Attribute: strPtr("subject")}},
+				},
+				ConditionExpression: strPtr("attribute_not_exists(customer)"),
+			}, {
+				EventType: "order.updated",
+				Operation: AWSDynamoDBOperationUpdate,
+				Key: []AWSDynamoDBAttribute{
+					{Name: "order", ValueFrom: EventValueSelector{Attribute: strPtr("subject")}},
+				},
+				UpdateAttributes:    []string{"status", "shipping.address"},
+				ConditionExpression: strPtr("version < :version"),
+				ConditionValues: []AWSDynamoDBAttribute{
+					{Name: ":version", ValueFrom: EventValueSelector{JSONPath: strPtr("$.version")}},
+				},
+			}, {
+				EventType: "order.read",
+				Operation: AWSDynamoDBOperationGet,
+				Key: []AWSDynamoDBAttribute{
+					{Name: "order", ValueFrom: EventValueSelector{Attribute: strPtr("subject")}},
+				},
+			}},
+		},
+		"missing fields": {
+			ops: []AWSDynamoDBOperation{{}},
+			expectError: "missing field(s): spec.operations[0].eventType, spec.operations[0].key, " +
+				"spec.operations[0].operation",
+		},
+		"invalid key": {
+			ops: []AWSDynamoDBOperation{{
+				EventType: "order.updated",
+				Operation: "replace",
+				Key: []AWSDynamoDBAttribute{
+					{Name: "order", ValueFrom: EventValueSelector{Attribute: strPtr("subject")}},
+					{Name: "order", ValueFrom: EventValueSelector{}},
+					{Name: "customer", ValueFrom: EventValueSelector{JSONPath: strPtr("$.customer[*]")}},
+				},
+			}},
+			expectError: "duplicate key attribute order: spec.operations[0].key[1].name\n" +
+				"expected 1 <= 3 <= 2: spec.operations[0].key\n" +
+				"expected exactly one, got neither: spec.operations[0].key[1].valueFrom.attribute, " +
+				"spec.operations[0].key[1].valueFrom.jsonPath\n" +
+				"invalid value: $.customer[*]: spec.operations[0].key[2].valueFrom.jsonPath\n" +
+				"invalid selector \"*\" in JSONPath expression \"$.customer[*]\"\n" +
+				"invalid value: replace: spec.operations[0].operation",
+		},
+		"invalid condition": {
+			ops: []AWSDynamoDBOperation{{
+				EventType: "order.read",
+				Operation: AWSDynamoDBOperationGet,
+				Key: []AWSDynamoDBAttribute{
+					{Name: "order", ValueFrom: EventValueSelector{Attribute: strPtr("subject")}},
+				},
+				UpdateAttributes:    []string{"status"},
+				ConditionExpression: strPtr("status = :tmv0 AND version = :version"),
+				ConditionValues: []AWSDynamoDBAttribute{
+					{Name: ":tmv0", ValueFrom: EventValueSelector{JSONPath: strPtr("$.status")}},
+					{Name: "version", ValueFrom: EventValueSelector{JSONPath: strPtr("$.version")}},
+				},
+			}, {
+				EventType: "order.updated",
+				Operation: AWSDynamoDBOperationUpsert,
+				Key: []AWSDynamoDBAttribute{
+					{Name: "order", ValueFrom: EventValueSelector{Attribute: strPtr("subject")}},
+				},
+				UpdateAttributes: []string{"order", "status"},
+				ConditionValues: []AWSDynamoDBAttribute{
+					{Name: ":status", ValueFrom: EventValueSelector{JSONPath: strPtr("$.status")}},
+				},
+			}},
+			expectError: "conditionValues requires a conditionExpression: spec.operations[1].conditionValues\n" +
+				"invalid value: :tmv0: spec.operations[0].conditionValues[0].name\n" +
+				"placeholders starting with :tm are reserved\n" +
+				"invalid value: order: spec.operations[1].updateAttributes[0]\n" +
+				"invalid value: version: spec.operations[0].conditionValues[1].name\n" +
+				"placeholders must start with ':' followed by alphanumeric characters\n" +
+				"must not set the field(s): spec.operations[0].conditionExpression, spec.operations[0].updateAttributes",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &AWSDynamoDBTarget{Spec: AWSDynamoDBTargetSpec{
+				ARN:        "arn:aws:dynamodb:us-west-2:123456789012:table/orders",
+				Operations: tc.ops,
+			}}
+
+			err := trg.Validate(context.Background())
+			if tc.expectError == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectError)
+			}
+		})
+	}
+}
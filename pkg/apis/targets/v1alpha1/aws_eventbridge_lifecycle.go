@@ -23,6 +23,7 @@ import (
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
 // Returned event types
@@ -77,3 +78,13 @@ func (t *AWSEventBridgeTarget) AsEventSource() string {
 func (t *AWSEventBridgeTarget) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return t.Spec.AdapterOverrides
 }
+
+// WantsOwnServiceAccount implements ServiceAccountProvider.
+func (t *AWSEventBridgeTarget) WantsOwnServiceAccount() bool {
+	return t.Spec.Auth.wantsOwnServiceAccount()
+}
+
+// ServiceAccountOptions implements ServiceAccountProvider.
+func (t *AWSEventBridgeTarget) ServiceAccountOptions() []resource.ServiceAccountOption {
+	return t.Spec.Auth.serviceAccountOptions()
+}
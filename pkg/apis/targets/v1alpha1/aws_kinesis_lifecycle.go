@@ -23,6 +23,7 @@ import (
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
 // GetGroupVersionKind implements kmeta.OwnerRefable.
@@ -52,3 +53,13 @@ func (t *AWSKinesisTarget) GetStatusManager() *v1alpha1.StatusManager {
 func (t *AWSKinesisTarget) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return t.Spec.AdapterOverrides
 }
+
+// WantsOwnServiceAccount implements ServiceAccountProvider.
+func (t *AWSKinesisTarget) WantsOwnServiceAccount() bool {
+	return t.Spec.Auth.wantsOwnServiceAccount()
+}
+
+// ServiceAccountOptions implements ServiceAccountProvider.
+func (t *AWSKinesisTarget) ServiceAccountOptions() []resource.ServiceAccountOption {
+	return t.Spec.Auth.serviceAccountOptions()
+}
@@ -23,6 +23,7 @@ import (
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
 // GetGroupVersionKind implements kmeta.OwnerRefable.
@@ -52,3 +53,13 @@ func (t *AWSLambdaTarget) GetStatusManager() *v1alpha1.StatusManager {
 func (t *AWSLambdaTarget) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return t.Spec.AdapterOverrides
 }
+
+// WantsOwnServiceAccount implements ServiceAccountProvider.
+func (t *AWSLambdaTarget) WantsOwnServiceAccount() bool {
+	return t.Spec.Auth.wantsOwnServiceAccount()
+}
+
+// ServiceAccountOptions implements ServiceAccountProvider.
+func (t *AWSLambdaTarget) ServiceAccountOptions() []resource.ServiceAccountOption {
+	return t.Spec.Auth.serviceAccountOptions()
+}
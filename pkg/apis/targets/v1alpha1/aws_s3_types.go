@@ -37,18 +37,23 @@ type AWSS3Target struct {
 
 // Check the interfaces the event target should be implementing.
 var (
-	_ v1alpha1.Reconcilable        = (*AWSS3Target)(nil)
-	_ v1alpha1.AdapterConfigurable = (*AWSS3Target)(nil)
-	_ v1alpha1.EventReceiver       = (*AWSS3Target)(nil)
-	_ v1alpha1.EventSource         = (*AWSS3Target)(nil)
+	_ v1alpha1.Reconcilable           = (*AWSS3Target)(nil)
+	_ v1alpha1.AdapterConfigurable    = (*AWSS3Target)(nil)
+	_ v1alpha1.ServiceAccountProvider = (*AWSS3Target)(nil)
+	_ v1alpha1.EventReceiver          = (*AWSS3Target)(nil)
+	_ v1alpha1.EventSource            = (*AWSS3Target)(nil)
 )
 
 // AWSS3TargetSpec holds the desired state of the even target.
 type AWSS3TargetSpec struct {
 	// AWS account Key
+	// Superseded by Auth.
+	// +optional
 	AWSApiKey SecretValueFromSource `json:"awsApiKey"`
 
 	// AWS account secret key
+	// Superseded by Auth.
+	// +optional
 	AWSApiSecret SecretValueFromSource `json:"awsApiSecret"`
 
 	// Amazon Resource Name of the S3 bucket.
@@ -60,6 +65,15 @@ type AWSS3TargetSpec struct {
 	// When this property is true, only the CloudEvent data is included.
 	DiscardCEContext bool `json:"discardCloudEventContext"`
 
+	// Authentication method to interact with the AWS API.
+	// Takes precedence over AWSApiKey and AWSApiSecret.
+	// +optional
+	Auth *AWSAuth `json:"auth,omitempty"`
+
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *AWSEndpoint `json:"endpoint,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
@@ -37,16 +37,21 @@ type AWSSQSTarget struct {
 
 // Check the interfaces the event target should be implementing.
 var (
-	_ v1alpha1.Reconcilable        = (*AWSSQSTarget)(nil)
-	_ v1alpha1.AdapterConfigurable = (*AWSSQSTarget)(nil)
+	_ v1alpha1.Reconcilable           = (*AWSSQSTarget)(nil)
+	_ v1alpha1.AdapterConfigurable    = (*AWSSQSTarget)(nil)
+	_ v1alpha1.ServiceAccountProvider = (*AWSSQSTarget)(nil)
 )
 
 // AWSSQSTargetSpec defines the desired state of the event target.
 type AWSSQSTargetSpec struct {
 	// AWS account Key
+	// Superseded by Auth.
+	// +optional
 	AWSApiKey SecretValueFromSource `json:"awsApiKey"`
 
 	// AWS account secret key
+	// Superseded by Auth.
+	// +optional
 	AWSApiSecret SecretValueFromSource `json:"awsApiSecret"`
 
 	// Amazon Resource Name of the SQS queue.
@@ -58,6 +63,15 @@ type AWSSQSTargetSpec struct {
 	// When this property is true, only the CloudEvent data is included.
 	DiscardCEContext bool `json:"discardCloudEventContext"`
 
+	// Authentication method to interact with the AWS API.
+	// Takes precedence over AWSApiKey and AWSApiSecret.
+	// +optional
+	Auth *AWSAuth `json:"auth,omitempty"`
+
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *AWSEndpoint `json:"endpoint,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
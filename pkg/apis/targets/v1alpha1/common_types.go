@@ -42,3 +42,16 @@ type EventOptions struct {
 	// +optional
 	PayloadPolicy *cloudevents.PayloadPolicy `json:"payloadPolicy,omitempty"`
 }
+
+// EventValueSelector selects a value from a CloudEvent, either from one of
+// its context attributes or from a field of its JSON data. Exactly one of
+// the fields must be set.
+type EventValueSelector struct {
+	// Name of a CloudEvent context attribute, e.g. "subject", "id" or the
+	// name of an extension.
+	// +optional
+	Attribute *string `json:"attribute,omitempty"`
+	// JSONPath expression selecting a field of the event data, e.g. "$.order.id".
+	// +optional
+	JSONPath *string `json:"jsonPath,omitempty"`
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"regexp"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/eventvalue"
+)
+
+// validate verifies that the selector points at exactly one valid location.
+func (s *EventValueSelector) validate() *apis.FieldError {
+	switch {
+	case s.Attribute == nil && s.JSONPath == nil:
+		return apis.ErrMissingOneOf("attribute", "jsonPath")
+	case s.Attribute != nil && s.JSONPath != nil:
+		return apis.ErrMultipleOneOf("attribute", "jsonPath")
+	case s.Attribute != nil:
+		if !ceAttributeNameRegexp.MatchString(*s.Attribute) {
+			return apis.ErrInvalidValue(*s.Attribute, "attribute",
+				"CloudEvent attribute names must consist of lower-case letters or digits")
+		}
+	default:
+		if _, err := eventvalue.ParseJSONPath(*s.JSONPath); err != nil {
+			return apis.ErrInvalidValue(*s.JSONPath, "jsonPath", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// ceAttributeNameRegexp matches valid names of CloudEvent context attributes.
+var ceAttributeNameRegexp = regexp.MustCompile(`^[a-z0-9]+$`)
@@ -22,12 +22,79 @@ limitations under the License.
 package v1alpha1
 
 import (
+	apis "github.com/triggermesh/triggermesh/pkg/apis"
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	cloudevents "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	pkgapis "knative.dev/pkg/apis"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSAssumeRole) DeepCopyInto(out *AWSAssumeRole) {
+	*out = *in
+	out.RoleARN = in.RoleARN
+	if in.ExternalID != nil {
+		in, out := &in.ExternalID, &out.ExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionName != nil {
+		in, out := &in.SessionName, &out.SessionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SessionTags != nil {
+		in, out := &in.SessionTags, &out.SessionTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSAssumeRole.
+func (in *AWSAssumeRole) DeepCopy() *AWSAssumeRole {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSAssumeRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSAuth) DeepCopyInto(out *AWSAuth) {
+	*out = *in
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(AWSSecurityCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EksIAMRole != nil {
+		in, out := &in.EksIAMRole, &out.EksIAMRole
+		*out = new(apis.ARN)
+		**out = **in
+	}
+	if in.AssumeRole != nil {
+		in, out := &in.AssumeRole, &out.AssumeRole
+		*out = new(AWSAssumeRole)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSAuth.
+func (in *AWSAuth) DeepCopy() *AWSAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSComprehendTarget) DeepCopyInto(out *AWSComprehendTarget) {
 	*out = *in
@@ -99,6 +166,16 @@ func (in *AWSComprehendTargetSpec) DeepCopyInto(out *AWSComprehendTargetSpec) {
 		*out = new(EventOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AWSAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -117,6 +194,63 @@ func (in *AWSComprehendTargetSpec) DeepCopy() *AWSComprehendTargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSDynamoDBAttribute) DeepCopyInto(out *AWSDynamoDBAttribute) {
+	*out = *in
+	in.ValueFrom.DeepCopyInto(&out.ValueFrom)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSDynamoDBAttribute.
+func (in *AWSDynamoDBAttribute) DeepCopy() *AWSDynamoDBAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSDynamoDBAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSDynamoDBOperation) DeepCopyInto(out *AWSDynamoDBOperation) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = make([]AWSDynamoDBAttribute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpdateAttributes != nil {
+		in, out := &in.UpdateAttributes, &out.UpdateAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConditionExpression != nil {
+		in, out := &in.ConditionExpression, &out.ConditionExpression
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConditionValues != nil {
+		in, out := &in.ConditionValues, &out.ConditionValues
+		*out = make([]AWSDynamoDBAttribute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSDynamoDBOperation.
+func (in *AWSDynamoDBOperation) DeepCopy() *AWSDynamoDBOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSDynamoDBOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSDynamoDBTarget) DeepCopyInto(out *AWSDynamoDBTarget) {
 	*out = *in
@@ -183,6 +317,23 @@ func (in *AWSDynamoDBTargetSpec) DeepCopyInto(out *AWSDynamoDBTargetSpec) {
 	*out = *in
 	in.AWSApiKey.DeepCopyInto(&out.AWSApiKey)
 	in.AWSApiSecret.DeepCopyInto(&out.AWSApiSecret)
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]AWSDynamoDBOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AWSAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -201,6 +352,27 @@ func (in *AWSDynamoDBTargetSpec) DeepCopy() *AWSDynamoDBTargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEndpoint) DeepCopyInto(out *AWSEndpoint) {
+	*out = *in
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(pkgapis.URL)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEndpoint.
+func (in *AWSEndpoint) DeepCopy() *AWSEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSEventBridgeTarget) DeepCopyInto(out *AWSEventBridgeTarget) {
 	*out = *in
@@ -267,6 +439,16 @@ func (in *AWSEventBridgeTargetSpec) DeepCopyInto(out *AWSEventBridgeTargetSpec)
 	*out = *in
 	in.AWSApiKey.DeepCopyInto(&out.AWSApiKey)
 	in.AWSApiSecret.DeepCopyInto(&out.AWSApiSecret)
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AWSAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -351,6 +533,16 @@ func (in *AWSKinesisTargetSpec) DeepCopyInto(out *AWSKinesisTargetSpec) {
 	*out = *in
 	in.AWSApiKey.DeepCopyInto(&out.AWSApiKey)
 	in.AWSApiSecret.DeepCopyInto(&out.AWSApiSecret)
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AWSAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -435,6 +627,16 @@ func (in *AWSLambdaTargetSpec) DeepCopyInto(out *AWSLambdaTargetSpec) {
 	*out = *in
 	in.AWSApiKey.DeepCopyInto(&out.AWSApiKey)
 	in.AWSApiSecret.DeepCopyInto(&out.AWSApiSecret)
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AWSAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -519,6 +721,16 @@ func (in *AWSS3TargetSpec) DeepCopyInto(out *AWSS3TargetSpec) {
 	*out = *in
 	in.AWSApiKey.DeepCopyInto(&out.AWSApiKey)
 	in.AWSApiSecret.DeepCopyInto(&out.AWSApiSecret)
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AWSAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -603,6 +815,16 @@ func (in *AWSSNSTargetSpec) DeepCopyInto(out *AWSSNSTargetSpec) {
 	*out = *in
 	in.AWSApiKey.DeepCopyInto(&out.AWSApiKey)
 	in.AWSApiSecret.DeepCopyInto(&out.AWSApiSecret)
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AWSAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -687,6 +909,16 @@ func (in *AWSSQSTargetSpec) DeepCopyInto(out *AWSSQSTargetSpec) {
 	*out = *in
 	in.AWSApiKey.DeepCopyInto(&out.AWSApiKey)
 	in.AWSApiSecret.DeepCopyInto(&out.AWSApiSecret)
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AWSAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -705,6 +937,24 @@ func (in *AWSSQSTargetSpec) DeepCopy() *AWSSQSTargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSecurityCredentials) DeepCopyInto(out *AWSSecurityCredentials) {
+	*out = *in
+	in.AccessKeyID.DeepCopyInto(&out.AccessKeyID)
+	in.SecretAccessKey.DeepCopyInto(&out.SecretAccessKey)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSecurityCredentials.
+func (in *AWSSecurityCredentials) DeepCopy() *AWSSecurityCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSecurityCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlibabaOSSTarget) DeepCopyInto(out *AlibabaOSSTarget) {
 	*out = *in
@@ -1442,6 +1692,80 @@ func (in *EventOptions) DeepCopy() *EventOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventValueSelector) DeepCopyInto(out *EventValueSelector) {
+	*out = *in
+	if in.Attribute != nil {
+		in, out := &in.Attribute, &out.Attribute
+		*out = new(string)
+		**out = **in
+	}
+	if in.JSONPath != nil {
+		in, out := &in.JSONPath, &out.JSONPath
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventValueSelector.
+func (in *EventValueSelector) DeepCopy() *EventValueSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(EventValueSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudFirestoreCondition) DeepCopyInto(out *GoogleCloudFirestoreCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudFirestoreCondition.
+func (in *GoogleCloudFirestoreCondition) DeepCopy() *GoogleCloudFirestoreCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudFirestoreCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudFirestoreOperation) DeepCopyInto(out *GoogleCloudFirestoreOperation) {
+	*out = *in
+	if in.Collection != nil {
+		in, out := &in.Collection, &out.Collection
+		*out = new(string)
+		**out = **in
+	}
+	in.DocumentID.DeepCopyInto(&out.DocumentID)
+	if in.UpdateMask != nil {
+		in, out := &in.UpdateMask, &out.UpdateMask
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(GoogleCloudFirestoreCondition)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudFirestoreOperation.
+func (in *GoogleCloudFirestoreOperation) DeepCopy() *GoogleCloudFirestoreOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudFirestoreOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GoogleCloudFirestoreTarget) DeepCopyInto(out *GoogleCloudFirestoreTarget) {
 	*out = *in
@@ -1507,6 +1831,13 @@ func (in *GoogleCloudFirestoreTargetList) DeepCopyObject() runtime.Object {
 func (in *GoogleCloudFirestoreTargetSpec) DeepCopyInto(out *GoogleCloudFirestoreTargetSpec) {
 	*out = *in
 	in.Credentials.DeepCopyInto(&out.Credentials)
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]GoogleCloudFirestoreOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.EventOptions != nil {
 		in, out := &in.EventOptions, &out.EventOptions
 		*out = new(EventOptions)
@@ -1706,6 +2037,27 @@ func (in *GoogleCloudWorkflowsTargetSpec) DeepCopy() *GoogleCloudWorkflowsTarget
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleSheetBatching) DeepCopyInto(out *GoogleSheetBatching) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleSheetBatching.
+func (in *GoogleSheetBatching) DeepCopy() *GoogleSheetBatching {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleSheetBatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GoogleSheetTarget) DeepCopyInto(out *GoogleSheetTarget) {
 	*out = *in
@@ -1771,6 +2123,16 @@ func (in *GoogleSheetTargetList) DeepCopyObject() runtime.Object {
 func (in *GoogleSheetTargetSpec) DeepCopyInto(out *GoogleSheetTargetSpec) {
 	*out = *in
 	in.GoogleServiceAccount.DeepCopyInto(&out.GoogleServiceAccount)
+	if in.Batching != nil {
+		in, out := &in.Batching, &out.Batching
+		*out = new(GoogleSheetBatching)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -2510,7 +2872,11 @@ func (in *LogzMetricsTargetList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogzMetricsTargetSpec) DeepCopyInto(out *LogzMetricsTargetSpec) {
 	*out = *in
-	in.Connection.DeepCopyInto(&out.Connection)
+	if in.Connection != nil {
+		in, out := &in.Connection, &out.Connection
+		*out = new(LogzMetricsConnection)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Instruments != nil {
 		in, out := &in.Instruments, &out.Instruments
 		*out = make([]Instrument, len(*in))
@@ -2518,6 +2884,11 @@ func (in *LogzMetricsTargetSpec) DeepCopyInto(out *LogzMetricsTargetSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLPConnection)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EventOptions != nil {
 		in, out := &in.EventOptions, &out.EventOptions
 		*out = new(EventOptions)
@@ -2645,6 +3016,61 @@ func (in *MQReplyOptions) DeepCopy() *MQReplyOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPConnection) DeepCopyInto(out *OTLPConnection) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.Insecure != nil {
+		in, out := &in.Insecure, &out.Insecure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]OTLPHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPConnection.
+func (in *OTLPConnection) DeepCopy() *OTLPConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPHeader) DeepCopyInto(out *OTLPHeader) {
+	*out = *in
+	in.Value.DeepCopyInto(&out.Value)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPHeader.
+func (in *OTLPHeader) DeepCopy() *OTLPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OracleFunctionSpecSpec) DeepCopyInto(out *OracleFunctionSpecSpec) {
 	*out = *in
@@ -2751,6 +3177,185 @@ func (in *OracleTargetSpec) DeepCopy() *OracleTargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusBasicAuth) DeepCopyInto(out *PrometheusBasicAuth) {
+	*out = *in
+	in.Password.DeepCopyInto(&out.Password)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusBasicAuth.
+func (in *PrometheusBasicAuth) DeepCopy() *PrometheusBasicAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusBasicAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRemoteWrite) DeepCopyInto(out *PrometheusRemoteWrite) {
+	*out = *in
+	in.URL.DeepCopyInto(&out.URL)
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(SecretValueFromSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(PrometheusBasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRemoteWrite.
+func (in *PrometheusRemoteWrite) DeepCopy() *PrometheusRemoteWrite {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRemoteWrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusSeriesLimits) DeepCopyInto(out *PrometheusSeriesLimits) {
+	*out = *in
+	if in.MaxSeriesPerInstrument != nil {
+		in, out := &in.MaxSeriesPerInstrument, &out.MaxSeriesPerInstrument
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxLabelsPerSeries != nil {
+		in, out := &in.MaxLabelsPerSeries, &out.MaxLabelsPerSeries
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusSeriesLimits.
+func (in *PrometheusSeriesLimits) DeepCopy() *PrometheusSeriesLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusSeriesLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusTarget) DeepCopyInto(out *PrometheusTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusTarget.
+func (in *PrometheusTarget) DeepCopy() *PrometheusTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PrometheusTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusTargetList) DeepCopyInto(out *PrometheusTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PrometheusTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusTargetList.
+func (in *PrometheusTargetList) DeepCopy() *PrometheusTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PrometheusTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusTargetSpec) DeepCopyInto(out *PrometheusTargetSpec) {
+	*out = *in
+	if in.Instruments != nil {
+		in, out := &in.Instruments, &out.Instruments
+		*out = make([]Instrument, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RemoteWrite != nil {
+		in, out := &in.RemoteWrite, &out.RemoteWrite
+		*out = new(PrometheusRemoteWrite)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(PrometheusSeriesLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(commonv1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusTargetSpec.
+func (in *PrometheusTargetSpec) DeepCopy() *PrometheusTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SalesforceAuth) DeepCopyInto(out *SalesforceAuth) {
 	*out = *in
@@ -2882,6 +3487,32 @@ func (in *SecretValueFromSource) DeepCopy() *SecretValueFromSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SendGridAttachmentURLs) DeepCopyInto(out *SendGridAttachmentURLs) {
+	*out = *in
+	if in.AllowedHosts != nil {
+		in, out := &in.AllowedHosts, &out.AllowedHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedSchemes != nil {
+		in, out := &in.AllowedSchemes, &out.AllowedSchemes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SendGridAttachmentURLs.
+func (in *SendGridAttachmentURLs) DeepCopy() *SendGridAttachmentURLs {
+	if in == nil {
+		return nil
+	}
+	out := new(SendGridAttachmentURLs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SendGridTarget) DeepCopyInto(out *SendGridTarget) {
 	*out = *in
@@ -2977,6 +3608,28 @@ func (in *SendGridTargetSpec) DeepCopyInto(out *SendGridTargetSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DefaultTemplateID != nil {
+		in, out := &in.DefaultTemplateID, &out.DefaultTemplateID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CategoryAttributes != nil {
+		in, out := &in.CategoryAttributes, &out.CategoryAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomArgAttributes != nil {
+		in, out := &in.CustomArgAttributes, &out.CustomArgAttributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AttachmentURLs != nil {
+		in, out := &in.AttachmentURLs, &out.AttachmentURLs
+		*out = new(SendGridAttachmentURLs)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EventOptions != nil {
 		in, out := &in.EventOptions, &out.EventOptions
 		*out = new(EventOptions)
@@ -3065,6 +3718,11 @@ func (in *SlackTargetList) DeepCopyObject() runtime.Object {
 func (in *SlackTargetSpec) DeepCopyInto(out *SlackTargetSpec) {
 	*out = *in
 	in.Token.DeepCopyInto(&out.Token)
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -3294,6 +3952,26 @@ func (in *TektonTargetSpec) DeepCopyInto(out *TektonTargetSpec) {
 		*out = new(TektonTargetReapPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Workspaces != nil {
+		in, out := &in.Workspaces, &out.Workspaces
+		*out = make([]TektonTargetWorkspace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceAccountName != nil {
+		in, out := &in.ServiceAccountName, &out.ServiceAccountName
+		*out = new(string)
+		**out = **in
+	}
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -3312,6 +3990,42 @@ func (in *TektonTargetSpec) DeepCopy() *TektonTargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TektonTargetWorkspace) DeepCopyInto(out *TektonTargetWorkspace) {
+	*out = *in
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(string)
+		**out = **in
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(string)
+		**out = **in
+	}
+	if in.EmptyDir != nil {
+		in, out := &in.EmptyDir, &out.EmptyDir
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TektonTargetWorkspace.
+func (in *TektonTargetWorkspace) DeepCopy() *TektonTargetWorkspace {
+	if in == nil {
+		return nil
+	}
+	out := new(TektonTargetWorkspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TwilioTarget) DeepCopyInto(out *TwilioTarget) {
 	*out = *in
@@ -3388,6 +4102,16 @@ func (in *TwilioTargetSpec) DeepCopyInto(out *TwilioTargetSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DefaultMessagingServiceSID != nil {
+		in, out := &in.DefaultMessagingServiceSID, &out.DefaultMessagingServiceSID
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusCallbackURL != nil {
+		in, out := &in.StatusCallbackURL, &out.StatusCallbackURL
+		*out = new(pkgapis.URL)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EventOptions != nil {
 		in, out := &in.EventOptions, &out.EventOptions
 		*out = new(EventOptions)
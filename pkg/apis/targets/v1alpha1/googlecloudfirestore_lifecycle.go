@@ -37,6 +37,8 @@ const (
 
 	EventTypeGoogleCloudFirestoreQueryTableResponse = "io.triggermesh.google.firestore.query.table.response"
 	EventTypeGoogleCloudFirestoreQueryTable         = "io.triggermesh.google.firestore.query.table"
+
+	EventTypeGoogleCloudFirestoreDocument = "io.triggermesh.google.firestore.document"
 )
 
 // GetGroupVersionKind implements kmeta.OwnerRefable.
@@ -63,12 +65,18 @@ func (t *GoogleCloudFirestoreTarget) GetStatusManager() *v1alpha1.StatusManager
 }
 
 // AcceptedEventTypes implements IntegrationTarget.
-func (*GoogleCloudFirestoreTarget) AcceptedEventTypes() []string {
-	return []string{
+func (t *GoogleCloudFirestoreTarget) AcceptedEventTypes() []string {
+	types := []string{
 		EventTypeGoogleCloudFirestoreWrite,
 		EventTypeGoogleCloudFirestoreQueryTables,
 		EventTypeGoogleCloudFirestoreQueryTable,
 	}
+
+	for _, op := range t.Spec.Operations {
+		types = append(types, op.EventType)
+	}
+
+	return types
 }
 
 // GetEventTypes implements EventSource.
@@ -80,6 +88,7 @@ func (*GoogleCloudFirestoreTarget) GetEventTypes() []string {
 		EventTypeGoogleCloudFirestoreQueryTables,
 		EventTypeGoogleCloudFirestoreQueryTableResponse,
 		EventTypeGoogleCloudFirestoreQueryTable,
+		EventTypeGoogleCloudFirestoreDocument,
 	}
 }
 
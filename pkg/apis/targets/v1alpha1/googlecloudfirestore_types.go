@@ -59,6 +59,12 @@ type GoogleCloudFirestoreTargetSpec struct {
 	// When this property is true, only the CloudEvent data is included.
 	DiscardCEContext bool `json:"discardCloudEventContext"`
 
+	// Operations performed against Firestore, selected by the type of the
+	// incoming event. Events whose type does not match any operation are
+	// processed according to the default behaviour of the target.
+	// +optional
+	Operations []GoogleCloudFirestoreOperation `json:"operations,omitempty"`
+
 	// EventOptions for targets
 	EventOptions *EventOptions `json:"eventOptions,omitempty"`
 
@@ -75,3 +81,58 @@ type GoogleCloudFirestoreTargetList struct {
 	metav1.ListMeta `json:"metadata"`
 	Items           []GoogleCloudFirestoreTarget `json:"items"`
 }
+
+// GoogleCloudFirestoreOperation is an operation performed on a Firestore
+// document upon reception of events of a given type.
+type GoogleCloudFirestoreOperation struct {
+	// Type of the events this operation is performed for.
+	EventType string `json:"eventType"`
+
+	// Operation to perform on the document.
+	Operation GoogleCloudFirestoreOperationType `json:"operation"`
+
+	// Collection the document belongs to. Defaults to the target's
+	// default collection.
+	// +optional
+	Collection *string `json:"collection,omitempty"`
+
+	// Location of the document ID in the incoming events.
+	DocumentID EventValueSelector `json:"documentID"`
+
+	// Paths of the document fields written by "update" and "upsert"
+	// operations, in dot notation. Fields that are listed but absent from
+	// the event data are deleted from the document. When empty, all
+	// top-level fields of the event data are written.
+	// +optional
+	UpdateMask []string `json:"updateMask,omitempty"`
+
+	// Condition on the existence of the document that must be satisfied
+	// for "put" and "delete" operations to be applied.
+	// +optional
+	Condition *GoogleCloudFirestoreCondition `json:"condition,omitempty"`
+}
+
+// GoogleCloudFirestoreOperationType is the type of an operation performed on a
+// Firestore document.
+type GoogleCloudFirestoreOperationType string
+
+// Supported Firestore operations.
+const (
+	// Write the event data as the document, replacing any existing content.
+	GoogleCloudFirestoreOperationPut GoogleCloudFirestoreOperationType = "put"
+	// Write fields of the event data to an existing document.
+	GoogleCloudFirestoreOperationUpdate GoogleCloudFirestoreOperationType = "update"
+	// Write fields of the event data to a document, creating it if necessary.
+	GoogleCloudFirestoreOperationUpsert GoogleCloudFirestoreOperationType = "upsert"
+	// Delete the document.
+	GoogleCloudFirestoreOperationDelete GoogleCloudFirestoreOperationType = "delete"
+	// Reply with the content of the document.
+	GoogleCloudFirestoreOperationGet GoogleCloudFirestoreOperationType = "get"
+)
+
+// GoogleCloudFirestoreCondition is a precondition on the state of a Firestore
+// document.
+type GoogleCloudFirestoreCondition struct {
+	// Whether the document must exist (true) or must not exist (false).
+	Exists bool `json:"exists"`
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (t *GoogleCloudFirestoreTarget) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *GoogleCloudFirestoreTargetSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	eventTypes := make(map[string]struct{}, len(s.Operations))
+
+	for i := range s.Operations {
+		op := &s.Operations[i]
+
+		if _, ok := eventTypes[op.EventType]; ok {
+			errs = errs.Also(apis.ErrGeneric("duplicate event type "+op.EventType, "eventType").ViaFieldIndex("operations", i))
+		}
+		eventTypes[op.EventType] = struct{}{}
+
+		errs = errs.Also(op.validate().ViaFieldIndex("operations", i))
+	}
+
+	return errs
+}
+
+// validate verifies the configuration of a single operation.
+func (o *GoogleCloudFirestoreOperation) validate() *apis.FieldError {
+	var errs *apis.FieldError
+
+	if o.EventType == "" {
+		errs = errs.Also(apis.ErrMissingField("eventType"))
+	}
+
+	switch o.Operation {
+	case "":
+		errs = errs.Also(apis.ErrMissingField("operation"))
+	case GoogleCloudFirestoreOperationPut,
+		GoogleCloudFirestoreOperationUpdate,
+		GoogleCloudFirestoreOperationUpsert,
+		GoogleCloudFirestoreOperationDelete,
+		GoogleCloudFirestoreOperationGet:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(o.Operation, "operation"))
+	}
+
+	if o.Collection != nil && *o.Collection == "" {
+		errs = errs.Also(apis.ErrInvalidValue(*o.Collection, "collection", "collection name can not be empty"))
+	}
+
+	errs = errs.Also(o.DocumentID.validate().ViaField("documentID"))
+
+	if len(o.UpdateMask) > 0 {
+		switch o.Operation {
+		case GoogleCloudFirestoreOperationUpdate, GoogleCloudFirestoreOperationUpsert:
+			for i, p := range o.UpdateMask {
+				if !isValidFieldPath(p) {
+					errs = errs.Also(apis.ErrInvalidArrayValue(p, "updateMask", i))
+				}
+			}
+		default:
+			errs = errs.Also(apis.ErrDisallowedFields("updateMask"))
+		}
+	}
+
+	if o.Condition != nil {
+		switch o.Operation {
+		case GoogleCloudFirestoreOperationPut:
+		case GoogleCloudFirestoreOperationDelete:
+			if !o.Condition.Exists {
+				errs = errs.Also(apis.ErrInvalidValue(o.Condition.Exists, "condition.exists",
+					"delete operations only support the condition that the document exists"))
+			}
+		default:
+			errs = errs.Also(apis.ErrDisallowedFields("condition"))
+		}
+	}
+
+	return errs
+}
+
+// isValidFieldPath returns whether the given string is a valid path of a
+// document field in dot notation.
+func isValidFieldPath(p string) bool {
+	for _, f := range strings.Split(p, ".") {
+		if f == "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoogleCloudFirestoreTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		ops         []GoogleCloudFirestoreOperation
+		expectError string
+	}{
+		"no operations": {},
+		"valid operations": {
+			ops: []GoogleCloudFirestoreOperation{{
+				EventType:  "order.created",
+				Operation:  GoogleCloudFirestoreOperationPut,
+				DocumentID: EventValueSelector{Attribute: strPtr("subject")},
+				Condition:  &GoogleCloudFirestoreCondition{Exists: false},
+			}, {
+				EventType:  "order.updated",
+				Operation:  GoogleCloudFirestoreOperationUpdate,
+				Collection: strPtr("orders"),
+				DocumentID: EventValueSelector{JSONPath: strPtr("$.order.id")},
+				UpdateMask: []string{"status", "shipping.address"},
+			}, {
+				EventType:  "order.deleted",
+				Operation:  GoogleCloudFirestoreOperationDelete,
+				DocumentID: EventValueSelector{JSONPath: strPtr("$.id")},
+				Condition:  &GoogleCloudFirestoreCondition{Exists: true},
+			}},
+		},
+		"invalid operation": {
+			ops: []GoogleCloudFirestoreOperation{{
+				EventType:  "order.read",
+				Operation:  GoogleCloudFirestoreOperationGet,
+				DocumentID: EventValueSelector{JSONPath: strPtr("order.id")},
+				UpdateMask: []string{"status"},
+				Condition:  &GoogleCloudFirestoreCondition{Exists: true},
+			}},
+			expectError: "invalid value: order.id: spec.operations[0].documentID.jsonPath\n" +
+				"JSONPath expression \"order.id\" must start with '$'\n" +
+				"must not set the field(s): spec.operations[0].condition, spec.operations[0].updateMask",
+		},
+		"missing fields": {
+			ops: []GoogleCloudFirestoreOperation{{}},
+			expectError: "expected exactly one, got neither: spec.operations[0].documentID.attribute, " +
+				"spec.operations[0].documentID.jsonPath\n" +
+				"missing field(s): spec.operations[0].eventType, spec.operations[0].operation",
+		},
+		"duplicate event types": {
+			ops: []GoogleCloudFirestoreOperation{{
+				EventType:  "order.updated",
+				Operation:  GoogleCloudFirestoreOperationUpsert,
+				DocumentID: EventValueSelector{Attribute: strPtr("subject")},
+				UpdateMask: []string{"status.", "total"},
+			}, {
+				EventType:  "order.updated",
+				Operation:  GoogleCloudFirestoreOperationDelete,
+				DocumentID: EventValueSelector{Attribute: strPtr("Subject")},
+				Condition:  &GoogleCloudFirestoreCondition{Exists: false},
+			}},
+			expectError: "duplicate event type order.updated: spec.operations[1].eventType\n" +
+				"invalid value: Subject: spec.operations[1].documentID.attribute\n" +
+				"CloudEvent attribute names must consist of lower-case letters or digits\n" +
+				"invalid value: false: spec.operations[1].condition.exists\n" +
+				"delete operations only support the condition that the document exists\n" +
+				"invalid value: status.: spec.operations[0].updateMask[0]",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &GoogleCloudFirestoreTarget{Spec: GoogleCloudFirestoreTargetSpec{
+				DefaultCollection: "events",
+				Operations:        tc.ops,
+			}}
+
+			err := trg.Validate(context.Background())
+			if tc.expectError == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectError)
+			}
+		})
+	}
+}
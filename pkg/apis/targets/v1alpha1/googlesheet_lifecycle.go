@@ -31,6 +31,21 @@ import (
 const (
 	// EventTypeGoogleSheetAppend represents a task to append a row to a sheet.
 	EventTypeGoogleSheetAppend = "io.triggermesh.googlesheet.append"
+	// EventTypeGoogleSheetRead represents a task to read a range of cells.
+	EventTypeGoogleSheetRead = "io.triggermesh.googlesheet.read"
+	// EventTypeGoogleSheetUpdate represents a task to update a range of cells.
+	EventTypeGoogleSheetUpdate = "io.triggermesh.googlesheet.update"
+	// EventTypeGoogleSheetUpsert represents a task to update the row matching
+	// a key, or to append it when no row matches.
+	EventTypeGoogleSheetUpsert = "io.triggermesh.googlesheet.upsert"
+	// EventTypeGoogleSheetClear represents a task to clear a range of cells.
+	EventTypeGoogleSheetClear = "io.triggermesh.googlesheet.clear"
+)
+
+// Returned event types
+const (
+	// EventTypeGoogleSheetValues contains the values of a range of cells.
+	EventTypeGoogleSheetValues = "io.triggermesh.googlesheet.values"
 )
 
 // GetGroupVersionKind implements kmeta.OwnerRefable.
@@ -60,6 +75,10 @@ func (t *GoogleSheetTarget) GetStatusManager() *v1alpha1.StatusManager {
 func (*GoogleSheetTarget) AcceptedEventTypes() []string {
 	return []string{
 		EventTypeGoogleSheetAppend,
+		EventTypeGoogleSheetRead,
+		EventTypeGoogleSheetUpdate,
+		EventTypeGoogleSheetUpsert,
+		EventTypeGoogleSheetClear,
 	}
 }
 
@@ -67,6 +86,7 @@ func (*GoogleSheetTarget) AcceptedEventTypes() []string {
 func (*GoogleSheetTarget) GetEventTypes() []string {
 	return []string{
 		EventTypeResponse,
+		EventTypeGoogleSheetValues,
 	}
 }
 
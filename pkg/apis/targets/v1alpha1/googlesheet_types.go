@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -54,11 +55,32 @@ type GoogleSheetTargetSpec struct {
 	// DefaultPrefix is a pre-defined prefix for the individual sheets.
 	DefaultPrefix string `json:"defaultPrefix"`
 
+	// Batching of appended rows. When set, rows appended within the same
+	// batching window are written to the spreadsheet using a single API call.
+	// +optional
+	Batching *GoogleSheetBatching `json:"batching,omitempty"`
+
+	// EventOptions for targets
+	// +optional
+	EventOptions *EventOptions `json:"eventOptions,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// GoogleSheetBatching defines how appended rows are buffered before being
+// written to the spreadsheet.
+type GoogleSheetBatching struct {
+	// Maximum number of rows written in a single API call.
+	MaxRows int `json:"maxRows"`
+
+	// Maximum duration rows are buffered for before being written.
+	// Defaults to 1s.
+	// +optional
+	Interval *apis.Duration `json:"interval,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // GoogleSheetTargetList is a list of event target instances.
@@ -0,0 +1,148 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+)
+
+var _ apis.Convertible = (*HTTPTarget)(nil)
+
+// ConvertTo implements apis.Convertible.
+func (t *HTTPTarget) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *v1beta1.HTTPTarget:
+		sink.ObjectMeta = t.ObjectMeta
+		sink.Spec = v1beta1.HTTPTargetSpec{
+			Response: v1beta1.HTTPEventResponse{
+				EventType:   t.Spec.Response.EventType,
+				EventSource: t.Spec.Response.EventSource,
+			},
+			Endpoint:         t.Spec.Endpoint,
+			Method:           t.Spec.Method,
+			Headers:          t.Spec.Headers,
+			SkipVerify:       t.Spec.SkipVerify,
+			CACertificate:    t.Spec.CACertificate,
+			AdapterOverrides: t.Spec.AdapterOverrides,
+		}
+
+		if t.Spec.BasicAuthUsername != nil || t.Spec.BasicAuthPassword.SecretKeyRef != nil {
+			sink.Spec.BasicAuth = &v1beta1.HTTPBasicAuth{
+				Password: v1alpha1.ValueFromField{
+					ValueFromSecret: t.Spec.BasicAuthPassword.SecretKeyRef,
+				},
+			}
+			if u := t.Spec.BasicAuthUsername; u != nil {
+				sink.Spec.BasicAuth.Username = *u
+			}
+		}
+
+		if t.Spec.OAuthClientID != nil || t.Spec.OAuthClientSecret.SecretKeyRef != nil ||
+			t.Spec.OAuthTokenURL != nil || t.Spec.OAuthScopes != nil {
+
+			sink.Spec.OAuth = &v1beta1.HTTPOAuth{
+				ClientSecret: v1alpha1.ValueFromField{
+					ValueFromSecret: t.Spec.OAuthClientSecret.SecretKeyRef,
+				},
+			}
+			if id := t.Spec.OAuthClientID; id != nil {
+				sink.Spec.OAuth.ClientID = *id
+			}
+			if u := t.Spec.OAuthTokenURL; u != nil {
+				sink.Spec.OAuth.TokenURL = *u
+			}
+			if s := t.Spec.OAuthScopes; s != nil {
+				sink.Spec.OAuth.Scopes = *s
+			}
+		}
+
+		sink.Status = t.Status
+		return nil
+
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *HTTPTarget) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *v1beta1.HTTPTarget:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec = HTTPTargetSpec{
+			Response: HTTPEventResponse{
+				EventType:   source.Spec.Response.EventType,
+				EventSource: source.Spec.Response.EventSource,
+			},
+			Endpoint:         source.Spec.Endpoint,
+			Method:           source.Spec.Method,
+			Headers:          source.Spec.Headers,
+			SkipVerify:       source.Spec.SkipVerify,
+			CACertificate:    source.Spec.CACertificate,
+			AdapterOverrides: source.Spec.AdapterOverrides,
+		}
+
+		if ba := source.Spec.BasicAuth; ba != nil {
+			if ba.Password.Value != "" {
+				return errLiteralSecretValue("basicAuth.password")
+			}
+			if ba.Username != "" {
+				u := ba.Username
+				t.Spec.BasicAuthUsername = &u
+			}
+			t.Spec.BasicAuthPassword.SecretKeyRef = ba.Password.ValueFromSecret
+		}
+
+		if oa := source.Spec.OAuth; oa != nil {
+			if oa.ClientSecret.Value != "" {
+				return errLiteralSecretValue("oauth.clientSecret")
+			}
+			if oa.ClientID != "" {
+				id := oa.ClientID
+				t.Spec.OAuthClientID = &id
+			}
+			if oa.TokenURL != "" {
+				u := oa.TokenURL
+				t.Spec.OAuthTokenURL = &u
+			}
+			if oa.Scopes != nil {
+				s := oa.Scopes
+				t.Spec.OAuthScopes = &s
+			}
+			t.Spec.OAuthClientSecret.SecretKeyRef = oa.ClientSecret.ValueFromSecret
+		}
+
+		t.Status = source.Status
+		return nil
+
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// errLiteralSecretValue returns an error indicating that a literal value was
+// set on a field which can only reference a Secret in v1alpha1.
+func errLiteralSecretValue(field string) error {
+	return errors.New("literal values are not supported in field " + field + ", a Secret must be referenced")
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+)
+
+func TestHTTPTargetConversionRoundTrip(t *testing.T) {
+	secretRef := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "creds"},
+		Key:                  "pass",
+	}
+
+	testCases := map[string]*HTTPTarget{
+		"no authentication": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "test"},
+			Spec: HTTPTargetSpec{
+				Response: HTTPEventResponse{EventType: "io.test.response"},
+				Endpoint: *apis.HTTPS("example.com"),
+				Method:   "POST",
+				Headers:  map[string]string{"X-Test": "true"},
+			},
+		},
+		"basic and oauth authentication": {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "test"},
+			Spec: HTTPTargetSpec{
+				Response:          HTTPEventResponse{EventType: "io.test.response"},
+				Endpoint:          *apis.HTTPS("example.com"),
+				Method:            "GET",
+				BasicAuthUsername: strPtr("user"),
+				BasicAuthPassword: SecretValueFromSource{SecretKeyRef: secretRef},
+				OAuthClientID:     strPtr("client"),
+				OAuthClientSecret: SecretValueFromSource{SecretKeyRef: secretRef},
+				OAuthTokenURL:     strPtr("https://example.com/token"),
+				OAuthScopes:       &[]string{"read", "write"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			beta := &v1beta1.HTTPTarget{}
+			require.NoError(t, tc.ConvertTo(context.Background(), beta))
+
+			got := &HTTPTarget{}
+			require.NoError(t, got.ConvertFrom(context.Background(), beta))
+
+			assert.Equal(t, tc, got)
+		})
+	}
+}
+
+func TestHTTPTargetConversionFromLiteralSecret(t *testing.T) {
+	testCases := map[string]struct {
+		spec        v1beta1.HTTPTargetSpec
+		expectError string
+	}{
+		"basic authentication password": {
+			spec: v1beta1.HTTPTargetSpec{
+				BasicAuth: &v1beta1.HTTPBasicAuth{
+					Username: "user",
+					Password: v1alpha1.ValueFromField{Value: "pass"},
+				},
+			},
+			expectError: "literal values are not supported in field basicAuth.password, a Secret must be referenced",
+		},
+		"OAuth client secret": {
+			spec: v1beta1.HTTPTargetSpec{
+				OAuth: &v1beta1.HTTPOAuth{
+					ClientID:     "client",
+					ClientSecret: v1alpha1.ValueFromField{Value: "secret"},
+					TokenURL:     "https://example.com/token",
+				},
+			},
+			expectError: "literal values are not supported in field oauth.clientSecret, a Secret must be referenced",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			beta := &v1beta1.HTTPTarget{Spec: tc.spec}
+
+			err := (&HTTPTarget{}).ConvertFrom(context.Background(), beta)
+			assert.EqualError(t, err, tc.expectError)
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
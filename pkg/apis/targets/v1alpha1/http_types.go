@@ -38,6 +38,9 @@ type HTTPTarget struct {
 
 // Check the interfaces the event target should be implementing.
 var (
+	_ apis.Validatable = (*HTTPTarget)(nil)
+	_ apis.Defaultable = (*HTTPTarget)(nil)
+
 	_ v1alpha1.Reconcilable        = (*HTTPTarget)(nil)
 	_ v1alpha1.AdapterConfigurable = (*HTTPTarget)(nil)
 )
@@ -66,26 +69,32 @@ type HTTPTargetSpec struct {
 	CACertificate *string `json:"caCertificate"`
 
 	// BasicAuthUsername used for basic authentication.
+	// Deprecated in favour of basicAuth.username in v1beta1.
 	// +optional
 	BasicAuthUsername *string `json:"basicAuthUsername,omitempty"`
 
 	// BasicAuthPassword used for basic authentication.
+	// Deprecated in favour of basicAuth.password in v1beta1.
 	// +optional
 	BasicAuthPassword SecretValueFromSource `json:"basicAuthPassword,omitempty"`
 
 	// OAuthClientID used for OAuth2 authentication.
+	// Deprecated in favour of oauth.clientID in v1beta1.
 	// +optional
 	OAuthClientID *string `json:"oauthClientID,omitempty"`
 
 	// OAuthClientSecret used for OAuth2 authentication.
+	// Deprecated in favour of oauth.clientSecret in v1beta1.
 	// +optional
 	OAuthClientSecret SecretValueFromSource `json:"oauthClientSecret,omitempty"`
 
 	// OAuthTokenURL used for OAuth2 authentication.
+	// Deprecated in favour of oauth.tokenURL in v1beta1.
 	// +optional
 	OAuthTokenURL *string `json:"oauthTokenURL,omitempty"`
 
 	// OAuthScopes used for OAuth2 authentication.
+	// Deprecated in favour of oauth.scopes in v1beta1.
 	// +optional
 	OAuthScopes *[]string `json:"oauthScopes,omitempty"`
 
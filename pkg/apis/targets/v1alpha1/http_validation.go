@@ -0,0 +1,90 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"net/http"
+
+	pkgapis "knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (t *HTTPTarget) Validate(ctx context.Context) *pkgapis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *HTTPTargetSpec) Validate(ctx context.Context) *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
+
+	if s.Response.EventType == "" {
+		errs = errs.Also(pkgapis.ErrMissingField("response.eventType"))
+	}
+
+	errs = errs.Also(apis.ValidateURL(&s.Endpoint, "endpoint", "http", "https"))
+
+	switch s.Method {
+	case "":
+		errs = errs.Also(pkgapis.ErrMissingField("method"))
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		errs = errs.Also(pkgapis.ErrInvalidValue(s.Method, "method"))
+	}
+
+	if s.BasicAuthUsername != nil {
+		errs = errs.Also(apis.WarnDeprecatedField("basicAuthUsername", "basicAuth.username in v1beta1"))
+	}
+	if s.BasicAuthPassword.SecretKeyRef != nil {
+		errs = errs.Also(apis.WarnDeprecatedField("basicAuthPassword", "basicAuth.password in v1beta1"))
+	}
+
+	if s.OAuthClientID != nil || s.OAuthClientSecret.SecretKeyRef != nil ||
+		s.OAuthTokenURL != nil || s.OAuthScopes != nil {
+
+		if s.OAuthClientID == nil || *s.OAuthClientID == "" {
+			errs = errs.Also(pkgapis.ErrMissingField("oauthClientID"))
+		} else {
+			errs = errs.Also(apis.WarnDeprecatedField("oauthClientID", "oauth.clientID in v1beta1"))
+		}
+
+		if s.OAuthClientSecret.SecretKeyRef == nil {
+			errs = errs.Also(pkgapis.ErrMissingField("oauthClientSecret.secretKeyRef"))
+		} else {
+			errs = errs.Also(apis.WarnDeprecatedField("oauthClientSecret", "oauth.clientSecret in v1beta1"))
+		}
+
+		if s.OAuthTokenURL == nil {
+			errs = errs.Also(pkgapis.ErrMissingField("oauthTokenURL"))
+		} else {
+			if u, err := pkgapis.ParseURL(*s.OAuthTokenURL); err != nil {
+				errs = errs.Also(pkgapis.ErrInvalidValue(*s.OAuthTokenURL, "oauthTokenURL", err.Error()))
+			} else {
+				errs = errs.Also(apis.ValidateURL(u, "oauthTokenURL", "http", "https"))
+			}
+			errs = errs.Also(apis.WarnDeprecatedField("oauthTokenURL", "oauth.tokenURL in v1beta1"))
+		}
+
+		if s.OAuthScopes != nil {
+			errs = errs.Also(apis.WarnDeprecatedField("oauthScopes", "oauth.scopes in v1beta1"))
+		}
+	}
+
+	return errs
+}
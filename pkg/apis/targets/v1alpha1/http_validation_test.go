@@ -0,0 +1,91 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestHTTPTargetValidate(t *testing.T) {
+	secretRef := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "creds"},
+		Key:                  "secret",
+	}
+
+	testCases := map[string]struct {
+		spec          HTTPTargetSpec
+		expectError   string
+		expectWarning string
+	}{
+		"valid spec": {
+			spec: HTTPTargetSpec{
+				Response: HTTPEventResponse{EventType: "io.test.response"},
+				Endpoint: *apis.HTTPS("example.com"),
+				Method:   "POST",
+			},
+		},
+		"invalid endpoint and method": {
+			spec: HTTPTargetSpec{
+				Response: HTTPEventResponse{EventType: "io.test.response"},
+				Endpoint: apis.URL{Scheme: "ftp", Host: "example.com"},
+				Method:   "TRACE",
+			},
+			expectError: "invalid value: TRACE: spec.method\n" +
+				"invalid value: ftp://example.com: spec.endpoint\nURL scheme must be one of: http, https",
+		},
+		"incomplete oauth": {
+			spec: HTTPTargetSpec{
+				Response:          HTTPEventResponse{EventType: "io.test.response"},
+				Endpoint:          *apis.HTTPS("example.com"),
+				Method:            "GET",
+				OAuthClientSecret: SecretValueFromSource{SecretKeyRef: secretRef},
+				OAuthTokenURL:     strPtr("/token"),
+			},
+			expectError: "invalid value: /token: spec.oauthTokenURL\nURL must be absolute\n" +
+				"missing field(s): spec.oauthClientID",
+			expectWarning: "field is deprecated, use oauth.clientSecret in v1beta1 instead: spec.oauthClientSecret\n" +
+				"field is deprecated, use oauth.tokenURL in v1beta1 instead: spec.oauthTokenURL",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &HTTPTarget{Spec: tc.spec}
+
+			err := trg.Validate(context.Background())
+
+			if errs := err.Filter(apis.ErrorLevel); tc.expectError == "" {
+				assert.Nil(t, errs)
+			} else {
+				assert.EqualError(t, errs, tc.expectError)
+			}
+
+			if warns := err.Filter(apis.WarningLevel); tc.expectWarning == "" {
+				assert.Nil(t, warns)
+			} else {
+				assert.EqualError(t, warns, tc.expectWarning)
+			}
+		})
+	}
+}
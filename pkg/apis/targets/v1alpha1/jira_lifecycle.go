@@ -29,11 +29,20 @@ import (
 
 // Managed event types
 const (
-	EventTypeJiraIssueCreate = "io.triggermesh.jira.issue.create"
-	EventTypeJiraIssueGet    = "io.triggermesh.jira.issue.get"
-	EventTypeJiraCustom      = "io.triggermesh.jira.custom"
+	EventTypeJiraIssueCreate     = "io.triggermesh.jira.issue.create"
+	EventTypeJiraIssueGet        = "io.triggermesh.jira.issue.get"
+	EventTypeJiraIssueUpdate     = "io.triggermesh.jira.issue.update"
+	EventTypeJiraIssueTransition = "io.triggermesh.jira.issue.transition"
+	EventTypeJiraIssueComment    = "io.triggermesh.jira.issue.comment"
+	EventTypeJiraIssueAttach     = "io.triggermesh.jira.issue.attach"
+	EventTypeJiraIssueLink       = "io.triggermesh.jira.issue.link"
+	EventTypeJiraIssueSearch     = "io.triggermesh.jira.issue.search"
+	EventTypeJiraCustom          = "io.triggermesh.jira.custom"
 
 	EventTypeJiraIssue          = "io.triggermesh.jira.issue"
+	EventTypeJiraIssues         = "io.triggermesh.jira.issues"
+	EventTypeJiraComment        = "io.triggermesh.jira.comment"
+	EventTypeJiraAttachments    = "io.triggermesh.jira.attachments"
 	EventTypeJiraCustomResponse = "io.triggermesh.jira.custom.response"
 )
 
@@ -65,6 +74,12 @@ func (*JiraTarget) AcceptedEventTypes() []string {
 	return []string{
 		EventTypeJiraIssueCreate,
 		EventTypeJiraIssueGet,
+		EventTypeJiraIssueUpdate,
+		EventTypeJiraIssueTransition,
+		EventTypeJiraIssueComment,
+		EventTypeJiraIssueAttach,
+		EventTypeJiraIssueLink,
+		EventTypeJiraIssueSearch,
 		EventTypeJiraCustom,
 	}
 }
@@ -73,6 +88,9 @@ func (*JiraTarget) AcceptedEventTypes() []string {
 func (*JiraTarget) GetEventTypes() []string {
 	return []string{
 		EventTypeJiraIssue,
+		EventTypeJiraIssues,
+		EventTypeJiraComment,
+		EventTypeJiraAttachments,
 		EventTypeJiraCustomResponse,
 	}
 }
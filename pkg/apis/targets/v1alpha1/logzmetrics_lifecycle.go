@@ -28,6 +28,8 @@ import (
 // Managed event types
 const (
 	EventTypeOpenTelemetryMetricsPush = "io.triggermesh.opentelemetry.metrics.push"
+	EventTypeOpenTelemetryTracesPush  = "io.triggermesh.opentelemetry.traces.push"
+	EventTypeOpenTelemetryLogsPush    = "io.triggermesh.opentelemetry.logs.push"
 )
 
 // GetGroupVersionKind implements kmeta.OwnerRefable.
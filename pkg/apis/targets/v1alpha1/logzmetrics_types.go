@@ -54,12 +54,22 @@ var (
 
 // LogzMetricsTargetSpec defines the desired state of the event target.
 type LogzMetricsTargetSpec struct {
-	// Connection information for LogzMetrics.
-	Connection LogzMetricsConnection `json:"connection"`
+	// Connection information for LogzMetrics. Required along with
+	// Instruments for pushing metrics.
+	// +optional
+	Connection *LogzMetricsConnection `json:"connection,omitempty"`
 
 	// Instruments configured for pushing metrics. It is mandatory that all metrics
 	// pushed by using this target are pre-registered using this list.
-	Instruments []Instrument `json:"instruments"`
+	// +optional
+	Instruments []Instrument `json:"instruments,omitempty"`
+
+	// Connection to an OpenTelemetry Protocol (OTLP) collector which spans
+	// and log records are exported to. Spans and log records are rejected
+	// when it is not set. Connection and Instruments can be omitted when it
+	// is set.
+	// +optional
+	OTLP *OTLPConnection `json:"otlp,omitempty"`
 
 	// EventOptions for targets
 	// +optional
@@ -79,6 +89,42 @@ type LogzMetricsConnection struct {
 	ListenerURL string `json:"listenerURL"`
 }
 
+// OTLPConnection contains the information to connect to an OTLP collector to
+// export spans and log records.
+type OTLPConnection struct {
+	// Address of the collector, e.g. "otel-collector:4317" for OTLP/gRPC or
+	// "http://otel-collector:4318" for OTLP/HTTP.
+	Endpoint string `json:"endpoint"`
+
+	// Transport protocol. Supported values are "grpc" and "http/protobuf".
+	// Defaults to "grpc".
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+
+	// Disables TLS.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+
+	// Headers sent along with each export request, such as credentials
+	// for authenticating with the collector.
+	// +optional
+	Headers []OTLPHeader `json:"headers,omitempty"`
+
+	// Value of the "service.name" attribute of the resource exported spans
+	// and log records are associated with. Defaults to "triggermesh".
+	// +optional
+	ServiceName *string `json:"serviceName,omitempty"`
+}
+
+// OTLPHeader is a header sent along with OTLP export requests.
+type OTLPHeader struct {
+	// Name of the header.
+	Name string `json:"name"`
+
+	// Value of the header.
+	Value v1alpha1.ValueFromField `json:"value"`
+}
+
 // InstrumentKind as defined by OpenTelemetry.
 type InstrumentKind string
 
@@ -0,0 +1,123 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgapis "knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PrometheusTarget receives CloudEvents typed `io.triggermesh.opentelemetry.metrics.push`
+// that fullfil the schema at https://docs.triggermesh.io/schemas/opentelemetry.metrics.push.json
+// and records their observations as Prometheus series.
+//
+// Series are exposed for scraping on the `/metrics` path of the target's
+// address, and can optionally be pushed to an endpoint which implements the
+// Prometheus remote-write protocol.
+type PrometheusTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PrometheusTargetSpec `json:"spec"`
+	Status v1alpha1.Status      `json:"status,omitempty"`
+}
+
+// Check the interfaces the event target should be implementing.
+var (
+	_ v1alpha1.Reconcilable        = (*PrometheusTarget)(nil)
+	_ v1alpha1.AdapterConfigurable = (*PrometheusTarget)(nil)
+	_ v1alpha1.EventReceiver       = (*PrometheusTarget)(nil)
+)
+
+// PrometheusTargetSpec defines the desired state of the event target.
+type PrometheusTargetSpec struct {
+	// Instruments configured for recording metrics. It is mandatory that all
+	// metrics recorded by using this target are pre-registered using this list.
+	Instruments []Instrument `json:"instruments"`
+
+	// Remote-write endpoint the recorded series are pushed to.
+	// +optional
+	RemoteWrite *PrometheusRemoteWrite `json:"remoteWrite,omitempty"`
+
+	// Limits to the cardinality of the recorded series.
+	// +optional
+	Limits *PrometheusSeriesLimits `json:"limits,omitempty"`
+
+	// EventOptions for targets
+	// +optional
+	EventOptions *EventOptions `json:"eventOptions,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// PrometheusRemoteWrite contains the information to push series to an
+// endpoint which implements the Prometheus remote-write protocol.
+type PrometheusRemoteWrite struct {
+	// URL of the remote-write endpoint.
+	URL pkgapis.URL `json:"url"`
+
+	// Bearer token used to authenticate against the remote-write endpoint.
+	// +optional
+	BearerToken *SecretValueFromSource `json:"bearerToken,omitempty"`
+
+	// Basic authentication credentials used to authenticate against the
+	// remote-write endpoint.
+	// +optional
+	BasicAuth *PrometheusBasicAuth `json:"basicAuth,omitempty"`
+
+	// Interval between two consecutive pushes. Defaults to 30s.
+	// +optional
+	Interval *apis.Duration `json:"interval,omitempty"`
+}
+
+// PrometheusBasicAuth contains basic authentication credentials.
+type PrometheusBasicAuth struct {
+	Username string                `json:"username"`
+	Password SecretValueFromSource `json:"password"`
+}
+
+// PrometheusSeriesLimits bounds the number of series recorded by the target.
+type PrometheusSeriesLimits struct {
+	// Maximum number of distinct label sets recorded for a single
+	// instrument. Observations which would create a series beyond that
+	// limit are rejected. Defaults to 1000.
+	// +optional
+	MaxSeriesPerInstrument *int `json:"maxSeriesPerInstrument,omitempty"`
+
+	// Maximum number of labels an observation can carry. Observations with
+	// more attributes are rejected. Defaults to 16.
+	// +optional
+	MaxLabelsPerSeries *int `json:"maxLabelsPerSeries,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PrometheusTargetList is a list of event target instances.
+type PrometheusTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []PrometheusTarget `json:"items"`
+}
@@ -88,6 +88,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&LogzTargetList{},
 		&OracleTarget{},
 		&OracleTargetList{},
+		&PrometheusTarget{},
+		&PrometheusTargetList{},
 		&SalesforceTarget{},
 		&SalesforceTargetList{},
 		&SendGridTarget{},
@@ -72,6 +72,26 @@ type SendGridTargetSpec struct {
 	// +optional
 	DefaultSubject *string `json:"defaultSubject,omitempty"`
 
+	// DefaultTemplateID is the ID of a dynamic template used to render the outgoing email's,
+	// unless a template ID is set in the event.
+	// +optional
+	DefaultTemplateID *string `json:"defaultTemplateID,omitempty"`
+
+	// CategoryAttributes is a list of CloudEvent context attributes, including extensions,
+	// whose values are added to the categories of the outgoing email's.
+	// +optional
+	CategoryAttributes []string `json:"categoryAttributes,omitempty"`
+
+	// CustomArgAttributes maps names of custom arguments to CloudEvent context attributes,
+	// including extensions, whose values are set as custom arguments of the outgoing email's.
+	// +optional
+	CustomArgAttributes map[string]string `json:"customArgAttributes,omitempty"`
+
+	// AttachmentURLs allows the attachments of the outgoing email's to be downloaded from URLs
+	// set in events. Attachments referenced by URL are rejected when unset.
+	// +optional
+	AttachmentURLs *SendGridAttachmentURLs `json:"attachmentURLs,omitempty"`
+
 	// EventOptions for targets
 	EventOptions *EventOptions `json:"eventOptions,omitempty"`
 
@@ -80,6 +100,18 @@ type SendGridTargetSpec struct {
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// SendGridAttachmentURLs restricts the URLs attachments can be downloaded from.
+type SendGridAttachmentURLs struct {
+	// AllowedHosts is the list of hosts attachments can be downloaded from. A host prefixed
+	// with "*." also matches all of its subdomains.
+	AllowedHosts []string `json:"allowedHosts"`
+
+	// AllowedSchemes is the list of URL schemes attachments can be downloaded with.
+	// Defaults to "https".
+	// +optional
+	AllowedSchemes []string `json:"allowedSchemes,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SendGridTargetList is a list of event target instances.
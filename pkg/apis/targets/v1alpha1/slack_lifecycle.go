@@ -32,6 +32,26 @@ const (
 	// EventTypeSlackAPI represents any type of Slack API method.
 	// https://api.slack.com/methods
 	EventTypeSlackAPI = "com.slack.webapi.*"
+
+	// EventTypeSlackPostMessage represents a request to post a message,
+	// optionally in the thread of another message.
+	EventTypeSlackPostMessage = "com.slack.message.post"
+	// EventTypeSlackUpdateMessage represents a request to update a message.
+	EventTypeSlackUpdateMessage = "com.slack.message.update"
+	// EventTypeSlackDeleteMessage represents a request to delete a message.
+	EventTypeSlackDeleteMessage = "com.slack.message.delete"
+	// EventTypeSlackUploadFile represents a request to upload a file.
+	EventTypeSlackUploadFile = "com.slack.file.upload"
+)
+
+// Returned event types
+const (
+	// EventTypeSlackMessageResponse is the type of the response to a
+	// message operation.
+	EventTypeSlackMessageResponse = "com.slack.message.response"
+	// EventTypeSlackFileResponse is the type of the response to a file
+	// upload.
+	EventTypeSlackFileResponse = "com.slack.file.response"
 )
 
 // GetGroupVersionKind implements kmeta.OwnerRefable.
@@ -61,6 +81,10 @@ func (t *SlackTarget) GetStatusManager() *v1alpha1.StatusManager {
 func (*SlackTarget) AcceptedEventTypes() []string {
 	return []string{
 		EventTypeSlackAPI,
+		EventTypeSlackPostMessage,
+		EventTypeSlackUpdateMessage,
+		EventTypeSlackDeleteMessage,
+		EventTypeSlackUploadFile,
 	}
 }
 
@@ -68,6 +92,8 @@ func (*SlackTarget) AcceptedEventTypes() []string {
 func (*SlackTarget) GetEventTypes() []string {
 	return []string{
 		EventTypeResponse,
+		EventTypeSlackMessageResponse,
+		EventTypeSlackFileResponse,
 	}
 }
 
@@ -40,6 +40,10 @@ type SlackTargetSpec struct {
 	// Token for Slack App
 	Token SecretValueFromSource `json:"token"`
 
+	// EventOptions for targets
+	// +optional
+	EventOptions *EventOptions `json:"eventOptions,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
@@ -35,13 +35,24 @@ const (
 	EventTypeTektonReap = "io.triggermesh.tekton.reap"
 )
 
+// Emitted event types
+const (
+	// EventTypeTektonRunSucceeded represents the successful completion of a run.
+	EventTypeTektonRunSucceeded = "io.triggermesh.tekton.run.succeeded"
+	// EventTypeTektonRunFailed represents the failure of a run.
+	EventTypeTektonRunFailed = "io.triggermesh.tekton.run.failed"
+)
+
 // GetGroupVersionKind implements kmeta.OwnerRefable.
 func (*TektonTarget) GetGroupVersionKind() schema.GroupVersionKind {
 	return SchemeGroupVersion.WithKind("TektonTarget")
 }
 
 // GetConditionSet implements duckv1.KRShaped.
-func (*TektonTarget) GetConditionSet() apis.ConditionSet {
+func (t *TektonTarget) GetConditionSet() apis.ConditionSet {
+	if t.Spec.Sink.Ref != nil || t.Spec.Sink.URI != nil {
+		return v1alpha1.EventSenderConditionSet
+	}
 	return v1alpha1.DefaultConditionSet
 }
 
@@ -70,9 +81,16 @@ func (*TektonTarget) AcceptedEventTypes() []string {
 func (*TektonTarget) GetEventTypes() []string {
 	return []string{
 		EventTypeResponse,
+		EventTypeTektonRunSucceeded,
+		EventTypeTektonRunFailed,
 	}
 }
 
+// GetSink implements EventSender.
+func (t *TektonTarget) GetSink() *duckv1.Destination {
+	return &t.Spec.Sink
+}
+
 // AsEventSource implements EventSource.
 func (t *TektonTarget) AsEventSource() string {
 	kind := strings.ToLower(t.GetGroupVersionKind().Kind)
@@ -19,6 +19,8 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -41,6 +43,28 @@ type TektonTargetSpec struct {
 	// +optional
 	ReapPolicy *TektonTargetReapPolicy `json:"reapPolicy,omitempty"`
 
+	// Parameters passed to every run, by name. Values are Go templates
+	// rendered against the incoming event, and can be overridden by
+	// parameters of the same name in the event's payload.
+	// +optional
+	Params map[string]string `json:"params,omitempty"`
+
+	// Workspaces bound to runs. Names of the referenced volume sources are
+	// Go templates rendered against the incoming event. Events can select
+	// which of these workspaces are bound to a run, all of them are bound
+	// otherwise.
+	// +optional
+	Workspaces []TektonTargetWorkspace `json:"workspaces,omitempty"`
+
+	// Name of the ServiceAccount runs are executed as. Go template rendered
+	// against the incoming event.
+	// +optional
+	ServiceAccountName *string `json:"serviceAccountName,omitempty"`
+
+	// Support sending the outcome of runs to an event sink. When a sink is
+	// set, the target follows each run it creates until completion.
+	duckv1.SourceSpec `json:",inline"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
@@ -54,12 +78,33 @@ type TektonTargetReapPolicy struct {
 	ReapFailAge *string `json:"fail,omitempty"`
 }
 
+// TektonTargetWorkspace binds a volume source to a workspace of runs.
+// Exactly one volume source must be set.
+type TektonTargetWorkspace struct {
+	// Name of the workspace declared by the Task or Pipeline.
+	Name string `json:"name"`
+
+	// Name of a PersistentVolumeClaim.
+	// +optional
+	PersistentVolumeClaim *string `json:"persistentVolumeClaim,omitempty"`
+	// Name of a ConfigMap.
+	// +optional
+	ConfigMap *string `json:"configMap,omitempty"`
+	// Name of a Secret.
+	// +optional
+	Secret *string `json:"secret,omitempty"`
+	// Whether to bind an emptyDir volume, scoped to the run.
+	// +optional
+	EmptyDir *bool `json:"emptyDir,omitempty"`
+}
+
 // Check the interfaces the event target should be implementing.
 var (
 	_ v1alpha1.Reconcilable        = (*TektonTarget)(nil)
 	_ v1alpha1.AdapterConfigurable = (*TektonTarget)(nil)
 	_ v1alpha1.EventReceiver       = (*TektonTarget)(nil)
 	_ v1alpha1.EventSource         = (*TektonTarget)(nil)
+	_ v1alpha1.EventSender         = (*TektonTarget)(nil)
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
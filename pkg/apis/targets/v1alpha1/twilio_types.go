@@ -19,6 +19,8 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"knative.dev/pkg/apis"
+
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -59,6 +61,18 @@ type TwilioTargetSpec struct {
 	// +optional
 	DefaultPhoneTo *string `json:"defaultPhoneTo,omitempty"`
 
+	// DefaultMessagingServiceSID is the SID of the Messaging Service used
+	// to send messages which don't have a sender.
+	// +optional
+	DefaultMessagingServiceSID *string `json:"defaultMessagingServiceSID,omitempty"`
+
+	// StatusCallbackURL is the URL Twilio reports the delivery status of
+	// sent messages to, typically the public URL of a TwilioSource. The
+	// ID of the CloudEvent that triggered each message is passed along
+	// so that status events can be correlated with it.
+	// +optional
+	StatusCallbackURL *apis.URL `json:"statusCallbackURL,omitempty"`
+
 	// EventOptions for targets
 	EventOptions *EventOptions `json:"eventOptions,omitempty"`
 
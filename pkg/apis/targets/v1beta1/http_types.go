@@ -0,0 +1,118 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HTTPTarget is the Schema for an HTTP Target.
+type HTTPTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HTTPTargetSpec  `json:"spec"`
+	Status v1alpha1.Status `json:"status,omitempty"`
+}
+
+var (
+	_ apis.Validatable = (*HTTPTarget)(nil)
+	_ apis.Defaultable = (*HTTPTarget)(nil)
+	_ apis.Convertible = (*HTTPTarget)(nil)
+)
+
+// HTTPTargetSpec defines the desired state of the event target.
+type HTTPTargetSpec struct {
+	// Response data to be used at replies.
+	Response HTTPEventResponse `json:"response"`
+
+	// Endpoint to connect to.
+	Endpoint apis.URL `json:"endpoint"`
+
+	// Method to use at requests.
+	Method string `json:"method"`
+
+	// Headers to be included at HTTP requests
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// SkipVerify disables server certificate validation.
+	// +optional
+	SkipVerify *bool `json:"skipVerify,omitempty"`
+
+	// CACertificate uses the CA certificate to verify the remote server certificate.
+	// +optional
+	CACertificate *string `json:"caCertificate,omitempty"`
+
+	// BasicAuth contains credentials used for HTTP Basic authentication.
+	// +optional
+	BasicAuth *HTTPBasicAuth `json:"basicAuth,omitempty"`
+
+	// OAuth contains parameters used for OAuth2 authentication.
+	// +optional
+	OAuth *HTTPOAuth `json:"oauth,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// HTTPEventResponse for reply events context.
+type HTTPEventResponse struct {
+	// EventType for the reply.
+	EventType string `json:"eventType"`
+
+	// EventSource for the reply.
+	EventSource string `json:"eventSource"`
+}
+
+// HTTPBasicAuth contains credentials for HTTP Basic authentication.
+type HTTPBasicAuth struct {
+	Username string `json:"username"`
+	// Unlike other ValueFromField attributes, only references to Kubernetes
+	// Secrets are supported, so that credentials aren't stored in plain text
+	// in the object, and can be represented in the v1alpha1 API.
+	Password v1alpha1.ValueFromField `json:"password"`
+}
+
+// HTTPOAuth contains parameters for OAuth2 authentication using the client
+// credentials flow.
+type HTTPOAuth struct {
+	ClientID string `json:"clientID"`
+	// Unlike other ValueFromField attributes, only references to Kubernetes
+	// Secrets are supported, so that credentials aren't stored in plain text
+	// in the object, and can be represented in the v1alpha1 API.
+	ClientSecret v1alpha1.ValueFromField `json:"clientSecret"`
+	TokenURL     string                  `json:"tokenURL"`
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HTTPTargetList is a list of event target instances.
+type HTTPTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []HTTPTarget `json:"items"`
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"net/http"
+
+	pkgapis "knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Validate implements apis.Validatable
+func (t *HTTPTarget) Validate(ctx context.Context) *pkgapis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *HTTPTargetSpec) Validate(ctx context.Context) *pkgapis.FieldError {
+	errs := apis.ValidateURL(&s.Endpoint, "endpoint", "http", "https")
+
+	switch s.Method {
+	case "":
+		errs = errs.Also(pkgapis.ErrMissingField("method"))
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		errs = errs.Also(pkgapis.ErrInvalidValue(s.Method, "method"))
+	}
+
+	if ba := s.BasicAuth; ba != nil {
+		if ba.Username == "" {
+			errs = errs.Also(pkgapis.ErrMissingField("basicAuth.username"))
+		}
+		errs = errs.Also(validateSecretValue(ba.Password).ViaField("basicAuth", "password"))
+	}
+
+	if oa := s.OAuth; oa != nil {
+		if oa.ClientID == "" {
+			errs = errs.Also(pkgapis.ErrMissingField("oauth.clientID"))
+		}
+		if oa.TokenURL == "" {
+			errs = errs.Also(pkgapis.ErrMissingField("oauth.tokenURL"))
+		}
+		errs = errs.Also(validateSecretValue(oa.ClientSecret).ViaField("oauth", "clientSecret"))
+	}
+
+	return errs
+}
+
+// validateSecretValue ensures the given ValueFromField references a Secret.
+func validateSecretValue(vff v1alpha1.ValueFromField) *pkgapis.FieldError {
+	if vff.Value != "" {
+		return pkgapis.ErrDisallowedFields("value")
+	}
+	if vff.ValueFromSecret == nil {
+		return pkgapis.ErrMissingField("valueFromSecret")
+	}
+	return nil
+}
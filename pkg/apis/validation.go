@@ -0,0 +1,85 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apis
+
+import (
+	"strings"
+	"time"
+
+	pkgapis "knative.dev/pkg/apis"
+)
+
+// ValidateARN returns an error if the given ARN is incomplete, or if it
+// doesn't belong to one of the given AWS services (e.g. "sqs", "iam").
+func ValidateARN(a ARN, fieldPath string, services ...string) *pkgapis.FieldError {
+	if a.Partition == "" || a.Service == "" || a.Resource == "" {
+		return pkgapis.ErrInvalidValue(a.String(), fieldPath,
+			"ARN must contain at least a partition, a service and a resource")
+	}
+
+	if len(services) == 0 {
+		return nil
+	}
+	for _, s := range services {
+		if a.Service == s {
+			return nil
+		}
+	}
+
+	return pkgapis.ErrInvalidValue(a.String(), fieldPath,
+		"ARN must refer to a resource of one of the AWS services: "+strings.Join(services, ", "))
+}
+
+// ValidateDuration returns an error if the given duration is not strictly
+// positive.
+func ValidateDuration(d Duration, fieldPath string) *pkgapis.FieldError {
+	if time.Duration(d) <= 0 {
+		return pkgapis.ErrInvalidValue(d.String(), fieldPath, "duration must be positive")
+	}
+	return nil
+}
+
+// ValidateURL returns an error if the given URL is missing or not absolute,
+// or if its scheme is not one of the given schemes (e.g. "http", "https").
+func ValidateURL(u *pkgapis.URL, fieldPath string, schemes ...string) *pkgapis.FieldError {
+	if u == nil || u.IsEmpty() {
+		return pkgapis.ErrMissingField(fieldPath)
+	}
+
+	if !u.URL().IsAbs() || u.Host == "" {
+		return pkgapis.ErrInvalidValue(u.String(), fieldPath, "URL must be absolute")
+	}
+
+	if len(schemes) == 0 {
+		return nil
+	}
+	for _, s := range schemes {
+		if u.Scheme == s {
+			return nil
+		}
+	}
+
+	return pkgapis.ErrInvalidValue(u.String(), fieldPath,
+		"URL scheme must be one of: "+strings.Join(schemes, ", "))
+}
+
+// WarnDeprecatedField returns a warning-level error indicating that the given
+// field is deprecated in favour of the given replacement.
+func WarnDeprecatedField(fieldPath, replacement string) *pkgapis.FieldError {
+	return pkgapis.ErrGeneric("field is deprecated, use "+replacement+" instead", fieldPath).
+		At(pkgapis.WarningLevel)
+}
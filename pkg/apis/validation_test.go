@@ -0,0 +1,133 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pkgapis "knative.dev/pkg/apis"
+)
+
+func TestValidateARN(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       ARN
+		services    []string
+		expectError string
+	}{{
+		name: "Valid ARN, any service",
+		input: ARN{
+			Partition: "aws",
+			Service:   "sqs",
+			Region:    "us-test-0",
+			AccountID: "123456789012",
+			Resource:  "my-queue",
+		},
+	}, {
+		name: "Valid ARN, matching service",
+		input: ARN{
+			Partition: "aws",
+			Service:   "sqs",
+			Region:    "us-test-0",
+			AccountID: "123456789012",
+			Resource:  "my-queue",
+		},
+		services: []string{"sqs"},
+	}, {
+		name: "Valid ARN, other service",
+		input: ARN{
+			Partition: "aws",
+			Service:   "sns",
+			Region:    "us-test-0",
+			AccountID: "123456789012",
+			Resource:  "my-topic",
+		},
+		services:    []string{"sqs"},
+		expectError: "invalid value: arn:aws:sns:us-test-0:123456789012:my-topic: arn\nARN must refer to a resource of one of the AWS services: sqs",
+	}, {
+		name: "Missing resource",
+		input: ARN{
+			Partition: "aws",
+			Service:   "sqs",
+		},
+		expectError: "invalid value: arn:aws:sqs:::: arn\nARN must contain at least a partition, a service and a resource",
+	}}
+
+	for _, tc := range testCases {
+		//nolint:scopelint
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateARN(tc.input, "arn", tc.services...)
+			if tc.expectError == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectError)
+		})
+	}
+}
+
+func TestValidateDuration(t *testing.T) {
+	assert.Nil(t, ValidateDuration(Duration(5*time.Second), "interval"))
+	assert.EqualError(t, ValidateDuration(Duration(0), "interval"),
+		"invalid value: 0s: interval\nduration must be positive")
+	assert.EqualError(t, ValidateDuration(Duration(-time.Second), "interval"),
+		"invalid value: -1s: interval\nduration must be positive")
+}
+
+func TestValidateURL(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       *pkgapis.URL
+		expectError string
+	}{{
+		name:  "Valid URL",
+		input: pkgapis.HTTPS("example.com"),
+	}, {
+		name:        "Missing URL",
+		input:       nil,
+		expectError: "missing field(s): endpoint",
+	}, {
+		name:        "Relative URL",
+		input:       &pkgapis.URL{Path: "/some/path"},
+		expectError: "invalid value: /some/path: endpoint\nURL must be absolute",
+	}, {
+		name:        "Unsupported scheme",
+		input:       &pkgapis.URL{Scheme: "ftp", Host: "example.com"},
+		expectError: "invalid value: ftp://example.com: endpoint\nURL scheme must be one of: http, https",
+	}}
+
+	for _, tc := range testCases {
+		//nolint:scopelint
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateURL(tc.input, "endpoint", "http", "https")
+			if tc.expectError == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectError)
+		})
+	}
+}
+
+func TestWarnDeprecatedField(t *testing.T) {
+	err := WarnDeprecatedField("basicAuthUsername", "v1beta1 basicAuth")
+	assert.Equal(t, pkgapis.WarningLevel, err.Level)
+	assert.EqualError(t, err, "field is deprecated, use v1beta1 basicAuth instead: basicAuthUsername")
+}
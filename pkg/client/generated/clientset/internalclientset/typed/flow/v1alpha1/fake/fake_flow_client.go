@@ -36,10 +36,18 @@ func (c *FakeFlowV1alpha1) JQTransformations(namespace string) v1alpha1.JQTransf
 	return &FakeJQTransformations{c, namespace}
 }
 
+func (c *FakeFlowV1alpha1) JSONToXMLTransformations(namespace string) v1alpha1.JSONToXMLTransformationInterface {
+	return &FakeJSONToXMLTransformations{c, namespace}
+}
+
 func (c *FakeFlowV1alpha1) Synchronizers(namespace string) v1alpha1.SynchronizerInterface {
 	return &FakeSynchronizers{c, namespace}
 }
 
+func (c *FakeFlowV1alpha1) TemplateTransformations(namespace string) v1alpha1.TemplateTransformationInterface {
+	return &FakeTemplateTransformations{c, namespace}
+}
+
 func (c *FakeFlowV1alpha1) Transformations(namespace string) v1alpha1.TransformationInterface {
 	return &FakeTransformations{c, namespace}
 }
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeJSONToXMLTransformations implements JSONToXMLTransformationInterface
+type FakeJSONToXMLTransformations struct {
+	Fake *FakeFlowV1alpha1
+	ns   string
+}
+
+var jsontoxmltransformationsResource = schema.GroupVersionResource{Group: "flow.triggermesh.io", Version: "v1alpha1", Resource: "jsontoxmltransformations"}
+
+var jsontoxmltransformationsKind = schema.GroupVersionKind{Group: "flow.triggermesh.io", Version: "v1alpha1", Kind: "JSONToXMLTransformation"}
+
+// Get takes name of the jSONToXMLTransformation, and returns the corresponding jSONToXMLTransformation object, and an error if there is any.
+func (c *FakeJSONToXMLTransformations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.JSONToXMLTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(jsontoxmltransformationsResource, c.ns, name), &v1alpha1.JSONToXMLTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONToXMLTransformation), err
+}
+
+// List takes label and field selectors, and returns the list of JSONToXMLTransformations that match those selectors.
+func (c *FakeJSONToXMLTransformations) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.JSONToXMLTransformationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(jsontoxmltransformationsResource, jsontoxmltransformationsKind, c.ns, opts), &v1alpha1.JSONToXMLTransformationList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.JSONToXMLTransformationList{ListMeta: obj.(*v1alpha1.JSONToXMLTransformationList).ListMeta}
+	for _, item := range obj.(*v1alpha1.JSONToXMLTransformationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested jSONToXMLTransformations.
+func (c *FakeJSONToXMLTransformations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(jsontoxmltransformationsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a jSONToXMLTransformation and creates it.  Returns the server's representation of the jSONToXMLTransformation, and an error, if there is any.
+func (c *FakeJSONToXMLTransformations) Create(ctx context.Context, jSONToXMLTransformation *v1alpha1.JSONToXMLTransformation, opts v1.CreateOptions) (result *v1alpha1.JSONToXMLTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(jsontoxmltransformationsResource, c.ns, jSONToXMLTransformation), &v1alpha1.JSONToXMLTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONToXMLTransformation), err
+}
+
+// Update takes the representation of a jSONToXMLTransformation and updates it. Returns the server's representation of the jSONToXMLTransformation, and an error, if there is any.
+func (c *FakeJSONToXMLTransformations) Update(ctx context.Context, jSONToXMLTransformation *v1alpha1.JSONToXMLTransformation, opts v1.UpdateOptions) (result *v1alpha1.JSONToXMLTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(jsontoxmltransformationsResource, c.ns, jSONToXMLTransformation), &v1alpha1.JSONToXMLTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONToXMLTransformation), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeJSONToXMLTransformations) UpdateStatus(ctx context.Context, jSONToXMLTransformation *v1alpha1.JSONToXMLTransformation, opts v1.UpdateOptions) (*v1alpha1.JSONToXMLTransformation, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(jsontoxmltransformationsResource, "status", c.ns, jSONToXMLTransformation), &v1alpha1.JSONToXMLTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONToXMLTransformation), err
+}
+
+// Delete takes name of the jSONToXMLTransformation and deletes it. Returns an error if one occurs.
+func (c *FakeJSONToXMLTransformations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(jsontoxmltransformationsResource, c.ns, name, opts), &v1alpha1.JSONToXMLTransformation{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeJSONToXMLTransformations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(jsontoxmltransformationsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.JSONToXMLTransformationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched jSONToXMLTransformation.
+func (c *FakeJSONToXMLTransformations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.JSONToXMLTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(jsontoxmltransformationsResource, c.ns, name, pt, data, subresources...), &v1alpha1.JSONToXMLTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONToXMLTransformation), err
+}
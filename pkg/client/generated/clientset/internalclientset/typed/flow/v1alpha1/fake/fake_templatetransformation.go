@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeTemplateTransformations implements TemplateTransformationInterface
+type FakeTemplateTransformations struct {
+	Fake *FakeFlowV1alpha1
+	ns   string
+}
+
+var templatetransformationsResource = schema.GroupVersionResource{Group: "flow.triggermesh.io", Version: "v1alpha1", Resource: "templatetransformations"}
+
+var templatetransformationsKind = schema.GroupVersionKind{Group: "flow.triggermesh.io", Version: "v1alpha1", Kind: "TemplateTransformation"}
+
+// Get takes name of the templateTransformation, and returns the corresponding templateTransformation object, and an error if there is any.
+func (c *FakeTemplateTransformations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.TemplateTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(templatetransformationsResource, c.ns, name), &v1alpha1.TemplateTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TemplateTransformation), err
+}
+
+// List takes label and field selectors, and returns the list of TemplateTransformations that match those selectors.
+func (c *FakeTemplateTransformations) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.TemplateTransformationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(templatetransformationsResource, templatetransformationsKind, c.ns, opts), &v1alpha1.TemplateTransformationList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.TemplateTransformationList{ListMeta: obj.(*v1alpha1.TemplateTransformationList).ListMeta}
+	for _, item := range obj.(*v1alpha1.TemplateTransformationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested templateTransformations.
+func (c *FakeTemplateTransformations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(templatetransformationsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a templateTransformation and creates it.  Returns the server's representation of the templateTransformation, and an error, if there is any.
+func (c *FakeTemplateTransformations) Create(ctx context.Context, templateTransformation *v1alpha1.TemplateTransformation, opts v1.CreateOptions) (result *v1alpha1.TemplateTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(templatetransformationsResource, c.ns, templateTransformation), &v1alpha1.TemplateTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TemplateTransformation), err
+}
+
+// Update takes the representation of a templateTransformation and updates it. Returns the server's representation of the templateTransformation, and an error, if there is any.
+func (c *FakeTemplateTransformations) Update(ctx context.Context, templateTransformation *v1alpha1.TemplateTransformation, opts v1.UpdateOptions) (result *v1alpha1.TemplateTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(templatetransformationsResource, c.ns, templateTransformation), &v1alpha1.TemplateTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TemplateTransformation), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeTemplateTransformations) UpdateStatus(ctx context.Context, templateTransformation *v1alpha1.TemplateTransformation, opts v1.UpdateOptions) (*v1alpha1.TemplateTransformation, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(templatetransformationsResource, "status", c.ns, templateTransformation), &v1alpha1.TemplateTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TemplateTransformation), err
+}
+
+// Delete takes name of the templateTransformation and deletes it. Returns an error if one occurs.
+func (c *FakeTemplateTransformations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(templatetransformationsResource, c.ns, name, opts), &v1alpha1.TemplateTransformation{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeTemplateTransformations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(templatetransformationsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.TemplateTransformationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched templateTransformation.
+func (c *FakeTemplateTransformations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.TemplateTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(templatetransformationsResource, c.ns, name, pt, data, subresources...), &v1alpha1.TemplateTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TemplateTransformation), err
+}
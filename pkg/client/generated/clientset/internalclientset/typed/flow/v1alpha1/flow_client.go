@@ -30,7 +30,9 @@ type FlowV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	DataWeaveTransformationsGetter
 	JQTransformationsGetter
+	JSONToXMLTransformationsGetter
 	SynchronizersGetter
+	TemplateTransformationsGetter
 	TransformationsGetter
 	XMLToJSONTransformationsGetter
 	XSLTTransformationsGetter
@@ -49,10 +51,18 @@ func (c *FlowV1alpha1Client) JQTransformations(namespace string) JQTransformatio
 	return newJQTransformations(c, namespace)
 }
 
+func (c *FlowV1alpha1Client) JSONToXMLTransformations(namespace string) JSONToXMLTransformationInterface {
+	return newJSONToXMLTransformations(c, namespace)
+}
+
 func (c *FlowV1alpha1Client) Synchronizers(namespace string) SynchronizerInterface {
 	return newSynchronizers(c, namespace)
 }
 
+func (c *FlowV1alpha1Client) TemplateTransformations(namespace string) TemplateTransformationInterface {
+	return newTemplateTransformations(c, namespace)
+}
+
 func (c *FlowV1alpha1Client) Transformations(namespace string) TransformationInterface {
 	return newTransformations(c, namespace)
 }
@@ -22,8 +22,12 @@ type DataWeaveTransformationExpansion interface{}
 
 type JQTransformationExpansion interface{}
 
+type JSONToXMLTransformationExpansion interface{}
+
 type SynchronizerExpansion interface{}
 
+type TemplateTransformationExpansion interface{}
+
 type TransformationExpansion interface{}
 
 type XMLToJSONTransformationExpansion interface{}
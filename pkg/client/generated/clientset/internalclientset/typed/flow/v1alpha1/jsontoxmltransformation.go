@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	scheme "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// JSONToXMLTransformationsGetter has a method to return a JSONToXMLTransformationInterface.
+// A group's client should implement this interface.
+type JSONToXMLTransformationsGetter interface {
+	JSONToXMLTransformations(namespace string) JSONToXMLTransformationInterface
+}
+
+// JSONToXMLTransformationInterface has methods to work with JSONToXMLTransformation resources.
+type JSONToXMLTransformationInterface interface {
+	Create(ctx context.Context, jSONToXMLTransformation *v1alpha1.JSONToXMLTransformation, opts v1.CreateOptions) (*v1alpha1.JSONToXMLTransformation, error)
+	Update(ctx context.Context, jSONToXMLTransformation *v1alpha1.JSONToXMLTransformation, opts v1.UpdateOptions) (*v1alpha1.JSONToXMLTransformation, error)
+	UpdateStatus(ctx context.Context, jSONToXMLTransformation *v1alpha1.JSONToXMLTransformation, opts v1.UpdateOptions) (*v1alpha1.JSONToXMLTransformation, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.JSONToXMLTransformation, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.JSONToXMLTransformationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.JSONToXMLTransformation, err error)
+	JSONToXMLTransformationExpansion
+}
+
+// jSONToXMLTransformations implements JSONToXMLTransformationInterface
+type jSONToXMLTransformations struct {
+	client rest.Interface
+	ns     string
+}
+
+// newJSONToXMLTransformations returns a JSONToXMLTransformations
+func newJSONToXMLTransformations(c *FlowV1alpha1Client, namespace string) *jSONToXMLTransformations {
+	return &jSONToXMLTransformations{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the jSONToXMLTransformation, and returns the corresponding jSONToXMLTransformation object, and an error if there is any.
+func (c *jSONToXMLTransformations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.JSONToXMLTransformation, err error) {
+	result = &v1alpha1.JSONToXMLTransformation{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("jsontoxmltransformations").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of JSONToXMLTransformations that match those selectors.
+func (c *jSONToXMLTransformations) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.JSONToXMLTransformationList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.JSONToXMLTransformationList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("jsontoxmltransformations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested jSONToXMLTransformations.
+func (c *jSONToXMLTransformations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("jsontoxmltransformations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a jSONToXMLTransformation and creates it.  Returns the server's representation of the jSONToXMLTransformation, and an error, if there is any.
+func (c *jSONToXMLTransformations) Create(ctx context.Context, jSONToXMLTransformation *v1alpha1.JSONToXMLTransformation, opts v1.CreateOptions) (result *v1alpha1.JSONToXMLTransformation, err error) {
+	result = &v1alpha1.JSONToXMLTransformation{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("jsontoxmltransformations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(jSONToXMLTransformation).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a jSONToXMLTransformation and updates it. Returns the server's representation of the jSONToXMLTransformation, and an error, if there is any.
+func (c *jSONToXMLTransformations) Update(ctx context.Context, jSONToXMLTransformation *v1alpha1.JSONToXMLTransformation, opts v1.UpdateOptions) (result *v1alpha1.JSONToXMLTransformation, err error) {
+	result = &v1alpha1.JSONToXMLTransformation{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("jsontoxmltransformations").
+		Name(jSONToXMLTransformation.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(jSONToXMLTransformation).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *jSONToXMLTransformations) UpdateStatus(ctx context.Context, jSONToXMLTransformation *v1alpha1.JSONToXMLTransformation, opts v1.UpdateOptions) (result *v1alpha1.JSONToXMLTransformation, err error) {
+	result = &v1alpha1.JSONToXMLTransformation{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("jsontoxmltransformations").
+		Name(jSONToXMLTransformation.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(jSONToXMLTransformation).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the jSONToXMLTransformation and deletes it. Returns an error if one occurs.
+func (c *jSONToXMLTransformations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("jsontoxmltransformations").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *jSONToXMLTransformations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("jsontoxmltransformations").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched jSONToXMLTransformation.
+func (c *jSONToXMLTransformations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.JSONToXMLTransformation, err error) {
+	result = &v1alpha1.JSONToXMLTransformation{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("jsontoxmltransformations").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
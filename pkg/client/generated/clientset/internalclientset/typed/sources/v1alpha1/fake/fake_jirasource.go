@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeJiraSources implements JiraSourceInterface
+type FakeJiraSources struct {
+	Fake *FakeSourcesV1alpha1
+	ns   string
+}
+
+var jirasourcesResource = schema.GroupVersionResource{Group: "sources.triggermesh.io", Version: "v1alpha1", Resource: "jirasources"}
+
+var jirasourcesKind = schema.GroupVersionKind{Group: "sources.triggermesh.io", Version: "v1alpha1", Kind: "JiraSource"}
+
+// Get takes name of the jiraSource, and returns the corresponding jiraSource object, and an error if there is any.
+func (c *FakeJiraSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.JiraSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(jirasourcesResource, c.ns, name), &v1alpha1.JiraSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JiraSource), err
+}
+
+// List takes label and field selectors, and returns the list of JiraSources that match those selectors.
+func (c *FakeJiraSources) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.JiraSourceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(jirasourcesResource, jirasourcesKind, c.ns, opts), &v1alpha1.JiraSourceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.JiraSourceList{ListMeta: obj.(*v1alpha1.JiraSourceList).ListMeta}
+	for _, item := range obj.(*v1alpha1.JiraSourceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested jiraSources.
+func (c *FakeJiraSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(jirasourcesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a jiraSource and creates it.  Returns the server's representation of the jiraSource, and an error, if there is any.
+func (c *FakeJiraSources) Create(ctx context.Context, jiraSource *v1alpha1.JiraSource, opts v1.CreateOptions) (result *v1alpha1.JiraSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(jirasourcesResource, c.ns, jiraSource), &v1alpha1.JiraSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JiraSource), err
+}
+
+// Update takes the representation of a jiraSource and updates it. Returns the server's representation of the jiraSource, and an error, if there is any.
+func (c *FakeJiraSources) Update(ctx context.Context, jiraSource *v1alpha1.JiraSource, opts v1.UpdateOptions) (result *v1alpha1.JiraSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(jirasourcesResource, c.ns, jiraSource), &v1alpha1.JiraSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JiraSource), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeJiraSources) UpdateStatus(ctx context.Context, jiraSource *v1alpha1.JiraSource, opts v1.UpdateOptions) (*v1alpha1.JiraSource, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(jirasourcesResource, "status", c.ns, jiraSource), &v1alpha1.JiraSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JiraSource), err
+}
+
+// Delete takes name of the jiraSource and deletes it. Returns an error if one occurs.
+func (c *FakeJiraSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(jirasourcesResource, c.ns, name, opts), &v1alpha1.JiraSource{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeJiraSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(jirasourcesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.JiraSourceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched jiraSource.
+func (c *FakeJiraSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.JiraSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(jirasourcesResource, c.ns, name, pt, data, subresources...), &v1alpha1.JiraSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JiraSource), err
+}
@@ -144,6 +144,10 @@ func (c *FakeSourcesV1alpha1) IBMMQSources(namespace string) v1alpha1.IBMMQSourc
 	return &FakeIBMMQSources{c, namespace}
 }
 
+func (c *FakeSourcesV1alpha1) JiraSources(namespace string) v1alpha1.JiraSourceInterface {
+	return &FakeJiraSources{c, namespace}
+}
+
 func (c *FakeSourcesV1alpha1) OCIMetricsSources(namespace string) v1alpha1.OCIMetricsSourceInterface {
 	return &FakeOCIMetricsSources{c, namespace}
 }
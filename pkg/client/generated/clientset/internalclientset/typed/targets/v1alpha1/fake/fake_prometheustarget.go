@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakePrometheusTargets implements PrometheusTargetInterface
+type FakePrometheusTargets struct {
+	Fake *FakeTargetsV1alpha1
+	ns   string
+}
+
+var prometheustargetsResource = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1alpha1", Resource: "prometheustargets"}
+
+var prometheustargetsKind = schema.GroupVersionKind{Group: "targets.triggermesh.io", Version: "v1alpha1", Kind: "PrometheusTarget"}
+
+// Get takes name of the prometheusTarget, and returns the corresponding prometheusTarget object, and an error if there is any.
+func (c *FakePrometheusTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.PrometheusTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(prometheustargetsResource, c.ns, name), &v1alpha1.PrometheusTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PrometheusTarget), err
+}
+
+// List takes label and field selectors, and returns the list of PrometheusTargets that match those selectors.
+func (c *FakePrometheusTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.PrometheusTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(prometheustargetsResource, prometheustargetsKind, c.ns, opts), &v1alpha1.PrometheusTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.PrometheusTargetList{ListMeta: obj.(*v1alpha1.PrometheusTargetList).ListMeta}
+	for _, item := range obj.(*v1alpha1.PrometheusTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested prometheusTargets.
+func (c *FakePrometheusTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(prometheustargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a prometheusTarget and creates it.  Returns the server's representation of the prometheusTarget, and an error, if there is any.
+func (c *FakePrometheusTargets) Create(ctx context.Context, prometheusTarget *v1alpha1.PrometheusTarget, opts v1.CreateOptions) (result *v1alpha1.PrometheusTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(prometheustargetsResource, c.ns, prometheusTarget), &v1alpha1.PrometheusTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PrometheusTarget), err
+}
+
+// Update takes the representation of a prometheusTarget and updates it. Returns the server's representation of the prometheusTarget, and an error, if there is any.
+func (c *FakePrometheusTargets) Update(ctx context.Context, prometheusTarget *v1alpha1.PrometheusTarget, opts v1.UpdateOptions) (result *v1alpha1.PrometheusTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(prometheustargetsResource, c.ns, prometheusTarget), &v1alpha1.PrometheusTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PrometheusTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakePrometheusTargets) UpdateStatus(ctx context.Context, prometheusTarget *v1alpha1.PrometheusTarget, opts v1.UpdateOptions) (*v1alpha1.PrometheusTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(prometheustargetsResource, "status", c.ns, prometheusTarget), &v1alpha1.PrometheusTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PrometheusTarget), err
+}
+
+// Delete takes name of the prometheusTarget and deletes it. Returns an error if one occurs.
+func (c *FakePrometheusTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(prometheustargetsResource, c.ns, name, opts), &v1alpha1.PrometheusTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakePrometheusTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(prometheustargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.PrometheusTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched prometheusTarget.
+func (c *FakePrometheusTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.PrometheusTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(prometheustargetsResource, c.ns, name, pt, data, subresources...), &v1alpha1.PrometheusTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PrometheusTarget), err
+}
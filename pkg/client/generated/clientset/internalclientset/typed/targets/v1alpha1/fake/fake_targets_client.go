@@ -132,6 +132,10 @@ func (c *FakeTargetsV1alpha1) OracleTargets(namespace string) v1alpha1.OracleTar
 	return &FakeOracleTargets{c, namespace}
 }
 
+func (c *FakeTargetsV1alpha1) PrometheusTargets(namespace string) v1alpha1.PrometheusTargetInterface {
+	return &FakePrometheusTargets{c, namespace}
+}
+
 func (c *FakeTargetsV1alpha1) SalesforceTargets(namespace string) v1alpha1.SalesforceTargetInterface {
 	return &FakeSalesforceTargets{c, namespace}
 }
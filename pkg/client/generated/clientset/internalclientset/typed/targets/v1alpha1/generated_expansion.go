@@ -70,6 +70,8 @@ type LogzTargetExpansion interface{}
 
 type OracleTargetExpansion interface{}
 
+type PrometheusTargetExpansion interface{}
+
 type SalesforceTargetExpansion interface{}
 
 type SendGridTargetExpansion interface{}
@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	scheme "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// PrometheusTargetsGetter has a method to return a PrometheusTargetInterface.
+// A group's client should implement this interface.
+type PrometheusTargetsGetter interface {
+	PrometheusTargets(namespace string) PrometheusTargetInterface
+}
+
+// PrometheusTargetInterface has methods to work with PrometheusTarget resources.
+type PrometheusTargetInterface interface {
+	Create(ctx context.Context, prometheusTarget *v1alpha1.PrometheusTarget, opts v1.CreateOptions) (*v1alpha1.PrometheusTarget, error)
+	Update(ctx context.Context, prometheusTarget *v1alpha1.PrometheusTarget, opts v1.UpdateOptions) (*v1alpha1.PrometheusTarget, error)
+	UpdateStatus(ctx context.Context, prometheusTarget *v1alpha1.PrometheusTarget, opts v1.UpdateOptions) (*v1alpha1.PrometheusTarget, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.PrometheusTarget, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.PrometheusTargetList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.PrometheusTarget, err error)
+	PrometheusTargetExpansion
+}
+
+// prometheusTargets implements PrometheusTargetInterface
+type prometheusTargets struct {
+	client rest.Interface
+	ns     string
+}
+
+// newPrometheusTargets returns a PrometheusTargets
+func newPrometheusTargets(c *TargetsV1alpha1Client, namespace string) *prometheusTargets {
+	return &prometheusTargets{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the prometheusTarget, and returns the corresponding prometheusTarget object, and an error if there is any.
+func (c *prometheusTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.PrometheusTarget, err error) {
+	result = &v1alpha1.PrometheusTarget{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("prometheustargets").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of PrometheusTargets that match those selectors.
+func (c *prometheusTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.PrometheusTargetList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.PrometheusTargetList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("prometheustargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested prometheusTargets.
+func (c *prometheusTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("prometheustargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a prometheusTarget and creates it.  Returns the server's representation of the prometheusTarget, and an error, if there is any.
+func (c *prometheusTargets) Create(ctx context.Context, prometheusTarget *v1alpha1.PrometheusTarget, opts v1.CreateOptions) (result *v1alpha1.PrometheusTarget, err error) {
+	result = &v1alpha1.PrometheusTarget{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("prometheustargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(prometheusTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a prometheusTarget and updates it. Returns the server's representation of the prometheusTarget, and an error, if there is any.
+func (c *prometheusTargets) Update(ctx context.Context, prometheusTarget *v1alpha1.PrometheusTarget, opts v1.UpdateOptions) (result *v1alpha1.PrometheusTarget, err error) {
+	result = &v1alpha1.PrometheusTarget{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("prometheustargets").
+		Name(prometheusTarget.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(prometheusTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *prometheusTargets) UpdateStatus(ctx context.Context, prometheusTarget *v1alpha1.PrometheusTarget, opts v1.UpdateOptions) (result *v1alpha1.PrometheusTarget, err error) {
+	result = &v1alpha1.PrometheusTarget{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("prometheustargets").
+		Name(prometheusTarget.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(prometheusTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the prometheusTarget and deletes it. Returns an error if one occurs.
+func (c *prometheusTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("prometheustargets").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *prometheusTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("prometheustargets").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched prometheusTarget.
+func (c *prometheusTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.PrometheusTarget, err error) {
+	result = &v1alpha1.PrometheusTarget{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("prometheustargets").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -54,6 +54,7 @@ type TargetsV1alpha1Interface interface {
 	LogzMetricsTargetsGetter
 	LogzTargetsGetter
 	OracleTargetsGetter
+	PrometheusTargetsGetter
 	SalesforceTargetsGetter
 	SendGridTargetsGetter
 	SlackTargetsGetter
@@ -173,6 +174,10 @@ func (c *TargetsV1alpha1Client) OracleTargets(namespace string) OracleTargetInte
 	return newOracleTargets(c, namespace)
 }
 
+func (c *TargetsV1alpha1Client) PrometheusTargets(namespace string) PrometheusTargetInterface {
+	return newPrometheusTargets(c, namespace)
+}
+
 func (c *TargetsV1alpha1Client) SalesforceTargets(namespace string) SalesforceTargetInterface {
 	return newSalesforceTargets(c, namespace)
 }
@@ -28,8 +28,12 @@ type Interface interface {
 	DataWeaveTransformations() DataWeaveTransformationInformer
 	// JQTransformations returns a JQTransformationInformer.
 	JQTransformations() JQTransformationInformer
+	// JSONToXMLTransformations returns a JSONToXMLTransformationInformer.
+	JSONToXMLTransformations() JSONToXMLTransformationInformer
 	// Synchronizers returns a SynchronizerInformer.
 	Synchronizers() SynchronizerInformer
+	// TemplateTransformations returns a TemplateTransformationInformer.
+	TemplateTransformations() TemplateTransformationInformer
 	// Transformations returns a TransformationInformer.
 	Transformations() TransformationInformer
 	// XMLToJSONTransformations returns a XMLToJSONTransformationInformer.
@@ -59,11 +63,21 @@ func (v *version) JQTransformations() JQTransformationInformer {
 	return &jQTransformationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// JSONToXMLTransformations returns a JSONToXMLTransformationInformer.
+func (v *version) JSONToXMLTransformations() JSONToXMLTransformationInformer {
+	return &jSONToXMLTransformationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Synchronizers returns a SynchronizerInformer.
 func (v *version) Synchronizers() SynchronizerInformer {
 	return &synchronizerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TemplateTransformations returns a TemplateTransformationInformer.
+func (v *version) TemplateTransformations() TemplateTransformationInformer {
+	return &templateTransformationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Transformations returns a TransformationInformer.
 func (v *version) Transformations() TransformationInformer {
 	return &transformationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
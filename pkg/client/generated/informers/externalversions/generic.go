@@ -65,8 +65,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().DataWeaveTransformations().Informer()}, nil
 	case flowv1alpha1.SchemeGroupVersion.WithResource("jqtransformations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().JQTransformations().Informer()}, nil
+	case flowv1alpha1.SchemeGroupVersion.WithResource("jsontoxmltransformations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().JSONToXMLTransformations().Informer()}, nil
 	case flowv1alpha1.SchemeGroupVersion.WithResource("synchronizers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().Synchronizers().Informer()}, nil
+	case flowv1alpha1.SchemeGroupVersion.WithResource("templatetransformations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().TemplateTransformations().Informer()}, nil
 	case flowv1alpha1.SchemeGroupVersion.WithResource("transformations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().Transformations().Informer()}, nil
 	case flowv1alpha1.SchemeGroupVersion.WithResource("xmltojsontransformations"):
@@ -139,6 +143,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().HTTPPollerSources().Informer()}, nil
 	case sourcesv1alpha1.SchemeGroupVersion.WithResource("ibmmqsources"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().IBMMQSources().Informer()}, nil
+	case sourcesv1alpha1.SchemeGroupVersion.WithResource("jirasources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().JiraSources().Informer()}, nil
 	case sourcesv1alpha1.SchemeGroupVersion.WithResource("ocimetricssources"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().OCIMetricsSources().Informer()}, nil
 	case sourcesv1alpha1.SchemeGroupVersion.WithResource("salesforcesources"):
@@ -205,6 +211,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1alpha1().LogzTargets().Informer()}, nil
 	case targetsv1alpha1.SchemeGroupVersion.WithResource("oracletargets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1alpha1().OracleTargets().Informer()}, nil
+	case targetsv1alpha1.SchemeGroupVersion.WithResource("prometheustargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1alpha1().PrometheusTargets().Informer()}, nil
 	case targetsv1alpha1.SchemeGroupVersion.WithResource("salesforcetargets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1alpha1().SalesforceTargets().Informer()}, nil
 	case targetsv1alpha1.SchemeGroupVersion.WithResource("sendgridtargets"):
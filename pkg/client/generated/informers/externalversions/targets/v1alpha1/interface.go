@@ -76,6 +76,8 @@ type Interface interface {
 	LogzTargets() LogzTargetInformer
 	// OracleTargets returns a OracleTargetInformer.
 	OracleTargets() OracleTargetInformer
+	// PrometheusTargets returns a PrometheusTargetInformer.
+	PrometheusTargets() PrometheusTargetInformer
 	// SalesforceTargets returns a SalesforceTargetInformer.
 	SalesforceTargets() SalesforceTargetInformer
 	// SendGridTargets returns a SendGridTargetInformer.
@@ -235,6 +237,11 @@ func (v *version) OracleTargets() OracleTargetInformer {
 	return &oracleTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// PrometheusTargets returns a PrometheusTargetInformer.
+func (v *version) PrometheusTargets() PrometheusTargetInformer {
+	return &prometheusTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // SalesforceTargets returns a SalesforceTargetInformer.
 func (v *version) SalesforceTargets() SalesforceTargetInformer {
 	return &salesforceTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
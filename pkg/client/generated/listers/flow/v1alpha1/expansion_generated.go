@@ -34,6 +34,14 @@ type JQTransformationListerExpansion interface{}
 // JQTransformationNamespaceLister.
 type JQTransformationNamespaceListerExpansion interface{}
 
+// JSONToXMLTransformationListerExpansion allows custom methods to be added to
+// JSONToXMLTransformationLister.
+type JSONToXMLTransformationListerExpansion interface{}
+
+// JSONToXMLTransformationNamespaceListerExpansion allows custom methods to be added to
+// JSONToXMLTransformationNamespaceLister.
+type JSONToXMLTransformationNamespaceListerExpansion interface{}
+
 // SynchronizerListerExpansion allows custom methods to be added to
 // SynchronizerLister.
 type SynchronizerListerExpansion interface{}
@@ -42,6 +50,14 @@ type SynchronizerListerExpansion interface{}
 // SynchronizerNamespaceLister.
 type SynchronizerNamespaceListerExpansion interface{}
 
+// TemplateTransformationListerExpansion allows custom methods to be added to
+// TemplateTransformationLister.
+type TemplateTransformationListerExpansion interface{}
+
+// TemplateTransformationNamespaceListerExpansion allows custom methods to be added to
+// TemplateTransformationNamespaceLister.
+type TemplateTransformationNamespaceListerExpansion interface{}
+
 // TransformationListerExpansion allows custom methods to be added to
 // TransformationLister.
 type TransformationListerExpansion interface{}
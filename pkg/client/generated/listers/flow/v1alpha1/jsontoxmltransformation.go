@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// JSONToXMLTransformationLister helps list JSONToXMLTransformations.
+// All objects returned here must be treated as read-only.
+type JSONToXMLTransformationLister interface {
+	// List lists all JSONToXMLTransformations in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.JSONToXMLTransformation, err error)
+	// JSONToXMLTransformations returns an object that can list and get JSONToXMLTransformations.
+	JSONToXMLTransformations(namespace string) JSONToXMLTransformationNamespaceLister
+	JSONToXMLTransformationListerExpansion
+}
+
+// jSONToXMLTransformationLister implements the JSONToXMLTransformationLister interface.
+type jSONToXMLTransformationLister struct {
+	indexer cache.Indexer
+}
+
+// NewJSONToXMLTransformationLister returns a new JSONToXMLTransformationLister.
+func NewJSONToXMLTransformationLister(indexer cache.Indexer) JSONToXMLTransformationLister {
+	return &jSONToXMLTransformationLister{indexer: indexer}
+}
+
+// List lists all JSONToXMLTransformations in the indexer.
+func (s *jSONToXMLTransformationLister) List(selector labels.Selector) (ret []*v1alpha1.JSONToXMLTransformation, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.JSONToXMLTransformation))
+	})
+	return ret, err
+}
+
+// JSONToXMLTransformations returns an object that can list and get JSONToXMLTransformations.
+func (s *jSONToXMLTransformationLister) JSONToXMLTransformations(namespace string) JSONToXMLTransformationNamespaceLister {
+	return jSONToXMLTransformationNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// JSONToXMLTransformationNamespaceLister helps list and get JSONToXMLTransformations.
+// All objects returned here must be treated as read-only.
+type JSONToXMLTransformationNamespaceLister interface {
+	// List lists all JSONToXMLTransformations in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.JSONToXMLTransformation, err error)
+	// Get retrieves the JSONToXMLTransformation from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.JSONToXMLTransformation, error)
+	JSONToXMLTransformationNamespaceListerExpansion
+}
+
+// jSONToXMLTransformationNamespaceLister implements the JSONToXMLTransformationNamespaceLister
+// interface.
+type jSONToXMLTransformationNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all JSONToXMLTransformations in the indexer for a given namespace.
+func (s jSONToXMLTransformationNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.JSONToXMLTransformation, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.JSONToXMLTransformation))
+	})
+	return ret, err
+}
+
+// Get retrieves the JSONToXMLTransformation from the indexer for a given namespace and name.
+func (s jSONToXMLTransformationNamespaceLister) Get(name string) (*v1alpha1.JSONToXMLTransformation, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("jsontoxmltransformation"), name)
+	}
+	return obj.(*v1alpha1.JSONToXMLTransformation), nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TemplateTransformationLister helps list TemplateTransformations.
+// All objects returned here must be treated as read-only.
+type TemplateTransformationLister interface {
+	// List lists all TemplateTransformations in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.TemplateTransformation, err error)
+	// TemplateTransformations returns an object that can list and get TemplateTransformations.
+	TemplateTransformations(namespace string) TemplateTransformationNamespaceLister
+	TemplateTransformationListerExpansion
+}
+
+// templateTransformationLister implements the TemplateTransformationLister interface.
+type templateTransformationLister struct {
+	indexer cache.Indexer
+}
+
+// NewTemplateTransformationLister returns a new TemplateTransformationLister.
+func NewTemplateTransformationLister(indexer cache.Indexer) TemplateTransformationLister {
+	return &templateTransformationLister{indexer: indexer}
+}
+
+// List lists all TemplateTransformations in the indexer.
+func (s *templateTransformationLister) List(selector labels.Selector) (ret []*v1alpha1.TemplateTransformation, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.TemplateTransformation))
+	})
+	return ret, err
+}
+
+// TemplateTransformations returns an object that can list and get TemplateTransformations.
+func (s *templateTransformationLister) TemplateTransformations(namespace string) TemplateTransformationNamespaceLister {
+	return templateTransformationNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// TemplateTransformationNamespaceLister helps list and get TemplateTransformations.
+// All objects returned here must be treated as read-only.
+type TemplateTransformationNamespaceLister interface {
+	// List lists all TemplateTransformations in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.TemplateTransformation, err error)
+	// Get retrieves the TemplateTransformation from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.TemplateTransformation, error)
+	TemplateTransformationNamespaceListerExpansion
+}
+
+// templateTransformationNamespaceLister implements the TemplateTransformationNamespaceLister
+// interface.
+type templateTransformationNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all TemplateTransformations in the indexer for a given namespace.
+func (s templateTransformationNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.TemplateTransformation, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.TemplateTransformation))
+	})
+	return ret, err
+}
+
+// Get retrieves the TemplateTransformation from the indexer for a given namespace and name.
+func (s templateTransformationNamespaceLister) Get(name string) (*v1alpha1.TemplateTransformation, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("templatetransformation"), name)
+	}
+	return obj.(*v1alpha1.TemplateTransformation), nil
+}
@@ -250,6 +250,14 @@ type IBMMQSourceListerExpansion interface{}
 // IBMMQSourceNamespaceLister.
 type IBMMQSourceNamespaceListerExpansion interface{}
 
+// JiraSourceListerExpansion allows custom methods to be added to
+// JiraSourceLister.
+type JiraSourceListerExpansion interface{}
+
+// JiraSourceNamespaceListerExpansion allows custom methods to be added to
+// JiraSourceNamespaceLister.
+type JiraSourceNamespaceListerExpansion interface{}
+
 // OCIMetricsSourceListerExpansion allows custom methods to be added to
 // OCIMetricsSourceLister.
 type OCIMetricsSourceListerExpansion interface{}
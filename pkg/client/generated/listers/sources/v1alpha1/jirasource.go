@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// JiraSourceLister helps list JiraSources.
+// All objects returned here must be treated as read-only.
+type JiraSourceLister interface {
+	// List lists all JiraSources in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.JiraSource, err error)
+	// JiraSources returns an object that can list and get JiraSources.
+	JiraSources(namespace string) JiraSourceNamespaceLister
+	JiraSourceListerExpansion
+}
+
+// jiraSourceLister implements the JiraSourceLister interface.
+type jiraSourceLister struct {
+	indexer cache.Indexer
+}
+
+// NewJiraSourceLister returns a new JiraSourceLister.
+func NewJiraSourceLister(indexer cache.Indexer) JiraSourceLister {
+	return &jiraSourceLister{indexer: indexer}
+}
+
+// List lists all JiraSources in the indexer.
+func (s *jiraSourceLister) List(selector labels.Selector) (ret []*v1alpha1.JiraSource, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.JiraSource))
+	})
+	return ret, err
+}
+
+// JiraSources returns an object that can list and get JiraSources.
+func (s *jiraSourceLister) JiraSources(namespace string) JiraSourceNamespaceLister {
+	return jiraSourceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// JiraSourceNamespaceLister helps list and get JiraSources.
+// All objects returned here must be treated as read-only.
+type JiraSourceNamespaceLister interface {
+	// List lists all JiraSources in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.JiraSource, err error)
+	// Get retrieves the JiraSource from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.JiraSource, error)
+	JiraSourceNamespaceListerExpansion
+}
+
+// jiraSourceNamespaceLister implements the JiraSourceNamespaceLister
+// interface.
+type jiraSourceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all JiraSources in the indexer for a given namespace.
+func (s jiraSourceNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.JiraSource, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.JiraSource))
+	})
+	return ret, err
+}
+
+// Get retrieves the JiraSource from the indexer for a given namespace and name.
+func (s jiraSourceNamespaceLister) Get(name string) (*v1alpha1.JiraSource, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("jirasource"), name)
+	}
+	return obj.(*v1alpha1.JiraSource), nil
+}
@@ -226,6 +226,14 @@ type OracleTargetListerExpansion interface{}
 // OracleTargetNamespaceLister.
 type OracleTargetNamespaceListerExpansion interface{}
 
+// PrometheusTargetListerExpansion allows custom methods to be added to
+// PrometheusTargetLister.
+type PrometheusTargetListerExpansion interface{}
+
+// PrometheusTargetNamespaceListerExpansion allows custom methods to be added to
+// PrometheusTargetNamespaceLister.
+type PrometheusTargetNamespaceListerExpansion interface{}
+
 // SalesforceTargetListerExpansion allows custom methods to be added to
 // SalesforceTargetLister.
 type SalesforceTargetListerExpansion interface{}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// PrometheusTargetLister helps list PrometheusTargets.
+// All objects returned here must be treated as read-only.
+type PrometheusTargetLister interface {
+	// List lists all PrometheusTargets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.PrometheusTarget, err error)
+	// PrometheusTargets returns an object that can list and get PrometheusTargets.
+	PrometheusTargets(namespace string) PrometheusTargetNamespaceLister
+	PrometheusTargetListerExpansion
+}
+
+// prometheusTargetLister implements the PrometheusTargetLister interface.
+type prometheusTargetLister struct {
+	indexer cache.Indexer
+}
+
+// NewPrometheusTargetLister returns a new PrometheusTargetLister.
+func NewPrometheusTargetLister(indexer cache.Indexer) PrometheusTargetLister {
+	return &prometheusTargetLister{indexer: indexer}
+}
+
+// List lists all PrometheusTargets in the indexer.
+func (s *prometheusTargetLister) List(selector labels.Selector) (ret []*v1alpha1.PrometheusTarget, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.PrometheusTarget))
+	})
+	return ret, err
+}
+
+// PrometheusTargets returns an object that can list and get PrometheusTargets.
+func (s *prometheusTargetLister) PrometheusTargets(namespace string) PrometheusTargetNamespaceLister {
+	return prometheusTargetNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// PrometheusTargetNamespaceLister helps list and get PrometheusTargets.
+// All objects returned here must be treated as read-only.
+type PrometheusTargetNamespaceLister interface {
+	// List lists all PrometheusTargets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.PrometheusTarget, err error)
+	// Get retrieves the PrometheusTarget from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.PrometheusTarget, error)
+	PrometheusTargetNamespaceListerExpansion
+}
+
+// prometheusTargetNamespaceLister implements the PrometheusTargetNamespaceLister
+// interface.
+type prometheusTargetNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all PrometheusTargets in the indexer for a given namespace.
+func (s prometheusTargetNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.PrometheusTarget, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.PrometheusTarget))
+	})
+	return ret, err
+}
+
+// Get retrieves the PrometheusTarget from the indexer for a given namespace and name.
+func (s prometheusTargetNamespaceLister) Get(name string) (*v1alpha1.PrometheusTarget, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("prometheustarget"), name)
+	}
+	return obj.(*v1alpha1.PrometheusTarget), nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dryrun evaluates routing and transformation components against
+// sample events, without deploying them to a cluster.
+package dryrun
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"knative.dev/pkg/apis"
+
+	flowv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	flowv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1beta1"
+	routingv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	routingv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1beta1"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation"
+	"github.com/triggermesh/triggermesh/pkg/routing/adapter/splitter"
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+)
+
+// Result is the outcome of a dry run.
+type Result struct {
+	// Kind of the evaluated component.
+	Kind string `json:"kind"`
+	// Pass is the decision of a Filter. Unset for other kinds.
+	Pass *bool `json:"pass,omitempty"`
+	// Events are the events which would be sent to the component's sink.
+	Events []cloudevents.Event `json:"events"`
+	// Trace contains human-readable details about the evaluation.
+	Trace []string `json:"trace,omitempty"`
+}
+
+// Object is a component which can be evaluated by Run.
+type Object interface {
+	apis.Convertible
+}
+
+// Decode decodes a YAML or JSON manifest into a component supported by Run.
+// Components of API version v1beta1 are converted to their v1alpha1
+// counterpart, which is the version understood by adapters.
+func Decode(manifest []byte) (Object, error) {
+	var tm struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+	}
+	if err := yaml.Unmarshal(manifest, &tm); err != nil {
+		return nil, fmt.Errorf("decoding type metadata: %w", err)
+	}
+
+	gvk := schema.FromAPIVersionAndKind(tm.APIVersion, tm.Kind)
+
+	var obj, hub Object
+
+	switch gvk {
+	case routingv1alpha1.SchemeGroupVersion.WithKind("Filter"):
+		obj = &routingv1alpha1.Filter{}
+	case routingv1beta1.SchemeGroupVersion.WithKind("Filter"):
+		obj, hub = &routingv1beta1.Filter{}, &routingv1alpha1.Filter{}
+	case routingv1alpha1.SchemeGroupVersion.WithKind("Splitter"):
+		obj = &routingv1alpha1.Splitter{}
+	case routingv1beta1.SchemeGroupVersion.WithKind("Splitter"):
+		obj, hub = &routingv1beta1.Splitter{}, &routingv1alpha1.Splitter{}
+	case flowv1alpha1.SchemeGroupVersion.WithKind("Transformation"):
+		obj = &flowv1alpha1.Transformation{}
+	case flowv1beta1.SchemeGroupVersion.WithKind("Transformation"):
+		obj, hub = &flowv1beta1.Transformation{}, &flowv1alpha1.Transformation{}
+	default:
+		return nil, fmt.Errorf("unsupported component %q", gvk)
+	}
+
+	dec := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), len(manifest))
+	if err := dec.Decode(obj); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", gvk.Kind, err)
+	}
+
+	if hub == nil {
+		return obj, nil
+	}
+	if err := hub.ConvertFrom(context.Background(), obj); err != nil {
+		return nil, fmt.Errorf("converting %s to v1alpha1: %w", gvk, err)
+	}
+	return hub, nil
+}
+
+// Run evaluates the given component against a sample event.
+func Run(obj Object, event cloudevents.Event) (*Result, error) {
+	switch o := obj.(type) {
+	case *routingv1alpha1.Filter:
+		return RunFilter(&o.Spec, event)
+	case *routingv1alpha1.Splitter:
+		return RunSplitter(&o.Spec, event)
+	case *flowv1alpha1.Transformation:
+		return RunTransformation(&o.Spec, event)
+	default:
+		return nil, fmt.Errorf("unsupported component type %T", obj)
+	}
+}
+
+// RunFilter evaluates the expression of a Filter against a sample event.
+func RunFilter(spec *routingv1alpha1.FilterSpec, event cloudevents.Event) (*Result, error) {
+	cond, err := cel.CompileExpression(spec.Expression)
+	if err != nil {
+		return nil, fmt.Errorf("compiling expression: %w", err)
+	}
+
+	res := &Result{
+		Kind:   "Filter",
+		Events: []cloudevents.Event{},
+	}
+
+	pass, vars, err := cond.Eval(event)
+	for _, v := range cond.Variables {
+		res.Trace = append(res.Trace, fmt.Sprintf("$%s.(%s) = %#v", v.Path, v.Type, vars[v.Name]))
+	}
+	if err != nil {
+		// the Filter adapter lets events through when the expression can not be evaluated
+		res.Trace = append(res.Trace, fmt.Sprintf("evaluation error, event passes: %s", err))
+		pass = true
+	}
+
+	res.Pass = &pass
+	if pass {
+		res.Events = append(res.Events, event)
+	}
+
+	return res, nil
+}
+
+// RunSplitter splits a sample event according to the spec of a Splitter.
+func RunSplitter(spec *routingv1alpha1.SplitterSpec, event cloudevents.Event) (*Result, error) {
+	res := &Result{
+		Kind:   "Splitter",
+		Events: []cloudevents.Event{},
+	}
+
+	events, err := splitter.Split(spec, &event)
+	if err != nil {
+		res.Trace = append(res.Trace, err.Error())
+	}
+	if len(events) == 0 {
+		res.Trace = append(res.Trace, fmt.Sprintf("no array found at path %q", spec.Path))
+	}
+
+	for _, e := range events {
+		res.Events = append(res.Events, *e)
+	}
+
+	return res, nil
+}
+
+// RunTransformation applies the pipelines of a Transformation to a sample event.
+func RunTransformation(spec *flowv1alpha1.TransformationSpec, event cloudevents.Event) (*Result, error) {
+	contextPl, dataPl, err := transformation.NewPipelines(spec.Context, spec.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	out, errs, err := transformation.Transform(contextPl, dataPl, event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Kind:   "Transformation",
+		Events: []cloudevents.Event{*out},
+		Trace:  errs,
+	}, nil
+}
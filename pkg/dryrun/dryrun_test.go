@@ -0,0 +1,148 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tFilterV1alpha1 = `
+apiVersion: routing.triggermesh.io/v1alpha1
+kind: Filter
+metadata:
+  name: test
+spec:
+  expression: $level.(int64) > 2
+`
+
+const tSplitterV1beta1 = `
+apiVersion: routing.triggermesh.io/v1beta1
+kind: Splitter
+metadata:
+  name: test
+spec:
+  path: items
+  ceContext:
+    type: io.triggermesh.item
+    source: test
+    extensions:
+      origin: dryrun
+`
+
+const tTransformationV1alpha1 = `
+apiVersion: flow.triggermesh.io/v1alpha1
+kind: Transformation
+metadata:
+  name: test
+spec:
+  context:
+  - operation: add
+    paths:
+    - key: type
+      value: io.triggermesh.transformed
+  data:
+  - operation: delete
+    paths:
+    - key: level
+`
+
+func TestRun(t *testing.T) {
+	testCases := map[string]struct {
+		manifest   string
+		data       string
+		expectPass *bool
+		expectData []string
+		expectType string
+	}{
+		"Filter passes": {
+			manifest:   tFilterV1alpha1,
+			data:       `{"level": 3}`,
+			expectPass: boolPtr(true),
+			expectData: []string{`{"level": 3}`},
+		},
+		"Filter drops": {
+			manifest:   tFilterV1alpha1,
+			data:       `{"level": 1}`,
+			expectPass: boolPtr(false),
+			expectData: []string{},
+		},
+		"Splitter of v1beta1 API version": {
+			manifest:   tSplitterV1beta1,
+			data:       `{"items": [{"a": 1}, {"b": 2}]}`,
+			expectData: []string{`{"a": 1}`, `{"b": 2}`},
+			expectType: "io.triggermesh.item",
+		},
+		"Splitter without array": {
+			manifest:   tSplitterV1beta1,
+			data:       `{"items": "none"}`,
+			expectData: []string{},
+		},
+		"Transformation": {
+			manifest:   tTransformationV1alpha1,
+			data:       `{"level": 3, "msg": "hi"}`,
+			expectData: []string{`{"msg":"hi"}`},
+			expectType: "io.triggermesh.transformed",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			obj, err := Decode([]byte(tc.manifest))
+			require.NoError(t, err)
+
+			res, err := Run(obj, newEvent(t, tc.data))
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectPass, res.Pass)
+
+			data := make([]string, 0, len(res.Events))
+			for _, e := range res.Events {
+				data = append(data, string(e.Data()))
+				if tc.expectType != "" {
+					assert.Equal(t, tc.expectType, e.Type())
+				}
+			}
+			assert.Equal(t, tc.expectData, data)
+		})
+	}
+}
+
+func TestDecodeUnsupported(t *testing.T) {
+	_, err := Decode([]byte("apiVersion: v1\nkind: ConfigMap\n"))
+	assert.EqualError(t, err, `unsupported component "/v1, Kind=ConfigMap"`)
+}
+
+func newEvent(t *testing.T, data string) cloudevents.Event {
+	t.Helper()
+
+	e := cloudevents.NewEvent()
+	e.SetID("0")
+	e.SetType("test.type")
+	e.SetSource("test.source")
+	require.NoError(t, e.SetData(cloudevents.ApplicationJSON, []byte(data)))
+
+	return e
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -19,15 +19,19 @@ package jqtransformation
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"os"
 
 	"github.com/itchyny/gojq"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
 	"go.uber.org/zap"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/flow"
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 )
@@ -54,13 +58,18 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Panicf("Error creating CloudEvents replier: %v", err)
 	}
 
-	query, err := gojq.Parse(env.Query)
+	args := argsFromEnv(os.Environ())
+
+	query, err := compileQuery(env.Query, args)
 	if err != nil {
 		logger.Panicf("Error creating query: %v", err)
 	}
 
 	return &jqadapter{
-		query: query,
+		query:     query,
+		argValues: argValues(args),
+		scope:     v1alpha1.JQScope(env.Scope),
+		results:   v1alpha1.JQResultsMode(env.Results),
 
 		sink:     env.Sink,
 		replier:  replier,
@@ -75,7 +84,10 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 var _ pkgadapter.Adapter = (*jqadapter)(nil)
 
 type jqadapter struct {
-	query *gojq.Query
+	query     *gojq.Code
+	argValues []interface{}
+	scope     v1alpha1.JQScope
+	results   v1alpha1.JQResultsMode
 
 	sink     string
 	replier  *targetce.Replier
@@ -86,6 +98,33 @@ type jqadapter struct {
 	sr *metrics.EventProcessingStatsReporter
 }
 
+// compileQuery parses the given jq query and compiles it with the variables
+// which are exposed to it: the event context, followed by the named
+// arguments.
+func compileQuery(q string, args []queryArg) (*gojq.Code, error) {
+	query, err := gojq.Parse(q)
+	if err != nil {
+		return nil, err
+	}
+
+	argNames := make([]string, len(args))
+	for i, a := range args {
+		argNames[i] = a.name
+	}
+
+	return gojq.Compile(query, gojq.WithVariables(v1alpha1.JQVariables(argNames...)))
+}
+
+// argValues returns the values of the given query arguments, in the order
+// expected by the compiled query.
+func argValues(args []queryArg) []interface{} {
+	vals := make([]interface{}, len(args))
+	for i, a := range args {
+		vals[i] = a.value
+	}
+	return vals
+}
+
 // Start is a blocking function and will return if an error occurs
 // or the context is cancelled.
 func (a *jqadapter) Start(ctx context.Context) error {
@@ -95,40 +134,247 @@ func (a *jqadapter) Start(ctx context.Context) error {
 }
 
 func (a *jqadapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
-	var data interface{}
-	var qd interface{}
-	if err := event.DataAs(&data); err != nil {
+	input, err := a.queryInput(&event)
+	if err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	results, err := a.runQuery(ctx, input, eventContext(&event))
+	if err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
 	}
 
-	iter := a.query.Run(data)
+	outEvents := make([]*cloudevents.Event, 0, len(results))
+	for i, r := range results {
+		out, err := a.makeEvent(&event, r)
+		if err != nil {
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
+		}
+		// Events split from the same input must not share its ID, unless
+		// the query explicitly assigned one.
+		if a.results == v1alpha1.JQResultsSplit && out.ID() == event.ID() {
+			out.SetID(fmt.Sprintf("%s-%d", event.ID(), i))
+		}
+		outEvents = append(outEvents, out)
+	}
+
+	if a.sink != "" {
+		for _, out := range outEvents {
+			if result := a.ceClient.Send(ctx, *out); !cloudevents.IsACK(result) {
+				return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, "sending the cloudevent to the sink")
+			}
+		}
+		return nil, cloudevents.ResultACK
+	}
+
+	if len(outEvents) == 0 {
+		return nil, cloudevents.ResultACK
+	}
+
+	return outEvents[0], cloudevents.ResultACK
+}
+
+// queryInput returns the input of the jq query for the given event,
+// according to the configured scope.
+func (a *jqadapter) queryInput(event *cloudevents.Event) (interface{}, error) {
+	var data interface{}
+
+	if a.scope != v1alpha1.JQScopeEvent {
+		if err := event.DataAs(&data); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+
+	// Render the event in the structured JSON format of CloudEvents.
+	ev := eventContext(event)
+	if len(event.Data()) > 0 {
+		if err := event.DataAs(&data); err != nil {
+			return nil, err
+		}
+		ev["data"] = data
+	}
+	return ev, nil
+}
+
+// runQuery runs the jq query against the given input, and returns its
+// results according to the configured results mode.
+func (a *jqadapter) runQuery(ctx context.Context, input interface{}, evCtx map[string]interface{}) ([]interface{}, error) {
+	vars := make([]interface{}, 0, len(a.argValues)+1)
+	vars = append(vars, evCtx)
+	vars = append(vars, a.argValues...)
+
+	var results []interface{}
+
+	iter := a.query.RunWithContext(ctx, input, vars...)
 	for {
 		v, ok := iter.Next()
 		if !ok {
 			break
 		}
 		if err, ok := v.(error); ok {
-			return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
+			return nil, err
 		}
-		qd = v
+		results = append(results, v)
+	}
+
+	switch a.results {
+	case v1alpha1.JQResultsSplit:
+		return results, nil
+	case v1alpha1.JQResultsArray:
+		if results == nil {
+			results = []interface{}{}
+		}
+		return []interface{}{results}, nil
+	default:
+		if len(results) == 0 {
+			return []interface{}{nil}, nil
+		}
+		return results[len(results)-1:], nil
+	}
+}
+
+// makeEvent returns the event resulting from the transformation of the given
+// event by a single query result.
+func (a *jqadapter) makeEvent(in *cloudevents.Event, result interface{}) (*cloudevents.Event, error) {
+	if a.scope == v1alpha1.JQScopeEvent {
+		return eventFromStructured(in, result)
 	}
 
 	// Reserialize the query results for the response
-	bs, err := json.Marshal(&qd)
+	bs, err := json.Marshal(result)
 	if err != nil {
-		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
+		return nil, err
 	}
 
-	if err := event.SetData(cloudevents.ApplicationJSON, bs); err != nil {
-		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
+	out := in.Clone()
+	if err := out.SetData(cloudevents.ApplicationJSON, bs); err != nil {
+		return nil, err
 	}
+	return &out, nil
+}
 
-	if a.sink != "" {
-		if result := a.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
-			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, "sending the cloudevent to the sink")
+// eventContext returns the context attributes of the given event, including
+// extensions, as a jq value.
+func eventContext(e *cloudevents.Event) map[string]interface{} {
+	ctx := map[string]interface{}{
+		"specversion": e.SpecVersion(),
+		"id":          e.ID(),
+		"source":      e.Source(),
+		"type":        e.Type(),
+	}
+
+	if v := e.DataContentType(); v != "" {
+		ctx["datacontenttype"] = v
+	}
+	if v := e.DataSchema(); v != "" {
+		ctx["dataschema"] = v
+	}
+	if v := e.Subject(); v != "" {
+		ctx["subject"] = v
+	}
+	if v := e.Time(); !v.IsZero() {
+		ctx["time"] = types.FormatTime(v)
+	}
+
+	for k, v := range e.Extensions() {
+		switch v := v.(type) {
+		case bool:
+			ctx[k] = v
+		case int32:
+			ctx[k] = int(v)
+		default:
+			if s, err := types.Format(v); err == nil {
+				ctx[k] = s
+			}
 		}
-		return nil, cloudevents.ResultACK
 	}
 
-	return &event, cloudevents.ResultACK
+	return ctx
+}
+
+// eventFromStructured returns a new event from a query result in the
+// structured JSON format of CloudEvents. Required attributes which are absent
+// from the result are inherited from the given input event, while attributes
+// with a null value are omitted.
+func eventFromStructured(in *cloudevents.Event, result interface{}) (*cloudevents.Event, error) {
+	obj, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected the query to return an event object, got %T", result)
+	}
+
+	out := cloudevents.NewEvent()
+	out.SetID(in.ID())
+	out.SetSource(in.Source())
+	out.SetType(in.Type())
+
+	var data interface{}
+	var hasData bool
+
+	for k, v := range obj {
+		if v == nil {
+			continue
+		}
+
+		switch k {
+		case "specversion", "datacontenttype", "data_base64":
+			// managed by the adapter, data is always serialized as JSON
+
+		case "data":
+			data, hasData = v, true
+
+		case "id", "source", "type", "subject", "dataschema", "time":
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("attribute %q must be a string, got %T", k, v)
+			}
+			if err := setStringAttribute(&out, k, s); err != nil {
+				return nil, err
+			}
+
+		default:
+			if err := out.Context.SetExtension(k, v); err != nil {
+				return nil, fmt.Errorf("setting extension %q: %w", k, err)
+			}
+		}
+	}
+
+	if hasData {
+		bs, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		if err := out.SetData(cloudevents.ApplicationJSON, bs); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := out.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// setStringAttribute sets the context attribute with the given name.
+func setStringAttribute(e *cloudevents.Event, name, val string) error {
+	switch name {
+	case "id":
+		e.SetID(val)
+	case "source":
+		e.SetSource(val)
+	case "type":
+		e.SetType(val)
+	case "subject":
+		e.SetSubject(val)
+	case "dataschema":
+		e.SetDataSchema(val)
+	case "time":
+		t, err := types.ParseTime(val)
+		if err != nil {
+			return fmt.Errorf("parsing time attribute: %w", err)
+		}
+		e.SetTime(t)
+	}
+	return nil
 }
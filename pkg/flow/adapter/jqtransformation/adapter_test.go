@@ -35,8 +35,7 @@ import (
 	"knative.dev/eventing/pkg/adapter/v2"
 	logtesting "knative.dev/pkg/logging/testing"
 
-	"github.com/itchyny/gojq"
-
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 	metricstesting "github.com/triggermesh/triggermesh/pkg/metrics/testing"
 	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
@@ -140,7 +139,7 @@ func TestReplier(t *testing.T) {
 			replier, err := targetce.New(tCloudEventSource, logger)
 			require.NoError(t, err)
 
-			query, err := gojq.Parse(tc.query)
+			query, err := compileQuery(tc.query, nil)
 			require.NoError(t, err)
 
 			mt := &adapter.MetricTag{}
@@ -180,6 +179,129 @@ func TestReplier(t *testing.T) {
 	}
 }
 
+func TestDispatch(t *testing.T) {
+	testCases := map[string]struct {
+		query   string
+		args    []queryArg
+		scope   v1alpha1.JQScope
+		results v1alpha1.JQResultsMode
+		inEvent cloudevents.Event
+
+		expectEvents []cloudevents.Event
+	}{
+		"context and arguments": {
+			query: `{type: $context.type, ext: $context.myext, greeting: $greeting}`,
+			args:  []queryArg{{name: "greeting", value: "hi"}},
+			inEvent: newCloudEvent(t, tJSON1, cloudevents.ApplicationJSON,
+				withExtension("myext", "extval")),
+			expectEvents: []cloudevents.Event{
+				newCloudEvent(t, `{"ext":"extval","greeting":"hi","type":"ce.test.type"}`, cloudevents.ApplicationJSON,
+					withExtension("myext", "extval")),
+			},
+		},
+		"last result": {
+			query:   `.[]`,
+			inEvent: newCloudEvent(t, `[1,2,3]`, cloudevents.ApplicationJSON),
+			expectEvents: []cloudevents.Event{
+				newCloudEvent(t, `3`, cloudevents.ApplicationJSON),
+			},
+		},
+		"array of results": {
+			query:   `.[]`,
+			results: v1alpha1.JQResultsArray,
+			inEvent: newCloudEvent(t, `[1,2,3]`, cloudevents.ApplicationJSON),
+			expectEvents: []cloudevents.Event{
+				newCloudEvent(t, `[1,2,3]`, cloudevents.ApplicationJSON),
+			},
+		},
+		"split results": {
+			query:   `.[]`,
+			results: v1alpha1.JQResultsSplit,
+			inEvent: newCloudEvent(t, `[1,2]`, cloudevents.ApplicationJSON),
+			expectEvents: []cloudevents.Event{
+				newCloudEvent(t, `1`, cloudevents.ApplicationJSON, withID(tCloudEventID+"-0")),
+				newCloudEvent(t, `2`, cloudevents.ApplicationJSON, withID(tCloudEventID+"-1")),
+			},
+		},
+		"event scope": {
+			query: `.type = "ce.test.newtype" | .subject = .data.name.first | .age = .data.age | .data |= {greeting}`,
+			scope: v1alpha1.JQScopeEvent,
+			inEvent: newCloudEvent(t, tJSON1, cloudevents.ApplicationJSON,
+				withExtension("myext", "extval")),
+			expectEvents: []cloudevents.Event{
+				newCloudEvent(t, `{"greeting":"hello"}`, cloudevents.ApplicationJSON,
+					withType("ce.test.newtype"), withSubject("bob"),
+					withExtension("myext", "extval"), withExtension("age", 42)),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			metricstesting.ResetMetrics(t)
+
+			logger := logtesting.TestLogger(t)
+
+			ceClient, sent := cetest.NewMockSenderClient(t, len(tc.expectEvents))
+
+			replier, err := targetce.New(tCloudEventSource, logger)
+			require.NoError(t, err)
+
+			query, err := compileQuery(tc.query, tc.args)
+			require.NoError(t, err)
+
+			mt := &adapter.MetricTag{}
+
+			a := &jqadapter{
+				query:     query,
+				argValues: argValues(tc.args),
+				scope:     tc.scope,
+				results:   tc.results,
+
+				sink:     "http://sink.example.com",
+				replier:  replier,
+				ceClient: ceClient,
+				logger:   logger,
+
+				mt: mt,
+				sr: metrics.MustNewEventProcessingStatsReporter(mt),
+			}
+
+			reply, result := a.dispatch(context.Background(), tc.inEvent)
+			require.True(t, cloudevents.IsACK(result), "Unexpected result: %v", result)
+			assert.Nil(t, reply)
+
+			for _, expect := range tc.expectEvents {
+				select {
+				case event := <-sent:
+					assert.Equal(t, expect.Context.String(), event.Context.String())
+					assert.Equal(t, string(expect.Data()), string(event.Data()))
+				case <-time.After(2 * time.Second):
+					assert.Fail(t, "expected event was not sent to the sink")
+				}
+			}
+		})
+	}
+}
+
+func TestArgsFromEnv(t *testing.T) {
+	environ := []string{
+		"JQ_QUERY=.",
+		"JQ_ARG_foo=bar=baz",
+		"JQ_ARG_empty=",
+		"JQ_ARG_=ignored",
+		"PATH=/bin",
+	}
+
+	expect := []queryArg{
+		{name: "empty", value: ""},
+		{name: "foo", value: "bar=baz"},
+	}
+
+	assert.Equal(t, expect, argsFromEnv(environ))
+}
+
 type cloudEventOptions func(*cloudevents.Event)
 
 func newCloudEvent(t *testing.T, data, contentType string, opts ...cloudEventOptions) cloudevents.Event {
@@ -189,9 +311,38 @@ func newCloudEvent(t *testing.T, data, contentType string, opts ...cloudEventOpt
 	event.SetSource(tCloudEventSource)
 	err := event.SetData(contentType, []byte(data))
 	require.NoError(t, err)
+
+	for _, opt := range opts {
+		opt(&event)
+	}
+
 	return event
 }
 
+func withID(id string) cloudEventOptions {
+	return func(e *cloudevents.Event) {
+		e.SetID(id)
+	}
+}
+
+func withType(typ string) cloudEventOptions {
+	return func(e *cloudevents.Event) {
+		e.SetType(typ)
+	}
+}
+
+func withSubject(subject string) cloudEventOptions {
+	return func(e *cloudevents.Event) {
+		e.SetSubject(subject)
+	}
+}
+
+func withExtension(name string, val interface{}) cloudEventOptions {
+	return func(e *cloudevents.Event) {
+		e.SetExtension(name, val)
+	}
+}
+
 func sendCE(t *testing.T, event *cloudevents.Event, sink string) protocol.Result {
 	ctx := cloudevents.ContextWithTarget(context.Background(), sink)
 	c, err := cloudevents.NewClientHTTP()
@@ -16,7 +16,12 @@ limitations under the License.
 
 package jqtransformation
 
-import pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+import (
+	"sort"
+	"strings"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+)
 
 // EnvAccessorCtor for configuration parameters
 func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
@@ -27,6 +32,11 @@ type envAccessor struct {
 	pkgadapter.EnvConfig
 	// Query represents the jq query to be applied to the incoming event
 	Query string `envconfig:"JQ_QUERY" required:"true"`
+	// Scope of the event the query runs against ("data" or "event")
+	Scope string `envconfig:"JQ_SCOPE" default:"data"`
+	// Results determines the handling of multiple query results ("last",
+	// "array" or "split")
+	Results string `envconfig:"JQ_RESULTS" default:"last"`
 	// BridgeIdentifier is the name of the bridge workflow this target is part of
 	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
 	// CloudEvents responses parametrization
@@ -35,3 +45,33 @@ type envAccessor struct {
 	// events are replied back to the sender.
 	Sink string `envconfig:"K_SINK"`
 }
+
+// envArgPrefix is the prefix of environment variables which contain the
+// values of named query arguments, e.g. JQ_ARG_foo for the argument "foo".
+const envArgPrefix = "JQ_ARG_"
+
+// queryArg is a named argument of the jq query.
+type queryArg struct {
+	name  string
+	value string
+}
+
+// argsFromEnv returns the named query arguments found in the given
+// environment, sorted by name.
+func argsFromEnv(environ []string) []queryArg {
+	var args []queryArg
+
+	for _, kv := range environ {
+		if !strings.HasPrefix(kv, envArgPrefix) {
+			continue
+		}
+		k, v, _ := strings.Cut(kv, "=")
+		if name := strings.TrimPrefix(k, envArgPrefix); name != "" {
+			args = append(args, queryArg{name: name, value: v})
+		}
+	}
+
+	sort.Slice(args, func(i, j int) bool { return args[i].name < args[j].name })
+
+	return args
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsontoxmltransformation
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow"
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/flow/xmljson"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+// EnvAccessorCtor for configuration parameters
+func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
+	return &envAccessor{}
+}
+
+type envAccessor struct {
+	pkgadapter.EnvConfig
+
+	// BridgeIdentifier is the name of the bridge workflow this target is part of
+	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
+	// CloudEvents responses parametrization
+	CloudEventPayloadPolicy string `envconfig:"EVENTS_PAYLOAD_POLICY" default:"error"`
+	// Sink defines the target sink for the events. If no Sink is defined the
+	// events are replied back to the sender.
+	Sink string `envconfig:"K_SINK"`
+
+	// JSON to XML conversion conventions
+	RootElement      string `envconfig:"JSONTOXML_ROOT_ELEMENT"`
+	Namespace        string `envconfig:"JSONTOXML_NAMESPACE"`
+	NamespacePrefix  string `envconfig:"JSONTOXML_NAMESPACE_PREFIX"`
+	ArrayItemElement string `envconfig:"JSONTOXML_ARRAY_ITEM_ELEMENT" default:"item"`
+	AttributePrefix  string `envconfig:"JSONTOXML_ATTRIBUTE_PREFIX" default:"-"`
+	TextKey          string `envconfig:"JSONTOXML_TEXT_KEY" default:"#content"`
+}
+
+// NewAdapter adapter implementation
+func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
+	logger := logging.FromContext(ctx)
+
+	mt := &pkgadapter.MetricTag{
+		ResourceGroup: flow.JSONToXMLTransformationResource.String(),
+		Namespace:     envAcc.GetNamespace(),
+		Name:          envAcc.GetName(),
+	}
+
+	metrics.MustRegisterEventProcessingStatsView()
+
+	env := envAcc.(*envAccessor)
+
+	replier, err := targetce.New(env.Component, logger.Named("replier"),
+		targetce.ReplierWithStatefulHeaders(env.BridgeIdentifier),
+		targetce.ReplierWithStaticResponseType(v1alpha1.EventTypeJSONToXMLGenericResponse),
+		targetce.ReplierWithPayloadPolicy(targetce.PayloadPolicy(env.CloudEventPayloadPolicy)))
+	if err != nil {
+		logger.Panicf("Error creating CloudEvents replier: %v", err)
+	}
+
+	return &Adapter{
+		opts: xmljson.EncodeOptions{
+			RootElement:      env.RootElement,
+			Namespace:        env.Namespace,
+			NamespacePrefix:  env.NamespacePrefix,
+			ArrayItemElement: env.ArrayItemElement,
+			AttributePrefix:  env.AttributePrefix,
+			TextKey:          env.TextKey,
+		},
+
+		sink:     env.Sink,
+		replier:  replier,
+		ceClient: ceClient,
+		logger:   logger,
+
+		mt: mt,
+		sr: metrics.MustNewEventProcessingStatsReporter(mt),
+	}
+}
+
+var _ pkgadapter.Adapter = (*Adapter)(nil)
+
+type Adapter struct {
+	opts xmljson.EncodeOptions
+
+	sink     string
+	replier  *targetce.Replier
+	ceClient cloudevents.Client
+	logger   *zap.SugaredLogger
+
+	mt *pkgadapter.MetricTag
+	sr *metrics.EventProcessingStatsReporter
+}
+
+// Start is a blocking function and will return if an error occurs
+// or the context is cancelled.
+func (a *Adapter) Start(ctx context.Context) error {
+	a.logger.Info("Starting JSONToXMLTransformation Adapter")
+	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
+	return a.ceClient.StartReceiver(ctx, a.dispatch)
+}
+
+func (a *Adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	v, err := xmljson.DecodeJSON(bytes.NewReader(event.Data()))
+	if err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation,
+			errors.New("invalid JSON"), err.Error())
+	}
+
+	var data bytes.Buffer
+	if err := xmljson.Encode(&data, v, a.opts); err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation, err, nil)
+	}
+
+	if err := event.SetData(cloudevents.ApplicationXML, data.Bytes()); err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
+	}
+
+	if a.sink != "" {
+		if result := a.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, "sending the cloudevent to the sink")
+		}
+		return nil, cloudevents.ResultACK
+	}
+
+	return &event, cloudevents.ResultACK
+}
@@ -18,12 +18,14 @@ package synchronizer
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
 	"go.uber.org/zap"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
@@ -44,6 +46,11 @@ type adapter struct {
 	correlationKey  *correlationKey
 	responseTimeout time.Duration
 
+	timeoutAttribute    string
+	maxResponseTimeout  time.Duration
+	completionAttribute string
+	errorTypes          map[string]struct{}
+
 	sessions *storage
 	sinkURL  string
 	bridgeID string
@@ -63,11 +70,21 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	env := envAcc.(*envAccessor)
 
-	key, err := newCorrelationKey(env.CorrelationKey, env.CorrelationKeyLength)
+	key, err := newCorrelationKey(env.CorrelationKey, env.CorrelationKeyLength, env.CorrelationClientKeyAttribute)
 	if err != nil {
 		logger.Panic("Cannot create an instance of Correlation Key: %v", err)
 	}
 
+	maxTimeout := env.ResponseMaxTimeout
+	if maxTimeout < env.ResponseWaitTimeout {
+		maxTimeout = env.ResponseWaitTimeout
+	}
+
+	errorTypes := make(map[string]struct{}, len(env.ResponseErrorTypes))
+	for _, t := range env.ResponseErrorTypes {
+		errorTypes[t] = struct{}{}
+	}
+
 	return &adapter{
 		ceClient: ceClient,
 		logger:   logger,
@@ -78,6 +95,11 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		correlationKey:  key,
 		responseTimeout: env.ResponseWaitTimeout,
 
+		timeoutAttribute:    env.ResponseTimeoutAttribute,
+		maxResponseTimeout:  maxTimeout,
+		completionAttribute: env.ResponseAggregationCompletionAttribute,
+		errorTypes:          errorTypes,
+
 		sessions: newStorage(),
 		sinkURL:  env.Sink,
 		bridgeID: env.BridgeIdentifier,
@@ -106,14 +128,19 @@ func (a *adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloud
 func (a *adapter) serveRequest(ctx context.Context, correlationID string, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	a.logger.Debugf("Handling request %q", correlationID)
 
+	timeout, err := a.requestTimeout(event)
+	if err != nil {
+		return nil, cloudevents.NewHTTPResult(http.StatusBadRequest, "invalid response timeout: %v", err)
+	}
+
 	respChan, err := a.sessions.add(correlationID)
 	if err != nil {
-		return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "cannot add session %q: %w", correlationID, err)
+		return nil, cloudevents.NewHTTPResult(http.StatusConflict, "cannot add session %q: %v", correlationID, err)
 	}
 	defer a.sessions.delete(correlationID)
 
-	sendErr := make(chan error)
-	defer close(sendErr)
+	// buffered so that the sender never blocks once the request is served
+	sendErr := make(chan error, 1)
 
 	go func() {
 		if res := a.ceClient.Send(cloudevents.ContextWithTarget(ctx, a.sinkURL), a.withBridgeIdentifier(&event)); cloudevents.IsUndelivered(res) {
@@ -123,21 +150,50 @@ func (a *adapter) serveRequest(ctx context.Context, correlationID string, event
 
 	a.logger.Debugf("Waiting response for %q", correlationID)
 
-	select {
-	case err := <-sendErr:
-		a.logger.Errorf("Unable to forward the request: %v", err)
-		return nil, cloudevents.NewHTTPResult(http.StatusBadRequest, "unable to forward the request: %v", err)
-	case result := <-respChan:
-		if result == nil {
-			a.logger.Errorf("No response from %q", correlationID)
-			return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "failed to communicate the response")
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	// partial responses received so far, when aggregation is enabled
+	var partials []*cloudevents.Event
+
+	for {
+		select {
+		case err := <-sendErr:
+			a.logger.Errorf("Unable to forward the request: %v", err)
+			return nil, cloudevents.NewHTTPResult(http.StatusBadRequest, "unable to forward the request: %v", err)
+
+		case result := <-respChan:
+			if result == nil {
+				a.logger.Errorf("No response from %q", correlationID)
+				return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "failed to communicate the response")
+			}
+			a.logger.Debugf("Received response for %q", correlationID)
+
+			if a.isError(result) {
+				a.logger.Debugf("Backend replied to %q with an error", correlationID)
+				errResp := a.withBridgeIdentifier(a.errorResponse(result))
+				return &errResp, cloudevents.NewHTTPResult(http.StatusBadGateway, "backend replied with an error")
+			}
+
+			if a.completionAttribute != "" {
+				partials = append(partials, result)
+				if !a.isAggregationComplete(result) {
+					continue
+				}
+
+				if result, err = aggregateResponses(partials); err != nil {
+					a.logger.Errorf("Unable to aggregate the responses to %q: %v", correlationID, err)
+					return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "failed to aggregate the responses")
+				}
+			}
+
+			res := a.withBridgeIdentifier(result)
+			return &res, cloudevents.ResultACK
+
+		case <-timer.C:
+			a.logger.Errorf("Request %q timed out", correlationID)
+			return nil, cloudevents.NewHTTPResult(http.StatusGatewayTimeout, "backend did not respond in time")
 		}
-		a.logger.Debugf("Received response for %q", correlationID)
-		res := a.withBridgeIdentifier(result)
-		return &res, cloudevents.ResultACK
-	case <-time.After(a.responseTimeout):
-		a.logger.Errorf("Request %q timed out", correlationID)
-		return nil, cloudevents.NewHTTPResult(http.StatusGatewayTimeout, "backend did not respond in time")
 	}
 }
 
@@ -145,22 +201,48 @@ func (a *adapter) serveRequest(ctx context.Context, correlationID string, event
 func (a *adapter) serveResponse(ctx context.Context, correlationID string, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	a.logger.Debugf("Handling response %q", correlationID)
 
-	responseChan, exists := a.sessions.get(correlationID)
-	if !exists {
+	switch err := a.sessions.send(correlationID, &event); {
+	case err == errSessionNotFound:
 		a.logger.Errorf("Session for %q does not exist", correlationID)
 		return nil, cloudevents.NewHTTPResult(http.StatusBadGateway, "client session does not exist")
+	case err != nil:
+		a.logger.Errorf("Unable to forward the response %q: %v", correlationID, err)
+		return nil, cloudevents.NewHTTPResult(http.StatusBadGateway, "client connection is closed")
 	}
 
-	a.logger.Debugf("Forwarding response %q", correlationID)
+	a.logger.Debugf("Response %q completed", correlationID)
+	return nil, cloudevents.ResultACK
+}
 
-	select {
-	case responseChan <- &event:
-		a.logger.Debugf("Response %q completed", correlationID)
-		return nil, cloudevents.ResultACK
-	default:
-		a.logger.Errorf("Unable to forward the response %q", correlationID)
-		return nil, cloudevents.NewHTTPResult(http.StatusBadGateway, "client connection is closed")
+// requestTimeout returns the duration during which the client of the given
+// request waits for a response.
+func (a *adapter) requestTimeout(event cloudevents.Event) (time.Duration, error) {
+	if a.timeoutAttribute == "" {
+		return a.responseTimeout, nil
+	}
+
+	val, exists := event.Extensions()[a.timeoutAttribute]
+	if !exists {
+		return a.responseTimeout, nil
+	}
+
+	str, err := types.ToString(val)
+	if err != nil {
+		return 0, err
+	}
+
+	timeout, err := time.ParseDuration(str)
+	if err != nil {
+		return 0, err
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("timeout must be positive, got %s", timeout)
+	}
+
+	if timeout > a.maxResponseTimeout {
+		timeout = a.maxResponseTimeout
 	}
+	return timeout, nil
 }
 
 // withBridgeIdentifier adds Bridge ID to the event context.
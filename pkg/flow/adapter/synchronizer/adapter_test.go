@@ -0,0 +1,251 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	logtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+const (
+	tCorrelationAttr = "correlationid"
+	tClientKeyAttr   = "requestid"
+	tTimeoutAttr     = "timeout"
+	tCompletionAttr  = "final"
+	tErrorType       = "com.example.error"
+)
+
+// reply is the outcome of a request served by the adapter.
+type reply struct {
+	event  *cloudevents.Event
+	result cloudevents.Result
+}
+
+func TestClientCorrelationKey(t *testing.T) {
+	ceClient := adaptertest.NewTestClient()
+	a := newTestAdapter(t, ceClient)
+
+	req := newEvent(t, "req.type", `{"q":1}`)
+	req.SetExtension(tClientKeyAttr, "my-key")
+
+	replies := serve(a, req)
+
+	fwd := waitForwarded(t, ceClient)
+	assert.Equal(t, "my-key", fwd.Extensions()[tCorrelationAttr])
+
+	dispatchResponse(t, a, "my-key", "resp.type", `{"a":1}`)
+
+	r := <-replies
+	assert.Equal(t, cloudevents.ResultACK, r.result)
+	require.NotNil(t, r.event)
+	assert.Equal(t, "resp.type", r.event.Type())
+	assert.JSONEq(t, `{"a":1}`, string(r.event.Data()))
+}
+
+func TestRequestTimeout(t *testing.T) {
+	ceClient := adaptertest.NewTestClient()
+	a := newTestAdapter(t, ceClient)
+
+	t.Run("timeout from attribute", func(t *testing.T) {
+		req := newEvent(t, "req.type", `{}`)
+		req.SetExtension(tTimeoutAttr, "10ms")
+
+		start := time.Now()
+		r := <-serve(a, req)
+
+		assert.Less(t, time.Since(start), a.responseTimeout)
+		assertHTTPStatus(t, http.StatusGatewayTimeout, r.result)
+	})
+
+	t.Run("invalid timeout", func(t *testing.T) {
+		req := newEvent(t, "req.type", `{}`)
+		req.SetExtension(tTimeoutAttr, "soon")
+
+		r := <-serve(a, req)
+		assertHTTPStatus(t, http.StatusBadRequest, r.result)
+	})
+
+	t.Run("timeout capped to maximum", func(t *testing.T) {
+		a.timeoutAttribute = tTimeoutAttr
+		a.maxResponseTimeout = 20 * time.Millisecond
+
+		req := newEvent(t, "req.type", `{}`)
+		req.SetExtension(tTimeoutAttr, "1h")
+
+		timeout, err := a.requestTimeout(req)
+		assert.NoError(t, err)
+		assert.Equal(t, 20*time.Millisecond, timeout)
+	})
+}
+
+func TestAggregatedResponses(t *testing.T) {
+	ceClient := adaptertest.NewTestClient()
+	a := newTestAdapter(t, ceClient)
+	a.completionAttribute = tCompletionAttr
+
+	replies := serve(a, newEvent(t, "req.type", `{}`))
+
+	fwd := waitForwarded(t, ceClient)
+	id := fwd.Extensions()[tCorrelationAttr].(string)
+
+	dispatchResponse(t, a, id, "resp.type", `{"part":1}`)
+	dispatchResponse(t, a, id, "resp.type", `"two"`)
+	last := newEvent(t, "resp.done", `{"part":3}`)
+	last.SetExtension(tCorrelationAttr, id)
+	last.SetExtension(tCompletionAttr, "true")
+	_, res := a.dispatch(context.Background(), last)
+	require.Equal(t, cloudevents.ResultACK, res)
+
+	r := <-replies
+	assert.Equal(t, cloudevents.ResultACK, r.result)
+	require.NotNil(t, r.event)
+	assert.Equal(t, "resp.done", r.event.Type())
+	assert.JSONEq(t, `[{"part":1},"two",{"part":3}]`, string(r.event.Data()))
+}
+
+func TestErrorResponse(t *testing.T) {
+	testCases := map[string]func(*cloudevents.Event){
+		"error type": func(e *cloudevents.Event) {
+			e.SetType(tErrorType)
+		},
+		"error category": func(e *cloudevents.Event) {
+			e.SetExtension(targetce.ExtensionCategory, targetce.ExtensionCategoryValueError)
+		},
+	}
+
+	for name, setError := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			ceClient := adaptertest.NewTestClient()
+			a := newTestAdapter(t, ceClient)
+
+			replies := serve(a, newEvent(t, "req.type", `{}`))
+
+			fwd := waitForwarded(t, ceClient)
+			id := fwd.Extensions()[tCorrelationAttr].(string)
+
+			resp := newEvent(t, "resp.type", `{"reason":"boom"}`)
+			resp.SetExtension(tCorrelationAttr, id)
+			setError(&resp)
+			_, res := a.dispatch(context.Background(), resp)
+			require.Equal(t, cloudevents.ResultACK, res)
+
+			r := <-replies
+			assertHTTPStatus(t, http.StatusBadGateway, r.result)
+			require.NotNil(t, r.event)
+			assert.Equal(t, v1alpha1.EventTypeSynchronizerError, r.event.Type())
+			assert.Equal(t, id, r.event.Extensions()[tCorrelationAttr])
+
+			var evErr targetce.EventError
+			require.NoError(t, json.Unmarshal(r.event.Data(), &evErr))
+			assert.Equal(t, errorCodeBackend, evErr.Code)
+			assert.Equal(t, map[string]interface{}{"reason": "boom"}, evErr.Details)
+		})
+	}
+}
+
+func TestResponseWithoutSession(t *testing.T) {
+	a := newTestAdapter(t, adaptertest.NewTestClient())
+
+	resp := newEvent(t, "resp.type", `{}`)
+	resp.SetExtension(tCorrelationAttr, "unknown")
+
+	_, res := a.dispatch(context.Background(), resp)
+	assertHTTPStatus(t, http.StatusBadGateway, res)
+}
+
+func newTestAdapter(t *testing.T, ceClient cloudevents.Client) *adapter {
+	key, err := newCorrelationKey(tCorrelationAttr, 8, tClientKeyAttr)
+	require.NoError(t, err)
+
+	return &adapter{
+		ceClient: ceClient,
+		logger:   logtesting.TestLogger(t),
+
+		correlationKey:  key,
+		responseTimeout: 5 * time.Second,
+
+		timeoutAttribute:   tTimeoutAttr,
+		maxResponseTimeout: 5 * time.Second,
+		errorTypes:         map[string]struct{}{tErrorType: {}},
+
+		sessions: newStorage(),
+	}
+}
+
+func newEvent(t *testing.T, typ, data string) cloudevents.Event {
+	t.Helper()
+
+	e := cloudevents.NewEvent()
+	e.SetID("test-id")
+	e.SetType(typ)
+	e.SetSource("test.source")
+	require.NoError(t, e.SetData(cloudevents.ApplicationJSON, []byte(data)))
+	return e
+}
+
+// serve dispatches the given request asynchronously.
+func serve(a *adapter, req cloudevents.Event) <-chan reply {
+	replies := make(chan reply, 1)
+	go func() {
+		e, res := a.dispatch(context.Background(), req)
+		replies <- reply{event: e, result: res}
+	}()
+	return replies
+}
+
+// waitForwarded waits for the request to be forwarded to the sink and returns it.
+func waitForwarded(t *testing.T, ceClient *adaptertest.TestCloudEventsClient) cloudevents.Event {
+	t.Helper()
+
+	require.Eventually(t, func() bool { return len(ceClient.Sent()) == 1 }, time.Second, time.Millisecond)
+	return ceClient.Sent()[0]
+}
+
+// dispatchResponse dispatches a response correlated with the given key.
+func dispatchResponse(t *testing.T, a *adapter, correlationID, typ, data string) {
+	t.Helper()
+
+	resp := newEvent(t, typ, data)
+	resp.SetExtension(tCorrelationAttr, correlationID)
+
+	_, res := a.dispatch(context.Background(), resp)
+	require.Equal(t, cloudevents.ResultACK, res)
+}
+
+func assertHTTPStatus(t *testing.T, expect int, res cloudevents.Result) {
+	t.Helper()
+
+	var httpRes *cehttp.Result
+	require.True(t, cloudevents.ResultAs(res, &httpRes), "result is not an HTTP result: %v", res)
+	assert.Equal(t, expect, httpRes.StatusCode)
+}
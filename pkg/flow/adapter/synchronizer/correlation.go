@@ -22,6 +22,7 @@ import (
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/types"
 )
 
 // Correlation Key charset.
@@ -49,19 +50,26 @@ var (
 type correlationKey struct {
 	attribute string
 	length    int
+
+	// optional attribute carrying correlation keys supplied by clients
+	clientAttribute string
 }
 
 // NewCorrelationKey returns an instance of the CloudEvent Correlation key.
-func newCorrelationKey(attribute string, length int) (*correlationKey, error) {
+func newCorrelationKey(attribute string, length int, clientAttribute string) (*correlationKey, error) {
 	for _, rk := range restrictedKeys {
 		if attribute == rk {
 			return nil, fmt.Errorf("%q cannot be used as a correlation key", attribute)
 		}
+		if clientAttribute == rk {
+			return nil, fmt.Errorf("%q cannot be used as a client correlation key", clientAttribute)
+		}
 	}
 
 	return &correlationKey{
-		attribute: attribute,
-		length:    length,
+		attribute:       attribute,
+		length:          length,
+		clientAttribute: clientAttribute,
 	}, nil
 }
 
@@ -73,13 +81,35 @@ func (k *correlationKey) get(event cloudevents.Event) (string, bool) {
 	return "", false
 }
 
-// Set updates the CloudEvent's context with the random Correlation Key value.
+// Set updates the CloudEvent's context with the Correlation Key value
+// supplied by the client, or with a random value if none was supplied.
 func (k *correlationKey) set(event *cloudevents.Event) string {
-	correlationID := randString(k.length)
+	correlationID, supplied := k.fromClient(*event)
+	if !supplied {
+		correlationID = randString(k.length)
+	}
 	event.SetExtension(k.attribute, correlationID)
 	return correlationID
 }
 
+// fromClient returns the value of the Correlation Key supplied by the client.
+func (k *correlationKey) fromClient(event cloudevents.Event) (string, bool) {
+	if k.clientAttribute == "" {
+		return "", false
+	}
+
+	val, exists := event.Extensions()[k.clientAttribute]
+	if !exists {
+		return "", false
+	}
+
+	key, err := types.ToString(val)
+	if err != nil || key == "" {
+		return "", false
+	}
+	return key, true
+}
+
 // randString generates the random string with fixed length.
 func randString(length int) string {
 	k := make([]byte, length)
@@ -30,9 +30,20 @@ func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
 type envAccessor struct {
 	pkgadapter.EnvConfig
 
-	CorrelationKey       string        `envconfig:"CORRELATION_KEY"`
-	CorrelationKeyLength int           `envconfig:"CORRELATION_KEY_LENGTH"`
-	ResponseWaitTimeout  time.Duration `envconfig:"RESPONSE_WAIT_TIMEOUT"`
+	CorrelationKey                string        `envconfig:"CORRELATION_KEY"`
+	CorrelationKeyLength          int           `envconfig:"CORRELATION_KEY_LENGTH"`
+	CorrelationClientKeyAttribute string        `envconfig:"CORRELATION_CLIENT_KEY_ATTRIBUTE"`
+	ResponseWaitTimeout           time.Duration `envconfig:"RESPONSE_WAIT_TIMEOUT"`
+
+	// Per-request response timeouts
+	ResponseTimeoutAttribute string        `envconfig:"RESPONSE_TIMEOUT_ATTRIBUTE"`
+	ResponseMaxTimeout       time.Duration `envconfig:"RESPONSE_MAX_TIMEOUT"`
+
+	// Aggregation of partial responses
+	ResponseAggregationCompletionAttribute string `envconfig:"RESPONSE_AGGREGATION_COMPLETION_ATTRIBUTE"`
+
+	// Types of the responses which denote an error
+	ResponseErrorTypes []string `envconfig:"RESPONSE_ERROR_TYPES"`
 
 	// BridgeIdentifier is the name of the bridge workflow this target is part of
 	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/google/uuid"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+// errorCodeBackend is the code of the errors returned to clients when the
+// backend replies with an error.
+const errorCodeBackend = "backend-error"
+
+// isError returns whether the given response denotes an error.
+func (a *adapter) isError(event *cloudevents.Event) bool {
+	if _, isErrorType := a.errorTypes[event.Type()]; isErrorType {
+		return true
+	}
+
+	category, err := types.ToString(event.Extensions()[targetce.ExtensionCategory])
+	return err == nil && category == targetce.ExtensionCategoryValueError
+}
+
+// isAggregationComplete returns whether the given response is the last of a
+// series of partial responses.
+func (a *adapter) isAggregationComplete(event *cloudevents.Event) bool {
+	val, exists := event.Extensions()[a.completionAttribute]
+	if !exists {
+		return false
+	}
+
+	str, err := types.ToString(val)
+	if err != nil {
+		return false
+	}
+
+	complete, err := strconv.ParseBool(str)
+	return err == nil && complete
+}
+
+// errorResponse returns the event sent to the client when the backend replies
+// with the given error.
+func (a *adapter) errorResponse(event *cloudevents.Event) *cloudevents.Event {
+	out := cloudevents.NewEvent(cloudevents.VersionV1)
+	out.SetID(uuid.New().String())
+	out.SetType(v1alpha1.EventTypeSynchronizerError)
+	out.SetSource(event.Source())
+	out.SetExtension(targetce.ExtensionCategory, targetce.ExtensionCategoryValueError)
+	if correlationID, exists := a.correlationKey.get(*event); exists {
+		out.SetExtension(a.correlationKey.attribute, correlationID)
+	}
+
+	evErr := &targetce.EventError{
+		Code:        errorCodeBackend,
+		Description: fmt.Sprintf("backend replied with an event of type %q", event.Type()),
+		Details:     responseData(event),
+	}
+
+	if err := out.SetData(cloudevents.ApplicationJSON, evErr); err != nil {
+		a.logger.Errorf("Could not set error payload at response CloudEvent: %v", err)
+	}
+
+	return &out
+}
+
+// aggregateResponses returns a single event containing the data of all the
+// given partial responses, as a JSON array. The context attributes of the
+// event are the ones of the last response.
+func aggregateResponses(partials []*cloudevents.Event) (*cloudevents.Event, error) {
+	data := make([]interface{}, 0, len(partials))
+	for _, e := range partials {
+		data = append(data, responseData(e))
+	}
+
+	out := partials[len(partials)-1].Clone()
+	if err := out.SetData(cloudevents.ApplicationJSON, data); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// responseData returns the data of the given response in a form that can be
+// embedded in a JSON document.
+func responseData(event *cloudevents.Event) interface{} {
+	data := event.Data()
+
+	switch {
+	case len(data) == 0:
+		return nil
+	case json.Valid(data):
+		return json.RawMessage(data)
+	default:
+		return string(data)
+	}
+}
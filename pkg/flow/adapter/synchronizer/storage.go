@@ -17,12 +17,17 @@ limitations under the License.
 package synchronizer
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 )
 
+// sessionBufferSize is the number of responses that can be queued in a
+// session while the client is being served a previous one.
+const sessionBufferSize = 16
+
 // storage holds the map of open connections and corresponding channels.
 type storage struct {
 	sync.Mutex
@@ -45,7 +50,7 @@ func (s *storage) add(id string) (<-chan *cloudevents.Event, error) {
 		return nil, fmt.Errorf("session already exists")
 	}
 
-	c := make(chan *cloudevents.Event)
+	c := make(chan *cloudevents.Event, sessionBufferSize)
 	s.sessions[id] = c
 	return c, nil
 }
@@ -59,11 +64,25 @@ func (s *storage) delete(id string) {
 	delete(s.sessions, id)
 }
 
-// open returns the communication channel for the session id.
-func (s *storage) get(id string) (chan<- *cloudevents.Event, bool) {
+// errSessionNotFound is returned when sending an event to a session that does not exist.
+var errSessionNotFound = errors.New("session does not exist")
+
+// send writes the event to the communication channel of the session id
+// without blocking. The lock prevents the channel from being closed while
+// the event is being written.
+func (s *storage) send(id string, event *cloudevents.Event) error {
 	s.Lock()
 	defer s.Unlock()
 
 	session, exists := s.sessions[id]
-	return session, exists
+	if !exists {
+		return errSessionNotFound
+	}
+
+	select {
+	case session <- event:
+		return nil
+	default:
+		return errors.New("session is not accepting responses")
+	}
 }
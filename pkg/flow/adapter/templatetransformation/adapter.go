@@ -0,0 +1,173 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatetransformation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"text/template"
+	"time"
+
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow"
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/flow/templating"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+// NewAdapter adapter implementation
+func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
+	logger := logging.FromContext(ctx)
+
+	mt := &pkgadapter.MetricTag{
+		ResourceGroup: flow.TemplateTransformationResource.String(),
+		Namespace:     envAcc.GetNamespace(),
+		Name:          envAcc.GetName(),
+	}
+
+	metrics.MustRegisterEventProcessingStatsView()
+
+	env := envAcc.(*envAccessor)
+
+	replier, err := targetce.New(env.Component, logger.Named("replier"),
+		targetce.ReplierWithStatefulHeaders(env.BridgeIdentifier),
+		targetce.ReplierWithStaticResponseType("io.triggermesh.templatetransformation.error"),
+		targetce.ReplierWithPayloadPolicy(targetce.PayloadPolicy(env.CloudEventPayloadPolicy)))
+	if err != nil {
+		logger.Panicf("Error creating CloudEvents replier: %v", err)
+	}
+
+	tmpl, err := templating.Parse("template", env.Template)
+	if err != nil {
+		logger.Panicf("Error parsing template: %v", err)
+	}
+
+	outputMediaType, _, err := mime.ParseMediaType(env.OutputContentType)
+	if err != nil {
+		logger.Panicf("Invalid output content type %q: %v", env.OutputContentType, err)
+	}
+
+	return &templateAdapter{
+		template:          tmpl,
+		inputFormat:       v1alpha1.TemplateDataFormat(env.InputFormat),
+		outputContentType: env.OutputContentType,
+		outputIsJSON:      outputMediaType == cloudevents.ApplicationJSON,
+
+		sink:     env.Sink,
+		replier:  replier,
+		ceClient: ceClient,
+		logger:   logger,
+
+		mt: mt,
+		sr: metrics.MustNewEventProcessingStatsReporter(mt),
+	}
+}
+
+var _ pkgadapter.Adapter = (*templateAdapter)(nil)
+
+type templateAdapter struct {
+	// template is parsed once, and safe for concurrent executions
+	template          *template.Template
+	inputFormat       v1alpha1.TemplateDataFormat
+	outputContentType string
+	outputIsJSON      bool
+
+	sink     string
+	replier  *targetce.Replier
+	ceClient cloudevents.Client
+	logger   *zap.SugaredLogger
+
+	mt *pkgadapter.MetricTag
+	sr *metrics.EventProcessingStatsReporter
+}
+
+// templateInput is the value templates are executed against.
+type templateInput struct {
+	// Parsed data of the event.
+	Data interface{}
+	// Context attributes and extensions of the event.
+	Context map[string]interface{}
+}
+
+// Start is a blocking function and will return if an error occurs
+// or the context is cancelled.
+func (a *templateAdapter) Start(ctx context.Context) error {
+	a.logger.Info("Starting TemplateTransformation Adapter")
+	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
+	return a.ceClient.StartReceiver(ctx, a.dispatch)
+}
+
+func (a *templateAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	ceTypeTag := metrics.TagEventType(event.Type())
+	ceSrcTag := metrics.TagEventSource(event.Source())
+
+	start := time.Now()
+	defer func() {
+		a.sr.ReportProcessingLatency(time.Since(start), ceTypeTag, ceSrcTag)
+	}()
+
+	data, err := parseData(&event, a.inputFormat)
+	if err != nil {
+		a.sr.ReportProcessingError(true, ceTypeTag, ceSrcTag)
+		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	in := &templateInput{
+		Data:    data,
+		Context: templating.EventContext(&event),
+	}
+
+	var out bytes.Buffer
+	if err := a.template.Execute(&out, in); err != nil {
+		a.sr.ReportProcessingError(true, ceTypeTag, ceSrcTag)
+		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess,
+			fmt.Errorf("executing template: %w", err), nil)
+	}
+
+	if a.outputIsJSON && !json.Valid(out.Bytes()) {
+		a.sr.ReportProcessingError(true, ceTypeTag, ceSrcTag)
+		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess,
+			errors.New("the template did not render valid JSON"), out.String())
+	}
+
+	if err := event.SetData(a.outputContentType, out.Bytes()); err != nil {
+		a.sr.ReportProcessingError(false, ceTypeTag, ceSrcTag)
+		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
+	}
+
+	if a.sink != "" {
+		if result := a.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
+			a.sr.ReportProcessingError(false, ceTypeTag, ceSrcTag)
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, "sending the cloudevent to the sink")
+		}
+		a.sr.ReportProcessingSuccess(ceTypeTag, ceSrcTag)
+		return nil, cloudevents.ResultACK
+	}
+
+	a.sr.ReportProcessingSuccess(ceTypeTag, ceSrcTag)
+	return &event, cloudevents.ResultACK
+}
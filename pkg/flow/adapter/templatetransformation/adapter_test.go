@@ -0,0 +1,166 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatetransformation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetest "github.com/cloudevents/sdk-go/v2/client/test"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+	logtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/flow/templating"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
+	metricstesting "github.com/triggermesh/triggermesh/pkg/metrics/testing"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+const (
+	tCloudEventID     = "ce-abcd-0123"
+	tCloudEventType   = "ce.test.type"
+	tCloudEventSource = "ce.test.source"
+)
+
+func TestDispatch(t *testing.T) {
+	testCases := map[string]struct {
+		template          string
+		inputFormat       v1alpha1.TemplateDataFormat
+		outputContentType string
+		inEvent           cloudevents.Event
+
+		expectData        string
+		expectContentType string
+		expectError       bool
+	}{
+		"JSON to JSON": {
+			template: `{"fullName": {{ printf "%s %s" .Data.name.first .Data.name.last | toJson }},` +
+				` "age": {{ .Data.age }}, "type": {{ toJson .Context.type }}}`,
+			inEvent:    newCloudEvent(t, `{"name":{"first":"bob","last":"smith"},"age":12345678901}`, cloudevents.ApplicationJSON),
+			expectData: `{"fullName": "bob smith", "age": 12345678901, "type": "ce.test.type"}`,
+		},
+		"XML to JSON": {
+			template:   `{"id": {{ .Data.order.id | toJson }}, "qty": {{ .Data.order.qty }}}`,
+			inEvent:    newCloudEvent(t, `<order><id>o-1</id><qty>3</qty></order>`, cloudevents.ApplicationXML),
+			expectData: `{"id": "o-1", "qty": 3}`,
+		},
+		"CSV to XML": {
+			template:          `<people>{{ range .Data }}<person name="{{ xmlEscape .name }}">{{ .age }}</person>{{ end }}</people>`,
+			outputContentType: cloudevents.ApplicationXML,
+			inEvent:           newCloudEvent(t, "name,age\nTom & Jerry,3\nAlice,30\n", "text/csv"),
+			expectData:        `<people><person name="Tom &amp; Jerry">3</person><person name="Alice">30</person></people>`,
+			expectContentType: cloudevents.ApplicationXML,
+		},
+		"JSON to CSV with explicit input format": {
+			template:          `{{ range .Data }}{{ csvRecord .id .label }}{{ "\n" }}{{ end }}`,
+			inputFormat:       v1alpha1.TemplateDataFormatJSON,
+			outputContentType: "text/csv",
+			inEvent:           newCloudEvent(t, `[{"id":1,"label":"a,b"},{"id":2,"label":"c"}]`, "text/plain"),
+			expectData:        "1,\"a,b\"\n2,c\n",
+			expectContentType: "text/csv",
+		},
+		"invalid JSON rendered": {
+			template:    `{"name": {{ .Data.name }}}`,
+			inEvent:     newCloudEvent(t, `{"name":"bob"}`, cloudevents.ApplicationJSON),
+			expectError: true,
+		},
+		"unsupported content type": {
+			template:    `{{ toJson .Data }}`,
+			inEvent:     newCloudEvent(t, `hello`, "text/plain"),
+			expectError: true,
+		},
+		"malformed input": {
+			template:    `{{ toJson .Data }}`,
+			inEvent:     newCloudEvent(t, `{"name":`, cloudevents.ApplicationJSON),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			metricstesting.ResetMetrics(t)
+
+			logger := logtesting.TestLogger(t)
+
+			ceClient, _ := cetest.NewMockSenderClient(t, 1)
+
+			replier, err := targetce.New(tCloudEventSource, logger,
+				targetce.ReplierWithStaticResponseType("io.triggermesh.templatetransformation.error"))
+			require.NoError(t, err)
+
+			tmpl, err := templating.Parse(name, tc.template)
+			require.NoError(t, err)
+
+			outputContentType := tc.outputContentType
+			if outputContentType == "" {
+				outputContentType = cloudevents.ApplicationJSON
+			}
+
+			mt := &adapter.MetricTag{}
+
+			a := &templateAdapter{
+				template:          tmpl,
+				inputFormat:       tc.inputFormat,
+				outputContentType: outputContentType,
+				outputIsJSON:      outputContentType == cloudevents.ApplicationJSON,
+
+				replier:  replier,
+				ceClient: ceClient,
+				logger:   logger,
+
+				mt: mt,
+				sr: metrics.MustNewEventProcessingStatsReporter(mt),
+			}
+
+			out, result := a.dispatch(context.Background(), tc.inEvent)
+			require.NotNil(t, out)
+
+			if tc.expectError {
+				assert.Equal(t, "io.triggermesh.templatetransformation.error", out.Type())
+				return
+			}
+
+			require.True(t, cloudevents.IsACK(result), "Unexpected result: %v", result)
+
+			expectContentType := tc.expectContentType
+			if expectContentType == "" {
+				expectContentType = cloudevents.ApplicationJSON
+			}
+
+			assert.Equal(t, tCloudEventType, out.Type())
+			assert.Equal(t, expectContentType, out.DataContentType())
+			assert.Equal(t, tc.expectData, string(out.Data()))
+		})
+	}
+}
+
+func newCloudEvent(t *testing.T, data, contentType string) cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(tCloudEventID)
+	event.SetType(tCloudEventType)
+	event.SetSource(tCloudEventSource)
+	err := event.SetData(contentType, []byte(data))
+	require.NoError(t, err)
+	return event
+}
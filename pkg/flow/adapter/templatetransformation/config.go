@@ -0,0 +1,42 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatetransformation
+
+import pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+
+// EnvAccessorCtor for configuration parameters
+func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
+	return &envAccessor{}
+}
+
+type envAccessor struct {
+	pkgadapter.EnvConfig
+	// Template is the Go template used to render the data of transformed events
+	Template string `envconfig:"TEMPLATETRANSFORMATION_TEMPLATE" required:"true"`
+	// InputFormat is the format of the data of incoming events. It is
+	// inferred from the content type of each event when empty.
+	InputFormat string `envconfig:"TEMPLATETRANSFORMATION_INPUT_FORMAT"`
+	// OutputContentType is the content type of the rendered data
+	OutputContentType string `envconfig:"TEMPLATETRANSFORMATION_OUTPUT_CONTENT_TYPE" default:"application/json"`
+	// BridgeIdentifier is the name of the bridge workflow this target is part of
+	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
+	// CloudEvents responses parametrization
+	CloudEventPayloadPolicy string `envconfig:"EVENTS_PAYLOAD_POLICY" default:"error"`
+	// Sink defines the target sink for the events. If no Sink is defined the
+	// events are replied back to the sender.
+	Sink string `envconfig:"K_SINK"`
+}
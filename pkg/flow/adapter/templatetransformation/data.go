@@ -0,0 +1,126 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatetransformation
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	xj "github.com/basgys/goxml2json"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+)
+
+// parseData parses the data of the given event into generic Go values
+// which templates can operate on. When no format is given, it is inferred
+// from the media type of the event's data.
+func parseData(e *cloudevents.Event, format v1alpha1.TemplateDataFormat) (interface{}, error) {
+	data := e.Data()
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	if format == "" {
+		var err error
+		if format, err = formatOf(e.DataMediaType()); err != nil {
+			return nil, err
+		}
+	}
+
+	switch format {
+	case v1alpha1.TemplateDataFormatJSON:
+		return decodeJSON(bytes.NewReader(data))
+
+	case v1alpha1.TemplateDataFormatXML:
+		jsn, err := xj.Convert(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("converting XML data: %w", err)
+		}
+		return decodeJSON(jsn)
+
+	case v1alpha1.TemplateDataFormatCSV:
+		return decodeCSV(bytes.NewReader(data))
+
+	default:
+		return nil, fmt.Errorf("unsupported input format %q", format)
+	}
+}
+
+// formatOf returns the data format matching the given media type.
+func formatOf(mediaType string) (v1alpha1.TemplateDataFormat, error) {
+	switch {
+	case mediaType == "",
+		mediaType == cloudevents.ApplicationJSON,
+		mediaType == "text/json",
+		strings.HasSuffix(mediaType, "+json"):
+		return v1alpha1.TemplateDataFormatJSON, nil
+
+	case mediaType == cloudevents.ApplicationXML,
+		mediaType == "text/xml",
+		strings.HasSuffix(mediaType, "+xml"):
+		return v1alpha1.TemplateDataFormatXML, nil
+
+	case mediaType == "text/csv":
+		return v1alpha1.TemplateDataFormatCSV, nil
+	}
+
+	return "", fmt.Errorf("unsupported data content type %q", mediaType)
+}
+
+// decodeJSON decodes JSON data, preserving the textual representation of
+// numbers.
+func decodeJSON(r io.Reader) (interface{}, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("decoding JSON data: %w", err)
+	}
+	return v, nil
+}
+
+// decodeCSV decodes CSV data into a list of records. The first row is
+// expected to be a header, and its columns are used as the keys of each
+// record.
+func decodeCSV(r io.Reader) (interface{}, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("decoding CSV data: %w", err)
+	}
+
+	records := make([]interface{}, 0, len(rows))
+	if len(rows) == 0 {
+		return records, nil
+	}
+
+	header := rows[0]
+	for _, row := range rows[1:] {
+		rec := make(map[string]interface{}, len(header))
+		for i, col := range header {
+			rec[col] = row[i]
+		}
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
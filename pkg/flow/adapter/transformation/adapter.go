@@ -31,7 +31,6 @@ import (
 
 	"github.com/triggermesh/triggermesh/pkg/apis/flow"
 	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
-	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 )
 
@@ -94,20 +93,11 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Fatalf("Cannot unmarshal data transformation env variable: %v", err)
 	}
 
-	contextPl, err := newPipeline(trnContext)
+	contextPl, dataPl, err := NewPipelines(trnContext, trnData)
 	if err != nil {
-		logger.Fatalf("Cannot create context transformation pipeline: %v", err)
+		logger.Fatalf("Cannot create transformation pipelines: %v", err)
 	}
 
-	dataPl, err := newPipeline(trnData)
-	if err != nil {
-		logger.Fatalf("Cannot create data transformation pipeline: %v", err)
-	}
-
-	sharedStorage := storage.New()
-	contextPl.setStorage(sharedStorage)
-	dataPl.setStorage(sharedStorage)
-
 	return &adapter{
 		ContextPipeline: contextPl,
 		DataPipeline:    dataPl,
@@ -182,12 +172,31 @@ func (t *adapter) receiveAndSend(ctx context.Context, event cloudevents.Event) e
 }
 
 func (t *adapter) applyTransformations(event cloudevents.Event) (*cloudevents.Event, error) {
+	result, errs, err := Transform(t.ContextPipeline, t.DataPipeline, event)
+	if err != nil {
+		t.logger.Errorw("Cannot transform event", zap.Error(err))
+		return nil, err
+	}
+
+	// Failed transformation operations should not stop event flow
+	// therefore, just log the errors
+	if len(errs) != 0 {
+		t.logger.Errorf("Event transformation errors: %s", strings.Join(errs, ","))
+	}
+
+	return result, nil
+}
+
+// Transform applies the given context and data Pipelines to a copy of the
+// event. Errors returned by individual transformation steps do not interrupt
+// the processing of the event and are returned as a list alongside the
+// transformed event.
+func Transform(contextPl, dataPl *Pipeline, event cloudevents.Event) (*cloudevents.Event, []string, error) {
 	// HTTPTargets sets content type from HTTP headers, i.e.:
 	// "datacontenttype: application/json; charset=utf-8"
 	// so we must use "contains" instead of strict equality
 	if !strings.Contains(event.DataContentType(), cloudevents.ApplicationJSON) {
-		t.logger.Errorf("CE Content Type %q is not supported", event.DataContentType())
-		return nil, fmt.Errorf("CE Content Type %q is not supported", event.DataContentType())
+		return nil, nil, fmt.Errorf("CE Content Type %q is not supported", event.DataContentType())
 	}
 
 	localContext := ceContext{
@@ -197,8 +206,7 @@ func (t *adapter) applyTransformations(event cloudevents.Event) (*cloudevents.Ev
 
 	localContextBytes, err := json.Marshal(localContext)
 	if err != nil {
-		t.logger.Errorf("Cannot encode CE context: %v", err)
-		return nil, fmt.Errorf("cannot encode CE context: %w", err)
+		return nil, nil, fmt.Errorf("cannot encode CE context: %w", err)
 	}
 
 	// init indicates if we need to run initial step transformation
@@ -206,44 +214,36 @@ func (t *adapter) applyTransformations(event cloudevents.Event) (*cloudevents.Ev
 	var errs []string
 
 	// Run init step such as load Pipeline variables first
-	eventContext, err := t.ContextPipeline.apply(localContextBytes, init)
+	eventContext, err := contextPl.apply(localContextBytes, init)
 	if err != nil {
 		errs = append(errs, err.Error())
 	}
-	eventPayload, err := t.DataPipeline.apply(event.Data(), init)
+	eventPayload, err := dataPl.apply(event.Data(), init)
 	if err != nil {
 		errs = append(errs, err.Error())
 	}
 
 	// CE Context transformation
-	if eventContext, err = t.ContextPipeline.apply(eventContext, !init); err != nil {
+	if eventContext, err = contextPl.apply(eventContext, !init); err != nil {
 		errs = append(errs, err.Error())
 	}
 	if err := json.Unmarshal(eventContext, &localContext); err != nil {
-		t.logger.Errorf("Cannot decode CE new context: %v", err)
-		return nil, fmt.Errorf("cannot decode CE new context: %w", err)
+		return nil, errs, fmt.Errorf("cannot decode CE new context: %w", err)
 	}
 	event.Context = localContext
 	for k, v := range localContext.Extensions {
 		if err := event.Context.SetExtension(k, v); err != nil {
-			t.logger.Errorf("Cannot set CE extension: %v", err)
-			return nil, fmt.Errorf("cannot set CE extension: %w", err)
+			return nil, errs, fmt.Errorf("cannot set CE extension: %w", err)
 		}
 	}
 
 	// CE Data transformation
-	if eventPayload, err = t.DataPipeline.apply(eventPayload, !init); err != nil {
+	if eventPayload, err = dataPl.apply(eventPayload, !init); err != nil {
 		errs = append(errs, err.Error())
 	}
 	if err = event.SetData(cloudevents.ApplicationJSON, eventPayload); err != nil {
-		t.logger.Errorf("Cannot set CE data: %v", err)
-		return nil, fmt.Errorf("cannot set CE data: %w", err)
-	}
-	// Failed transformation operations should not stop event flow
-	// therefore, just log the errors
-	if len(errs) != 0 {
-		t.logger.Errorf("Event transformation errors: %s", strings.Join(errs, ","))
+		return nil, errs, fmt.Errorf("cannot set CE data: %w", err)
 	}
 
-	return &event, nil
+	return &event, errs, nil
 }
@@ -69,6 +69,24 @@ func newPipeline(transformations []v1alpha1.Transform) (*Pipeline, error) {
 	}, nil
 }
 
+// NewPipelines creates the Pipelines which transform respectively the context
+// and the data of events, and makes them share the same storage for Pipeline
+// vars.
+func NewPipelines(context, data []v1alpha1.Transform) (contextPl, dataPl *Pipeline, err error) {
+	if contextPl, err = newPipeline(context); err != nil {
+		return nil, nil, fmt.Errorf("creating context transformation pipeline: %w", err)
+	}
+	if dataPl, err = newPipeline(data); err != nil {
+		return nil, nil, fmt.Errorf("creating data transformation pipeline: %w", err)
+	}
+
+	sharedStorage := storage.New()
+	contextPl.setStorage(sharedStorage)
+	dataPl.setStorage(sharedStorage)
+
+	return contextPl, dataPl, nil
+}
+
 // SetStorage injects shared storage with Pipeline vars.
 func (p *Pipeline) setStorage(s *storage.Storage) {
 	for _, v := range p.Transformers {
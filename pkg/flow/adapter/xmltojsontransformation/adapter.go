@@ -19,9 +19,7 @@ package xmltojsontransformation
 import (
 	"bytes"
 	"context"
-	"encoding/xml"
 	"errors"
-	"io/ioutil"
 
 	"go.uber.org/zap"
 
@@ -30,10 +28,9 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
-	xj "github.com/basgys/goxml2json"
-
 	"github.com/triggermesh/triggermesh/pkg/apis/flow"
 	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/flow/xmljson"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 )
@@ -53,6 +50,13 @@ type envAccessor struct {
 	// Sink defines the target sink for the events. If no Sink is defined the
 	// events are replied back to the sender.
 	Sink string `envconfig:"K_SINK"`
+
+	// XML to JSON conversion conventions
+	AttributePrefix string   `envconfig:"XMLTOJSON_ATTRIBUTE_PREFIX" default:"-"`
+	TextKey         string   `envconfig:"XMLTOJSON_TEXT_KEY" default:"#content"`
+	InferTypes      bool     `envconfig:"XMLTOJSON_INFER_TYPES"`
+	ForceArray      []string `envconfig:"XMLTOJSON_FORCE_ARRAY"`
+	Namespaces      string   `envconfig:"XMLTOJSON_NAMESPACES" default:"local"`
 }
 
 // NewAdapter adapter implementation
@@ -78,6 +82,14 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 	}
 
 	return &Adapter{
+		opts: xmljson.DecodeOptions{
+			AttributePrefix: env.AttributePrefix,
+			TextKey:         env.TextKey,
+			InferTypes:      env.InferTypes,
+			ForceArray:      env.ForceArray,
+			Namespaces:      xmljson.NamespaceMode(env.Namespaces),
+		},
+
 		sink:     env.Sink,
 		replier:  replier,
 		ceClient: ceClient,
@@ -91,6 +103,8 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 var _ pkgadapter.Adapter = (*Adapter)(nil)
 
 type Adapter struct {
+	opts xmljson.DecodeOptions
+
 	sink     string
 	replier  *targetce.Replier
 	ceClient cloudevents.Client
@@ -109,36 +123,27 @@ func (a *Adapter) Start(ctx context.Context) error {
 }
 
 func (a *Adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
-	if !isValidXML(event.Data()) {
-		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation,
-			errors.New("invalid XML"), nil)
-	}
-
-	xml := bytes.NewReader(event.Data())
-	jsn, err := xj.Convert(xml)
+	v, err := xmljson.Decode(bytes.NewReader(event.Data()), a.opts)
 	if err != nil {
-		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
+		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation,
+			errors.New("invalid XML"), err.Error())
 	}
 
-	readBuf, err := ioutil.ReadAll(jsn)
-	if err != nil {
+	var data bytes.Buffer
+	if err := xmljson.EncodeJSON(&data, v); err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 	}
 
-	if err := event.SetData(cloudevents.ApplicationJSON, readBuf); err != nil {
+	if err := event.SetData(cloudevents.ApplicationJSON, data.Bytes()); err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 	}
 
 	if a.sink != "" {
 		if result := a.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
-			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, "sending the cloudevent to the sink")
 		}
 		return nil, cloudevents.ResultACK
 	}
 
 	return &event, cloudevents.ResultACK
 }
-
-func isValidXML(data []byte) bool {
-	return xml.Unmarshal(data, new(interface{})) == nil
-}
@@ -31,6 +31,7 @@ import (
 	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
 	logtesting "knative.dev/pkg/logging/testing"
 
+	"github.com/triggermesh/triggermesh/pkg/flow/xmljson"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 	metricstesting "github.com/triggermesh/triggermesh/pkg/metrics/testing"
 	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
@@ -44,8 +45,11 @@ const (
 	tXML1        = `<note><to>Tove</to></note>`
 	tJSONOutput1 = `{"note": {"to": "Tove"}}` + "\n"
 
+	tXML2        = `<list xmlns="urn:test"><v n="1">true</v><w>1.5e3</w></list>`
+	tJSONOutput2 = `{"list": {"@xmlns": "urn:test", "v": [{"@n": 1, "_text": true}], "w": 1.5e3}}` + "\n"
+
 	tFalseXML         = `"this is not xml"`
-	tFalseXMLResponse = `{"Code":"request-validation","Description":"invalid XML","Details":null}`
+	tFalseXMLResponse = `{"Code":"request-validation","Description":"invalid XML","Details":"document has no root element"}`
 )
 
 func TestSink(t *testing.T) {
@@ -73,6 +77,8 @@ func TestSink(t *testing.T) {
 			mt := &adapter.MetricTag{}
 
 			a := &Adapter{
+				opts: xmljson.NewDecodeOptions(),
+
 				sink:     "http://fake",
 				replier:  replier,
 				ceClient: ceClient,
@@ -96,15 +102,31 @@ func TestSink(t *testing.T) {
 }
 
 func TestReplier(t *testing.T) {
+	customOpts := xmljson.DecodeOptions{
+		AttributePrefix: "@",
+		TextKey:         "_text",
+		InferTypes:      true,
+		ForceArray:      []string{"v"},
+		Namespaces:      xmljson.NamespaceLocal,
+	}
+
 	testCases := map[string]struct {
+		opts        xmljson.DecodeOptions
 		inEvent     cloudevents.Event
 		expectEvent cloudevents.Event
 	}{
 		"transform ok": {
+			opts:        xmljson.NewDecodeOptions(),
 			inEvent:     newCloudEvent(t, tXML1, cloudevents.ApplicationXML),
 			expectEvent: newCloudEvent(t, tJSONOutput1, cloudevents.ApplicationJSON),
 		},
+		"transform with options": {
+			opts:        customOpts,
+			inEvent:     newCloudEvent(t, tXML2, cloudevents.ApplicationXML),
+			expectEvent: newCloudEvent(t, tJSONOutput2, cloudevents.ApplicationJSON),
+		},
 		"transform error": {
+			opts:        xmljson.NewDecodeOptions(),
 			inEvent:     newCloudEvent(t, tFalseXML, cloudevents.ApplicationXML),
 			expectEvent: newCloudEvent(t, tFalseXMLResponse, cloudevents.ApplicationXML),
 		},
@@ -124,6 +146,8 @@ func TestReplier(t *testing.T) {
 			mt := &adapter.MetricTag{}
 
 			a := &Adapter{
+				opts: tc.opts,
+
 				replier:  replier,
 				ceClient: ceClient,
 				logger:   logger,
@@ -22,6 +22,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"runtime"
 
 	xslt "github.com/wamuir/go-xslt"
@@ -31,6 +32,8 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/cloudevents/sdk-go/v2/types"
+
 	"github.com/triggermesh/triggermesh/pkg/apis/flow"
 	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
@@ -40,8 +43,14 @@ import (
 var _ pkgadapter.Adapter = (*xsltTransformAdapter)(nil)
 
 type xsltTransformAdapter struct {
-	defaultXSLT  *xslt.Stylesheet
+	defaultXSLT *xslt.Stylesheet
+	// stylesheets used instead of the default XSLT, by event type
+	typedXSLT    map[string]*xslt.Stylesheet
 	xsltOverride bool
+	params       []v1alpha1.XSLTParameter
+
+	compiler *compiler
+	cache    *stylesheetCache
 
 	replier  *targetce.Replier
 	ceClient cloudevents.Client
@@ -79,8 +88,17 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		logger.Panicf("Error creating CloudEvents replier: %v", err)
 	}
 
+	cache, err := newStylesheetCache(env.PerEventXSLTCacheSize)
+	if err != nil {
+		logger.Panicf("Error creating XSLT cache: %v", err)
+	}
+
 	adapter := &xsltTransformAdapter{
 		xsltOverride: env.AllowXSLTOverride,
+		params:       env.Parameters,
+
+		compiler: newCompiler(env.ImportsPath),
+		cache:    cache,
 
 		replier:  replier,
 		ceClient: ceClient,
@@ -92,7 +110,7 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 	}
 
 	if env.XSLT != "" {
-		adapter.defaultXSLT, err = xslt.NewStylesheet([]byte(env.XSLT))
+		adapter.defaultXSLT, err = adapter.compiler.compile([]byte(env.XSLT))
 		if err != nil {
 			logger.Panicf("XSLT validation error: %v", err)
 		}
@@ -100,6 +118,14 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		runtime.SetFinalizer(adapter.defaultXSLT, (*xslt.Stylesheet).Close)
 	}
 
+	if len(env.EventTypeStylesheets) > 0 {
+		adapter.typedXSLT, err = compileNamedStylesheets(adapter.compiler,
+			env.EventTypeStylesheets, stylesheetsFromEnv(os.Environ()))
+		if err != nil {
+			logger.Panicf("XSLT validation error: %v", err)
+		}
+	}
+
 	return adapter
 }
 
@@ -132,22 +158,30 @@ func (a *xsltTransformAdapter) dispatch(ctx context.Context, event cloudevents.E
 		}
 
 		xmlin = []byte(req.XML)
-		style, err = xslt.NewStylesheet([]byte(req.XSLT))
+		style, err = a.perEventStylesheet([]byte(req.XSLT))
 		if err != nil {
 			return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
 		}
-		defer style.Close()
+		if a.cache == nil {
+			defer style.Close()
+		}
 
 	case isXML:
 		xmlin = event.DataEncoded
-		style = a.defaultXSLT
+		style = a.stylesheetFor(event.Type())
+		if style == nil {
+			return a.replier.Error(&event, targetce.ErrorCodeRequestValidation,
+				fmt.Errorf("no XSLT configured for events of type %q", event.Type()), nil)
+		}
 
 	default:
 		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation,
 			errors.New("unexpected type or media-type for the incoming event"), nil)
 	}
 
-	res, err := style.Transform(xmlin)
+	res, err := style.Transform(xmlin, a.parameters(&event)...)
+	// cached stylesheets are closed by a finalizer once evicted and unused
+	runtime.KeepAlive(style)
 	if err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation,
 			fmt.Errorf("error processing XML with XSLT: %v", err), nil)
@@ -160,10 +194,124 @@ func (a *xsltTransformAdapter) dispatch(ctx context.Context, event cloudevents.E
 		}
 
 		if result := a.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
-			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, "sending the cloudevent to the sink")
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, "sending the cloudevent to the sink")
 		}
 		return nil, cloudevents.ResultACK
 	}
 
 	return a.replier.Ok(&event, res, targetce.ResponseWithDataContentType(cloudevents.ApplicationXML))
 }
+
+// stylesheetFor returns the stylesheet used to transform events of the given
+// type, or nil if there is none.
+func (a *xsltTransformAdapter) stylesheetFor(eventType string) *xslt.Stylesheet {
+	if st, ok := a.typedXSLT[eventType]; ok {
+		return st
+	}
+	return a.defaultXSLT
+}
+
+// perEventStylesheet returns the stylesheet compiled from an XSLT document
+// informed in an event. The stylesheet must be closed by the caller when
+// caching is disabled.
+func (a *xsltTransformAdapter) perEventStylesheet(doc []byte) (*xslt.Stylesheet, error) {
+	if a.cache == nil {
+		return a.compiler.compile(doc)
+	}
+	return a.cache.get(doc, a.compiler)
+}
+
+// parameters returns the values of the XSLT parameters for the given event.
+// Parameters populated from attributes which the event doesn't have are
+// omitted, so that the default value declared in the stylesheet applies.
+func (a *xsltTransformAdapter) parameters(event *cloudevents.Event) []xslt.Parameter {
+	if len(a.params) == 0 {
+		return nil
+	}
+
+	params := make([]xslt.Parameter, 0, len(a.params))
+
+	for _, p := range a.params {
+		switch {
+		case p.Value != nil:
+			params = append(params, xslt.StringParameter{Name: p.Name, Value: *p.Value})
+
+		case p.ValueFromAttribute != nil:
+			if v, ok := attributeValue(event, *p.ValueFromAttribute); ok {
+				params = append(params, xslt.StringParameter{Name: p.Name, Value: v})
+			}
+		}
+	}
+
+	return params
+}
+
+// attributeValue returns the string representation of the context attribute
+// or extension with the given name.
+func attributeValue(event *cloudevents.Event, name string) (string, bool) {
+	var v string
+
+	switch name {
+	case "specversion":
+		v = event.SpecVersion()
+	case "id":
+		v = event.ID()
+	case "source":
+		v = event.Source()
+	case "type":
+		v = event.Type()
+	case "subject":
+		v = event.Subject()
+	case "datacontenttype":
+		v = event.DataContentType()
+	case "dataschema":
+		v = event.DataSchema()
+	case "time":
+		if t := event.Time(); !t.IsZero() {
+			v = types.FormatTime(t)
+		}
+	default:
+		ext, ok := event.Extensions()[name]
+		if !ok {
+			return "", false
+		}
+		s, err := types.Format(ext)
+		if err != nil {
+			return "", false
+		}
+		return s, true
+	}
+
+	return v, v != ""
+}
+
+// compileNamedStylesheets compiles the given named stylesheets, and returns
+// them by event type.
+func compileNamedStylesheets(cmp *compiler, eventTypeNames map[string]string,
+	stylesheets map[string]string) (map[string]*xslt.Stylesheet, error) {
+
+	compiled := make(map[string]*xslt.Stylesheet, len(stylesheets))
+	byType := make(map[string]*xslt.Stylesheet, len(eventTypeNames))
+
+	for typ, name := range eventTypeNames {
+		st, ok := compiled[name]
+		if !ok {
+			doc, ok := stylesheets[name]
+			if !ok {
+				return nil, fmt.Errorf("stylesheet %q for events of type %q is not defined", name, typ)
+			}
+
+			var err error
+			if st, err = cmp.compile([]byte(doc)); err != nil {
+				return nil, fmt.Errorf("compiling stylesheet %q: %w", name, err)
+			}
+			runtime.SetFinalizer(st, (*xslt.Stylesheet).Close)
+
+			compiled[name] = st
+		}
+
+		byType[typ] = st
+	}
+
+	return byType, nil
+}
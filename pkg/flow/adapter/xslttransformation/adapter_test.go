@@ -124,6 +124,21 @@ const (
 
 	tAlternativeOutXML = `<?xml version="1.0"?>
 <alt><item>A1</item><item>B2</item><item>C3</item></alt>
+`
+
+	tParamsXSLT = `
+<xsl:stylesheet version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform">
+  <xsl:param name="env" select="'dev'"/>
+  <xsl:param name="src" select="'none'"/>
+  <xsl:param name="region" select="'default'"/>
+  <xsl:template match="/">
+    <out env="{$env}" src="{$src}" region="{$region}"/>
+  </xsl:template>
+</xsl:stylesheet>
+`
+
+	tParamsOutXML = `<?xml version="1.0"?>
+<out env="prod" src="ce.test.source" region="default"/>
 `
 )
 
@@ -177,6 +192,9 @@ func TestXSLTTransformationEvents(t *testing.T) {
 	testCases := map[string]struct {
 		allowXSLTOverride bool
 		xslt              string
+		typedXSLT         map[string]string
+		params            []v1alpha1.XSLTParameter
+		cacheSize         int
 
 		inEvent cloudevents.Event
 
@@ -220,6 +238,55 @@ func TestXSLTTransformationEvents(t *testing.T) {
 			expectEvent:    newCloudEvent(tAlternativeOutXML, cloudevents.ApplicationXML),
 			expectCategory: tSuccessAttribute,
 		},
+		"transform xslt at event with cache, ok": {
+			allowXSLTOverride: true,
+			cacheSize:         8,
+			inEvent: newCloudEvent(
+				createStructuredRequest(tXML, tAlternativeXSLT),
+				cloudevents.ApplicationJSON,
+				cloudEventWithEventType(v1alpha1.EventTypeXSLTTransformation)),
+
+			expectEvent:    newCloudEvent(tAlternativeOutXML, cloudevents.ApplicationXML),
+			expectCategory: tSuccessAttribute,
+		},
+		"transform with stylesheet selected by event type, ok": {
+			xslt:      tXSLT,
+			typedXSLT: map[string]string{tCloudEventType: tAlternativeXSLT},
+			inEvent:   newCloudEvent(tXML, cloudevents.ApplicationXML),
+
+			expectEvent:    newCloudEvent(tAlternativeOutXML, cloudevents.ApplicationXML),
+			expectCategory: tSuccessAttribute,
+		},
+		"transform with default stylesheet for other event types, ok": {
+			xslt:      tXSLT,
+			typedXSLT: map[string]string{"other.type": tAlternativeXSLT},
+			inEvent:   newCloudEvent(tXML, cloudevents.ApplicationXML),
+
+			expectEvent:    newCloudEvent(tOutXML, cloudevents.ApplicationXML),
+			expectCategory: tSuccessAttribute,
+		},
+		"transform with parameters, ok": {
+			xslt: tParamsXSLT,
+			params: []v1alpha1.XSLTParameter{
+				{Name: "env", Value: strPtr("prod")},
+				{Name: "src", ValueFromAttribute: strPtr("source")},
+				{Name: "region", ValueFromAttribute: strPtr("region")},
+			},
+			inEvent: newCloudEvent(tXML, cloudevents.ApplicationXML),
+
+			expectEvent:    newCloudEvent(tParamsOutXML, cloudevents.ApplicationXML),
+			expectCategory: tSuccessAttribute,
+		},
+		"no stylesheet for event type": {
+			allowXSLTOverride: true,
+			typedXSLT:         map[string]string{"other.type": tAlternativeXSLT},
+			inEvent:           newCloudEvent(tXML, cloudevents.ApplicationXML),
+
+			expectEvent: newCloudEvent(
+				createErrorResponse(targetce.ErrorCodeRequestValidation, `no XSLT configured for events of type "ce.test.type"`),
+				cloudevents.ApplicationJSON),
+			expectCategory: tErrorAttribute,
+		},
 		"malformed incoming event": {
 			allowXSLTOverride: false,
 			xslt:              tXSLT,
@@ -247,8 +314,15 @@ func TestXSLTTransformationEvents(t *testing.T) {
 
 			mt := &adapter.MetricTag{}
 
+			cache, err := newStylesheetCache(tc.cacheSize)
+			require.NoError(t, err)
+
 			a := &xsltTransformAdapter{
 				xsltOverride: tc.allowXSLTOverride,
+				params:       tc.params,
+
+				compiler: newCompiler(""),
+				cache:    cache,
 
 				replier:  replier,
 				ceClient: ceClient,
@@ -264,6 +338,18 @@ func TestXSLTTransformationEvents(t *testing.T) {
 				runtime.SetFinalizer(a.defaultXSLT, (*xslt.Stylesheet).Close)
 			}
 
+			if len(tc.typedXSLT) > 0 {
+				eventTypeNames := make(map[string]string, len(tc.typedXSLT))
+				stylesheets := make(map[string]string, len(tc.typedXSLT))
+				for typ, doc := range tc.typedXSLT {
+					eventTypeNames[typ] = typ
+					stylesheets[typ] = doc
+				}
+
+				a.typedXSLT, err = compileNamedStylesheets(a.compiler, eventTypeNames, stylesheets)
+				require.NoError(t, err)
+			}
+
 			ctx, cancel := context.WithCancel(context.Background())
 			t.Cleanup(cancel)
 
@@ -316,6 +402,7 @@ func TestXSLTTransformationToSink(t *testing.T) {
 				ceClient:     ceClient,
 				xsltOverride: false,
 				defaultXSLT:  style,
+				compiler:     newCompiler(""),
 				sink:         "http://localhost:8080",
 			}
 
@@ -389,3 +476,7 @@ func createErrorResponse(code, description string) string {
 
 	return string(b)
 }
+
+func strPtr(s string) *string {
+	return &s
+}
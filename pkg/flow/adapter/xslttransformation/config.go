@@ -19,11 +19,19 @@ limitations under the License.
 package xslttransformation
 
 import (
+	"encoding/json"
 	"errors"
+	"strings"
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
 )
 
+// envStylesheetPrefix is the prefix of the environment variables containing
+// named stylesheets.
+const envStylesheetPrefix = "XSLTTRANSFORMATION_STYLESHEET_"
+
 // EnvAccessorCtor for configuration parameters
 func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
 	return &envAccessor{}
@@ -36,6 +44,18 @@ type envAccessor struct {
 	// If set to true, enables consuming structured CloudEvents that include
 	// fields for the XML and XSLT field.
 	AllowXSLTOverride bool `envconfig:"XSLTTRANSFORMATION_ALLOW_XSLT_OVERRIDE" required:"true"`
+	// Maximum number of compiled per-event XSLT documents kept in memory.
+	// Caching is disabled when set to 0.
+	PerEventXSLTCacheSize int `envconfig:"XSLTTRANSFORMATION_PER_EVENT_XSLT_CACHE_SIZE" default:"64"`
+	// Names of the stylesheets used instead of the default XSLT, by event
+	// type. The stylesheets are read from environment variables prefixed
+	// with envStylesheetPrefix.
+	EventTypeStylesheets eventTypeStylesheets `envconfig:"XSLTTRANSFORMATION_EVENT_TYPE_STYLESHEETS"`
+	// Parameters passed to stylesheets at each transformation.
+	Parameters parameters `envconfig:"XSLTTRANSFORMATION_PARAMETERS"`
+	// Directory against which the relative locations of xsl:import and
+	// xsl:include elements are resolved.
+	ImportsPath string `envconfig:"XSLTTRANSFORMATION_IMPORTS_PATH"`
 	// BridgeIdentifier is the name of the bridge workflow this target is part of
 	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
 	// Sink defines the target sink for the events. If no Sink is defined the
@@ -44,8 +64,45 @@ type envAccessor struct {
 }
 
 func (e *envAccessor) validate() error {
-	if !e.AllowXSLTOverride && e.XSLT == "" {
+	if !e.AllowXSLTOverride && e.XSLT == "" && len(e.EventTypeStylesheets) == 0 {
 		return errors.New("if XSLT cannot be overriden by CloudEvent payloads, configured XSLT cannot be empty")
 	}
+	if e.PerEventXSLTCacheSize < 0 {
+		return errors.New("the size of the per-event XSLT cache cannot be negative")
+	}
 	return nil
 }
+
+// eventTypeStylesheets contains names of stylesheets by event type.
+type eventTypeStylesheets map[string]string
+
+// Decode implements envconfig.Decoder.
+func (s *eventTypeStylesheets) Decode(value string) error {
+	return json.Unmarshal([]byte(value), s)
+}
+
+// parameters is a list of XSLT parameters.
+type parameters []v1alpha1.XSLTParameter
+
+// Decode implements envconfig.Decoder.
+func (p *parameters) Decode(value string) error {
+	return json.Unmarshal([]byte(value), p)
+}
+
+// stylesheetsFromEnv returns the named stylesheets found in the given
+// environment, by name.
+func stylesheetsFromEnv(environ []string) map[string]string {
+	stylesheets := make(map[string]string)
+
+	for _, kv := range environ {
+		if !strings.HasPrefix(kv, envStylesheetPrefix) {
+			continue
+		}
+		k, v, _ := strings.Cut(kv, "=")
+		if name := strings.TrimPrefix(k, envStylesheetPrefix); name != "" {
+			stylesheets[name] = v
+		}
+	}
+
+	return stylesheets
+}
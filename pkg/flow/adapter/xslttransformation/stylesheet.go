@@ -0,0 +1,167 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xslttransformation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	lru "github.com/hashicorp/golang-lru"
+	xslt "github.com/wamuir/go-xslt"
+)
+
+const (
+	xslNamespace = "http://www.w3.org/1999/XSL/Transform"
+	xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+)
+
+// compiler compiles XSLT documents.
+type compiler struct {
+	// URL against which the relative locations of xsl:import and
+	// xsl:include elements are resolved, if set.
+	baseURL string
+}
+
+// newCompiler returns a compiler which resolves the relative locations of
+// imported documents against the given directory, if not empty.
+func newCompiler(importsPath string) *compiler {
+	c := &compiler{}
+	if importsPath != "" {
+		c.baseURL = "file://" + filepath.ToSlash(filepath.Clean(importsPath)) + "/"
+	}
+	return c
+}
+
+// compile returns the stylesheet compiled from the given XSLT document.
+// Callers are responsible for closing the returned stylesheet.
+func (c *compiler) compile(doc []byte) (*xslt.Stylesheet, error) {
+	if c.baseURL != "" {
+		var err error
+		if doc, err = withBase(doc, c.baseURL); err != nil {
+			return nil, err
+		}
+	}
+
+	return xslt.NewStylesheet(doc)
+}
+
+// withBase sets the xml:base attribute of the root element of the given
+// XSLT document, so that libxslt resolves the relative locations of imported
+// documents against the given base URL instead of its working directory.
+//
+// Documents which are not xsl:stylesheet or xsl:transform elements, or which
+// already declare a base URL, are returned unchanged. In particular, literal
+// result elements (simplified stylesheets) can not import other documents,
+// and all their attributes are copied to the output.
+func withBase(doc []byte, baseURL string) ([]byte, error) {
+	dec := xml.NewDecoder(bytes.NewReader(doc))
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil, errors.New("document has no root element")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing XSLT document: %w", err)
+		}
+
+		root, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		if root.Name.Space != xslNamespace {
+			return doc, nil
+		}
+		for _, attr := range root.Attr {
+			if attr.Name.Space == xmlNamespace && attr.Name.Local == "base" {
+				return doc, nil
+			}
+		}
+
+		// The offset points right after the '>' closing the start tag
+		// of the root element, which may be self-closing.
+		end := int(dec.InputOffset()) - 1
+		if doc[end-1] == '/' {
+			end--
+		}
+
+		var b strings.Builder
+		if err := xml.EscapeText(&b, []byte(baseURL)); err != nil {
+			return nil, err
+		}
+
+		out := make([]byte, 0, len(doc)+len(` xml:base=""`)+b.Len())
+		out = append(out, doc[:end]...)
+		out = append(out, ` xml:base="`...)
+		out = append(out, b.String()...)
+		out = append(out, '"')
+		out = append(out, doc[end:]...)
+
+		return out, nil
+	}
+}
+
+// stylesheetCache is a LRU cache of compiled per-event stylesheets, keyed by
+// the hash of their XSLT document.
+type stylesheetCache struct {
+	*lru.Cache
+}
+
+// newStylesheetCache returns a stylesheetCache of the given size, or nil if
+// the size is 0.
+func newStylesheetCache(size int) (*stylesheetCache, error) {
+	if size == 0 {
+		return nil, nil
+	}
+
+	c, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &stylesheetCache{Cache: c}, nil
+}
+
+// get returns the stylesheet compiled from the given XSLT document,
+// compiling it if it isn't already cached.
+//
+// Evicted stylesheets may still be in use by concurrent transformations, so
+// they are closed by a finalizer instead of upon eviction.
+func (c *stylesheetCache) get(doc []byte, cmp *compiler) (*xslt.Stylesheet, error) {
+	key := sha256.Sum256(doc)
+
+	if st, ok := c.Get(key); ok {
+		return st.(*xslt.Stylesheet), nil
+	}
+
+	st, err := cmp.compile(doc)
+	if err != nil {
+		return nil, err
+	}
+	runtime.SetFinalizer(st, (*xslt.Stylesheet).Close)
+
+	c.Add(key, st)
+
+	return st, nil
+}
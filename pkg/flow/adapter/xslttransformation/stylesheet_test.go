@@ -0,0 +1,113 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xslttransformation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithBase(t *testing.T) {
+	const base = "file:///opt/xslt/"
+
+	testCases := map[string]struct {
+		doc    string
+		expect string
+	}{
+		"stylesheet": {
+			doc: `<?xml version="1.0"?>` + "\n" +
+				`<xsl:stylesheet version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform"><xsl:import href="a/b.xsl"/></xsl:stylesheet>`,
+			expect: `<?xml version="1.0"?>` + "\n" +
+				`<xsl:stylesheet version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform" xml:base="file:///opt/xslt/"><xsl:import href="a/b.xsl"/></xsl:stylesheet>`,
+		},
+		"empty transform": {
+			doc:    `<t:transform version="1.0" xmlns:t="http://www.w3.org/1999/XSL/Transform"/>`,
+			expect: `<t:transform version="1.0" xmlns:t="http://www.w3.org/1999/XSL/Transform" xml:base="file:///opt/xslt/"/>`,
+		},
+		"base already set": {
+			doc:    `<xsl:stylesheet version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform" xml:base="file:///tmp/"/>`,
+			expect: `<xsl:stylesheet version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform" xml:base="file:///tmp/"/>`,
+		},
+		"simplified stylesheet": {
+			doc:    `<out xsl:version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform"/>`,
+			expect: `<out xsl:version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform"/>`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			out, err := withBase([]byte(tc.doc), base)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, string(out))
+		})
+	}
+}
+
+func TestCompileWithImports(t *testing.T) {
+	dir := t.TempDir()
+
+	const common = `<xsl:stylesheet version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform">
+  <xsl:template match="test"><item><xsl:value-of select="data/el1"/></item></xsl:template>
+</xsl:stylesheet>`
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "common"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "common", "items.xsl"), []byte(common), 0o600))
+
+	const doc = `<xsl:stylesheet version="1.0" xmlns:xsl="http://www.w3.org/1999/XSL/Transform">
+  <xsl:import href="common/items.xsl"/>
+  <xsl:template match="tests"><output><xsl:apply-templates select="test"/></output></xsl:template>
+</xsl:stylesheet>`
+
+	st, err := newCompiler(dir).compile([]byte(doc))
+	require.NoError(t, err)
+	defer st.Close()
+
+	out, err := st.Transform([]byte(tXML))
+	require.NoError(t, err)
+	assert.Equal(t, "<?xml version=\"1.0\"?>\n<output><item>A</item><item>B</item><item>C</item></output>\n", string(out))
+}
+
+func TestStylesheetCache(t *testing.T) {
+	c, err := newStylesheetCache(1)
+	require.NoError(t, err)
+
+	cmp := newCompiler("")
+
+	st1, err := c.get([]byte(tXSLT), cmp)
+	require.NoError(t, err)
+	st2, err := c.get([]byte(tXSLT), cmp)
+	require.NoError(t, err)
+	assert.Same(t, st1, st2, "Expected the cached stylesheet to be reused")
+
+	_, err = c.get([]byte(tAlternativeXSLT), cmp)
+	require.NoError(t, err)
+	st3, err := c.get([]byte(tXSLT), cmp)
+	require.NoError(t, err)
+	assert.NotSame(t, st1, st3, "Expected the evicted stylesheet to be compiled again")
+
+	_, err = c.get([]byte(tFaultyXML), cmp)
+	assert.Error(t, err)
+
+	c, err = newStylesheetCache(0)
+	assert.NoError(t, err)
+	assert.Nil(t, c)
+}
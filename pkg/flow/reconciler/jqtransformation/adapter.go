@@ -31,6 +31,9 @@ import (
 
 const (
 	envQuery               = "JQ_QUERY"
+	envScope               = "JQ_SCOPE"
+	envResults             = "JQ_RESULTS"
+	envArgPrefix           = "JQ_ARG_"
 	envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
 )
 
@@ -69,6 +72,25 @@ func makeAppEnv(o *v1alpha1.JQTransformation) []corev1.EnvVar {
 		},
 	}
 
+	for i := range o.Spec.Args {
+		arg := &o.Spec.Args[i]
+		env = append(env, *arg.ToEnvironmentVariable(envArgPrefix + arg.Name))
+	}
+
+	if o.Spec.Scope != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envScope,
+			Value: string(*o.Spec.Scope),
+		})
+	}
+
+	if o.Spec.Results != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envResults,
+			Value: string(*o.Spec.Results),
+		})
+	}
+
 	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
 		env = append(env, corev1.EnvVar{
 			Name:  envEventsPayloadPolicy,
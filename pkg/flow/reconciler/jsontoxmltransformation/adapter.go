@@ -0,0 +1,123 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsontoxmltransformation
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/eventing/pkg/reconciler/source"
+	"knative.dev/pkg/apis"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	common "github.com/triggermesh/triggermesh/pkg/reconciler"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
+)
+
+const (
+	envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
+	envRootElement         = "JSONTOXML_ROOT_ELEMENT"
+	envNamespace           = "JSONTOXML_NAMESPACE"
+	envNamespacePrefix     = "JSONTOXML_NAMESPACE_PREFIX"
+	envArrayItemElement    = "JSONTOXML_ARRAY_ITEM_ELEMENT"
+	envAttributePrefix     = "JSONTOXML_ATTRIBUTE_PREFIX"
+	envTextKey             = "JSONTOXML_TEXT_KEY"
+)
+
+// adapterConfig contains properties used to configure the target's adapter.
+// Public fields are automatically populated by envconfig.
+type adapterConfig struct {
+	// Configuration accessor for logging/metrics/tracing
+	obsConfig source.ConfigAccessor
+	// Container image
+	Image string `default:"gcr.io/triggermesh/jsontoxmltransformation-adapter"`
+}
+
+// Verify that Reconciler implements common.AdapterBuilder.
+var _ common.AdapterBuilder[*servingv1.Service] = (*Reconciler)(nil)
+
+// BuildAdapter implements common.AdapterBuilder.
+func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, sinkURI *apis.URL) (*servingv1.Service, error) {
+	typedTrg := trg.(*v1alpha1.JSONToXMLTransformation)
+
+	return common.NewAdapterKnService(trg, sinkURI,
+		resource.Image(r.adapterCfg.Image),
+		resource.EnvVars(makeAppEnv(typedTrg)...),
+		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
+	), nil
+}
+
+func makeAppEnv(o *v1alpha1.JSONToXMLTransformation) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{
+			Name:  common.EnvBridgeID,
+			Value: common.GetStatefulBridgeID(o),
+		},
+	}
+
+	if o.Spec.RootElement != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envRootElement,
+			Value: *o.Spec.RootElement,
+		})
+	}
+
+	if o.Spec.Namespace != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envNamespace,
+			Value: *o.Spec.Namespace,
+		})
+	}
+
+	if o.Spec.NamespacePrefix != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envNamespacePrefix,
+			Value: *o.Spec.NamespacePrefix,
+		})
+	}
+
+	if o.Spec.ArrayItemElement != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envArrayItemElement,
+			Value: *o.Spec.ArrayItemElement,
+		})
+	}
+
+	if o.Spec.AttributePrefix != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envAttributePrefix,
+			Value: *o.Spec.AttributePrefix,
+		})
+	}
+
+	if o.Spec.TextKey != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envTextKey,
+			Value: *o.Spec.TextKey,
+		})
+	}
+
+	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envEventsPayloadPolicy,
+			Value: string(*o.Spec.EventOptions.PayloadPolicy),
+		})
+	}
+
+	return env
+}
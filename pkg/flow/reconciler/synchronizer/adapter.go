@@ -18,6 +18,7 @@ package synchronizer
 
 import (
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -77,5 +78,40 @@ func makeAppEnv(o *v1alpha1.Synchronizer) []corev1.EnvVar {
 		})
 	}
 
+	if a := o.Spec.CorrelationKey.ClientKeyAttribute; a != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "CORRELATION_CLIENT_KEY_ATTRIBUTE",
+			Value: *a,
+		})
+	}
+
+	if a := o.Spec.Response.TimeoutAttribute; a != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "RESPONSE_TIMEOUT_ATTRIBUTE",
+			Value: *a,
+		})
+	}
+
+	if t := o.Spec.Response.MaxTimeout; t != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "RESPONSE_MAX_TIMEOUT",
+			Value: t.String(),
+		})
+	}
+
+	if a := o.Spec.Response.AggregationCompletionAttribute; a != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "RESPONSE_AGGREGATION_COMPLETION_ATTRIBUTE",
+			Value: *a,
+		})
+	}
+
+	if len(o.Spec.Response.ErrorTypes) > 0 {
+		env = append(env, corev1.EnvVar{
+			Name:  "RESPONSE_ERROR_TYPES",
+			Value: strings.Join(o.Spec.Response.ErrorTypes, ","),
+		})
+	}
+
 	return env
 }
@@ -17,6 +17,9 @@ limitations under the License.
 package xmltojsontransformation
 
 import (
+	"strconv"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
@@ -31,6 +34,11 @@ import (
 
 const (
 	envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
+	envAttributePrefix     = "XMLTOJSON_ATTRIBUTE_PREFIX"
+	envTextKey             = "XMLTOJSON_TEXT_KEY"
+	envInferTypes          = "XMLTOJSON_INFER_TYPES"
+	envForceArray          = "XMLTOJSON_FORCE_ARRAY"
+	envNamespaces          = "XMLTOJSON_NAMESPACES"
 )
 
 // adapterConfig contains properties used to configure the target's adapter.
@@ -64,6 +72,41 @@ func makeAppEnv(o *v1alpha1.XMLToJSONTransformation) []corev1.EnvVar {
 		},
 	}
 
+	if o.Spec.AttributePrefix != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envAttributePrefix,
+			Value: *o.Spec.AttributePrefix,
+		})
+	}
+
+	if o.Spec.TextKey != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envTextKey,
+			Value: *o.Spec.TextKey,
+		})
+	}
+
+	if o.Spec.InferTypes != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envInferTypes,
+			Value: strconv.FormatBool(*o.Spec.InferTypes),
+		})
+	}
+
+	if len(o.Spec.ForceArray) > 0 {
+		env = append(env, corev1.EnvVar{
+			Name:  envForceArray,
+			Value: strings.Join(o.Spec.ForceArray, ","),
+		})
+	}
+
+	if o.Spec.Namespaces != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envNamespaces,
+			Value: string(*o.Spec.Namespaces),
+		})
+	}
+
 	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
 		env = append(env, corev1.EnvVar{
 			Name:  envEventsPayloadPolicy,
@@ -17,6 +17,9 @@ limitations under the License.
 package xslttransformation
 
 import (
+	"encoding/json"
+	"fmt"
+	"path"
 	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
@@ -32,10 +35,19 @@ import (
 )
 
 const (
-	envXSLT              = "XSLTTRANSFORMATION_XSLT"
-	envAllowXSLTOverride = "XSLTTRANSFORMATION_ALLOW_XSLT_OVERRIDE"
+	envXSLT                  = "XSLTTRANSFORMATION_XSLT"
+	envAllowXSLTOverride     = "XSLTTRANSFORMATION_ALLOW_XSLT_OVERRIDE"
+	envPerEventXSLTCacheSize = "XSLTTRANSFORMATION_PER_EVENT_XSLT_CACHE_SIZE"
+	envStylesheetPrefix      = "XSLTTRANSFORMATION_STYLESHEET_"
+	envEventTypeStylesheets  = "XSLTTRANSFORMATION_EVENT_TYPE_STYLESHEETS"
+	envParameters            = "XSLTTRANSFORMATION_PARAMETERS"
+	envImportsPath           = "XSLTTRANSFORMATION_IMPORTS_PATH"
 )
 
+// importsPath is the directory in which ConfigMaps referenced by
+// xsl:import and xsl:include elements are mounted.
+const importsPath = "/opt/xslt"
+
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
 type adapterConfig struct {
@@ -52,14 +64,23 @@ var _ common.AdapterBuilder[*servingv1.Service] = (*Reconciler)(nil)
 func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, sinkURI *apis.URL) (*servingv1.Service, error) {
 	typedTrg := trg.(*v1alpha1.XSLTTransformation)
 
+	appEnv, err := makeAppEnv(typedTrg)
+	if err != nil {
+		return nil, err
+	}
+
+	importVolumes, importVolumeMounts := importsVolumesAndMounts(typedTrg.Spec.Imports)
+
 	return common.NewAdapterKnService(trg, sinkURI,
 		resource.Image(r.adapterCfg.Image),
-		resource.EnvVars(makeAppEnv(typedTrg)...),
+		resource.Volumes(importVolumes...),
+		resource.VolumeMounts(importVolumeMounts...),
+		resource.EnvVars(appEnv...),
 		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
 	), nil
 }
 
-func makeAppEnv(o *v1alpha1.XSLTTransformation) []corev1.EnvVar {
+func makeAppEnv(o *v1alpha1.XSLTTransformation) ([]corev1.EnvVar, error) {
 	env := []corev1.EnvVar{
 		*o.Spec.XSLT.ToEnvironmentVariable(envXSLT),
 		{
@@ -75,5 +96,88 @@ func makeAppEnv(o *v1alpha1.XSLTTransformation) []corev1.EnvVar {
 		})
 	}
 
-	return env
+	if o.Spec.PerEventXSLTCacheSize != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envPerEventXSLTCacheSize,
+			Value: strconv.Itoa(*o.Spec.PerEventXSLTCacheSize),
+		})
+	}
+
+	if len(o.Spec.Stylesheets) > 0 {
+		eventTypeStylesheets := make(map[string]string)
+
+		for _, st := range o.Spec.Stylesheets {
+			st := st
+			env = append(env, *st.XSLT.ToEnvironmentVariable(envStylesheetPrefix + st.Name))
+
+			for _, typ := range st.EventTypes {
+				eventTypeStylesheets[typ] = st.Name
+			}
+		}
+
+		s, err := json.Marshal(eventTypeStylesheets)
+		if err != nil {
+			return nil, fmt.Errorf("serializing stylesheets event types to JSON: %w", err)
+		}
+
+		env = append(env, corev1.EnvVar{
+			Name:  envEventTypeStylesheets,
+			Value: string(s),
+		})
+	}
+
+	if len(o.Spec.Parameters) > 0 {
+		s, err := json.Marshal(o.Spec.Parameters)
+		if err != nil {
+			return nil, fmt.Errorf("serializing XSLT parameters to JSON: %w", err)
+		}
+
+		env = append(env, corev1.EnvVar{
+			Name:  envParameters,
+			Value: string(s),
+		})
+	}
+
+	if len(o.Spec.Imports) > 0 {
+		env = append(env, corev1.EnvVar{
+			Name:  envImportsPath,
+			Value: importsPath,
+		})
+	}
+
+	return env, nil
+}
+
+// importsVolumesAndMounts returns the volumes and volume mounts which expose
+// the keys of the given ConfigMaps as files at "<importsPath>/<name>/<key>".
+func importsVolumesAndMounts(refs []corev1.LocalObjectReference) ([]corev1.Volume, []corev1.VolumeMount) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	vs := make([]corev1.Volume, 0, len(refs))
+	vms := make([]corev1.VolumeMount, 0, len(refs))
+
+	for i, ref := range refs {
+		// ConfigMap names can be longer than the 63 characters allowed
+		// in volume names
+		name := "xslt-import-" + strconv.Itoa(i)
+
+		vs = append(vs, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: ref,
+				},
+			},
+		})
+
+		vms = append(vms, corev1.VolumeMount{
+			Name:      name,
+			ReadOnly:  true,
+			MountPath: path.Join(importsPath, ref.Name),
+		})
+	}
+
+	return vs, vms
 }
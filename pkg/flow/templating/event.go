@@ -0,0 +1,53 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templating
+
+import (
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+// EventContext returns the context attributes of the given event, including
+// extensions, keyed by attribute name. It is the value templates access the
+// context of events through.
+func EventContext(e *cloudevents.Event) map[string]interface{} {
+	ctx := map[string]interface{}{
+		"specversion": e.SpecVersion(),
+		"id":          e.ID(),
+		"source":      e.Source(),
+		"type":        e.Type(),
+	}
+
+	if v := e.DataContentType(); v != "" {
+		ctx["datacontenttype"] = v
+	}
+	if v := e.DataSchema(); v != "" {
+		ctx["dataschema"] = v
+	}
+	if v := e.Subject(); v != "" {
+		ctx["subject"] = v
+	}
+	if v := e.Time(); !v.IsZero() {
+		ctx["time"] = types.FormatTime(v)
+	}
+
+	for k, v := range e.Extensions() {
+		ctx[k] = v
+	}
+
+	return ctx
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templating
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+func TestEventContext(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetID("1234")
+	event.SetType("test.type")
+	event.SetSource("test.source")
+	event.SetSubject("test-subject")
+	event.SetTime(time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC))
+	event.SetExtension("myext", "value")
+
+	assert.Equal(t, map[string]interface{}{
+		"specversion": "1.0",
+		"id":          "1234",
+		"type":        "test.type",
+		"source":      "test.source",
+		"subject":     "test-subject",
+		"time":        "2022-06-01T10:00:00Z",
+		"myext":       "value",
+	}, EventContext(&event))
+}
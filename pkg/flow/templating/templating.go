@@ -0,0 +1,170 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package templating provides the Go template dialect used to map the data of
+// events in TemplateTransformation and TektonTarget.
+package templating
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+)
+
+// Parse parses the given Go template, with the functions of the mapping
+// dialect available to it.
+// The returned template can be executed concurrently.
+func Parse(name, text string) (*template.Template, error) {
+	return template.New(name).Funcs(funcs).Parse(text)
+}
+
+// funcs are the functions available to templates, in addition to the
+// built-in functions of Go templates. Functions which take a value to
+// operate on accept it as their last argument, so they can be chained in
+// pipelines.
+var funcs = template.FuncMap{
+	"toJson":    toJSON,
+	"xmlEscape": xmlEscape,
+	"csvRecord": csvRecord,
+	"default":   defaultValue,
+	"lower":     strings.ToLower,
+	"upper":     strings.ToUpper,
+	"trim":      strings.TrimSpace,
+	"replace":   replace,
+	"split":     split,
+	"join":      join,
+	"hasPrefix": hasPrefix,
+	"hasSuffix": hasSuffix,
+	"contains":  contains,
+}
+
+// toJSON returns the JSON representation of v.
+func toJSON(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// xmlEscape returns the textual representation of v, escaped for inclusion
+// in XML text or attribute values.
+func xmlEscape(v interface{}) (string, error) {
+	var b strings.Builder
+	if err := xml.EscapeText(&b, []byte(toString(v))); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// csvRecord returns the given values as a single CSV record, without a
+// trailing newline.
+func csvRecord(vals ...interface{}) (string, error) {
+	rec := make([]string, len(vals))
+	for i, v := range vals {
+		rec[i] = toString(v)
+	}
+
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	if err := w.Write(rec); err != nil {
+		return "", err
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// defaultValue returns v, or def if v is empty.
+func defaultValue(def, v interface{}) interface{} {
+	if isEmpty(v) {
+		return def
+	}
+	return v
+}
+
+func replace(old, repl, s string) string {
+	return strings.ReplaceAll(s, old, repl)
+}
+
+func split(sep, s string) []string {
+	return strings.Split(s, sep)
+}
+
+// join concatenates the elements of the given list, which can be of any
+// slice type, into a single string.
+func join(sep string, list interface{}) (string, error) {
+	if list == nil {
+		return "", nil
+	}
+
+	l := reflect.ValueOf(list)
+	if k := l.Kind(); k != reflect.Slice && k != reflect.Array {
+		return "", fmt.Errorf("join: expected a list, got %T", list)
+	}
+
+	elems := make([]string, l.Len())
+	for i := range elems {
+		elems[i] = toString(l.Index(i).Interface())
+	}
+
+	return strings.Join(elems, sep), nil
+}
+
+func hasPrefix(prefix, s string) bool {
+	return strings.HasPrefix(s, prefix)
+}
+
+func hasSuffix(suffix, s string) bool {
+	return strings.HasSuffix(s, suffix)
+}
+
+func contains(substr, s string) bool {
+	return strings.Contains(s, substr)
+}
+
+// toString returns the textual representation of v, as it would be printed
+// by a template. Nil values are represented by an empty string.
+func toString(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// isEmpty returns whether v is nil or the zero value of its type, or an empty
+// collection.
+func isEmpty(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.String:
+		return rv.Len() == 0
+	default:
+		return rv.IsZero()
+	}
+}
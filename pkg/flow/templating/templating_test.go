@@ -0,0 +1,87 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templating
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	data := map[string]interface{}{
+		"name":  "Tom & Jerry",
+		"tags":  []interface{}{"cat", "mouse"},
+		"empty": "",
+		"obj":   map[string]interface{}{"a": 1},
+	}
+
+	testCases := map[string]struct {
+		tmpl   string
+		expect string
+	}{
+		"toJson": {
+			tmpl:   `{{ toJson .obj }}`,
+			expect: `{"a":1}`,
+		},
+		"xmlEscape": {
+			tmpl:   `<name>{{ xmlEscape .name }}</name>`,
+			expect: `<name>Tom &amp; Jerry</name>`,
+		},
+		"csvRecord": {
+			tmpl:   `{{ csvRecord .name "a,b" .missing }}`,
+			expect: `Tom & Jerry,"a,b",`,
+		},
+		"default": {
+			tmpl:   `{{ .empty | default "none" }} {{ .name | default "none" }}`,
+			expect: `none Tom & Jerry`,
+		},
+		"string pipeline": {
+			tmpl:   `{{ .name | replace "&" "and" | upper }}`,
+			expect: `TOM AND JERRY`,
+		},
+		"join and split": {
+			tmpl:   `{{ join "|" .tags }} {{ range split "," "x,y" }}[{{ . }}]{{ end }}`,
+			expect: `cat|mouse [x][y]`,
+		},
+		"predicates": {
+			tmpl:   `{{ hasPrefix "Tom" .name }} {{ hasSuffix "Tom" .name }} {{ contains "&" .name }}`,
+			expect: `true false true`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			tmpl, err := Parse(name, tc.tmpl)
+			require.NoError(t, err)
+
+			var out strings.Builder
+			err = tmpl.Execute(&out, data)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expect, out.String())
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	_, err := Parse("test", `{{ nosuchfunc . }}`)
+	assert.EqualError(t, err, `template: test:1: function "nosuchfunc" not defined`)
+}
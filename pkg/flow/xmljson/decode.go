@@ -0,0 +1,272 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package xmljson converts XML documents to JSON values and back, following
+// configurable mapping conventions.
+package xmljson
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html/charset"
+)
+
+// Default mapping conventions.
+const (
+	DefaultAttributePrefix = "-"
+	DefaultTextKey         = "#content"
+)
+
+// NamespaceMode determines how XML namespaces are reflected in JSON values.
+type NamespaceMode string
+
+// Supported namespace modes.
+const (
+	// NamespaceLocal uses the local names of elements and attributes.
+	// Namespace declarations are kept as attributes.
+	NamespaceLocal NamespaceMode = "local"
+	// NamespacePrefixed uses the names of elements and attributes as written
+	// in the document, including their namespace prefix. Namespace
+	// declarations are kept as attributes.
+	NamespacePrefixed NamespaceMode = "prefixed"
+	// NamespaceStrip uses the local names of elements and attributes, and
+	// drops namespace declarations.
+	NamespaceStrip NamespaceMode = "strip"
+)
+
+// DecodeOptions are the conventions used to convert XML to JSON.
+type DecodeOptions struct {
+	// Prefix of the keys of attributes.
+	AttributePrefix string
+	// Key of the text content of elements which also have attributes or
+	// child elements.
+	TextKey string
+	// Whether to convert numeric and boolean text to JSON numbers and
+	// booleans instead of strings.
+	InferTypes bool
+	// Names of elements which are always converted to JSON arrays, even
+	// when they occur only once.
+	ForceArray []string
+	// Handling of namespaces.
+	Namespaces NamespaceMode
+}
+
+// NewDecodeOptions returns DecodeOptions initialized with default values.
+func NewDecodeOptions() DecodeOptions {
+	return DecodeOptions{
+		AttributePrefix: DefaultAttributePrefix,
+		TextKey:         DefaultTextKey,
+		Namespaces:      NamespaceLocal,
+	}
+}
+
+// node is an XML element being decoded.
+type node struct {
+	name     string
+	attrs    []keyValue
+	children []keyValue
+	text     strings.Builder
+}
+
+type keyValue struct {
+	key string
+	val interface{}
+}
+
+// decoder converts XML elements to JSON values.
+type decoder struct {
+	DecodeOptions
+	forceArray map[string]struct{}
+}
+
+// Decode reads an XML document from r and returns its JSON representation,
+// as an object with a single key named after the root element. The members
+// of objects follow the order of attributes and elements in the document.
+func Decode(r io.Reader, opts DecodeOptions) (Object, error) {
+	d := &decoder{
+		DecodeOptions: opts,
+		forceArray:    make(map[string]struct{}, len(opts.ForceArray)),
+	}
+	for _, n := range opts.ForceArray {
+		d.forceArray[n] = struct{}{}
+	}
+
+	return d.decode(r)
+}
+
+func (d *decoder) decode(r io.Reader) (Object, error) {
+	dec := xml.NewDecoder(r)
+	// convert non-UTF-8 documents
+	dec.CharsetReader = charset.NewReaderLabel
+
+	token := dec.Token
+	if d.Namespaces == NamespacePrefixed {
+		// RawToken does not translate prefixes to namespace URLs. It also
+		// doesn't verify that start and end elements match, which is
+		// handled below.
+		token = dec.RawToken
+	}
+
+	var stack []*node
+	var root Object
+
+	for {
+		tok, err := token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if root != nil {
+				return nil, errors.New("unexpected element after the root element")
+			}
+
+			n := &node{name: d.name(t.Name)}
+			for _, a := range t.Attr {
+				if d.Namespaces == NamespaceStrip && isNamespaceDecl(a.Name) {
+					continue
+				}
+				n.attrs = append(n.attrs, keyValue{
+					key: d.AttributePrefix + d.name(a.Name),
+					val: d.scalar(a.Value),
+				})
+			}
+			stack = append(stack, n)
+
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+
+		case xml.EndElement:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("unexpected end element </%s>", d.name(t.Name))
+			}
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if name := d.name(t.Name); name != n.name {
+				return nil, fmt.Errorf("element <%s> closed by </%s>", n.name, name)
+			}
+
+			if len(stack) == 0 {
+				root = Object{{Key: n.name, Value: d.value(n)}}
+				continue
+			}
+
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, keyValue{key: n.name, val: d.value(n)})
+		}
+	}
+
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("unexpected end of document in element <%s>", stack[len(stack)-1].name)
+	}
+	if root == nil {
+		return nil, errors.New("document has no root element")
+	}
+
+	return root, nil
+}
+
+// value returns the JSON representation of the given element.
+func (d *decoder) value(n *node) interface{} {
+	text := strings.TrimFunc(n.text.String(), isNotGraphicOrSpace)
+
+	if len(n.attrs) == 0 && len(n.children) == 0 {
+		return d.scalar(text)
+	}
+
+	obj := make(Object, 0, len(n.attrs)+len(n.children)+1)
+
+	for _, a := range n.attrs {
+		obj.set(a.key, a.val)
+	}
+
+	// group children by name, repeated elements become arrays positioned
+	// at their first occurrence
+	var names []string
+	groups := make(map[string][]interface{}, len(n.children))
+	for _, c := range n.children {
+		if _, ok := groups[c.key]; !ok {
+			names = append(names, c.key)
+		}
+		groups[c.key] = append(groups[c.key], c.val)
+	}
+	for _, k := range names {
+		vals := groups[k]
+		if _, force := d.forceArray[k]; len(vals) == 1 && !force {
+			obj.set(k, vals[0])
+			continue
+		}
+		obj.set(k, vals)
+	}
+
+	if text != "" {
+		obj.set(d.TextKey, d.scalar(text))
+	}
+
+	return obj
+}
+
+// scalar returns the JSON representation of the given text.
+func (d *decoder) scalar(s string) interface{} {
+	if !d.InferTypes {
+		return s
+	}
+
+	switch {
+	case s == "true":
+		return true
+	case s == "false":
+		return false
+	case numberRegexp.MatchString(s):
+		return json.Number(s)
+	}
+	return s
+}
+
+// numberRegexp matches the textual representation of JSON numbers.
+var numberRegexp = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+// name returns the JSON key of the given XML name.
+func (d *decoder) name(n xml.Name) string {
+	if d.Namespaces == NamespacePrefixed && n.Space != "" {
+		return n.Space + ":" + n.Local
+	}
+	return n.Local
+}
+
+// isNamespaceDecl returns whether the given attribute name is a namespace
+// declaration.
+func isNamespaceDecl(n xml.Name) bool {
+	return n.Space == "xmlns" || n.Space == "" && n.Local == "xmlns"
+}
+
+func isNotGraphicOrSpace(r rune) bool {
+	return !unicode.IsGraphic(r) || unicode.IsSpace(r)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xmljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode(t *testing.T) {
+	const doc = `<?xml version="1.0" encoding="UTF-8"?>
+<ns:order xmlns:ns="urn:orders" id="42">
+  <ns:item sku="a1">
+    <qty>2</qty>
+    <gift>true</gift>
+  </ns:item>
+  <note lang="en">Leave at door</note>
+  <empty/>
+</ns:order>`
+
+	testCases := map[string]struct {
+		opts   func(*DecodeOptions)
+		expect string
+	}{
+		"defaults": {
+			opts: func(*DecodeOptions) {},
+			expect: `{"order":{"-id":"42","-ns":"urn:orders",` +
+				`"item":{"-sku":"a1","qty":"2","gift":"true"},` +
+				`"note":{"-lang":"en","#content":"Leave at door"},` +
+				`"empty":""}}`,
+		},
+		"custom keys": {
+			opts: func(o *DecodeOptions) {
+				o.AttributePrefix = "@"
+				o.TextKey = "_text"
+			},
+			expect: `{"order":{"@id":"42","@ns":"urn:orders",` +
+				`"item":{"@sku":"a1","qty":"2","gift":"true"},` +
+				`"note":{"@lang":"en","_text":"Leave at door"},` +
+				`"empty":""}}`,
+		},
+		"type inference": {
+			opts: func(o *DecodeOptions) {
+				o.InferTypes = true
+			},
+			expect: `{"order":{"-id":42,"-ns":"urn:orders",` +
+				`"item":{"-sku":"a1","qty":2,"gift":true},` +
+				`"note":{"-lang":"en","#content":"Leave at door"},` +
+				`"empty":""}}`,
+		},
+		"forced arrays": {
+			opts: func(o *DecodeOptions) {
+				o.ForceArray = []string{"item"}
+			},
+			expect: `{"order":{"-id":"42","-ns":"urn:orders",` +
+				`"item":[{"-sku":"a1","qty":"2","gift":"true"}],` +
+				`"note":{"-lang":"en","#content":"Leave at door"},` +
+				`"empty":""}}`,
+		},
+		"prefixed namespaces": {
+			opts: func(o *DecodeOptions) {
+				o.Namespaces = NamespacePrefixed
+			},
+			expect: `{"ns:order":{"-id":"42","-xmlns:ns":"urn:orders",` +
+				`"ns:item":{"-sku":"a1","qty":"2","gift":"true"},` +
+				`"note":{"-lang":"en","#content":"Leave at door"},` +
+				`"empty":""}}`,
+		},
+		"stripped namespaces": {
+			opts: func(o *DecodeOptions) {
+				o.Namespaces = NamespaceStrip
+			},
+			expect: `{"order":{"-id":"42",` +
+				`"item":{"-sku":"a1","qty":"2","gift":"true"},` +
+				`"note":{"-lang":"en","#content":"Leave at door"},` +
+				`"empty":""}}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			opts := NewDecodeOptions()
+			tc.opts(&opts)
+
+			v, err := Decode(strings.NewReader(doc), opts)
+			require.NoError(t, err)
+
+			out, err := json.Marshal(v)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, tc.expect, string(out))
+		})
+	}
+}
+
+func TestDecodeRepeatedElements(t *testing.T) {
+	const doc = `<list><v>1</v><v>2</v><w>x</w></list>`
+
+	v, err := Decode(strings.NewReader(doc), NewDecodeOptions())
+	require.NoError(t, err)
+
+	out, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"list":{"v":["1","2"],"w":"x"}}`, string(out))
+}
+
+func TestDecodeMemberOrder(t *testing.T) {
+	const doc = `<order z="1" a="2"><zip>x</zip><item>1</item><name>y</name><item>2</item>text</order>`
+
+	v, err := Decode(strings.NewReader(doc), NewDecodeOptions())
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, EncodeJSON(&out, v))
+
+	const expect = `{"order": {"-z": "1", "-a": "2", "zip": "x", "item": ["1", "2"], "name": "y", "#content": "text"}}`
+	assert.Equal(t, expect+"\n", out.String())
+}
+
+func TestDecodeJSON(t *testing.T) {
+	v, err := DecodeJSON(strings.NewReader(`{"b":{"z":[1.5,true,null],"a":"x"},"a":{},"b2":[],"b":2}`))
+	require.NoError(t, err)
+
+	expect := Object{
+		{Key: "b", Value: json.Number("2")},
+		{Key: "a", Value: Object{}},
+		{Key: "b2", Value: []interface{}{}},
+	}
+	assert.Equal(t, expect, v)
+
+	out, err := json.Marshal(v)
+	require.NoError(t, err)
+	assert.Equal(t, `{"b":2,"a":{},"b2":[]}`, string(out))
+
+	_, err = DecodeJSON(strings.NewReader(`{"a":`))
+	assert.Error(t, err)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	testCases := map[string]string{
+		"empty":            ``,
+		"not XML":          `{"a":1}`,
+		"unclosed element": `<a><b></b>`,
+		"mismatched end":   `<a></b>`,
+		"multiple roots":   `<a/><b/>`,
+	}
+
+	for name, doc := range testCases {
+		for _, ns := range []NamespaceMode{NamespaceLocal, NamespacePrefixed} {
+			//nolint:scopelint
+			t.Run(name+"/"+string(ns), func(t *testing.T) {
+				opts := NewDecodeOptions()
+				opts.Namespaces = ns
+
+				_, err := Decode(strings.NewReader(doc), opts)
+				assert.Error(t, err)
+			})
+		}
+	}
+}
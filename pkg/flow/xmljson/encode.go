@@ -0,0 +1,247 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xmljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Default encoding conventions.
+const (
+	DefaultRootElement      = "root"
+	DefaultArrayItemElement = "item"
+)
+
+// EncodeOptions are the conventions used to convert JSON to XML.
+type EncodeOptions struct {
+	// Name of the root element. When empty, the key of JSON objects which
+	// have a single member is used as the root element, otherwise the
+	// value is wrapped in an element named DefaultRootElement.
+	RootElement string
+	// Namespace URI declared on the root element.
+	Namespace string
+	// Prefix associated with Namespace. When empty, Namespace is declared
+	// as the default namespace.
+	NamespacePrefix string
+	// Name of the elements wrapping the items of JSON arrays which do not
+	// belong to an object member, such as nested or top-level arrays.
+	ArrayItemElement string
+	// Prefix of the keys converted to attributes.
+	AttributePrefix string
+	// Key converted to the text content of an element.
+	TextKey string
+}
+
+// NewEncodeOptions returns EncodeOptions initialized with default values.
+func NewEncodeOptions() EncodeOptions {
+	return EncodeOptions{
+		ArrayItemElement: DefaultArrayItemElement,
+		AttributePrefix:  DefaultAttributePrefix,
+		TextKey:          DefaultTextKey,
+	}
+}
+
+// encoder converts JSON values to XML elements.
+type encoder struct {
+	EncodeOptions
+	buf bytes.Buffer
+}
+
+// Encode writes the XML representation of the given JSON value to w.
+//
+// The value is expected to have the types produced by DecodeJSON, or by
+// encoding/json when decoding to an interface{}. Elements follow the order
+// of the members of Object values, and the order of keys for maps.
+func Encode(w io.Writer, v interface{}, opts EncodeOptions) error {
+	if opts.ArrayItemElement == "" {
+		opts.ArrayItemElement = DefaultArrayItemElement
+	}
+
+	e := &encoder{EncodeOptions: opts}
+
+	root, content := e.root(v)
+
+	e.buf.WriteString(xml.Header)
+	if err := e.element(root, content, true); err != nil {
+		return err
+	}
+
+	_, err := e.buf.WriteTo(w)
+	return err
+}
+
+// root returns the name of the root element and its content.
+func (e *encoder) root(v interface{}) (string, interface{}) {
+	if e.RootElement != "" {
+		return e.RootElement, v
+	}
+
+	if m, ok := v.(map[string]interface{}); ok {
+		v = objectFromMap(m)
+	}
+
+	if obj, ok := v.(Object); ok && len(obj) == 1 {
+		if k := obj[0].Key; !e.isAttribute(k) && k != e.TextKey {
+			return k, obj[0].Value
+		}
+	}
+
+	return DefaultRootElement, v
+}
+
+// element writes an XML element with the given name and content.
+func (e *encoder) element(name string, v interface{}, isRoot bool) error {
+	if !IsValidName(name) {
+		return fmt.Errorf("%q is not a valid XML element name", name)
+	}
+	if e.NamespacePrefix != "" && !strings.ContainsRune(name, ':') {
+		name = e.NamespacePrefix + ":" + name
+	}
+
+	e.buf.WriteByte('<')
+	e.buf.WriteString(name)
+
+	if isRoot && e.Namespace != "" {
+		attr := "xmlns"
+		if e.NamespacePrefix != "" {
+			attr += ":" + e.NamespacePrefix
+		}
+		e.attribute(attr, e.Namespace)
+	}
+
+	if m, ok := v.(map[string]interface{}); ok {
+		v = objectFromMap(m)
+	}
+
+	if obj, ok := v.(Object); ok {
+		for _, m := range obj {
+			if !e.isAttribute(m.Key) {
+				continue
+			}
+			attr := strings.TrimPrefix(m.Key, e.AttributePrefix)
+			if !IsValidName(attr) {
+				return fmt.Errorf("%q is not a valid XML attribute name", attr)
+			}
+			e.attribute(attr, scalarText(m.Value))
+		}
+	}
+
+	e.buf.WriteByte('>')
+
+	switch val := v.(type) {
+	case Object:
+		if text, ok := val.Get(e.TextKey); ok {
+			e.text(scalarText(text))
+		}
+		for _, m := range val {
+			if e.isAttribute(m.Key) || m.Key == e.TextKey {
+				continue
+			}
+			if err := e.member(m.Key, m.Value); err != nil {
+				return err
+			}
+		}
+
+	case []interface{}:
+		for _, item := range val {
+			if err := e.element(e.ArrayItemElement, item, false); err != nil {
+				return err
+			}
+		}
+
+	default:
+		e.text(scalarText(val))
+	}
+
+	e.buf.WriteString("</")
+	e.buf.WriteString(name)
+	e.buf.WriteByte('>')
+
+	return nil
+}
+
+// member writes the XML representation of an object member. Arrays are
+// converted to repeated elements.
+func (e *encoder) member(name string, v interface{}) error {
+	arr, ok := v.([]interface{})
+	if !ok {
+		return e.element(name, v, false)
+	}
+
+	for _, item := range arr {
+		if err := e.element(name, item, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attribute writes an XML attribute.
+func (e *encoder) attribute(name, value string) {
+	e.buf.WriteByte(' ')
+	e.buf.WriteString(name)
+	e.buf.WriteString(`="`)
+	e.text(value)
+	e.buf.WriteByte('"')
+}
+
+// text writes escaped character data.
+func (e *encoder) text(s string) {
+	// writes to a bytes.Buffer never fail
+	_ = xml.EscapeText(&e.buf, []byte(s))
+}
+
+// isAttribute returns whether the given object key represents an attribute.
+func (e *encoder) isAttribute(key string) bool {
+	return e.AttributePrefix != "" && len(key) > len(e.AttributePrefix) &&
+		strings.HasPrefix(key, e.AttributePrefix)
+}
+
+// scalarText returns the textual representation of a JSON scalar.
+func scalarText(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case json.Number:
+		return val.String()
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// nameRegexp matches valid XML names, optionally qualified with a namespace
+// prefix.
+var nameRegexp = regexp.MustCompile(`^[\pL_][\pL\pN_.-]*(:[\pL_][\pL\pN_.-]*)?$`)
+
+// IsValidName returns whether the given string is a valid XML element or
+// attribute name, optionally qualified with a namespace prefix.
+func IsValidName(name string) bool {
+	return nameRegexp.MatchString(name)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xmljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	testCases := map[string]struct {
+		input  string
+		opts   func(*EncodeOptions)
+		expect string
+	}{
+		"single key object": {
+			input:  `{"note":{"-lang":"en","to":"Tove","#content":"hi & bye"}}`,
+			opts:   func(*EncodeOptions) {},
+			expect: `<note lang="en">hi &amp; bye<to>Tove</to></note>`,
+		},
+		"multiple keys object": {
+			input:  `{"b":2.5,"a":true,"c":null}`,
+			opts:   func(*EncodeOptions) {},
+			expect: `<root><a>true</a><b>2.5</b><c></c></root>`,
+		},
+		"repeated elements": {
+			input:  `{"list":{"v":[1,2]}}`,
+			opts:   func(*EncodeOptions) {},
+			expect: `<list><v>1</v><v>2</v></list>`,
+		},
+		"top-level array": {
+			input:  `[{"a":1},"x",[2]]`,
+			opts:   func(*EncodeOptions) {},
+			expect: `<root><item><a>1</a></item><item>x</item><item><item>2</item></item></root>`,
+		},
+		"custom names": {
+			input: `{"@id":"1","_text":"x","n":[["a"]]}`,
+			opts: func(o *EncodeOptions) {
+				o.RootElement = "doc"
+				o.ArrayItemElement = "entry"
+				o.AttributePrefix = "@"
+				o.TextKey = "_text"
+			},
+			expect: `<doc id="1">x<n><entry>a</entry></n></doc>`,
+		},
+		"default namespace": {
+			input: `{"a":1}`,
+			opts: func(o *EncodeOptions) {
+				o.Namespace = "urn:test"
+			},
+			expect: `<a xmlns="urn:test">1</a>`,
+		},
+		"prefixed namespace": {
+			input: `{"a":{"b":1}}`,
+			opts: func(o *EncodeOptions) {
+				o.Namespace = "urn:test"
+				o.NamespacePrefix = "t"
+			},
+			expect: `<t:a xmlns:t="urn:test"><t:b>1</t:b></t:a>`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			opts := NewEncodeOptions()
+			tc.opts(&opts)
+
+			var out bytes.Buffer
+			err := Encode(&out, unmarshal(t, tc.input), opts)
+			require.NoError(t, err)
+
+			assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+tc.expect, out.String())
+		})
+	}
+}
+
+func TestEncodeMemberOrder(t *testing.T) {
+	v, err := DecodeJSON(strings.NewReader(`{"order":{"-z":"1","-a":"2","zip":"x","name":"y","items":[3,1]}}`))
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	err = Encode(&out, v, NewEncodeOptions())
+	require.NoError(t, err)
+
+	const expect = `<order z="1" a="2"><zip>x</zip><name>y</name><items>3</items><items>1</items></order>`
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+expect, out.String())
+}
+
+func TestEncodeInvalidName(t *testing.T) {
+	var out bytes.Buffer
+	err := Encode(&out, unmarshal(t, `{"a":{"1b":true}}`), NewEncodeOptions())
+	assert.EqualError(t, err, `"1b" is not a valid XML element name`)
+}
+
+func TestRoundTrip(t *testing.T) {
+	const doc = `<order id="42"><item sku="a1"><qty>2</qty><desc>x</desc></item><item sku="b2"><qty>1</qty></item><customer>me</customer></order>`
+
+	v, err := Decode(strings.NewReader(doc), NewDecodeOptions())
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	err = Encode(&out, v, NewEncodeOptions())
+	require.NoError(t, err)
+
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+doc, out.String())
+}
+
+func unmarshal(t *testing.T, s string) interface{} {
+	t.Helper()
+
+	d := json.NewDecoder(strings.NewReader(s))
+	d.UseNumber()
+
+	var v interface{}
+	require.NoError(t, d.Decode(&v))
+	return v
+}
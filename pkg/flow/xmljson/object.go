@@ -0,0 +1,202 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package xmljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Object is a JSON object which preserves the order of its members, so that
+// the order of XML elements survives conversions.
+type Object []Member
+
+// Member is a member of a JSON Object.
+type Member struct {
+	Key   string
+	Value interface{}
+}
+
+// Get returns the value of the member with the given key.
+func (o Object) Get(key string) (interface{}, bool) {
+	for _, m := range o {
+		if m.Key == key {
+			return m.Value, true
+		}
+	}
+	return nil, false
+}
+
+// set sets the value of the member with the given key, appending a new
+// member if the key doesn't exist.
+func (o *Object) set(key string, v interface{}) {
+	for i := range *o {
+		if (*o)[i].Key == key {
+			(*o)[i].Value = v
+			return
+		}
+	}
+	*o = append(*o, Member{Key: key, Value: v})
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o Object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeJSON(&buf, o, ","); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// objectFromMap returns the members of the given map as an Object, sorted by
+// key.
+func objectFromMap(m map[string]interface{}) Object {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	obj := make(Object, 0, len(keys))
+	for _, k := range keys {
+		obj = append(obj, Member{Key: k, Value: m[k]})
+	}
+	return obj
+}
+
+// DecodeJSON reads a JSON value from r. Objects are decoded to Object values
+// in order to preserve the order of their members, and numbers are decoded to
+// json.Number values.
+func DecodeJSON(r io.Reader) (interface{}, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	return decodeJSONValue(dec)
+}
+
+// decodeJSONValue decodes the next JSON value from the given decoder.
+func decodeJSONValue(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		obj := Object{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			v, err := decodeJSONValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			// like encoding/json, the last duplicate key wins
+			obj.set(key.(string), v)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return obj, nil
+
+	case json.Delim('['):
+		arr := []interface{}{}
+		for dec.More() {
+			v, err := decodeJSONValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return arr, nil
+	}
+
+	return tok, nil
+}
+
+// EncodeJSON writes the JSON encoding of the given value to w, followed by a
+// newline. Members and items are separated by a comma and a space.
+func EncodeJSON(w io.Writer, v interface{}) error {
+	var buf bytes.Buffer
+	if err := writeJSON(&buf, v, ", "); err != nil {
+		return err
+	}
+	buf.WriteByte('\n')
+
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// writeJSON writes the JSON encoding of the given value to buf, using the
+// given separator between members and items. A space is written after the
+// colon of object members whenever the separator ends with a space.
+func writeJSON(buf *bytes.Buffer, v interface{}, sep string) error {
+	colon := ":"
+	if sep != "" && sep[len(sep)-1] == ' ' {
+		colon = ": "
+	}
+
+	switch val := v.(type) {
+	case Object:
+		buf.WriteByte('{')
+		for i, m := range val {
+			if i > 0 {
+				buf.WriteString(sep)
+			}
+			if err := writeJSON(buf, m.Key, sep); err != nil {
+				return err
+			}
+			buf.WriteString(colon)
+			if err := writeJSON(buf, m.Value, sep); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+
+	case map[string]interface{}:
+		return writeJSON(buf, objectFromMap(val), sep)
+
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, item := range val {
+			if i > 0 {
+				buf.WriteString(sep)
+			}
+			if err := writeJSON(buf, item, sep); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+
+	default:
+		b, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Errorf("encoding JSON value: %w", err)
+		}
+		buf.Write(b)
+	}
+
+	return nil
+}
@@ -129,6 +129,58 @@ func TestEventProcessingStatsReporter(t *testing.T) {
 	})
 }
 
+func TestRequestRejectionStatsReporter(t *testing.T) {
+	const (
+		tRg   = "foos.fake.example.com"
+		tNs   = "test-ns"
+		tName = "test"
+	)
+
+	testMetricTags := &pkgadapter.MetricTag{
+		ResourceGroup: tRg,
+		Namespace:     tNs,
+		Name:          tName,
+	}
+
+	st := MustNewRequestRejectionStatsReporter(testMetricTags)
+
+	wantCommonTags := map[string]string{
+		"resource_group": tRg,
+		"namespace_name": tNs,
+		"name":           tName,
+	}
+
+	t.Run("record without caller", func(t *testing.T) {
+		metricstesting.ResetMetrics(t)
+
+		st.ReportRejection(RejectReasonUnauthenticated, "")
+
+		metricstest.CheckCountData(t,
+			"request_rejected_count",
+			appendTags(wantCommonTags, map[string]string{
+				"reason": RejectReasonUnauthenticated,
+			}),
+			1,
+		)
+	})
+
+	t.Run("record with caller", func(t *testing.T) {
+		metricstesting.ResetMetrics(t)
+
+		st.ReportRejection(RejectReasonRateLimited, "apikey/test")
+		st.ReportRejection(RejectReasonRateLimited, "apikey/test")
+
+		metricstest.CheckCountData(t,
+			"request_rejected_count",
+			appendTags(wantCommonTags, map[string]string{
+				"reason": RejectReasonRateLimited,
+				"caller": "apikey/test",
+			}),
+			2,
+		)
+	})
+}
+
 // appendTags returns a copy of the given metrics tags with extra key/values inserted.
 func appendTags(tags, kvs map[string]string) map[string]string {
 	tagsCpy := make(map[string]string, len(tags)+len(kvs))
@@ -0,0 +1,120 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/metrics"
+)
+
+const (
+	metricNameRequestRejectedCount = "request_rejected_count"
+
+	// Identifies the kind of caller which sent a rejected request, e.g. the
+	// authentication method it used.
+	labelCaller = "caller"
+	// Conveys the reason why a request was rejected.
+	labelReason = "reason"
+)
+
+var (
+	tagKeyCaller = tag.MustNewKey(labelCaller)
+	tagKeyReason = tag.MustNewKey(labelReason)
+)
+
+// Reasons for rejecting a request.
+const (
+	RejectReasonUnauthenticated = "unauthenticated"
+	RejectReasonRateLimited     = "rate_limited"
+	RejectReasonQuotaExceeded   = "quota_exceeded"
+	RejectReasonForbiddenEvent  = "forbidden_event"
+	RejectReasonInvalidEvent    = "invalid_event"
+)
+
+// requestRejectedCountM is a measure of the number of requests that were
+// rejected by a component before their event could be processed.
+var requestRejectedCountM = stats.Int64(
+	metricNameRequestRejectedCount,
+	"Number of requests rejected by the HTTP handler",
+	stats.UnitDimensionless,
+)
+
+// MustRegisterRequestRejectionStatsView registers an OpenCensus stats view for
+// metrics related to rejected requests, and panics in case of error.
+func MustRegisterRequestRejectionStatsView() {
+	err := view.Register(
+		&view.View{
+			Measure:     requestRejectedCountM,
+			Description: requestRejectedCountM.Description(),
+			Aggregation: view.Count(),
+			TagKeys: []tag.Key{
+				tagKeyResourceGroup,
+				tagKeyNamespace,
+				tagKeyName,
+				tagKeyCaller,
+				tagKeyReason,
+			},
+		},
+	)
+	if err != nil {
+		panic(fmt.Errorf("error registering OpenCensus stats view: %w", err))
+	}
+}
+
+// RequestRejectionStatsReporter collects and reports stats about rejected requests.
+type RequestRejectionStatsReporter struct {
+	// context that holds pre-populated OpenCensus tags
+	tagsCtx context.Context
+}
+
+// MustNewRequestRejectionStatsReporter returns a new RequestRejectionStatsReporter
+// initialized with the given tags and panics in case of error.
+func MustNewRequestRejectionStatsReporter(tags *pkgadapter.MetricTag) *RequestRejectionStatsReporter {
+	ctx, err := tag.New(context.Background(),
+		tag.Insert(tagKeyResourceGroup, tags.ResourceGroup),
+		tag.Insert(tagKeyNamespace, tags.Namespace),
+		tag.Insert(tagKeyName, tags.Name),
+	)
+	if err != nil {
+		panic(fmt.Errorf("error creating OpenCensus tags: %w", err))
+	}
+
+	return &RequestRejectionStatsReporter{
+		tagsCtx: ctx,
+	}
+}
+
+// ReportRejection increments requestRejectedCountM. The caller is empty when
+// the request could not be authenticated. It must take a bounded set of
+// values, such as an authentication method, to keep the cardinality of the
+// metric bounded.
+func (r *RequestRejectionStatsReporter) ReportRejection(reason, caller string) {
+	tms := []tag.Mutator{tag.Insert(tagKeyReason, reason)}
+	if caller != "" {
+		tms = append(tms, tag.Insert(tagKeyCaller, caller))
+	}
+
+	tagsCtx, _ := tag.New(r.tagsCtx, tms...)
+	metrics.Record(tagsCtx, requestRejectedCountM.M(1))
+}
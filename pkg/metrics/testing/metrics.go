@@ -35,22 +35,26 @@ func ResetMetrics(t *testing.T) {
 	UnregisterMetrics()
 
 	metrics.MustRegisterEventProcessingStatsView()
+	metrics.MustRegisterRequestRejectionStatsView()
 
 	metricstest.AssertNoMetric(t,
 		"event_processing_success_count",
 		"event_processing_error_count",
 		"event_processing_latencies",
+		"request_rejected_count",
 	)
 }
 
 // UnregisterMetrics unregisters the metrics that were registered in the global
 // state of OpenCensus.
 // Can be used instead of ResetMetrics to avoid panics in tests that already
-// call metrics.MustRegisterEventProcessingStatsView.
+// call metrics.MustRegisterEventProcessingStatsView or
+// metrics.MustRegisterRequestRejectionStatsView.
 func UnregisterMetrics() {
 	metricstest.Unregister(
 		"event_processing_success_count",
 		"event_processing_error_count",
 		"event_processing_latencies",
+		"request_rejected_count",
 	)
 }
@@ -36,6 +36,12 @@ const (
 	EnvSecretAccessKey = "AWS_SECRET_ACCESS_KEY" //nolint:gosec
 	EnvEndpointURL     = "AWS_ENDPOINT_URL"
 
+	// AWS role assumption attributes
+	EnvAssumeRoleARN         = "AWS_ASSUME_ROLE_ARN"
+	EnvAssumeRoleExternalID  = "AWS_ASSUME_ROLE_EXTERNAL_ID"
+	EnvAssumeRoleSessionName = "AWS_ASSUME_ROLE_SESSION_NAME"
+	EnvAssumeRoleSessionTags = "AWS_ASSUME_ROLE_SESSION_TAGS"
+
 	// Common Azure attributes
 	EnvAADTenantID     = "AZURE_TENANT_ID"
 	EnvAADClientID     = "AZURE_CLIENT_ID"
@@ -55,6 +61,10 @@ const (
 	EnvServiceBusKeyValue         = "SERVICEBUS_KEY_VALUE"
 	EnvServiceBusConnStr          = "SERVICEBUS_CONNECTION_STRING"
 	EnvServiceBusEntityResourceID = "SERVICEBUS_ENTITY_RESOURCE_ID"
+	EnvServiceBusMaxConcurrency   = "SERVICEBUS_MAX_CONCURRENCY"
+	EnvServiceBusRetryBackoff     = "SERVICEBUS_RETRY_BACKOFF"
+	EnvServiceBusMaxDeliveries    = "SERVICEBUS_MAX_DELIVERY_ATTEMPTS"
+	EnvServiceBusSessions         = "SERVICEBUS_SESSIONS_ENABLED"
 
 	// Common Google Cloud attributes
 	EnvGCloudSAKey = "GCLOUD_SERVICEACCOUNT_KEY"
@@ -325,8 +325,9 @@ func (r *GenericRBACReconciler[T, L]) reconcileRBAC(ctx context.Context,
 
 	// Bind serviceAccount to "{kind}-adapter" clusterRole.
 	// Multi-tenant adapters require extra permissions to interact with
-	// objects of their kind.
-	if v1alpha1.IsMultiTenant(rcl) {
+	// objects of their kind. Some single-tenant adapters also require
+	// extra permissions depending on their configuration.
+	if v1alpha1.WantsAdapterClusterRole(rcl) {
 		desiredRB := newMTAdapterRoleBinding(rcl, currentSA)
 		currentRB, err := r.getOrCreateAdapterRoleBinding(ctx, desiredRB)
 		if err != nil {
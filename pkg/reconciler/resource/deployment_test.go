@@ -55,6 +55,7 @@ func TestNewDeploymentWithDefaultContainer(t *testing.T) {
 		Port("h2c", 8080),
 		Image(tImg),
 		PodLabel("test.podlabel/1", "val1"),
+		PodAnnotation("test.podannot/1", "val1"),
 		EnvVar("TEST_ENV1", "val1"),
 		Selector("test.selector/2", "val2"),
 		Port("health", 8081),
@@ -97,6 +98,9 @@ func TestNewDeploymentWithDefaultContainer(t *testing.T) {
 						"test.podlabel/1": "val1",
 						"test.podlabel/2": "val2",
 					},
+					Annotations: map[string]string{
+						"test.podannot/1": "val1",
+					},
 				},
 				Spec: corev1.PodSpec{
 					ServiceAccountName: "god-mode",
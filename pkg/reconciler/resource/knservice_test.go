@@ -54,6 +54,7 @@ func TestNewServiceWithDefaultContainer(t *testing.T) {
 		Port("health", 8081),
 		Image(tImg),
 		PodLabel("test.podlabel/1", "val1"),
+		PodAnnotation("test.podannot/1", "val1"),
 		EnvVar("TEST_ENV1", "val1"),
 		Port("h2c", 8080), // overrides previously defined port
 		Label("test.label/1", "val1"),
@@ -89,6 +90,9 @@ func TestNewServiceWithDefaultContainer(t *testing.T) {
 							"test.podlabel/1": "val1",
 							"test.podlabel/2": "val2",
 						},
+						Annotations: map[string]string{
+							"test.podannot/1": "val1",
+						},
 					},
 					Spec: servingv1.RevisionSpec{
 						PodSpec: corev1.PodSpec{
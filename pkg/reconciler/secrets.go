@@ -0,0 +1,108 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
+)
+
+// secretsMountPath is the directory under which Secrets are mounted inside
+// the adapter's container when a component instance requests hot reload of
+// its Secrets.
+const secretsMountPath = "/var/run/triggermesh/secrets"
+
+// EnvFileSuffix is appended to the name of an environment variable to denote
+// a variable that contains the path of a file holding the actual value,
+// rather than the value itself.
+const EnvFileSuffix = "_FILE"
+
+// SecretEnvVar returns an ObjectOption which exposes the value referenced by
+// the given Secret key selector to the adapter of the given component
+// instance.
+//
+// The value is exposed through the environment variable with the given name,
+// unless the component instance requests hot reload of its Secrets. In this
+// case, the Secret is mounted as a file, and the path of this file is exposed
+// through the environment variable with the given name suffixed by "_FILE".
+func SecretEnvVar(rcl v1alpha1.Reconcilable, name string, sel *corev1.SecretKeySelector) resource.ObjectOption {
+	if sel == nil {
+		return func(interface{}) {}
+	}
+
+	if !v1alpha1.WantsSecretsHotReload(rcl) {
+		return resource.EnvVars(corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: sel,
+			},
+		})
+	}
+
+	volName := secretVolumeName(name)
+	mountPath := filepath.Join(secretsMountPath, volName)
+
+	vol := corev1.Volume{
+		Name: volName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: sel.Name,
+				Items: []corev1.KeyToPath{{
+					Key:  sel.Key,
+					Path: sel.Key,
+				}},
+				Optional: sel.Optional,
+			},
+		},
+	}
+
+	// Secret volumes mounted using a subPath do not receive updates, so
+	// the entire volume is mounted.
+	vm := corev1.VolumeMount{
+		Name:      volName,
+		ReadOnly:  true,
+		MountPath: mountPath,
+	}
+
+	return func(object interface{}) {
+		resource.Volumes(vol)(object)
+		resource.VolumeMounts(vm)(object)
+		resource.EnvVar(name+EnvFileSuffix, filepath.Join(mountPath, sel.Key))(object)
+	}
+}
+
+// ValueFromEnvVar is like SecretEnvVar, but accepts a ValueFromField. Literal
+// values are always exposed as environment variables.
+func ValueFromEnvVar(rcl v1alpha1.Reconcilable, name string, valueFrom v1alpha1.ValueFromField) resource.ObjectOption {
+	if vfs := valueFrom.ValueFromSecret; vfs != nil {
+		return SecretEnvVar(rcl, name, vfs)
+	}
+
+	return resource.EnvVars(MaybeAppendValueFromEnvVar(nil, name, valueFrom)...)
+}
+
+// secretVolumeName returns the name of the volume used to mount the Secret
+// that backs the environment variable with the given name.
+// e.g. "SPLUNK_HEC_TOKEN" -> "secret-splunk-hec-token"
+func secretVolumeName(envName string) string {
+	return "secret-" + strings.ReplaceAll(strings.ToLower(envName), "_", "-")
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+func TestSecretEnvVar(t *testing.T) {
+	sel := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: "my-secret",
+		},
+		Key: "token",
+	}
+
+	t.Run("environment variable", func(t *testing.T) {
+		svc := &servingv1.Service{}
+		SecretEnvVar(&v1alpha1.SplunkTarget{}, "MY_TOKEN", sel)(svc)
+
+		podSpec := svc.Spec.Template.Spec
+		assert.Empty(t, podSpec.Volumes)
+		assert.Empty(t, podSpec.Containers[0].VolumeMounts)
+		assert.Equal(t, []corev1.EnvVar{{
+			Name: "MY_TOKEN",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: sel,
+			},
+		}}, podSpec.Containers[0].Env)
+	})
+
+	t.Run("mounted file", func(t *testing.T) {
+		trg := &v1alpha1.SplunkTarget{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					commonv1alpha1.AnnotationSecretsHotReload: "true",
+				},
+			},
+		}
+
+		svc := &servingv1.Service{}
+		SecretEnvVar(trg, "MY_TOKEN", sel)(svc)
+
+		podSpec := svc.Spec.Template.Spec
+		assert.Equal(t, []corev1.Volume{{
+			Name: "secret-my-token",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: "my-secret",
+					Items: []corev1.KeyToPath{{
+						Key:  "token",
+						Path: "token",
+					}},
+				},
+			},
+		}}, podSpec.Volumes)
+		assert.Equal(t, []corev1.VolumeMount{{
+			Name:      "secret-my-token",
+			ReadOnly:  true,
+			MountPath: "/var/run/triggermesh/secrets/secret-my-token",
+		}}, podSpec.Containers[0].VolumeMounts)
+		assert.Equal(t, []corev1.EnvVar{{
+			Name:  "MY_TOKEN_FILE",
+			Value: "/var/run/triggermesh/secrets/secret-my-token/token",
+		}}, podSpec.Containers[0].Env)
+	})
+}
+
+func TestValueFromEnvVar(t *testing.T) {
+	trg := &v1alpha1.SplunkTarget{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				commonv1alpha1.AnnotationSecretsHotReload: "true",
+			},
+		},
+	}
+
+	svc := &servingv1.Service{}
+	ValueFromEnvVar(trg, "MY_TOKEN", commonv1alpha1.ValueFromField{Value: "s3cr3t"})(svc)
+
+	podSpec := svc.Spec.Template.Spec
+	assert.Empty(t, podSpec.Volumes)
+	assert.Equal(t, []corev1.EnvVar{{
+		Name:  "MY_TOKEN",
+		Value: "s3cr3t",
+	}}, podSpec.Containers[0].Env)
+}
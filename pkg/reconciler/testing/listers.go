@@ -139,11 +139,21 @@ func (l *Listers) GetJQTransformationLister() flowlistersv1alpha1.JQTransformati
 	return flowlistersv1alpha1.NewJQTransformationLister(l.IndexerFor(&flowv1alpha1.JQTransformation{}))
 }
 
+// GetJSONToXMLTransformationLister returns a Lister for JSONToXMLTransformation objects.
+func (l *Listers) GetJSONToXMLTransformationLister() flowlistersv1alpha1.JSONToXMLTransformationLister {
+	return flowlistersv1alpha1.NewJSONToXMLTransformationLister(l.IndexerFor(&flowv1alpha1.JSONToXMLTransformation{}))
+}
+
 // GetSynchronizerLister returns a Lister for Synchronizer objects.
 func (l *Listers) GetSynchronizerLister() flowlistersv1alpha1.SynchronizerLister {
 	return flowlistersv1alpha1.NewSynchronizerLister(l.IndexerFor(&flowv1alpha1.Synchronizer{}))
 }
 
+// GetTemplateTransformationLister returns a Lister for TemplateTransformation objects.
+func (l *Listers) GetTemplateTransformationLister() flowlistersv1alpha1.TemplateTransformationLister {
+	return flowlistersv1alpha1.NewTemplateTransformationLister(l.IndexerFor(&flowv1alpha1.TemplateTransformation{}))
+}
+
 // GetTransformationLister returns a Lister for Transformation objects.
 func (l *Listers) GetTransformationLister() flowlistersv1alpha1.TransformationLister {
 	return flowlistersv1alpha1.NewTransformationLister(l.IndexerFor(&flowv1alpha1.Transformation{}))
@@ -284,6 +294,11 @@ func (l *Listers) GetHTTPPollerSourceLister() sourceslistersv1alpha1.HTTPPollerS
 	return sourceslistersv1alpha1.NewHTTPPollerSourceLister(l.IndexerFor(&sourcesv1alpha1.HTTPPollerSource{}))
 }
 
+// GetJiraSourceLister returns a Lister for JiraSource objects.
+func (l *Listers) GetJiraSourceLister() sourceslistersv1alpha1.JiraSourceLister {
+	return sourceslistersv1alpha1.NewJiraSourceLister(l.IndexerFor(&sourcesv1alpha1.JiraSource{}))
+}
+
 // GetOCIMetricsSourceLister returns a Lister for OCIMetricsSource objects.
 func (l *Listers) GetOCIMetricsSourceLister() sourceslistersv1alpha1.OCIMetricsSourceLister {
 	return sourceslistersv1alpha1.NewOCIMetricsSourceLister(l.IndexerFor(&sourcesv1alpha1.OCIMetricsSource{}))
@@ -444,6 +459,11 @@ func (l *Listers) GetOracleTargetLister() targetslistersv1alpha1.OracleTargetLis
 	return targetslistersv1alpha1.NewOracleTargetLister(l.IndexerFor(&targetsv1alpha1.OracleTarget{}))
 }
 
+// GetPrometheusTargetLister returns a Lister for PrometheusTarget objects.
+func (l *Listers) GetPrometheusTargetLister() targetslistersv1alpha1.PrometheusTargetLister {
+	return targetslistersv1alpha1.NewPrometheusTargetLister(l.IndexerFor(&targetsv1alpha1.PrometheusTarget{}))
+}
+
 // GetSalesforceTargetLister returns a Lister for SalesforceTarget objects.
 func (l *Listers) GetSalesforceTargetLister() targetslistersv1alpha1.SalesforceTargetLister {
 	return targetslistersv1alpha1.NewSalesforceTargetLister(l.IndexerFor(&targetsv1alpha1.SalesforceTarget{}))
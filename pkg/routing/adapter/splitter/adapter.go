@@ -35,6 +35,7 @@ import (
 	"knative.dev/pkg/injection"
 	"knative.dev/pkg/logging"
 
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
 	informerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/splitter"
 	routinglisters "github.com/triggermesh/triggermesh/pkg/client/generated/listers/routing/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/env"
@@ -125,13 +126,12 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	for i, e := range h.split(s.Spec.Path, event) {
-		e.SetID(fmt.Sprintf("%s-%d", event.ID(), i))
-		e.SetType(s.Spec.CEContext.Type)
-		e.SetSource(s.Spec.CEContext.Source)
-		for key, value := range s.Spec.CEContext.Extensions {
-			e.SetExtension(key, value)
-		}
+	events, err := Split(&s.Spec, event)
+	if err != nil {
+		h.logger.Errorw("Failed to split the event", zap.Error(err))
+	}
+
+	for _, e := range events {
 		// we may want to keep responses and send them back to the source
 		_, err := h.sendEvent(ctx, request.Header, s.Status.SinkURI.String(), e)
 		if err != nil {
@@ -142,23 +142,46 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 	writer.WriteHeader(http.StatusOK)
 }
 
-func (h *Handler) split(path string, e *event.Event) []*event.Event {
+// rootPath is the GJSON path which selects the root of the data. It is used
+// when the spec doesn't set any path.
+const rootPath = "@this"
+
+// Split returns the events produced by splitting the given event according to
+// the Splitter spec. Each element of the array located at the spec's path
+// becomes the payload of a new event. Elements which can not be set as event
+// data are skipped, and the last such error is returned alongside the events
+// that could be produced.
+func Split(spec *v1alpha1.SplitterSpec, e *event.Event) ([]*event.Event, error) {
 	var result []*event.Event
+	var err error
+
+	path := spec.Path
+	if path == "" {
+		path = rootPath
+	}
 
 	val := gjson.Get(string(e.Data()), path)
 	if !val.IsArray() {
-		return result
+		return result, nil
 	}
-	for _, v := range val.Array() {
+	for i, v := range val.Array() {
 		newCE := cloudevents.NewEvent()
-		if err := newCE.SetData(cloudevents.ApplicationJSON, []byte(v.Raw)); err != nil {
-			h.logger.Errorw("Failed to set event data", zap.Error(err))
+		if setErr := newCE.SetData(cloudevents.ApplicationJSON, []byte(v.Raw)); setErr != nil {
+			err = fmt.Errorf("setting data of element %d: %w", i, setErr)
 			continue
 		}
 		newCE.DataBase64 = false
+
+		newCE.SetID(fmt.Sprintf("%s-%d", e.ID(), i))
+		newCE.SetType(spec.CEContext.Type)
+		newCE.SetSource(spec.CEContext.Source)
+		for key, value := range spec.CEContext.Extensions {
+			newCE.SetExtension(key, value)
+		}
+
 		result = append(result, &newCE)
 	}
-	return result
+	return result, err
 }
 
 func (h *Handler) sendEvent(ctx context.Context, headers http.Header, target string, event *cloudevents.Event) (*http.Response, error) {
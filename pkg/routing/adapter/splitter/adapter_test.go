@@ -150,6 +150,52 @@ func TestAdapter(t *testing.T) {
 	}
 }
 
+func TestSplit(t *testing.T) {
+	testCases := map[string]struct {
+		path        string
+		input       string
+		expectParts []string
+	}{
+		"Nested path": {
+			path:        "items",
+			input:       `{"items":[{"id":5},{"id":10}]}`,
+			expectParts: []string{`{"id":5}`, `{"id":10}`},
+		},
+		"Root path": {
+			input:       `[{"id":5},{"id":10}]`,
+			expectParts: []string{`{"id":5}`, `{"id":10}`},
+		},
+		"Path not matching an array": {
+			path:  "items",
+			input: `{"items":{"id":5}}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			spec := &v1alpha1.SplitterSpec{
+				Path: tc.path,
+				CEContext: v1alpha1.CloudEventContext{
+					Type:   "io.test.item",
+					Source: "test",
+				},
+			}
+
+			ce := newCloudEvent(t, tc.input)
+
+			events, err := Split(spec, &ce)
+			require.NoError(t, err)
+
+			var parts []string
+			for _, e := range events {
+				parts = append(parts, string(e.Data()))
+			}
+			assert.Equal(t, tc.expectParts, parts)
+		})
+	}
+}
+
 func newCloudEvent(t *testing.T, data string) cloudevents.Event {
 	event := cloudevents.NewEvent()
 	event.SetID(tCloudEventID)
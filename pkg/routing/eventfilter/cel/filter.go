@@ -18,6 +18,7 @@ package cel
 
 import (
 	"context"
+	"fmt"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/google/cel-go/cel"
@@ -44,6 +45,18 @@ type Variable struct {
 // Filter parses Event payload values defined as the expression variables, asserts their types,
 // and executes CEL Program. If expression result is true, Event passes the filter.
 func (c *ConditionalFilter) Filter(ctx context.Context, event cloudevents.Event) eventfilter.FilterResult {
+	pass, _, err := c.Eval(event)
+	if err != nil || pass {
+		return eventfilter.PassFilter
+	}
+
+	return eventfilter.FailFilter
+}
+
+// Eval parses Event payload values defined as the expression variables and
+// executes CEL Program. Alongside the result of the expression, it returns the
+// values which were assigned to each variable, keyed by variable name.
+func (c *ConditionalFilter) Eval(event cloudevents.Event) (bool, map[string]interface{}, error) {
 	vars := make(map[string]interface{})
 
 	for _, v := range c.Variables {
@@ -62,15 +75,19 @@ func (c *ConditionalFilter) Filter(ctx context.Context, event cloudevents.Event)
 	}
 
 	pass, err := eval(*c.Expression, vars)
-	if err != nil || pass {
-		return eventfilter.PassFilter
-	}
-
-	return eventfilter.FailFilter
+	return pass, vars, err
 }
 
 // eval evaluates precompiled Expression with passed variables
 func eval(program cel.Program, vars map[string]interface{}) (bool, error) {
 	out, _, err := program.Eval(vars)
-	return out.Value().(bool), err
+	if err != nil {
+		return false, err
+	}
+
+	pass, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to a non-boolean value: %v", out.Value())
+	}
+	return pass, nil
 }
@@ -57,7 +57,7 @@ type envConfig struct {
 	// Name of a message processor which takes care of converting SQS
 	// messages to CloudEvents.
 	//
-	// Supported values: [ default s3 eventbridge ]
+	// Supported values: [ default s3 eventbridge sns cloudevents ]
 	MessageProcessor string `envconfig:"SQS_MESSAGE_PROCESSOR" default:"default"`
 
 	// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html
@@ -65,6 +65,14 @@ type envConfig struct {
 	// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html
 	VisibilityTimeout *time.Duration `envconfig:"SQS_VISIBILITY_TIMEOUT"`
 
+	// Base delay before a message which could not be delivered becomes
+	// visible again in the queue. Doubled with each delivery attempt.
+	RetryBackoff *time.Duration `envconfig:"SQS_RETRY_BACKOFF"`
+
+	// Number of receives after which an undelivered message is deleted,
+	// when the queue has no redrive policy.
+	MaxReceiveCount int `envconfig:"SQS_MAX_RECEIVE_COUNT"`
+
 	// Allows overriding common CloudEvents attributes.
 	CEOverrideSource string `envconfig:"CE_SOURCE"`
 	CEOverrideType   string `envconfig:"CE_TYPE"`
@@ -92,6 +100,13 @@ type adapter struct {
 
 	visibilityTimeoutSeconds *int64
 
+	// delivery settings
+	retryBackoff    time.Duration
+	maxReceiveCount int
+	// visibility timeout in effect for received messages, resolved upon
+	// startup when not explicitly set
+	visibilityTimeout time.Duration
+
 	processQueue chan *sqs.Message
 	deleteQueue  chan *sqs.Message
 
@@ -130,6 +145,14 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 			ceSource:         env.CEOverrideSource,
 			ceSourceFallback: arn.String(),
 		}
+	case "sns":
+		msgPrcsr = &snsMessageProcessor{
+			ceSourceFallback: arn.String(),
+		}
+	case "cloudevents":
+		msgPrcsr = &cloudeventsMessageProcessor{
+			ceSourceFallback: arn.String(),
+		}
 	case "default":
 		msgPrcsr = &defaultMessageProcessor{
 			ceSource: arn.String(),
@@ -138,13 +161,24 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		panic("Unsupported message processor " + strconv.Quote(env.MessageProcessor))
 	}
 
+	var visibilityTimeout time.Duration
 	var visibilityTimeoutSeconds *int64
 	if vt := env.VisibilityTimeout; vt != nil {
-		if *vt < 0 || *vt > 12*time.Hour {
+		if *vt < 0 || *vt > maxVisibilityTimeout {
 			logger.Warn("Ignoring out of bounds visibility timeout (", *vt, ")")
 		} else {
 			vts := durationInSeconds(*vt)
 			visibilityTimeoutSeconds = &vts
+			visibilityTimeout = *vt
+		}
+	}
+
+	var retryBackoff time.Duration
+	if rb := env.RetryBackoff; rb != nil {
+		if *rb <= 0 || *rb > maxVisibilityTimeout {
+			logger.Warn("Ignoring out of bounds retry backoff (", *rb, ")")
+		} else {
+			retryBackoff = *rb
 		}
 	}
 
@@ -178,6 +212,10 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 		visibilityTimeoutSeconds: visibilityTimeoutSeconds,
 
+		retryBackoff:      retryBackoff,
+		maxReceiveCount:   env.MaxReceiveCount,
+		visibilityTimeout: visibilityTimeout,
+
 		processQueue: make(chan *sqs.Message, queueBufferSizeProcess),
 		deleteQueue:  make(chan *sqs.Message, queueBufferSizeDelete),
 
@@ -198,6 +236,9 @@ func (a *adapter) Start(ctx context.Context) error {
 	health.MarkReady()
 
 	queueURL := *url.QueueUrl
+
+	a.applyQueueAttributes(ctx, queueURL)
+
 	a.logger.Infof("Listening to SQS queue at URL: %s", queueURL)
 
 	msgCtx, cancel := context.WithCancel(pkgadapter.ContextWithMetricTag(ctx, a.mt))
@@ -224,7 +265,7 @@ func (a *adapter) Start(ctx context.Context) error {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			a.runMessagesProcessor(msgCtx)
+			a.runMessagesProcessor(msgCtx, queueURL)
 		}()
 
 		wg.Add(1)
@@ -251,6 +292,31 @@ func (a *adapter) queueLookup(queueName string) (*sqs.GetQueueUrlOutput, error)
 	})
 }
 
+// applyQueueAttributes adjusts the delivery settings of the adapter to the
+// attributes of the given queue.
+func (a *adapter) applyQueueAttributes(ctx context.Context, queueURL string) {
+	attrs, err := queueAttributes(ctx, a.sqsClient, queueURL)
+	if err != nil {
+		// Not fatal. Messages still get delivered, but without
+		// visibility extension, and without knowledge of the queue's
+		// redrive policy.
+		a.logger.Warnw("Unable to read the attributes of the SQS queue", zap.Error(err))
+		return
+	}
+
+	if a.visibilityTimeout == 0 {
+		a.visibilityTimeout = attrs.visibilityTimeout
+	}
+
+	if attrs.maxReceiveCount > 0 && a.maxReceiveCount > 0 {
+		a.logger.Infof("Queue has a redrive policy with a maximum receive count of %d, "+
+			"ignoring the configured maximum receive count", attrs.maxReceiveCount)
+	}
+	if attrs.maxReceiveCount > 0 {
+		a.maxReceiveCount = 0
+	}
+}
+
 // prettifyBatchResultErrors returns a pretty string representing a list of
 // batch failures.
 func prettifyBatchResultErrors(errs []*sqs.BatchResultErrorEntry) string {
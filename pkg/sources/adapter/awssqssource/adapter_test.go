@@ -20,6 +20,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -27,6 +29,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -36,6 +42,8 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
 	loggingtesting "knative.dev/pkg/logging/testing"
+	"knative.dev/pkg/metrics/metricstest"
+	_ "knative.dev/pkg/metrics/testing"
 )
 
 const (
@@ -227,6 +235,178 @@ func TestProcessRawJSON(t *testing.T) {
 	}
 }
 
+func TestDeliverySemantics(t *testing.T) {
+	arn := makeARN(tQueueArnResource)
+
+	testCases := map[string]struct {
+		sinkFails       bool
+		receiveCount    int
+		retryBackoff    time.Duration
+		maxReceiveCount int
+		redrivePolicy   *string
+
+		expectDeleted    bool
+		expectVisibility *int64 // seconds
+		expectMetric     string
+	}{
+		"delivered": {
+			receiveCount:  1,
+			expectDeleted: true,
+			expectMetric:  metricNameMsgDeliveredCount,
+		},
+		"undelivered without backoff": {
+			sinkFails:    true,
+			receiveCount: 1,
+			expectMetric: metricNameMsgRetriedCount,
+		},
+		"undelivered with backoff": {
+			sinkFails:        true,
+			receiveCount:     3,
+			retryBackoff:     10 * time.Second,
+			expectVisibility: aws.Int64(40),
+			expectMetric:     metricNameMsgRetriedCount,
+		},
+		"undelivered with backoff above maximum": {
+			sinkFails:        true,
+			receiveCount:     100,
+			retryBackoff:     10 * time.Second,
+			expectVisibility: aws.Int64(int64(maxVisibilityTimeout.Seconds())),
+			expectMetric:     metricNameMsgRetriedCount,
+		},
+		"undelivered below maximum receive count": {
+			sinkFails:       true,
+			receiveCount:    2,
+			maxReceiveCount: 3,
+			expectMetric:    metricNameMsgRetriedCount,
+		},
+		"undelivered at maximum receive count": {
+			sinkFails:       true,
+			receiveCount:    3,
+			maxReceiveCount: 3,
+			expectDeleted:   true,
+			expectMetric:    metricNameMsgAbandonedCount,
+		},
+		"undelivered at maximum receive count with redrive policy": {
+			sinkFails:       true,
+			receiveCount:    3,
+			maxReceiveCount: 3,
+			redrivePolicy:   aws.String(`{"deadLetterTargetArn":"arn:aws:sqs:us-fake-0:123456789012:DLQ","maxReceiveCount":5}`),
+			expectMetric:    metricNameMsgRetriedCount,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			resetMetrics()
+
+			var ceCli cloudevents.Client = adaptertest.NewTestClient()
+			if tc.sinkFails {
+				ceCli = &failingCEClient{Client: ceCli}
+			}
+
+			sqsCli := &standardMockSQSClient{
+				redrivePolicy: tc.redrivePolicy,
+			}
+
+			mt := &pkgadapter.MetricTag{}
+
+			a := &adapter{
+				logger: loggingtesting.TestLogger(t),
+
+				mt: mt,
+				sr: mustNewStatsReporter(mt),
+
+				sqsClient: sqsCli,
+				ceClient:  ceCli,
+
+				msgPrcsr: &defaultMessageProcessor{ceSource: arn.String()},
+
+				retryBackoff:    tc.retryBackoff,
+				maxReceiveCount: tc.maxReceiveCount,
+
+				deleteQueue: make(chan *sqs.Message, 1),
+			}
+
+			a.applyQueueAttributes(context.Background(), tQueueURL)
+
+			msg := makeMockMessages(1)[0]
+			msg.Attributes = map[string]*string{
+				sqs.MessageSystemAttributeNameApproximateReceiveCount: aws.String(strconv.Itoa(tc.receiveCount)),
+			}
+
+			a.handleMessage(context.Background(), tQueueURL, msg)
+
+			if tc.expectDeleted {
+				require.Len(t, a.deleteQueue, 1, "Message should be enqueued for deletion")
+				assert.Equal(t, msg, <-a.deleteQueue)
+			} else {
+				assert.Empty(t, a.deleteQueue, "Message should not be enqueued for deletion")
+			}
+
+			metricstest.CheckCountData(t, tc.expectMetric, map[string]string{}, 1)
+
+			chgVisRequests := sqsCli.chgVisRecorder.Requests()
+			if tc.expectVisibility == nil {
+				assert.Empty(t, chgVisRequests, "Visibility timeout should not be changed")
+				return
+			}
+			require.Len(t, chgVisRequests, 1, "Expected one ChangeMessageVisibility request")
+			assert.Equal(t, *tc.expectVisibility, *chgVisRequests[0].VisibilityTimeout)
+		})
+	}
+}
+
+func TestVisibilityExtension(t *testing.T) {
+	const visibilityTimeout = 2 * time.Second
+
+	sqsCli := &standardMockSQSClient{}
+
+	a := &adapter{
+		logger:            loggingtesting.TestLogger(t),
+		sqsClient:         sqsCli,
+		visibilityTimeout: visibilityTimeout,
+	}
+
+	msg := makeMockMessages(1)[0]
+
+	stop := a.extendVisibility(context.Background(), tQueueURL, msg)
+	time.Sleep(visibilityTimeout * 3 / 4)
+	stop()
+
+	chgVisRequests := sqsCli.chgVisRecorder.Requests()
+	require.Len(t, chgVisRequests, 1, "Expected one ChangeMessageVisibility request")
+	assert.EqualValues(t, visibilityTimeout.Seconds(), *chgVisRequests[0].VisibilityTimeout)
+	assert.Equal(t, msg.ReceiptHandle, chgVisRequests[0].ReceiptHandle)
+}
+
+// resetMetrics resets the state of the source's metrics.
+func resetMetrics() {
+	metricstest.Unregister(
+		metricNameQueueCapacityProcess,
+		metricNameQueueCapacityDelete,
+		metricNameMsgEnqueuedProcessCount,
+		metricNameMsgDequeuedProcessCount,
+		metricNameMsgEnqueuedDeleteCount,
+		metricNameMsgDequeuedDeleteCount,
+		metricNameMsgDeliveredCount,
+		metricNameMsgRetriedCount,
+		metricNameMsgAbandonedCount,
+	)
+	mustRegisterStatsView()
+}
+
+// failingCEClient is a CloudEvents client which never gets its events
+// acknowledged.
+type failingCEClient struct {
+	cloudevents.Client
+}
+
+// Send implements cloudevents.Client.
+func (*failingCEClient) Send(context.Context, cloudevents.Event) protocol.Result {
+	return cehttp.NewResult(http.StatusServiceUnavailable, "%w", protocol.ResultNACK)
+}
+
 // stringifyEventData returns the given data as a JSON-encoded string. This
 // helps asserting the value of a SQS messages's Body contained in a
 // CloudEvent, which can be either a JSON object encoded as a
@@ -268,6 +448,10 @@ type standardMockSQSClient struct {
 	totalDeleted int
 
 	rcvMsgRecorder receiveMessageRequestRecorder
+	chgVisRecorder changeMessageVisibilityRequestRecorder
+
+	// JSON-encoded redrive policy of the queue, if any
+	redrivePolicy *string
 }
 
 func (*standardMockSQSClient) GetQueueUrl(*sqs.GetQueueUrlInput) (*sqs.GetQueueUrlOutput, error) { //nolint:golint,stylecheck
@@ -276,6 +460,29 @@ func (*standardMockSQSClient) GetQueueUrl(*sqs.GetQueueUrlInput) (*sqs.GetQueueU
 	}, nil
 }
 
+func (c *standardMockSQSClient) GetQueueAttributesWithContext(context.Context,
+	*sqs.GetQueueAttributesInput, ...request.Option) (*sqs.GetQueueAttributesOutput, error) {
+
+	attrs := map[string]*string{
+		sqs.QueueAttributeNameVisibilityTimeout: aws.String(strconv.Itoa(tVisibilityTimeout)),
+	}
+	if c.redrivePolicy != nil {
+		attrs[sqs.QueueAttributeNameRedrivePolicy] = c.redrivePolicy
+	}
+
+	return &sqs.GetQueueAttributesOutput{
+		Attributes: attrs,
+	}, nil
+}
+
+func (c *standardMockSQSClient) ChangeMessageVisibilityWithContext(_ context.Context,
+	in *sqs.ChangeMessageVisibilityInput, _ ...request.Option) (*sqs.ChangeMessageVisibilityOutput, error) {
+
+	c.chgVisRecorder.Record(in)
+
+	return &sqs.ChangeMessageVisibilityOutput{}, nil
+}
+
 func (c *standardMockSQSClient) ReceiveMessageWithContext(_ context.Context,
 	in *sqs.ReceiveMessageInput, _ ...request.Option) (*sqs.ReceiveMessageOutput, error) {
 
@@ -429,3 +636,25 @@ func (r *receiveMessageRequestRecorder) Record(req *sqs.ReceiveMessageInput) {
 
 	r.requests = append(r.requests, req)
 }
+
+// changeMessageVisibilityRequestRecorder records calls to ChangeMessageVisibility.
+type changeMessageVisibilityRequestRecorder struct {
+	sync.Mutex
+	requests []*sqs.ChangeMessageVisibilityInput
+}
+
+// Record records a call to ChangeMessageVisibility.
+func (r *changeMessageVisibilityRequestRecorder) Record(req *sqs.ChangeMessageVisibilityInput) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.requests = append(r.requests, req)
+}
+
+// Requests returns the recorded calls to ChangeMessageVisibility.
+func (r *changeMessageVisibilityRequestRecorder) Requests() []*sqs.ChangeMessageVisibilityInput {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.requests
+}
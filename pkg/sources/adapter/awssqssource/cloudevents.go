@@ -24,6 +24,7 @@ import (
 
 const sqsMgsAttrDataTypeBinary = "Binary"
 const ceExtensionSQSMessagePrefix = "sqsmsg"
+const ceExtensionSNSMessagePrefix = "snsmsg"
 
 // ceExtensionAttrsForMessage returns a collection of CloudEvents extension
 // attributes translated from the message attributes of the given SQS message.
@@ -35,29 +36,62 @@ const ceExtensionSQSMessagePrefix = "sqsmsg"
 //
 // https://github.com/cloudevents/spec/blob/v1.0.1/spec.md#extension-context-attributes
 func ceExtensionAttrsForMessage(msg *sqs.Message) map[string]interface{} {
-	if len(msg.MessageAttributes) == 0 {
+	return ceExtensionAttrsForMessageAttrs(msg.MessageAttributes, ceExtensionSQSMessagePrefix)
+}
+
+// ceExtensionAttrsForMessageAttrs returns a collection of CloudEvents
+// extension attributes translated from the given SQS message attributes, using
+// the given prefix for their names.
+//
+// Attributes with a Binary data type are excluded.
+func ceExtensionAttrsForMessageAttrs(attrs map[string]*sqs.MessageAttributeValue, prefix string) map[string]interface{} {
+	if len(attrs) == 0 {
 		return nil
 	}
 
 	ceExtAttrs := make(map[string]interface{})
 
-	for name, attrVal := range msg.MessageAttributes {
+	for name, attrVal := range attrs {
 		if !strings.HasPrefix(*attrVal.DataType, sqsMgsAttrDataTypeBinary) {
-			ceExtAttrs[ceExtensionAttrForMessageAttr(name)] = *attrVal.StringValue
+			ceExtAttrs[ceExtensionAttrForMessageAttr(prefix, name)] = *attrVal.StringValue
+		}
+	}
+
+	return ceExtAttrs
+}
+
+// ceExtensionAttrsForSNSMessage returns a collection of CloudEvents extension
+// attributes translated from the message attributes of the given SNS
+// notification.
+//
+// Attributes with a Binary data type are excluded.
+// The resulting extension attribute name is composed of the 'snsmsg' prefix,
+// followed by the lowercase name of the SNS message attribute, from which all
+// non-alphanumeric characters have been removed (e.g. "snsmsgmyattribute").
+func ceExtensionAttrsForSNSMessage(notif *snsNotification) map[string]interface{} {
+	if len(notif.MessageAttributes) == 0 {
+		return nil
+	}
+
+	ceExtAttrs := make(map[string]interface{})
+
+	for name, attrVal := range notif.MessageAttributes {
+		if !strings.HasPrefix(attrVal.Type, sqsMgsAttrDataTypeBinary) {
+			ceExtAttrs[ceExtensionAttrForMessageAttr(ceExtensionSNSMessagePrefix, name)] = attrVal.Value
 		}
 	}
 
 	return ceExtAttrs
 }
 
-// ceExtensionAttrForMessageAttr sanitizes the name of a SQS message attribute
-// so that it can be used as a CloudEvent context attribute.
+// ceExtensionAttrForMessageAttr sanitizes the name of a SQS or SNS message
+// attribute so that it can be used as a CloudEvent context attribute.
 //
 // The naming conventions for both formats are described at:
 //  - https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-message-metadata.html#message-attribute-components
 //  - https://github.com/cloudevents/spec/blob/v1.0.1/spec.md#context-attributes
-func ceExtensionAttrForMessageAttr(attrName string) string {
-	return ceExtensionSQSMessagePrefix + stripNonAlphanumCharsAndMapToLower(attrName)
+func ceExtensionAttrForMessageAttr(prefix, attrName string) string {
+	return prefix + stripNonAlphanumCharsAndMapToLower(attrName)
 }
 
 // stripNonAlphanumCharsAndMapToLower applies the following transformations to
@@ -27,8 +27,10 @@ import (
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/aws/aws-sdk-go/service/sqs"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
@@ -36,7 +38,12 @@ import (
 
 // A message processor processes SQS messages (sends as CloudEvent)
 // sequentially, as soon as they are written to processQueue.
-func (a *adapter) runMessagesProcessor(ctx context.Context) {
+//
+// Messages are only deleted from the queue once all the events derived from
+// them have been acknowledged by the sink (at-least-once delivery). Other
+// messages are either left for redelivery or abandoned, depending on the
+// number of times they have been received.
+func (a *adapter) runMessagesProcessor(ctx context.Context, queueURL string) {
 	for {
 		select {
 		case <-ctx.Done():
@@ -44,27 +51,86 @@ func (a *adapter) runMessagesProcessor(ctx context.Context) {
 
 		case msg := <-a.processQueue:
 			a.sr.reportMessageDequeuedProcessCount()
+			a.handleMessage(ctx, queueURL, msg)
+		}
+	}
+}
 
-			a.logger.Debugw("Processing message", zap.String(logfieldMsgID, *msg.MessageId))
+// handleMessage delivers the given SQS message and determines its fate.
+func (a *adapter) handleMessage(ctx context.Context, queueURL string, msg *sqs.Message) {
+	a.logger.Debugw("Processing message", zap.String(logfieldMsgID, *msg.MessageId))
 
-			events, err := a.msgPrcsr.Process(msg)
-			if err != nil {
-				a.logger.Errorw("Failed to process SQS message", zap.Error(err),
-					zap.String(logfieldMsgID, *msg.MessageId))
-				continue
-			}
+	if err := a.processMessage(ctx, queueURL, msg); err != nil {
+		if ctx.Err() != nil {
+			// the message becomes visible again once its
+			// visibility timeout expires
+			return
+		}
 
-			for _, event := range events {
-				if err := sendSQSEvent(ctx, a.ceClient, event); err != nil {
-					a.logger.Errorw("Failed to send event to the sink", zap.Error(err))
-					continue
-				}
-			}
+		a.logger.Errorw("Failed to deliver SQS message", zap.Error(err),
+			zap.String(logfieldMsgID, *msg.MessageId))
+
+		a.handleUndeliveredMessage(ctx, queueURL, msg)
+		return
+	}
+
+	a.sr.reportMessageDeliveredCount()
+
+	a.deleteQueue <- msg
+	a.sr.reportMessageEnqueuedDeleteCount()
+}
 
-			a.deleteQueue <- msg
-			a.sr.reportMessageEnqueuedDeleteCount()
+// processMessage converts the given SQS message to CloudEvents and sends them
+// to the event sink. An error is returned unless all events were acknowledged.
+func (a *adapter) processMessage(ctx context.Context, queueURL string, msg *sqs.Message) error {
+	events, err := a.msgPrcsr.Process(msg)
+	if err != nil {
+		return fmt.Errorf("processing SQS message: %w", err)
+	}
+
+	stopVisibilityExtension := a.extendVisibility(ctx, queueURL, msg)
+	defer stopVisibilityExtension()
+
+	for _, event := range events {
+		// events are re-sent in their entirety upon redelivery, so
+		// there is no point in sending the remaining ones
+		if err := sendSQSEvent(ctx, a.ceClient, event); err != nil {
+			return fmt.Errorf("sending event to the sink: %w", err)
 		}
 	}
+
+	return nil
+}
+
+// handleUndeliveredMessage abandons the given message if it has reached the
+// maximum receive count, or otherwise leaves it in the queue for redelivery,
+// after an optional backoff delay.
+func (a *adapter) handleUndeliveredMessage(ctx context.Context, queueURL string, msg *sqs.Message) {
+	rc := receiveCount(msg)
+
+	if a.maxReceiveCount > 0 && rc >= a.maxReceiveCount {
+		a.logger.Warnw("Abandoning SQS message after reaching the maximum receive count",
+			zap.String(logfieldMsgID, *msg.MessageId), zap.Int("receiveCount", rc))
+
+		a.sr.reportMessageAbandonedCount()
+
+		a.deleteQueue <- msg
+		a.sr.reportMessageEnqueuedDeleteCount()
+		return
+	}
+
+	a.sr.reportMessageRetriedCount()
+
+	if a.retryBackoff == 0 {
+		// the message becomes visible again once its visibility
+		// timeout expires
+		return
+	}
+
+	if err := changeMessageVisibility(ctx, a.sqsClient, queueURL, msg, retryDelay(a.retryBackoff, rc)); err != nil {
+		a.logger.Errorw("Failed to set the retry backoff of SQS message", zap.Error(err),
+			zap.String(logfieldMsgID, *msg.MessageId))
+	}
 }
 
 // sendSQSEvent sends a single SQS message as a CloudEvent to the event sink.
@@ -83,6 +149,9 @@ type MessageProcessor interface {
 var (
 	_ MessageProcessor = (*defaultMessageProcessor)(nil)
 	_ MessageProcessor = (*s3MessageProcessor)(nil)
+	_ MessageProcessor = (*eventbridgeMessageProcessor)(nil)
+	_ MessageProcessor = (*snsMessageProcessor)(nil)
+	_ MessageProcessor = (*cloudeventsMessageProcessor)(nil)
 )
 
 // defaultMessageProcessor is the default message processor.
@@ -375,3 +444,164 @@ func makeEventBridgeEvent(data map[string]interface{}, srcAttr string) (*cloudev
 
 	return &event, nil
 }
+
+// snsMessageProcessor processes messages originating from SNS topics.
+type snsMessageProcessor struct {
+	// this value is set as the "source" CE context attribute when the SNS
+	// processor handles messages which don't reveal their topic, such as
+	// messages delivered in raw format
+	ceSourceFallback string
+}
+
+// Process implements MessageProcessor.
+//
+// This processor unwraps the SNS notification contained in the body of the
+// given message, and discards the envelope. The notification's message
+// attributes are converted to CloudEvent extensions.
+//
+// Messages delivered with raw message delivery enabled have no envelope. In
+// this case, the body is used as is, and the message attributes, which SNS
+// copies from the notification to the SQS message, are converted instead.
+//
+// Expected notification structure: https://docs.aws.amazon.com/sns/latest/dg/sns-message-and-json-formats.html#http-notification-json
+func (p *snsMessageProcessor) Process(msg *sqs.Message) ([]*cloudevents.Event, error) {
+	body := aws.StringValue(msg.Body)
+
+	notif := &snsNotification{}
+	if err := json.Unmarshal([]byte(body), notif); err != nil || !notif.isNotification() {
+		event, err := makeSNSEventFromRawMessage(msg, p.ceSourceFallback)
+		if err != nil {
+			return nil, fmt.Errorf("creating CloudEvent from raw SNS message: %w", err)
+		}
+
+		return []*cloudevents.Event{event}, nil
+	}
+
+	event, err := makeSNSEvent(notif)
+	if err != nil {
+		return nil, fmt.Errorf("creating CloudEvent from SNS notification: %w", err)
+	}
+
+	return []*cloudevents.Event{event}, nil
+}
+
+// snsNotification represents a SNS message of type Notification.
+type snsNotification struct {
+	Type              string
+	MessageID         string `json:"MessageId"`
+	TopicARN          string `json:"TopicArn"`
+	Subject           string
+	Message           string
+	Timestamp         string
+	MessageAttributes map[string]snsMessageAttribute
+}
+
+// snsMessageAttribute represents the attribute of a SNS message.
+type snsMessageAttribute struct {
+	Type  string
+	Value string
+}
+
+// isNotification returns whether the SNS message is a well-formed
+// notification.
+func (n *snsNotification) isNotification() bool {
+	return n.Type == "Notification" && n.MessageID != "" && n.TopicARN != ""
+}
+
+// makeSNSEvent returns a CloudEvent for the given SNS notification.
+func makeSNSEvent(notif *snsNotification) (*cloudevents.Event, error) {
+	event := cloudevents.NewEvent()
+	event.SetType(v1alpha1.AWSEventType(sns.ServiceName, v1alpha1.AWSSNSGenericEventType))
+	event.SetSource(notif.TopicARN)
+	event.SetID(notif.MessageID)
+	event.SetSubject(notif.Subject)
+
+	if ts, err := time.Parse(time.RFC3339, notif.Timestamp); err == nil {
+		event.SetTime(ts)
+	}
+
+	for name, val := range ceExtensionAttrsForSNSMessage(notif) {
+		event.SetExtension(name, val)
+	}
+
+	if err := setSNSMessageData(&event, notif.Message); err != nil {
+		return nil, fmt.Errorf("setting CloudEvent data: %w", err)
+	}
+
+	return &event, nil
+}
+
+// makeSNSEventFromRawMessage returns a CloudEvent for a SNS message delivered
+// in raw format.
+func makeSNSEventFromRawMessage(msg *sqs.Message, srcAttr string) (*cloudevents.Event, error) {
+	event := cloudevents.NewEvent()
+	event.SetType(v1alpha1.AWSEventType(sns.ServiceName, v1alpha1.AWSSNSGenericEventType))
+	event.SetSource(srcAttr)
+	event.SetID(*msg.MessageId)
+
+	for name, val := range ceExtensionAttrsForMessageAttrs(msg.MessageAttributes, ceExtensionSNSMessagePrefix) {
+		event.SetExtension(name, val)
+	}
+
+	if err := setSNSMessageData(&event, aws.StringValue(msg.Body)); err != nil {
+		return nil, fmt.Errorf("setting CloudEvent data: %w", err)
+	}
+
+	return &event, nil
+}
+
+// setSNSMessageData sets the given SNS message as the data of a CloudEvent.
+// Messages that contain raw JSON data are embedded as is, others as plain
+// text.
+func setSNSMessageData(event *cloudevents.Event, msg string) error {
+	if json.Valid([]byte(msg)) {
+		return event.SetData(cloudevents.ApplicationJSON, json.RawMessage(msg))
+	}
+	return event.SetData(cloudevents.TextPlain, msg)
+}
+
+// cloudeventsMessageProcessor processes messages which body contains a
+// CloudEvent in structured content mode.
+type cloudeventsMessageProcessor struct {
+	// this value is set as the "source" CE context attribute when the
+	// CloudEvents processor handles messages which do not contain a
+	// CloudEvent
+	ceSourceFallback string
+}
+
+// Process implements MessageProcessor.
+//
+// This processor passes CloudEvents through intact, including their context
+// attributes and extensions.
+//
+// Expected events structure: https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/formats/json-format.md
+func (p *cloudeventsMessageProcessor) Process(msg *sqs.Message) ([]*cloudevents.Event, error) {
+	if event := parseStructuredCloudEvent(aws.StringValue(msg.Body)); event != nil {
+		return []*cloudevents.Event{event}, nil
+	}
+
+	// instead of discarding messages which don't contain a CloudEvent,
+	// fall back to the default processor's behaviour
+	event, err := makeSQSEvent(msg, p.ceSourceFallback)
+	if err != nil {
+		return nil, fmt.Errorf("creating CloudEvent from SQS message: %w", err)
+	}
+
+	return []*cloudevents.Event{event}, nil
+}
+
+// parseStructuredCloudEvent returns the CloudEvent represented by the given
+// data, or nil if this data isn't a valid CloudEvent in structured content
+// mode.
+func parseStructuredCloudEvent(data string) *cloudevents.Event {
+	event := cloudevents.NewEvent()
+
+	if err := json.Unmarshal([]byte(data), &event); err != nil {
+		return nil
+	}
+	if err := event.Validate(); err != nil {
+		return nil
+	}
+
+	return &event
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awssqssource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+func TestSNSMessageProcessor(t *testing.T) {
+	const queueARN = "arn:aws:sqs:us-fake-0:123456789012:MyQueue"
+	const topicARN = "arn:aws:sns:us-fake-0:123456789012:MyTopic"
+
+	testCases := map[string]struct {
+		msg *sqs.Message
+
+		expectSource     string
+		expectID         string
+		expectSubject    string
+		expectTime       time.Time
+		expectExtensions map[string]interface{}
+		expectData       string
+		expectDataType   string
+	}{
+		"SNS envelope with JSON message": {
+			msg: &sqs.Message{
+				MessageId: aws.String(tMsgIDPrefix + "001"),
+				Body: aws.String(`{"Type":"Notification","MessageId":"sns-msg-id","TopicArn":"` + topicARN + `",` +
+					`"Subject":"greeting","Message":"{\"hello\":\"world\"}","Timestamp":"2022-05-04T10:21:03.123Z",` +
+					`"MessageAttributes":{"Country.Spain.Capital":{"Type":"String","Value":"Madrid"},` +
+					`"Blue_Pixel":{"Type":"Binary","Value":"iVBORw0KGgo="}}}`),
+			},
+			expectSource:  topicARN,
+			expectID:      "sns-msg-id",
+			expectSubject: "greeting",
+			expectTime:    time.Date(2022, 5, 4, 10, 21, 3, 123000000, time.UTC),
+			expectExtensions: map[string]interface{}{
+				"snsmsgcountryspaincapital": "Madrid",
+			},
+			expectData:     `{"hello":"world"}`,
+			expectDataType: cloudevents.ApplicationJSON,
+		},
+		"SNS envelope with text message": {
+			msg: &sqs.Message{
+				MessageId: aws.String(tMsgIDPrefix + "001"),
+				Body: aws.String(`{"Type":"Notification","MessageId":"sns-msg-id","TopicArn":"` + topicARN + `",` +
+					`"Message":"hello world","Timestamp":"2022-05-04T10:21:03.123Z"}`),
+			},
+			expectSource:   topicARN,
+			expectID:       "sns-msg-id",
+			expectTime:     time.Date(2022, 5, 4, 10, 21, 3, 123000000, time.UTC),
+			expectData:     `hello world`,
+			expectDataType: cloudevents.TextPlain,
+		},
+		"raw message delivery": {
+			msg: &sqs.Message{
+				MessageId: aws.String(tMsgIDPrefix + "001"),
+				Body:      aws.String(`{"hello":"world"}`),
+				MessageAttributes: map[string]*sqs.MessageAttributeValue{
+					"Country.Spain.Capital": {
+						DataType:    aws.String("String"),
+						StringValue: aws.String("Madrid"),
+					},
+				},
+			},
+			expectSource: queueARN,
+			expectID:     tMsgIDPrefix + "001",
+			expectExtensions: map[string]interface{}{
+				"snsmsgcountryspaincapital": "Madrid",
+			},
+			expectData:     `{"hello":"world"}`,
+			expectDataType: cloudevents.ApplicationJSON,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			msgPrcsr := &snsMessageProcessor{ceSourceFallback: queueARN}
+
+			events, err := msgPrcsr.Process(tc.msg)
+			require.NoError(t, err)
+			require.Len(t, events, 1, "Expected one processed event")
+
+			event := events[0]
+			assert.Equal(t, "com.amazon.sns.notification", event.Type())
+			assert.Equal(t, tc.expectSource, event.Source())
+			assert.Equal(t, tc.expectID, event.ID())
+			assert.Equal(t, tc.expectSubject, event.Subject())
+			assert.Equal(t, tc.expectTime, event.Time())
+			assert.Equal(t, tc.expectExtensions, event.Extensions())
+			assert.Equal(t, tc.expectDataType, event.DataContentType())
+			assert.Equal(t, tc.expectData, string(event.Data()))
+		})
+	}
+}
+
+func TestCloudEventsMessageProcessor(t *testing.T) {
+	const queueARN = "arn:aws:sqs:us-fake-0:123456789012:MyQueue"
+
+	msgPrcsr := &cloudeventsMessageProcessor{ceSourceFallback: queueARN}
+
+	t.Run("structured CloudEvent", func(t *testing.T) {
+		msg := &sqs.Message{
+			MessageId: aws.String(tMsgIDPrefix + "001"),
+			Body: aws.String(`{"specversion":"1.0","id":"ce-id","type":"io.triggermesh.test","source":"test",` +
+				`"myextension":"myvalue","datacontenttype":"application/json","data":{"hello":"world"}}`),
+		}
+
+		events, err := msgPrcsr.Process(msg)
+		require.NoError(t, err)
+		require.Len(t, events, 1, "Expected one processed event")
+
+		event := events[0]
+		assert.Equal(t, "io.triggermesh.test", event.Type())
+		assert.Equal(t, "test", event.Source())
+		assert.Equal(t, "ce-id", event.ID())
+		assert.Equal(t, map[string]interface{}{"myextension": "myvalue"}, event.Extensions())
+		assert.Equal(t, `{"hello":"world"}`, string(event.Data()))
+	})
+
+	t.Run("not a CloudEvent", func(t *testing.T) {
+		msg := &sqs.Message{
+			MessageId: aws.String(tMsgIDPrefix + "001"),
+			Body:      aws.String(`{"specversion":"1.0","hello":"world"}`),
+		}
+
+		events, err := msgPrcsr.Process(msg)
+		require.NoError(t, err)
+		require.Len(t, events, 1, "Expected one processed event")
+
+		event := events[0]
+		assert.Equal(t, "com.amazon.sqs.message", event.Type())
+		assert.Equal(t, queueARN, event.Source())
+		assert.Equal(t, tMsgIDPrefix+"001", event.ID())
+	})
+}
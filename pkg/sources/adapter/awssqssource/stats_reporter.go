@@ -36,6 +36,9 @@ const (
 	metricNameMsgDequeuedProcessCount = "message_dequeued_process_count"
 	metricNameMsgEnqueuedDeleteCount  = "message_enqueued_delete_count"
 	metricNameMsgDequeuedDeleteCount  = "message_dequeued_delete_count"
+	metricNameMsgDeliveredCount       = "message_delivered_count"
+	metricNameMsgRetriedCount         = "message_retried_count"
+	metricNameMsgAbandonedCount       = "message_abandoned_count"
 )
 
 var (
@@ -90,6 +93,30 @@ var msgDequeuedDeleteCountM = stats.Int64(
 	stats.UnitDimensionless,
 )
 
+// msgDeliveredCountM records the number of SQS messages which events have all
+// been delivered to the sink.
+var msgDeliveredCountM = stats.Int64(
+	metricNameMsgDeliveredCount,
+	"Number of SQS messages which events have all been delivered to the sink",
+	stats.UnitDimensionless,
+)
+
+// msgRetriedCountM records the number of SQS messages that have been left in
+// the queue for redelivery after a failed delivery.
+var msgRetriedCountM = stats.Int64(
+	metricNameMsgRetriedCount,
+	"Number of SQS messages that have been left in the queue for redelivery after a failed delivery",
+	stats.UnitDimensionless,
+)
+
+// msgAbandonedCountM records the number of SQS messages that have been deleted
+// from the queue after exceeding the maximum receive count.
+var msgAbandonedCountM = stats.Int64(
+	metricNameMsgAbandonedCount,
+	"Number of SQS messages that have been deleted from the queue after exceeding the maximum receive count",
+	stats.UnitDimensionless,
+)
+
 // mustRegisterStatsView registers an OpenCensus stats view for the source's
 // metrics and panics in case of error.
 func mustRegisterStatsView() {
@@ -136,6 +163,24 @@ func mustRegisterStatsView() {
 			Aggregation: view.Count(),
 			TagKeys:     tagKeys,
 		},
+		&view.View{
+			Measure:     msgDeliveredCountM,
+			Description: msgDeliveredCountM.Description(),
+			Aggregation: view.Count(),
+			TagKeys:     tagKeys,
+		},
+		&view.View{
+			Measure:     msgRetriedCountM,
+			Description: msgRetriedCountM.Description(),
+			Aggregation: view.Count(),
+			TagKeys:     tagKeys,
+		},
+		&view.View{
+			Measure:     msgAbandonedCountM,
+			Description: msgAbandonedCountM.Description(),
+			Aggregation: view.Count(),
+			TagKeys:     tagKeys,
+		},
 	)
 	if err != nil {
 		panic(fmt.Errorf("error registering OpenCensus stats view: %w", err))
@@ -194,3 +239,18 @@ func (r *statsReporter) reportMessageEnqueuedDeleteCount() {
 func (r *statsReporter) reportMessageDequeuedDeleteCount() {
 	metrics.Record(r.tagsCtx, msgDequeuedDeleteCountM.M(1))
 }
+
+// reportMessageDeliveredCount increments msgDeliveredCountM.
+func (r *statsReporter) reportMessageDeliveredCount() {
+	metrics.Record(r.tagsCtx, msgDeliveredCountM.M(1))
+}
+
+// reportMessageRetriedCount increments msgRetriedCountM.
+func (r *statsReporter) reportMessageRetriedCount() {
+	metrics.Record(r.tagsCtx, msgRetriedCountM.M(1))
+}
+
+// reportMessageAbandonedCount increments msgAbandonedCountM.
+func (r *statsReporter) reportMessageAbandonedCount() {
+	metrics.Record(r.tagsCtx, msgAbandonedCountM.M(1))
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awssqssource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+)
+
+const (
+	// Longest possible visibility timeout of a message.
+	// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html
+	maxVisibilityTimeout = 12 * time.Hour
+
+	// Calls to ChangeMessageVisibility and GetQueueAttributes are
+	// cancelled when they exceed this duration.
+	visibilityRequestTimeout = 10 * time.Second
+)
+
+// queueAttrs contains the attributes of a SQS queue which are relevant to the
+// delivery of messages.
+type queueAttrs struct {
+	visibilityTimeout time.Duration
+	// maximum receive count of the queue's redrive policy, if any
+	maxReceiveCount int
+}
+
+// queueAttributes returns the delivery attributes of the given SQS queue.
+func queueAttributes(ctx context.Context, cli sqsiface.SQSAPI, queueURL string) (*queueAttrs, error) {
+	ctx, cancel := context.WithTimeout(ctx, visibilityRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.GetQueueAttributesWithContext(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl: &queueURL,
+		AttributeNames: aws.StringSlice([]string{
+			sqs.QueueAttributeNameVisibilityTimeout,
+			sqs.QueueAttributeNameRedrivePolicy,
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := &queueAttrs{}
+
+	if vt, ok := resp.Attributes[sqs.QueueAttributeNameVisibilityTimeout]; ok {
+		vts, err := strconv.Atoi(aws.StringValue(vt))
+		if err != nil {
+			return nil, fmt.Errorf("parsing visibility timeout: %w", err)
+		}
+		attrs.visibilityTimeout = time.Duration(vts) * time.Second
+	}
+
+	if rp, ok := resp.Attributes[sqs.QueueAttributeNameRedrivePolicy]; ok {
+		if attrs.maxReceiveCount, err = parseRedrivePolicyMaxReceiveCount(aws.StringValue(rp)); err != nil {
+			return nil, fmt.Errorf("parsing redrive policy: %w", err)
+		}
+	}
+
+	return attrs, nil
+}
+
+// parseRedrivePolicyMaxReceiveCount returns the maximum receive count of the
+// given JSON-encoded redrive policy.
+//
+// Amazon SQS encodes this value either as a number or as a string, depending
+// on how the policy was set.
+func parseRedrivePolicyMaxReceiveCount(policy string) (int, error) {
+	if policy == "" {
+		return 0, nil
+	}
+
+	rp := &struct {
+		MaxReceiveCount json.RawMessage `json:"maxReceiveCount"`
+	}{}
+
+	if err := json.Unmarshal([]byte(policy), rp); err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(strings.Trim(string(rp.MaxReceiveCount), `"`))
+}
+
+// extendVisibility periodically extends the visibility timeout of the given
+// message until the returned function is called. This prevents the message
+// from being received by other consumers while it is being delivered to a
+// slow sink.
+func (a *adapter) extendVisibility(ctx context.Context, queueURL string, msg *sqs.Message) (stop func()) {
+	if a.visibilityTimeout <= 0 {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		// extending the visibility halfway through the current
+		// timeout leaves enough margin for the API request to
+		// complete
+		t := time.NewTicker(a.visibilityTimeout / 2)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case <-t.C:
+				a.logger.Debugw("Extending visibility timeout of message", zap.String(logfieldMsgID, *msg.MessageId))
+
+				if err := changeMessageVisibility(ctx, a.sqsClient, queueURL, msg, a.visibilityTimeout); err != nil {
+					a.logger.Warnw("Failed to extend visibility timeout of message", zap.Error(err),
+						zap.String(logfieldMsgID, *msg.MessageId))
+				}
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// changeMessageVisibility sets the remaining visibility timeout of a message.
+func changeMessageVisibility(ctx context.Context, cli sqsiface.SQSAPI,
+	queueURL string, msg *sqs.Message, timeout time.Duration) error {
+
+	ctx, cancel := context.WithTimeout(ctx, visibilityRequestTimeout)
+	defer cancel()
+
+	_, err := cli.ChangeMessageVisibilityWithContext(ctx, &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          &queueURL,
+		ReceiptHandle:     msg.ReceiptHandle,
+		VisibilityTimeout: aws.Int64(durationInSeconds(timeout)),
+	})
+	return err
+}
+
+// retryDelay returns the duration after which a message which has been
+// received the given number of times should become visible again. The delay
+// grows exponentially from the given base, and is capped to the longest
+// possible visibility timeout.
+func retryDelay(base time.Duration, receiveCount int) time.Duration {
+	delay := base
+	for i := 1; i < receiveCount && delay < maxVisibilityTimeout; i++ {
+		delay *= 2
+	}
+
+	if delay > maxVisibilityTimeout {
+		delay = maxVisibilityTimeout
+	}
+
+	return delay
+}
+
+// receiveCount returns the number of times the given message has been
+// received from the queue.
+func receiveCount(msg *sqs.Message) int {
+	rc, ok := msg.Attributes[sqs.MessageSystemAttributeNameApproximateReceiveCount]
+	if !ok {
+		return 1
+	}
+
+	n, err := strconv.Atoi(aws.StringValue(rc))
+	if err != nil || n < 1 {
+		return 1
+	}
+
+	return n
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awssqssource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRedrivePolicyMaxReceiveCount(t *testing.T) {
+	testCases := map[string]struct {
+		policy      string
+		expectCount int
+		expectErr   bool
+	}{
+		"no policy": {
+			policy:      "",
+			expectCount: 0,
+		},
+		"numeric count": {
+			policy:      `{"deadLetterTargetArn":"arn:aws:sqs:us-fake-0:123456789012:DLQ","maxReceiveCount":5}`,
+			expectCount: 5,
+		},
+		"string count": {
+			policy:      `{"deadLetterTargetArn":"arn:aws:sqs:us-fake-0:123456789012:DLQ","maxReceiveCount":"10"}`,
+			expectCount: 10,
+		},
+		"invalid policy": {
+			policy:    `{"maxReceiveCount":`,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			count, err := parseRedrivePolicyMaxReceiveCount(tc.policy)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectCount, count)
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	const base = 30 * time.Second
+
+	assert.Equal(t, base, retryDelay(base, 0))
+	assert.Equal(t, base, retryDelay(base, 1))
+	assert.Equal(t, 2*base, retryDelay(base, 2))
+	assert.Equal(t, 8*base, retryDelay(base, 4))
+	assert.Equal(t, maxVisibilityTimeout, retryDelay(base, 20))
+	assert.Equal(t, maxVisibilityTimeout, retryDelay(base, 1000))
+}
@@ -29,9 +29,15 @@ import (
 	"github.com/cloudevents/sdk-go/v2/event"
 	"github.com/cloudevents/sdk-go/v2/protocol"
 
+	"github.com/Azure/azure-amqp-common-go/v3/aad"
+	"github.com/Azure/azure-amqp-common-go/v3/auth"
+	"github.com/Azure/azure-amqp-common-go/v3/sas"
 	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/Azure/azure-event-hubs-go/v3/eph"
+	"github.com/Azure/azure-event-hubs-go/v3/persist"
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/logging"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
@@ -64,11 +70,28 @@ type envConfig struct {
 	CEOverrideSource string `envconfig:"CE_SOURCE"`
 	CEOverrideType   string `envconfig:"CE_TYPE"`
 
+	// Name of the consumer group to receive events from.
+	ConsumerGroup string `envconfig:"EVENTHUB_CONSUMER_GROUP" default:"$Default"`
+
+	// Position in the stream of each partition from which events start
+	// being received when no checkpoint exists for that partition.
+	//
+	// Supported values: [ latest earliest ]
+	StartPosition string `envconfig:"EVENTHUB_START_POSITION" default:"latest"`
+
+	// Parameters of the store in which partition leases and offset
+	// checkpoints are persisted. At most one store can be set.
+	CheckpointBlobConnStr   string `envconfig:"EVENTHUB_CHECKPOINT_BLOB_CONNECTION_STRING"`
+	CheckpointBlobContainer string `envconfig:"EVENTHUB_CHECKPOINT_BLOB_CONTAINER"`
+	CheckpointConfigMap     string `envconfig:"EVENTHUB_CHECKPOINT_CONFIGMAP"`
+
+	// Namespace and name of the Event Hub.
+	HubNamespace string `envconfig:"EVENTHUB_NAMESPACE" required:"true"`
+	HubName      string `envconfig:"EVENTHUB_NAME" required:"true"`
+
 	// The environment variables below aren't read from the envConfig struct
 	// by the Event Hubs SDK, but rather directly using os.Getenv().
 	// They are nevertheless listed here for documentation purposes.
-	_ string `envconfig:"EVENTHUB_NAMESPACE"`
-	_ string `envconfig:"EVENTHUB_NAME"`
 	_ string `envconfig:"AZURE_TENANT_ID"`
 	_ string `envconfig:"AZURE_CLIENT_ID"`
 	_ string `envconfig:"AZURE_CLIENT_SECRET"`
@@ -88,6 +111,18 @@ type adapter struct {
 	ceClient cloudevents.Client
 
 	msgPrcsr MessageProcessor
+
+	// options of receivers of individual partitions, used when partitions
+	// are not balanced across replicas
+	rcvOpts []eventhub.ReceiveOption
+
+	// parameters of the Event Processor Host, used when partitions are
+	// balanced across replicas
+	hubNamespace  string
+	hubName       string
+	consumerGroup string
+	tokenProvider auth.TokenProvider
+	checkpointer  *leaserCheckpointer
 }
 
 // NewEnvConfig satisfies pkgadapter.EnvConfigConstructor.
@@ -108,9 +143,48 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	env := envAcc.(*envConfig)
 
-	hub, err := eventhub.NewHubFromEnvironment()
-	if err != nil {
-		logger.Panicw("Unable to create Event Hub client", zap.Error(err))
+	var initialCheckpoint persist.Checkpoint
+	rcvOpts := []eventhub.ReceiveOption{eventhub.ReceiveWithConsumerGroup(env.ConsumerGroup)}
+	switch env.StartPosition {
+	case "latest":
+		initialCheckpoint = persist.NewCheckpointFromEndOfStream()
+		rcvOpts = append(rcvOpts, eventhub.ReceiveWithLatestOffset())
+	case "earliest":
+		initialCheckpoint = persist.NewCheckpointFromStartOfStream()
+		rcvOpts = append(rcvOpts, eventhub.ReceiveWithStartingOffset(persist.StartOfStream))
+	default:
+		logger.Panic("Unsupported start position " + strconv.Quote(env.StartPosition))
+	}
+
+	var store partitionStore
+	switch {
+	case env.CheckpointBlobContainer != "":
+		blobStore, err := newBlobPartitionStore(env.CheckpointBlobConnStr, env.CheckpointBlobContainer,
+			env.HubNamespace, env.HubName, env.ConsumerGroup)
+		if err != nil {
+			logger.Panicw("Unable to create Blob checkpoint store", zap.Error(err))
+		}
+		store = blobStore
+
+	case env.CheckpointConfigMap != "":
+		cmCli := kubeclient.Get(ctx).CoreV1().ConfigMaps(env.Namespace)
+		store = newConfigMapPartitionStore(cmCli, env.CheckpointConfigMap, env.ConsumerGroup)
+	}
+
+	var hub *eventhub.Hub
+	var tokenProvider auth.TokenProvider
+	var checkpointer *leaserCheckpointer
+	var err error
+
+	if store == nil {
+		if hub, err = eventhub.NewHubFromEnvironment(); err != nil {
+			logger.Panicw("Unable to create Event Hub client", zap.Error(err))
+		}
+	} else {
+		if tokenProvider, err = tokenProviderFromEnvironment(); err != nil {
+			logger.Panicw("Unable to create Event Hub token provider", zap.Error(err))
+		}
+		checkpointer = newLeaserCheckpointer(store, initialCheckpoint)
 	}
 
 	ceSource := env.HubResourceID
@@ -153,13 +227,43 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		ceClient: ceClient,
 
 		msgPrcsr: msgPrcsr,
+
+		rcvOpts: rcvOpts,
+
+		hubNamespace:  env.HubNamespace,
+		hubName:       env.HubName,
+		consumerGroup: env.ConsumerGroup,
+		tokenProvider: tokenProvider,
+		checkpointer:  checkpointer,
 	}
 }
 
+// tokenProviderFromEnvironment returns a token provider for the Event Hubs
+// API, built from the same environment variables as the client returned by
+// eventhub.NewHubFromEnvironment.
+func tokenProviderFromEnvironment() (auth.TokenProvider, error) {
+	sasProvider, sasErr := sas.NewTokenProvider(sas.TokenProviderWithEnvironmentVars())
+	if sasErr == nil {
+		return sasProvider, nil
+	}
+
+	aadProvider, aadErr := aad.NewJWTProvider(aad.JWTProviderWithEnvironmentVars())
+	if aadErr == nil {
+		return aadProvider, nil
+	}
+
+	return nil, fmt.Errorf("neither Azure Active Directory nor SAS token provider could be built: "+
+		"AAD error: %v, SAS error: %v", aadErr, sasErr)
+}
+
 // Start implements adapter.Adapter.
 func (a *adapter) Start(ctx context.Context) error {
 	go health.Start(ctx)
 
+	if a.checkpointer != nil {
+		return a.startProcessorHost(ctx)
+	}
+
 	connCtx, cancel := context.WithTimeout(ctx, connTimeout)
 	runtimeInfo, err := a.ehClient.GetRuntimeInformation(connCtx)
 	cancel()
@@ -179,7 +283,7 @@ func (a *adapter) Start(ctx context.Context) error {
 	// listen to each partition of the Event Hub
 	for _, partitionID := range runtimeInfo.PartitionIDs {
 		connCtx, cancel := context.WithTimeout(ctx, connTimeout)
-		_, err := a.ehClient.Receive(connCtx, partitionID, a.handleMessage, eventhub.ReceiveWithLatestOffset())
+		_, err := a.ehClient.Receive(connCtx, partitionID, a.handleMessage, a.rcvOpts...)
 		cancel()
 		if err != nil {
 			a.logger.Errorw("An error occurred while starting message receivers. "+
@@ -210,6 +314,46 @@ func (a *adapter) Start(ctx context.Context) error {
 	return nil
 }
 
+// startProcessorHost receives events using an Event Processor Host, which
+// balances partitions across all replicas of the adapter and persists
+// offset checkpoints.
+func (a *adapter) startProcessorHost(ctx context.Context) error {
+	connCtx, cancel := context.WithTimeout(ctx, connTimeout)
+	host, err := eph.New(connCtx, a.hubNamespace, a.hubName, a.tokenProvider, a.checkpointer, a.checkpointer,
+		eph.WithNoBanner(),
+		eph.WithConsumerGroup(a.consumerGroup),
+	)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("creating Event Processor Host: %w", err)
+	}
+
+	a.logger.Info("Starting Event Processor Host for partitions ", host.GetPartitionIDs())
+
+	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
+
+	if _, err := host.RegisterHandler(ctx, a.handleMessage); err != nil {
+		return fmt.Errorf("registering Event Processor Host handler: %w", err)
+	}
+
+	if err := host.StartNonBlocking(ctx); err != nil {
+		return fmt.Errorf("starting Event Processor Host: %w", err)
+	}
+
+	health.MarkReady()
+
+	<-ctx.Done()
+	a.logger.Info("Terminating Event Processor Host")
+
+	closeCtx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+	if err := host.Close(closeCtx); err != nil {
+		return fmt.Errorf("terminating Event Processor Host: %w", err)
+	}
+
+	return nil
+}
+
 // handleMessage satisfies eventhub.Handler.
 func (a *adapter) handleMessage(ctx context.Context, msg *eventhub.Event) error {
 	if msg == nil {
@@ -0,0 +1,178 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azureeventhubsource
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+)
+
+// blobPartitionStore is a partitionStore which persists the state of
+// partitions in an Azure Blob Storage container, as one blob per partition.
+//
+// Concurrent writes are detected using the ETag of blobs.
+type blobPartitionStore struct {
+	cli *azblob.ContainerClient
+
+	// prefix of the names of all blobs, which scopes partition states to
+	// an Event Hub and a consumer group
+	blobPrefix string
+}
+
+var _ partitionStore = (*blobPartitionStore)(nil)
+
+// newBlobPartitionStore returns a partition store backed by the given
+// container of the storage account referenced by connStr. The container
+// is created if it doesn't exist.
+func newBlobPartitionStore(connStr, container, hubNamespace, hubName, consumerGroup string) (*blobPartitionStore, error) {
+	cli, err := azblob.NewContainerClientFromConnectionString(connStr, container, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating Blob container client: %w", err)
+	}
+
+	return &blobPartitionStore{
+		cli:        cli,
+		blobPrefix: path.Join(hubNamespace, hubName, consumerGroup),
+	}, nil
+}
+
+// exists implements partitionStore.
+func (s *blobPartitionStore) exists(ctx context.Context) (bool, error) {
+	_, err := s.cli.GetProperties(ctx, nil)
+	switch {
+	case isStorageError(err, azblob.StorageErrorCodeContainerNotFound):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("getting properties of Blob container: %w", err)
+	}
+
+	return true, nil
+}
+
+// ensure implements partitionStore.
+func (s *blobPartitionStore) ensure(ctx context.Context) error {
+	_, err := s.cli.Create(ctx, nil)
+	if err != nil && !isStorageError(err, azblob.StorageErrorCodeContainerAlreadyExists) {
+		return fmt.Errorf("creating Blob container: %w", err)
+	}
+
+	return nil
+}
+
+// load implements partitionStore.
+func (s *blobPartitionStore) load(ctx context.Context, partitionID string) (*partitionState, error) {
+	blob, err := s.cli.NewBlobClient(s.blobName(partitionID))
+	if err != nil {
+		return nil, fmt.Errorf("creating Blob client: %w", err)
+	}
+
+	resp, err := blob.Download(ctx, nil)
+	switch {
+	case isStorageError(err, azblob.StorageErrorCodeBlobNotFound):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("downloading state of partition %s: %w", partitionID, err)
+	}
+
+	body := resp.Body(nil)
+	defer body.Close()
+
+	st := &partitionState{}
+	if err := json.NewDecoder(body).Decode(st); err != nil {
+		return nil, fmt.Errorf("decoding state of partition %s: %w", partitionID, err)
+	}
+	if resp.ETag != nil {
+		st.version = *resp.ETag
+	}
+
+	return st, nil
+}
+
+// store implements partitionStore.
+func (s *blobPartitionStore) store(ctx context.Context, partitionID string, st *partitionState) error {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return fmt.Errorf("encoding state of partition %s: %w", partitionID, err)
+	}
+
+	blob, err := s.cli.NewBlockBlobClient(s.blobName(partitionID))
+	if err != nil {
+		return fmt.Errorf("creating Blob client: %w", err)
+	}
+
+	cond := &azblob.ModifiedAccessConditions{}
+	if st.version == "" {
+		anyETag := "*"
+		cond.IfNoneMatch = &anyETag
+	} else {
+		etag := st.version
+		cond.IfMatch = &etag
+	}
+
+	resp, err := blob.Upload(ctx, streaming.NopCloser(bytes.NewReader(data)), &azblob.BlockBlobUploadOptions{
+		BlobAccessConditions: &azblob.BlobAccessConditions{
+			ModifiedAccessConditions: cond,
+		},
+	})
+	switch {
+	case isStorageError(err, azblob.StorageErrorCodeConditionNotMet),
+		isStorageError(err, azblob.StorageErrorCodeBlobAlreadyExists):
+		return errConflict
+	case err != nil:
+		return fmt.Errorf("uploading state of partition %s: %w", partitionID, err)
+	}
+
+	if resp.ETag != nil {
+		st.version = *resp.ETag
+	}
+
+	return nil
+}
+
+// delete implements partitionStore.
+func (s *blobPartitionStore) delete(ctx context.Context, partitionID string) error {
+	blob, err := s.cli.NewBlobClient(s.blobName(partitionID))
+	if err != nil {
+		return fmt.Errorf("creating Blob client: %w", err)
+	}
+
+	if _, err := blob.Delete(ctx, nil); err != nil && !isStorageError(err, azblob.StorageErrorCodeBlobNotFound) {
+		return fmt.Errorf("deleting state of partition %s: %w", partitionID, err)
+	}
+
+	return nil
+}
+
+// blobName returns the name of the blob which contains the state of the
+// given partition.
+func (s *blobPartitionStore) blobName(partitionID string) string {
+	return path.Join(s.blobPrefix, partitionID)
+}
+
+// isStorageError returns whether err is an Azure Storage error with the
+// given code.
+func isStorageError(err error, code azblob.StorageErrorCode) bool {
+	var stErr *azblob.StorageError
+	return errors.As(err, &stErr) && stErr.ErrorCode == code
+}
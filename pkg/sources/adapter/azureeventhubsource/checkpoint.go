@@ -0,0 +1,390 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azureeventhubsource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-event-hubs-go/v3/eph"
+	"github.com/Azure/azure-event-hubs-go/v3/persist"
+)
+
+// errConflict indicates that the state of a partition was modified in the
+// store after it was loaded.
+var errConflict = errors.New("partition state was modified concurrently")
+
+// partitionStore persists the state of Event Hubs partitions.
+//
+// Implementations must guarantee that concurrent writes to the state of a
+// given partition are detected, so that a lease can never be held by more
+// than one processor at a time.
+type partitionStore interface {
+	// exists returns whether the store exists.
+	exists(ctx context.Context) (bool, error)
+	// ensure creates the store if it doesn't exist.
+	ensure(ctx context.Context) error
+	// load returns the state of the given partition, or nil if no state
+	// was ever stored for that partition.
+	load(ctx context.Context, partitionID string) (*partitionState, error)
+	// store persists the state of the given partition, provided that the
+	// state in the store wasn't modified since it was loaded. errConflict
+	// is returned otherwise. The version of the state is updated upon
+	// success.
+	store(ctx context.Context, partitionID string, state *partitionState) error
+	// delete removes the state of the given partition.
+	delete(ctx context.Context, partitionID string) error
+}
+
+// partitionState is the persisted state of an Event Hubs partition, which
+// combines a lease and an offset checkpoint.
+type partitionState struct {
+	Owner      string              `json:"owner"`
+	Epoch      int64               `json:"epoch"`
+	Expiration time.Time           `json:"expiration"`
+	Checkpoint *persist.Checkpoint `json:"checkpoint,omitempty"`
+
+	// Opaque version of the state in the store, used to detect concurrent
+	// writes. Empty if the state was never stored.
+	version string
+}
+
+// partitionLease is an eph.LeaseMarker for a partitionState.
+type partitionLease struct {
+	eph.Lease
+	expiration time.Time
+}
+
+var _ eph.LeaseMarker = (*partitionLease)(nil)
+
+// newPartitionLease returns a lease for the given partition state.
+func newPartitionLease(partitionID string, st *partitionState) *partitionLease {
+	return &partitionLease{
+		Lease: eph.Lease{
+			PartitionID: partitionID,
+			Epoch:       st.Epoch,
+			Owner:       st.Owner,
+		},
+		expiration: st.Expiration,
+	}
+}
+
+// IsExpired implements eph.LeaseMarker.
+func (l *partitionLease) IsExpired(context.Context) bool {
+	return l.Owner == "" || time.Now().After(l.expiration)
+}
+
+// leaserCheckpointer coordinates the ownership of partitions across Event
+// Processor Hosts, and records the progress of each host in the partitions
+// it owns.
+//
+// Offset checkpoints are kept in memory and persisted together with the
+// lease of their partition, each time the lease is renewed or released.
+type leaserCheckpointer struct {
+	store             partitionStore
+	leaseDuration     time.Duration
+	initialCheckpoint persist.Checkpoint
+
+	processor processorInfo
+
+	mu sync.Mutex
+	// last known state of the partitions owned by the processor
+	owned map[string]*partitionState
+}
+
+var (
+	_ eph.Leaser       = (*leaserCheckpointer)(nil)
+	_ eph.Checkpointer = (*leaserCheckpointer)(nil)
+)
+
+// processorInfo provides information about an Event Processor Host.
+type processorInfo interface {
+	GetName() string
+	GetPartitionIDs() []string
+}
+
+var _ processorInfo = (*eph.EventProcessorHost)(nil)
+
+// newLeaserCheckpointer returns a leaserCheckpointer which persists the
+// state of partitions in the given store. Partitions without checkpoint are
+// processed starting from initialCheckpoint.
+func newLeaserCheckpointer(s partitionStore, initialCheckpoint persist.Checkpoint) *leaserCheckpointer {
+	return &leaserCheckpointer{
+		store:             s,
+		leaseDuration:     eph.DefaultLeaseDuration,
+		initialCheckpoint: initialCheckpoint,
+		owned:             make(map[string]*partitionState),
+	}
+}
+
+// SetEventHostProcessor implements eph.EventProcessHostSetter.
+func (lc *leaserCheckpointer) SetEventHostProcessor(p *eph.EventProcessorHost) {
+	lc.setProcessor(p)
+}
+
+// setProcessor sets the Event Processor Host which uses the
+// leaserCheckpointer.
+func (lc *leaserCheckpointer) setProcessor(p processorInfo) {
+	lc.processor = p
+}
+
+// StoreExists implements eph.StoreProvisioner.
+func (lc *leaserCheckpointer) StoreExists(ctx context.Context) (bool, error) {
+	return lc.store.exists(ctx)
+}
+
+// EnsureStore implements eph.StoreProvisioner.
+func (lc *leaserCheckpointer) EnsureStore(ctx context.Context) error {
+	return lc.store.ensure(ctx)
+}
+
+// DeleteStore implements eph.StoreProvisioner.
+//
+// The store is provided by the user, therefore it is never deleted.
+func (lc *leaserCheckpointer) DeleteStore(context.Context) error {
+	return nil
+}
+
+// GetLeases implements eph.Leaser.
+func (lc *leaserCheckpointer) GetLeases(ctx context.Context) ([]eph.LeaseMarker, error) {
+	partitionIDs := lc.processor.GetPartitionIDs()
+
+	leases := make([]eph.LeaseMarker, 0, len(partitionIDs))
+	for _, partitionID := range partitionIDs {
+		st, err := lc.loadOrNew(ctx, partitionID)
+		if err != nil {
+			return nil, err
+		}
+		leases = append(leases, newPartitionLease(partitionID, st))
+	}
+
+	return leases, nil
+}
+
+// EnsureLease implements eph.Leaser.
+func (lc *leaserCheckpointer) EnsureLease(ctx context.Context, partitionID string) (eph.LeaseMarker, error) {
+	st, err := lc.loadOrNew(ctx, partitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if st.version == "" {
+		// another processor may have created the lease in the meantime,
+		// in which case there is nothing left to do
+		if err := lc.store.store(ctx, partitionID, st); err != nil && !errors.Is(err, errConflict) {
+			return nil, fmt.Errorf("creating lease of partition %s: %w", partitionID, err)
+		}
+	}
+
+	return newPartitionLease(partitionID, st), nil
+}
+
+// DeleteLease implements eph.Leaser.
+func (lc *leaserCheckpointer) DeleteLease(ctx context.Context, partitionID string) error {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	delete(lc.owned, partitionID)
+	return lc.store.delete(ctx, partitionID)
+}
+
+// AcquireLease implements eph.Leaser.
+//
+// The lease is acquired regardless of its current owner, which allows
+// processors to steal partitions from each other when the load is
+// imbalanced.
+func (lc *leaserCheckpointer) AcquireLease(ctx context.Context, partitionID string) (eph.LeaseMarker, bool, error) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	st, err := lc.loadOrNew(ctx, partitionID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if cur, isOwned := lc.owned[partitionID]; isOwned && st.Owner == lc.processor.GetName() && cur.Checkpoint != nil {
+		st.Checkpoint = cur.Checkpoint
+	}
+
+	st.Owner = lc.processor.GetName()
+	st.Epoch++
+	st.Expiration = time.Now().Add(lc.leaseDuration)
+
+	switch err := lc.store.store(ctx, partitionID, st); {
+	case errors.Is(err, errConflict):
+		return nil, false, nil
+	case err != nil:
+		return nil, false, fmt.Errorf("acquiring lease of partition %s: %w", partitionID, err)
+	}
+
+	lc.owned[partitionID] = st
+
+	return newPartitionLease(partitionID, st), true, nil
+}
+
+// RenewLease implements eph.Leaser.
+func (lc *leaserCheckpointer) RenewLease(ctx context.Context, partitionID string) (eph.LeaseMarker, bool, error) {
+	return lc.renewLease(ctx, partitionID)
+}
+
+// UpdateLease implements eph.Leaser.
+func (lc *leaserCheckpointer) UpdateLease(ctx context.Context, partitionID string) (eph.LeaseMarker, bool, error) {
+	return lc.renewLease(ctx, partitionID)
+}
+
+// renewLease extends the lease of a partition owned by the processor, and
+// persists the last checkpoint recorded for that partition.
+func (lc *leaserCheckpointer) renewLease(ctx context.Context, partitionID string) (eph.LeaseMarker, bool, error) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	cur, isOwned := lc.owned[partitionID]
+	if !isOwned {
+		return nil, false, nil
+	}
+
+	st := *cur
+	st.Expiration = time.Now().Add(lc.leaseDuration)
+
+	switch err := lc.store.store(ctx, partitionID, &st); {
+	case errors.Is(err, errConflict):
+		// the lease was acquired by another processor
+		delete(lc.owned, partitionID)
+		return nil, false, nil
+	case err != nil:
+		return nil, false, fmt.Errorf("renewing lease of partition %s: %w", partitionID, err)
+	}
+
+	lc.owned[partitionID] = &st
+
+	return newPartitionLease(partitionID, &st), true, nil
+}
+
+// ReleaseLease implements eph.Leaser.
+func (lc *leaserCheckpointer) ReleaseLease(ctx context.Context, partitionID string) (bool, error) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	cur, isOwned := lc.owned[partitionID]
+	if !isOwned {
+		return false, nil
+	}
+	delete(lc.owned, partitionID)
+
+	st := *cur
+	st.Owner = ""
+	st.Expiration = time.Time{}
+
+	switch err := lc.store.store(ctx, partitionID, &st); {
+	case errors.Is(err, errConflict):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("releasing lease of partition %s: %w", partitionID, err)
+	}
+
+	return true, nil
+}
+
+// GetCheckpoint implements eph.Checkpointer.
+func (lc *leaserCheckpointer) GetCheckpoint(ctx context.Context, partitionID string) (persist.Checkpoint, bool) {
+	cp, found, _ := lc.checkpoint(ctx, partitionID)
+	return cp, found
+}
+
+// EnsureCheckpoint implements eph.Checkpointer.
+func (lc *leaserCheckpointer) EnsureCheckpoint(ctx context.Context, partitionID string) (persist.Checkpoint, error) {
+	cp, _, err := lc.checkpoint(ctx, partitionID)
+	return cp, err
+}
+
+// UpdateCheckpoint implements eph.Checkpointer.
+//
+// The checkpoint is persisted the next time the lease of the partition is
+// renewed or released.
+func (lc *leaserCheckpointer) UpdateCheckpoint(_ context.Context, partitionID string, cp persist.Checkpoint) error {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	cur, isOwned := lc.owned[partitionID]
+	if !isOwned {
+		return fmt.Errorf("partition %s is not owned by this processor", partitionID)
+	}
+	cur.Checkpoint = &cp
+
+	return nil
+}
+
+// DeleteCheckpoint implements eph.Checkpointer.
+func (lc *leaserCheckpointer) DeleteCheckpoint(_ context.Context, partitionID string) error {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	if cur, isOwned := lc.owned[partitionID]; isOwned {
+		cur.Checkpoint = nil
+	}
+
+	return nil
+}
+
+// Close implements io.Closer.
+//
+// Leases are released by the Event Processor Host before it closes its
+// Leaser, so there is nothing left to persist.
+func (lc *leaserCheckpointer) Close() error {
+	return nil
+}
+
+// checkpoint returns the last checkpoint of the given partition, and
+// whether such checkpoint exists. The initial checkpoint is returned for
+// partitions which were never checkpointed.
+func (lc *leaserCheckpointer) checkpoint(ctx context.Context, partitionID string) (persist.Checkpoint, bool, error) {
+	lc.mu.Lock()
+	cur, isOwned := lc.owned[partitionID]
+	if isOwned && cur.Checkpoint != nil {
+		cp := *cur.Checkpoint
+		lc.mu.Unlock()
+		return cp, true, nil
+	}
+	lc.mu.Unlock()
+
+	st, err := lc.store.load(ctx, partitionID)
+	if err != nil {
+		return lc.initialCheckpoint, false, fmt.Errorf("loading checkpoint of partition %s: %w", partitionID, err)
+	}
+	if st == nil || st.Checkpoint == nil {
+		return lc.initialCheckpoint, false, nil
+	}
+
+	return *st.Checkpoint, true, nil
+}
+
+// loadOrNew returns the stored state of the given partition, or a blank
+// state if none exists.
+func (lc *leaserCheckpointer) loadOrNew(ctx context.Context, partitionID string) (*partitionState, error) {
+	st, err := lc.store.load(ctx, partitionID)
+	if err != nil {
+		return nil, fmt.Errorf("loading state of partition %s: %w", partitionID, err)
+	}
+	if st == nil {
+		st = &partitionState{}
+	}
+
+	return st, nil
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azureeventhubsource
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/azure-event-hubs-go/v3/persist"
+)
+
+const (
+	tNamespace = "test-ns"
+	tCMName    = "test-checkpoints"
+)
+
+func TestLeaserCheckpointer(t *testing.T) {
+	ctx := context.Background()
+
+	cmCli := fake.NewSimpleClientset().CoreV1().ConfigMaps(tNamespace)
+	partitionIDs := []string{"0", "1"}
+
+	newProcessor := func(name string) *leaserCheckpointer {
+		lc := newLeaserCheckpointer(newConfigMapPartitionStore(cmCli, tCMName, "$Default"),
+			persist.NewCheckpointFromEndOfStream())
+		lc.setProcessor(&fakeProcessor{name: name, partitionIDs: partitionIDs})
+		return lc
+	}
+
+	p1 := newProcessor("p1")
+	p2 := newProcessor("p2")
+
+	require.NoError(t, p1.EnsureStore(ctx))
+	exists, err := p2.StoreExists(ctx)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	for _, id := range partitionIDs {
+		_, err := p1.EnsureLease(ctx, id)
+		require.NoError(t, err)
+		_, err = p2.EnsureLease(ctx, id)
+		require.NoError(t, err)
+	}
+
+	leases, err := p2.GetLeases(ctx)
+	require.NoError(t, err)
+	require.Len(t, leases, 2)
+	for _, l := range leases {
+		assert.True(t, l.IsExpired(ctx), "Lease of a new partition should be expired")
+	}
+
+	t.Run("acquire and checkpoint", func(t *testing.T) {
+		lease, ok, err := p1.AcquireLease(ctx, "0")
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "p1", lease.GetOwner())
+		assert.Equal(t, int64(1), lease.GetEpoch())
+		assert.False(t, lease.IsExpired(ctx))
+
+		cp, found := p1.GetCheckpoint(ctx, "0")
+		assert.False(t, found)
+		assert.Equal(t, persist.EndOfStream, cp.Offset)
+
+		require.NoError(t, p1.UpdateCheckpoint(ctx, "0", persist.NewCheckpoint("42", 7, time.Time{})))
+
+		// checkpoints are persisted upon renewal
+		cp, found = p2.GetCheckpoint(ctx, "0")
+		assert.False(t, found)
+		assert.Equal(t, persist.EndOfStream, cp.Offset)
+
+		_, ok, err = p1.RenewLease(ctx, "0")
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		cp, found = p2.GetCheckpoint(ctx, "0")
+		assert.True(t, found)
+		assert.Equal(t, "42", cp.Offset)
+		assert.Equal(t, int64(7), cp.SequenceNumber)
+	})
+
+	t.Run("checkpoint partition not owned", func(t *testing.T) {
+		err := p2.UpdateCheckpoint(ctx, "0", persist.NewCheckpoint("43", 8, time.Time{}))
+		assert.Error(t, err)
+	})
+
+	t.Run("steal lease", func(t *testing.T) {
+		lease, ok, err := p2.AcquireLease(ctx, "0")
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "p2", lease.GetOwner())
+		assert.Equal(t, int64(2), lease.GetEpoch())
+
+		cp, err := p2.EnsureCheckpoint(ctx, "0")
+		require.NoError(t, err)
+		assert.Equal(t, "42", cp.Offset)
+
+		// the former owner loses the lease upon renewal
+		_, ok, err = p1.RenewLease(ctx, "0")
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		assert.Error(t, p1.UpdateCheckpoint(ctx, "0", persist.NewCheckpoint("50", 9, time.Time{})))
+	})
+
+	t.Run("release lease", func(t *testing.T) {
+		require.NoError(t, p2.UpdateCheckpoint(ctx, "0", persist.NewCheckpoint("60", 10, time.Time{})))
+
+		ok, err := p2.ReleaseLease(ctx, "0")
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		leases, err := p1.GetLeases(ctx)
+		require.NoError(t, err)
+		for _, l := range leases {
+			assert.True(t, l.IsExpired(ctx), "Lease of partition %s should be expired", l.GetPartitionID())
+		}
+
+		cp, found := p1.GetCheckpoint(ctx, "0")
+		assert.True(t, found)
+		assert.Equal(t, "60", cp.Offset)
+	})
+
+	t.Run("persisted state", func(t *testing.T) {
+		cm, err := cmCli.Get(ctx, tCMName, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Contains(t, cm.Data, "_Default.0")
+		require.Contains(t, cm.Data, "_Default.1")
+
+		var st partitionState
+		require.NoError(t, json.Unmarshal([]byte(cm.Data["_Default.0"]), &st))
+		assert.Empty(t, st.Owner)
+		assert.Equal(t, int64(2), st.Epoch)
+		require.NotNil(t, st.Checkpoint)
+		assert.Equal(t, "60", st.Checkpoint.Offset)
+	})
+}
+
+func TestConfigMapPartitionStoreConflict(t *testing.T) {
+	ctx := context.Background()
+
+	cmCli := fake.NewSimpleClientset().CoreV1().ConfigMaps(tNamespace)
+	s := newConfigMapPartitionStore(cmCli, tCMName, "$Default")
+
+	st, err := s.load(ctx, "0")
+	require.NoError(t, err)
+	assert.Nil(t, st)
+
+	st1 := &partitionState{Owner: "p1"}
+	require.NoError(t, s.store(ctx, "0", st1))
+	assert.NotEmpty(t, st1.version)
+
+	// a partition state which was never stored can not overwrite an existing one
+	assert.ErrorIs(t, s.store(ctx, "0", &partitionState{Owner: "p2"}), errConflict)
+
+	st2, err := s.load(ctx, "0")
+	require.NoError(t, err)
+	require.NotNil(t, st2)
+	assert.Equal(t, "p1", st2.Owner)
+
+	st2.Owner = "p2"
+	require.NoError(t, s.store(ctx, "0", st2))
+
+	// st1 is stale
+	st1.Epoch++
+	assert.ErrorIs(t, s.store(ctx, "0", st1), errConflict)
+
+	require.NoError(t, s.delete(ctx, "0"))
+	st, err = s.load(ctx, "0")
+	require.NoError(t, err)
+	assert.Nil(t, st)
+}
+
+// fakeProcessor is a processorInfo with static properties.
+type fakeProcessor struct {
+	name         string
+	partitionIDs []string
+}
+
+var _ processorInfo = (*fakeProcessor)(nil)
+
+func (p *fakeProcessor) GetName() string           { return p.name }
+func (p *fakeProcessor) GetPartitionIDs() []string { return p.partitionIDs }
@@ -0,0 +1,204 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azureeventhubsource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// configMapPartitionStore is a partitionStore which persists the state of
+// partitions in a Kubernetes ConfigMap, under one entry per partition.
+type configMapPartitionStore struct {
+	cmCli corev1client.ConfigMapInterface
+	name  string
+
+	// prefix of the keys of all entries, which scopes partition states to
+	// a consumer group
+	keyPrefix string
+}
+
+var _ partitionStore = (*configMapPartitionStore)(nil)
+
+// newConfigMapPartitionStore returns a partition store backed by the
+// ConfigMap with the given name. The ConfigMap is created if it doesn't
+// exist.
+func newConfigMapPartitionStore(cmCli corev1client.ConfigMapInterface, name, consumerGroup string) *configMapPartitionStore {
+	return &configMapPartitionStore{
+		cmCli:     cmCli,
+		name:      name,
+		keyPrefix: invalidConfigMapKeyChars.ReplaceAllString(consumerGroup, "_") + ".",
+	}
+}
+
+// invalidConfigMapKeyChars matches characters which are not allowed in the
+// keys of ConfigMap entries.
+var invalidConfigMapKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+
+// exists implements partitionStore.
+func (s *configMapPartitionStore) exists(ctx context.Context) (bool, error) {
+	_, err := s.cmCli.Get(ctx, s.name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("getting ConfigMap %q: %w", s.name, err)
+	}
+
+	return true, nil
+}
+
+// ensure implements partitionStore.
+func (s *configMapPartitionStore) ensure(ctx context.Context) error {
+	exists, err := s.exists(ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: s.name,
+		},
+	}
+
+	if _, err := s.cmCli.Create(ctx, cm, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("creating ConfigMap %q: %w", s.name, err)
+	}
+
+	return nil
+}
+
+// load implements partitionStore.
+func (s *configMapPartitionStore) load(ctx context.Context, partitionID string) (*partitionState, error) {
+	cm, err := s.cmCli.Get(ctx, s.name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("getting ConfigMap %q: %w", s.name, err)
+	}
+
+	data, ok := cm.Data[s.key(partitionID)]
+	if !ok {
+		return nil, nil
+	}
+
+	st := &partitionState{}
+	if err := json.Unmarshal([]byte(data), st); err != nil {
+		return nil, fmt.Errorf("decoding state of partition %s from ConfigMap %q: %w", partitionID, s.name, err)
+	}
+	// the serialized state itself is used as version, which is sufficient
+	// to detect writes by other processors
+	st.version = data
+
+	return st, nil
+}
+
+// store implements partitionStore.
+func (s *configMapPartitionStore) store(ctx context.Context, partitionID string, st *partitionState) error {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return fmt.Errorf("encoding state of partition %s: %w", partitionID, err)
+	}
+
+	key := s.key(partitionID)
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := s.cmCli.Get(ctx, s.name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			if st.version != "" {
+				return errConflict
+			}
+
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: s.name,
+				},
+				Data: map[string]string{
+					key: string(data),
+				},
+			}
+
+			_, err = s.cmCli.Create(ctx, cm, metav1.CreateOptions{})
+			if apierrors.IsAlreadyExists(err) {
+				// retry as an update
+				return apierrors.NewConflict(corev1.Resource("configmaps"), s.name, err)
+			}
+			return err
+
+		case err != nil:
+			return fmt.Errorf("getting ConfigMap %q: %w", s.name, err)
+		}
+
+		if cm.Data[key] != st.version {
+			return errConflict
+		}
+
+		if cm.Data == nil {
+			cm.Data = make(map[string]string, 1)
+		}
+		cm.Data[key] = string(data)
+
+		_, err = s.cmCli.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	st.version = string(data)
+
+	return nil
+}
+
+// delete implements partitionStore.
+func (s *configMapPartitionStore) delete(ctx context.Context, partitionID string) error {
+	key := s.key(partitionID)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := s.cmCli.Get(ctx, s.name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			return nil
+		case err != nil:
+			return fmt.Errorf("getting ConfigMap %q: %w", s.name, err)
+		}
+
+		if _, ok := cm.Data[key]; !ok {
+			return nil
+		}
+		delete(cm.Data, key)
+
+		_, err = s.cmCli.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// key returns the key of the ConfigMap entry which contains the state of
+// the given partition.
+func (s *configMapPartitionStore) key(partitionID string) string {
+	return s.keyPrefix + partitionID
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/devigned/tab"
 	"go.uber.org/zap"
@@ -71,6 +72,21 @@ type envConfig struct {
 	// Supported values: [ default ]
 	MessageProcessor string `envconfig:"SERVICEBUS_MESSAGE_PROCESSOR" default:"default"`
 
+	// Maximum number of messages delivered concurrently, or number of
+	// sessions processed concurrently when sessions are enabled.
+	MaxConcurrency int `envconfig:"SERVICEBUS_MAX_CONCURRENCY" default:"1"`
+
+	// Base delay before a message which could not be delivered is
+	// abandoned. Doubled with each delivery attempt.
+	RetryBackoff time.Duration `envconfig:"SERVICEBUS_RETRY_BACKOFF"`
+
+	// Number of delivery attempts after which a message which could not be
+	// delivered is moved to the dead-letter sub-queue.
+	MaxDeliveryAttempts uint32 `envconfig:"SERVICEBUS_MAX_DELIVERY_ATTEMPTS"`
+
+	// Whether the Service Bus entity requires sessions.
+	SessionsEnabled bool `envconfig:"SERVICEBUS_SESSIONS_ENABLED"`
+
 	// The environment variables below aren't read from the envConfig struct
 	// by the Service Bus SDK, but rather directly using os.Getenv().
 	// They are nevertheless listed here for documentation purposes.
@@ -84,12 +100,21 @@ type envConfig struct {
 
 // adapter implements the source's adapter.
 type adapter struct {
-	mt *pkgadapter.MetricTag
+	mt     *pkgadapter.MetricTag
+	logger *zap.SugaredLogger
+
+	// only one of these is set, depending on whether the Service Bus
+	// entity requires sessions
+	msgRcvr       msgReceiver
+	acceptSession sessionAcceptor
 
-	msgRcvr  *azservicebus.Receiver
 	ceClient cloudevents.Client
 
 	msgPrcsr MessageProcessor
+
+	maxConcurrency      int
+	retryBackoff        time.Duration
+	maxDeliveryAttempts uint32
 }
 
 // NewEnvConfig satisfies pkgadapter.EnvConfigConstructor.
@@ -118,17 +143,33 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Panicw("Unable to obtain interface for Service Bus Namespace", zap.Error(err))
 	}
 
-	var rcvr *azservicebus.Receiver
 	switch entityID.ResourceType {
 	case resourceTypeQueues:
-		rcvr, err = client.NewReceiverForQueue(entityID.ResourceName, nil)
 		mt.ResourceGroup = sources.AzureServiceBusQueueSourceResource.String()
 	case resourceTypeSubscriptions, resourceTypeTopics:
-		rcvr, err = client.NewReceiverForSubscription(entityID.ResourceName, entityID.SubResourceName, nil)
 		mt.ResourceGroup = sources.AzureServiceBusTopicSourceResource.String()
 	}
-	if err != nil {
-		logger.Panicw("Unable to obtain message receiver for Service Bus entity "+strconv.Quote(strconv.Quote(entityPath(entityID))), zap.Error(err))
+
+	var rcvr *azservicebus.Receiver
+	var acceptSession sessionAcceptor
+	if env.SessionsEnabled {
+		acceptSession = newSessionAcceptor(client, entityID)
+	} else {
+		switch entityID.ResourceType {
+		case resourceTypeQueues:
+			rcvr, err = client.NewReceiverForQueue(entityID.ResourceName, nil)
+		case resourceTypeSubscriptions, resourceTypeTopics:
+			rcvr, err = client.NewReceiverForSubscription(entityID.ResourceName, entityID.SubResourceName, nil)
+		}
+		if err != nil {
+			logger.Panicw("Unable to obtain message receiver for Service Bus entity "+strconv.Quote(entityPath(entityID)), zap.Error(err))
+		}
+	}
+
+	maxConcurrency := env.MaxConcurrency
+	if maxConcurrency < 1 {
+		logger.Warn("Ignoring invalid maximum concurrency (", maxConcurrency, ")")
+		maxConcurrency = 1
 	}
 
 	ceSource := env.EntityResourceID
@@ -147,14 +188,28 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 	// Knative's logging facilities.
 	tab.Register(trace.NewNoOpTracerWithLogger(logger))
 
-	return &adapter{
-		mt: mt,
+	a := &adapter{
+		mt:     mt,
+		logger: logger,
+
+		acceptSession: acceptSession,
 
 		ceClient: ceClient,
 
-		msgRcvr:  rcvr,
 		msgPrcsr: msgPrcsr,
+
+		maxConcurrency:      maxConcurrency,
+		retryBackoff:        env.RetryBackoff,
+		maxDeliveryAttempts: env.MaxDeliveryAttempts,
 	}
+
+	// avoid assigning a nil *azservicebus.Receiver to the msgReceiver
+	// interface, which would make it non-nil
+	if rcvr != nil {
+		a.msgRcvr = rcvr
+	}
+
+	return a
 }
 
 // parseServiceBusResourceID parses the given resource ID string to a
@@ -253,39 +308,16 @@ func connectionStringFromEnvironment(namespace, entityPath string) string {
 //  Both (DataAction):
 //  - Microsoft.ServiceBus/namespaces/messages/receive/action
 func (a *adapter) Start(ctx context.Context) error {
-	const maxMessages = 100
 	logging.FromContext(ctx).Info("Listening for messages")
 
 	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
 
-loop:
-	for {
-		select {
-		case <-ctx.Done():
-			break loop
-		default:
-			messages, err := a.msgRcvr.ReceiveMessages(ctx, maxMessages, nil)
-			if err != nil {
-				return fmt.Errorf("error receiving messages: %w", err)
-			}
-			for _, m := range messages {
-				msg, err := toMessage(m)
-				if err != nil {
-					return fmt.Errorf("error transforming message: %w", err)
-				}
-
-				if err := a.handleMessage(ctx, msg); err != nil {
-					return fmt.Errorf("error processing message: %w", err)
-				}
-
-				if err := a.msgRcvr.CompleteMessage(ctx, m, nil); err != nil {
-					return fmt.Errorf("error completing message: %w", err)
-				}
-			}
-		}
+	if a.acceptSession != nil {
+		a.receiveSessions(ctx)
+		return nil
 	}
 
-	return nil
+	return a.receiveMessages(ctx)
 }
 
 // handleMessage handles a single Service Bus message.
@@ -0,0 +1,390 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azureservicebussource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+const (
+	// Maximum delay before a message which could not be delivered is
+	// abandoned.
+	maxRetryDelay = 5 * time.Minute
+
+	// Duration after which a session which has no message available is
+	// released, so that the receiver can accept another session.
+	sessionIdleTimeout = 30 * time.Second
+
+	// Delay before accepting a new session after a failed attempt.
+	acceptSessionBackoff = 5 * time.Second
+
+	// Lower bound of the interval between two lock renewals.
+	minLockRenewalInterval = time.Second
+)
+
+// Reason set on messages which are moved to the dead-letter sub-queue.
+const deadLetterReasonMaxDeliveries = "MaxDeliveryAttemptsExceeded"
+
+// msgSettler settles messages received from a Service Bus entity.
+type msgSettler interface {
+	CompleteMessage(context.Context, *azservicebus.ReceivedMessage, *azservicebus.CompleteMessageOptions) error
+	AbandonMessage(context.Context, *azservicebus.ReceivedMessage, *azservicebus.AbandonMessageOptions) error
+	DeadLetterMessage(context.Context, *azservicebus.ReceivedMessage, *azservicebus.DeadLetterOptions) error
+}
+
+// msgReceiver receives messages from a Service Bus entity which doesn't
+// require sessions.
+type msgReceiver interface {
+	msgSettler
+	ReceiveMessages(context.Context, int, *azservicebus.ReceiveMessagesOptions) ([]*azservicebus.ReceivedMessage, error)
+	RenewMessageLock(context.Context, *azservicebus.ReceivedMessage, *azservicebus.RenewMessageLockOptions) error
+}
+
+// sessionReceiver receives messages from a single session of a Service Bus
+// entity which requires sessions.
+type sessionReceiver interface {
+	msgSettler
+	ReceiveMessages(context.Context, int, *azservicebus.ReceiveMessagesOptions) ([]*azservicebus.ReceivedMessage, error)
+	RenewSessionLock(context.Context, *azservicebus.RenewSessionLockOptions) error
+	LockedUntil() time.Time
+	SessionID() string
+	Close(context.Context) error
+}
+
+var (
+	_ msgReceiver     = (*azservicebus.Receiver)(nil)
+	_ sessionReceiver = (*azservicebus.SessionReceiver)(nil)
+)
+
+// sessionAcceptor accepts the next available session of a Service Bus entity.
+type sessionAcceptor func(context.Context) (sessionReceiver, error)
+
+// newSessionAcceptor returns a sessionAcceptor for the given Service Bus entity.
+func newSessionAcceptor(cli *azservicebus.Client, entityID *v1alpha1.AzureResourceID) sessionAcceptor {
+	return func(ctx context.Context) (sessionReceiver, error) {
+		var sr *azservicebus.SessionReceiver
+		var err error
+
+		switch entityID.ResourceType {
+		case resourceTypeQueues:
+			sr, err = cli.AcceptNextSessionForQueue(ctx, entityID.ResourceName, nil)
+		default:
+			sr, err = cli.AcceptNextSessionForSubscription(ctx, entityID.ResourceName, entityID.SubResourceName, nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return sr, nil
+	}
+}
+
+// receiveMessages receives messages from a Service Bus entity which doesn't
+// require sessions, and processes them using a pool of up to maxConcurrency
+// workers. Messages are only received when workers are available, so that
+// their lock doesn't expire while they wait to be processed.
+func (a *adapter) receiveMessages(ctx context.Context) error {
+	workers := make(chan struct{}, a.maxConcurrency)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	renewLock := func(ctx context.Context, msg *azservicebus.ReceivedMessage) (time.Time, error) {
+		// RenewMessageLock updates the expiration time of the message it
+		// receives, which is concurrently read while the message is being
+		// processed. We therefore operate on a shallow copy.
+		msgCpy := *msg
+		if err := a.msgRcvr.RenewMessageLock(ctx, &msgCpy, nil); err != nil {
+			return time.Time{}, err
+		}
+		return lockedUntil(&msgCpy), nil
+	}
+
+	for {
+		// block until at least one worker is available, then reserve
+		// all other idle workers
+		select {
+		case <-ctx.Done():
+			return nil
+		case workers <- struct{}{}:
+		}
+
+		available := 1
+	reserve:
+		for available < a.maxConcurrency {
+			select {
+			case workers <- struct{}{}:
+				available++
+			default:
+				break reserve
+			}
+		}
+
+		msgs, err := a.msgRcvr.ReceiveMessages(ctx, available, nil)
+
+		// release the workers which won't be used
+		for i := len(msgs); i < available; i++ {
+			<-workers
+		}
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("error receiving messages: %w", err)
+		}
+
+		for _, m := range msgs {
+			wg.Add(1)
+			go func(m *azservicebus.ReceivedMessage) {
+				defer func() {
+					<-workers
+					wg.Done()
+				}()
+
+				stopRenewal := keepLockAlive(ctx, a.logger, lockedUntil(m), func(ctx context.Context) (time.Time, error) {
+					return renewLock(ctx, m)
+				})
+				defer stopRenewal()
+
+				a.processMessage(ctx, a.msgRcvr, m)
+			}(m)
+		}
+	}
+}
+
+// receiveSessions accepts up to maxConcurrency sessions of a Service Bus
+// entity which requires sessions, and processes the messages of each session
+// in order.
+func (a *adapter) receiveSessions(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < a.maxConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for ctx.Err() == nil {
+				sr, err := a.acceptSession(ctx)
+				if err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+
+					// Service Bus times out accept operations when no
+					// session is available, this is expected.
+					a.logger.Debugw("No session accepted", zap.Error(err))
+
+					select {
+					case <-ctx.Done():
+					case <-time.After(acceptSessionBackoff):
+					}
+					continue
+				}
+
+				a.receiveSession(ctx, sr)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// receiveSession processes the messages of the given session sequentially,
+// until the session is idle for longer than sessionIdleTimeout or its lock
+// is lost.
+func (a *adapter) receiveSession(ctx context.Context, sr sessionReceiver) {
+	sessionID := sr.SessionID()
+	logger := a.logger.With(zap.String("sessionID", sessionID))
+
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.Background(), acceptSessionBackoff)
+		defer cancel()
+		if err := sr.Close(closeCtx); err != nil {
+			logger.Warnw("Failed to close session receiver", zap.Error(err))
+		}
+	}()
+
+	// the lock of a session covers the messages it contains, so there is
+	// no need to renew the lock of individual messages
+	sessCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stopRenewal := keepLockAlive(sessCtx, logger, sr.LockedUntil(), func(ctx context.Context) (time.Time, error) {
+		if err := sr.RenewSessionLock(ctx, nil); err != nil {
+			// the session can't be settled anymore, interrupt its processing
+			cancel()
+			return time.Time{}, err
+		}
+		return sr.LockedUntil(), nil
+	})
+	defer stopRenewal()
+
+	for {
+		rcvCtx, rcvCancel := context.WithTimeout(sessCtx, sessionIdleTimeout)
+		msgs, err := sr.ReceiveMessages(rcvCtx, 1, nil)
+		rcvCancel()
+
+		switch {
+		case sessCtx.Err() != nil:
+			return
+		case err != nil:
+			logger.Errorw("Failed to receive messages from session", zap.Error(err))
+			return
+		case len(msgs) == 0:
+			logger.Debug("Releasing idle session")
+			return
+		}
+
+		for _, m := range msgs {
+			a.processMessage(sessCtx, sr, m)
+		}
+	}
+}
+
+// processMessage delivers the given message to the sink and settles it
+// according to the outcome of the delivery.
+//
+// Messages which could not be delivered are abandoned after a backoff delay,
+// or moved to the dead-letter sub-queue once they reach the maximum number of
+// delivery attempts.
+func (a *adapter) processMessage(ctx context.Context, s msgSettler, m *azservicebus.ReceivedMessage) {
+	logger := a.logger.With(zap.String("messageID", m.MessageID), zap.Uint32("deliveryCount", m.DeliveryCount))
+
+	msg, err := toMessage(m)
+	if err == nil {
+		err = a.handleMessage(ctx, msg)
+	}
+
+	if err == nil {
+		if err := s.CompleteMessage(ctx, m, nil); err != nil {
+			logger.Errorw("Failed to complete message", zap.Error(err))
+		}
+		return
+	}
+
+	if ctx.Err() != nil {
+		// the adapter is stopping, let the lock of the message expire
+		return
+	}
+
+	logger.Errorw("Failed to deliver message", zap.Error(err))
+
+	if a.maxDeliveryAttempts > 0 && m.DeliveryCount >= a.maxDeliveryAttempts {
+		logger.Warn("Moving message to the dead-letter sub-queue after reaching the maximum number of delivery attempts")
+
+		err := s.DeadLetterMessage(ctx, m, &azservicebus.DeadLetterOptions{
+			Reason:           to.Ptr(deadLetterReasonMaxDeliveries),
+			ErrorDescription: to.Ptr(err.Error()),
+		})
+		if err != nil {
+			logger.Errorw("Failed to dead-letter message", zap.Error(err))
+		}
+		return
+	}
+
+	if a.retryBackoff > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryDelay(a.retryBackoff, m.DeliveryCount)):
+		}
+	}
+
+	if err := s.AbandonMessage(ctx, m, nil); err != nil {
+		logger.Errorw("Failed to abandon message", zap.Error(err))
+	}
+}
+
+// lockRenewer renews a lock and returns its new expiration time.
+type lockRenewer func(context.Context) (time.Time, error)
+
+// keepLockAlive periodically renews a lock which expires at the given time,
+// until the returned function is called or renewing the lock fails.
+func keepLockAlive(ctx context.Context, logger *zap.SugaredLogger, expiry time.Time, renew lockRenewer) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(lockRenewalInterval(expiry)):
+			}
+
+			var err error
+			if expiry, err = renew(ctx); err != nil {
+				if ctx.Err() == nil {
+					logger.Warnw("Failed to renew lock", zap.Error(err))
+				}
+				return
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// lockRenewalInterval returns the duration after which a lock which expires
+// at the given time should be renewed.
+func lockRenewalInterval(expiry time.Time) time.Duration {
+	interval := time.Until(expiry) / 2
+	if interval < minLockRenewalInterval {
+		interval = minLockRenewalInterval
+	}
+	return interval
+}
+
+// lockedUntil returns the time at which the lock of the given message
+// expires.
+func lockedUntil(m *azservicebus.ReceivedMessage) time.Time {
+	if m.LockedUntil == nil {
+		return time.Time{}
+	}
+	return *m.LockedUntil
+}
+
+// retryDelay returns the delay before a message which has been delivered the
+// given number of times is abandoned. The delay grows exponentially from the
+// given base, and is capped to maxRetryDelay.
+func retryDelay(base time.Duration, deliveryCount uint32) time.Duration {
+	delay := base
+	for i := uint32(1); i < deliveryCount && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,344 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azureservicebussource
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+)
+
+func TestSettleMessage(t *testing.T) {
+	testCases := map[string]struct {
+		sendFails           bool
+		deliveryCount       uint32
+		maxDeliveryAttempts uint32
+		expectSettlement    string
+	}{
+		"delivered": {
+			deliveryCount:    1,
+			expectSettlement: settlementComplete,
+		},
+		"undelivered without maximum attempts": {
+			sendFails:        true,
+			deliveryCount:    5,
+			expectSettlement: settlementAbandon,
+		},
+		"undelivered below maximum attempts": {
+			sendFails:           true,
+			deliveryCount:       2,
+			maxDeliveryAttempts: 3,
+			expectSettlement:    settlementAbandon,
+		},
+		"undelivered at maximum attempts": {
+			sendFails:           true,
+			deliveryCount:       3,
+			maxDeliveryAttempts: 3,
+			expectSettlement:    settlementDeadLetter,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			var ceClient cloudevents.Client = adaptertest.NewTestClient()
+			if tc.sendFails {
+				ceClient = &failingClient{}
+			}
+
+			a := &adapter{
+				logger:              zap.NewNop().Sugar(),
+				ceClient:            ceClient,
+				msgPrcsr:            &defaultMessageProcessor{},
+				maxDeliveryAttempts: tc.maxDeliveryAttempts,
+			}
+
+			s := &fakeSettler{}
+			a.processMessage(context.Background(), s, newTestMessage("0", tc.deliveryCount))
+
+			require.Len(t, s.settlements, 1)
+			assert.Equal(t, tc.expectSettlement, s.settlements[0].kind)
+
+			if tc.expectSettlement == settlementDeadLetter {
+				opts := s.settlements[0].deadLetterOpts
+				require.NotNil(t, opts)
+				require.NotNil(t, opts.Reason)
+				assert.Equal(t, deadLetterReasonMaxDeliveries, *opts.Reason)
+				require.NotNil(t, opts.ErrorDescription)
+				assert.Contains(t, *opts.ErrorDescription, errFakeSend.Error())
+			}
+		})
+	}
+}
+
+func TestReceiveMessagesConcurrency(t *testing.T) {
+	const maxConcurrency = 3
+	const numMessages = 10
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rcvr := &fakeReceiver{
+		fakeSettler: fakeSettler{
+			onSettle: func(n int) {
+				if n == numMessages {
+					cancel()
+				}
+			},
+		},
+	}
+	for i := 0; i < numMessages; i++ {
+		rcvr.msgs = append(rcvr.msgs, newTestMessage(string(rune('a'+i)), 1))
+	}
+
+	a := &adapter{
+		logger:         zap.NewNop().Sugar(),
+		msgRcvr:        rcvr,
+		ceClient:       adaptertest.NewTestClientWithDelay(10 * time.Millisecond),
+		msgPrcsr:       &defaultMessageProcessor{},
+		maxConcurrency: maxConcurrency,
+	}
+
+	err := a.receiveMessages(ctx)
+	require.NoError(t, err)
+
+	assert.Len(t, rcvr.settlements, numMessages)
+	for _, s := range rcvr.settlements {
+		assert.Equal(t, settlementComplete, s.kind)
+	}
+
+	for _, n := range rcvr.requested {
+		assert.LessOrEqual(t, n, maxConcurrency, "Received more messages than available workers")
+	}
+}
+
+func TestReceiveSession(t *testing.T) {
+	sr := &fakeSessionReceiver{}
+	sr.msgs = []*azservicebus.ReceivedMessage{
+		newTestMessage("0", 1),
+		newTestMessage("1", 1),
+		newTestMessage("2", 1),
+	}
+
+	ceClient := adaptertest.NewTestClient()
+
+	a := &adapter{
+		logger:   zap.NewNop().Sugar(),
+		ceClient: ceClient,
+		msgPrcsr: &defaultMessageProcessor{},
+	}
+
+	a.receiveSession(context.Background(), sr)
+
+	assert.True(t, sr.closed, "Session receiver wasn't closed")
+
+	sent := ceClient.Sent()
+	require.Len(t, sent, 3)
+	for i, ev := range sent {
+		assert.Equal(t, string(rune('0'+i)), ev.ID(), "Messages were delivered out of order")
+	}
+
+	require.Len(t, sr.settlements, 3)
+	for _, s := range sr.settlements {
+		assert.Equal(t, settlementComplete, s.kind)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	const base = 10 * time.Second
+
+	testCases := map[uint32]time.Duration{
+		0:  base,
+		1:  base,
+		2:  2 * base,
+		3:  4 * base,
+		20: maxRetryDelay,
+	}
+
+	for deliveryCount, expectDelay := range testCases {
+		assert.Equal(t, expectDelay, retryDelay(base, deliveryCount), "Delivery count: %d", deliveryCount)
+	}
+}
+
+func newTestMessage(id string, deliveryCount uint32) *azservicebus.ReceivedMessage {
+	lockedUntil := time.Now().Add(time.Minute)
+
+	return &azservicebus.ReceivedMessage{
+		MessageID:     id,
+		Body:          []byte(`{"test": null}`),
+		DeliveryCount: deliveryCount,
+		LockedUntil:   &lockedUntil,
+	}
+}
+
+var errFakeSend = errors.New("fake send error")
+
+// failingClient is a cloudevents.Client which fails to send events.
+type failingClient struct {
+	cloudevents.Client
+}
+
+func (*failingClient) Send(context.Context, cloudevents.Event) protocol.Result {
+	return errFakeSend
+}
+
+const (
+	settlementComplete   = "complete"
+	settlementAbandon    = "abandon"
+	settlementDeadLetter = "deadletter"
+)
+
+type settlement struct {
+	kind           string
+	msgID          string
+	deadLetterOpts *azservicebus.DeadLetterOptions
+}
+
+// fakeSettler is a msgSettler which records settlements.
+type fakeSettler struct {
+	mu          sync.Mutex
+	settlements []settlement
+
+	// called with the total number of settlements after each settlement
+	onSettle func(int)
+}
+
+var _ msgSettler = (*fakeSettler)(nil)
+
+func (s *fakeSettler) settle(st settlement) {
+	s.mu.Lock()
+	s.settlements = append(s.settlements, st)
+	n := len(s.settlements)
+	s.mu.Unlock()
+
+	if s.onSettle != nil {
+		s.onSettle(n)
+	}
+}
+
+func (s *fakeSettler) CompleteMessage(_ context.Context, m *azservicebus.ReceivedMessage,
+	_ *azservicebus.CompleteMessageOptions) error {
+
+	s.settle(settlement{kind: settlementComplete, msgID: m.MessageID})
+	return nil
+}
+
+func (s *fakeSettler) AbandonMessage(_ context.Context, m *azservicebus.ReceivedMessage,
+	_ *azservicebus.AbandonMessageOptions) error {
+
+	s.settle(settlement{kind: settlementAbandon, msgID: m.MessageID})
+	return nil
+}
+
+func (s *fakeSettler) DeadLetterMessage(_ context.Context, m *azservicebus.ReceivedMessage,
+	opts *azservicebus.DeadLetterOptions) error {
+
+	s.settle(settlement{kind: settlementDeadLetter, msgID: m.MessageID, deadLetterOpts: opts})
+	return nil
+}
+
+// fakeReceiver is a msgReceiver which returns messages from a static list,
+// and blocks once all messages have been received.
+type fakeReceiver struct {
+	fakeSettler
+
+	msgs      []*azservicebus.ReceivedMessage
+	requested []int
+}
+
+var _ msgReceiver = (*fakeReceiver)(nil)
+
+func (r *fakeReceiver) ReceiveMessages(ctx context.Context, maxMessages int,
+	_ *azservicebus.ReceiveMessagesOptions) ([]*azservicebus.ReceivedMessage, error) {
+
+	r.requested = append(r.requested, maxMessages)
+
+	if len(r.msgs) == 0 {
+		<-ctx.Done()
+		return nil, nil
+	}
+
+	n := maxMessages
+	if n > len(r.msgs) {
+		n = len(r.msgs)
+	}
+
+	msgs := r.msgs[:n]
+	r.msgs = r.msgs[n:]
+
+	return msgs, nil
+}
+
+func (*fakeReceiver) RenewMessageLock(context.Context, *azservicebus.ReceivedMessage,
+	*azservicebus.RenewMessageLockOptions) error {
+
+	return nil
+}
+
+// fakeSessionReceiver is a sessionReceiver which returns messages from a
+// static list, one at a time.
+type fakeSessionReceiver struct {
+	fakeSettler
+
+	msgs   []*azservicebus.ReceivedMessage
+	closed bool
+}
+
+var _ sessionReceiver = (*fakeSessionReceiver)(nil)
+
+func (r *fakeSessionReceiver) ReceiveMessages(_ context.Context, _ int,
+	_ *azservicebus.ReceiveMessagesOptions) ([]*azservicebus.ReceivedMessage, error) {
+
+	if len(r.msgs) == 0 {
+		return nil, nil
+	}
+
+	msg := r.msgs[0]
+	r.msgs = r.msgs[1:]
+
+	return []*azservicebus.ReceivedMessage{msg}, nil
+}
+
+func (*fakeSessionReceiver) RenewSessionLock(context.Context, *azservicebus.RenewSessionLockOptions) error {
+	return nil
+}
+
+func (*fakeSessionReceiver) LockedUntil() time.Time {
+	return time.Now().Add(time.Minute)
+}
+
+func (*fakeSessionReceiver) SessionID() string {
+	return "test-session"
+}
+
+func (r *fakeSessionReceiver) Close(context.Context) error {
+	r.closed = true
+	return nil
+}
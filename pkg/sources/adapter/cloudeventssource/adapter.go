@@ -19,6 +19,8 @@ package cloudeventssource
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -30,9 +32,15 @@ import (
 
 	"github.com/triggermesh/triggermesh/pkg/adapter/fs"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/cloudeventssource/jwks"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/cloudeventssource/ratelimiter"
 )
 
+// jwksRefreshInterval is the interval at which a JSON Web Key Set served at a
+// URL is retrieved again.
+const jwksRefreshInterval = 10 * time.Minute
+
 // NewAdapter satisfies pkgadapter.AdapterConstructor.
 func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
 	logger := logging.FromContext(ctx)
@@ -50,21 +58,58 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Panicw("Could not create a file watcher", zap.Error(err))
 	}
 
-	for _, as := range env.BasicAuths {
-		if err := cfw.Add(as.MountedValueFile); err != nil {
+	for _, kvs := range []KeyMountedValues{env.BasicAuths, env.APIKeys} {
+		for _, as := range kvs {
+			if err := cfw.Add(as.MountedValueFile); err != nil {
+				logger.Panicw(
+					fmt.Sprintf("Authentication secret at %q could not be watched", as.MountedValueFile),
+					zap.Error(err))
+			}
+		}
+	}
+
+	var keys jwks.Provider
+	switch {
+	case env.JWKS != "":
+		if keys, err = jwks.NewStaticProvider([]byte(env.JWKS)); err != nil {
+			logger.Panicw("Could not parse the JSON Web Key Set", zap.Error(err))
+		}
+	case env.JWKSFile != "":
+		if err := cfw.Add(env.JWKSFile); err != nil {
 			logger.Panicw(
-				fmt.Sprintf("Authentication secret at %q could not be watched", as.MountedValueFile),
+				fmt.Sprintf("JSON Web Key Set at %q could not be watched", env.JWKSFile),
 				zap.Error(err))
 		}
+		keys = jwks.NewFileProvider(cfw, env.JWKSFile)
+	case env.JWKSURL != "":
+		keys = jwks.NewURLProvider(http.DefaultClient, env.JWKSURL, jwksRefreshInterval)
 	}
 
+	policies := make(map[string]*callerPolicy, len(env.CallerPolicies))
+	for caller, p := range env.CallerPolicies {
+		if policies[caller], err = newCallerPolicy(&p); err != nil {
+			logger.Panicw(fmt.Sprintf("Could not create the policy of caller %q", caller), zap.Error(err))
+		}
+	}
+
+	metrics.MustRegisterRequestRejectionStatsView()
+
 	ceh := &cloudEventsHandler{
 		basicAuths: env.BasicAuths,
+		apiKeys:    env.APIKeys,
+
+		tokens:         keys,
+		jwtIssuer:      env.JWTIssuer,
+		jwtAudience:    env.JWTAudience,
+		jwtCallerClaim: env.JWTCallerClaim,
+
+		policies: policies,
 
 		cfw:      cfw,
 		ceClient: ceClient,
 		logger:   logging.FromContext(ctx),
 		mt:       mt,
+		rr:       metrics.MustNewRequestRejectionStatsReporter(mt),
 	}
 
 	// prepare CE server options
@@ -73,7 +118,7 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 	if env.Path != "" {
 		options = append(options, cehttp.WithPath(env.Path))
 	}
-	if len(env.BasicAuths) != 0 {
+	if ceh.requiresAuthentication() {
 		options = append(options, cehttp.WithMiddleware(ceh.handleAuthentication))
 	}
 
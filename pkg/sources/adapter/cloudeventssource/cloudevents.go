@@ -34,31 +34,68 @@ THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
 package cloudeventssource
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"crypto/subtle"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"mime"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
 	"github.com/cloudevents/sdk-go/v2/event"
 	"github.com/cloudevents/sdk-go/v2/protocol"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
 	"github.com/triggermesh/triggermesh/pkg/adapter/fs"
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/cloudeventssource/jwks"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/cloudeventssource/ratelimiter"
+	cereconciler "github.com/triggermesh/triggermesh/pkg/sources/reconciler/cloudeventssource"
+)
+
+// headerAPIKey is the request header which carries API keys.
+const headerAPIKey = "X-API-Key"
+
+// maxPeekedBodySize is the maximum size of request bodies which are read
+// to enforce policies on events, before those events are processed.
+const maxPeekedBodySize = 4 << 20 // 4 MiB
+
+var (
+	errBodyTooLarge     = fmt.Errorf("request body exceeds %d bytes", maxPeekedBodySize)
+	errBatchUnsupported = errors.New("batches of events are not supported")
 )
 
 type cloudEventsHandler struct {
 	basicAuths KeyMountedValues
+	apiKeys    KeyMountedValues
+
+	tokens         jwks.Provider
+	jwtIssuer      string
+	jwtAudience    string
+	jwtCallerClaim string
+
+	// policies applied to authenticated callers, by caller identifier
+	policies map[string]*callerPolicy
 
 	cfw      fs.CachedFileWatcher
 	ceServer cloudevents.Client
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
 	mt       *pkgadapter.MetricTag
+	rr       *metrics.RequestRejectionStatsReporter
 }
 
 // Start implements adapter.Adapter.
@@ -82,40 +119,308 @@ func (h *cloudEventsHandler) handle(ctx context.Context, e event.Event) protocol
 	return result
 }
 
-// code based on VMware's VEBA's webhook:
-// https://github.com/vmware-samples/vcenter-event-broker-appliance/blob/e91e4bd8a17dad6ce4fe370c42a15694c03dac88/vmware-event-router/internal/provider/webhook/webhook.go#L167-L189
+// requiresAuthentication returns whether requests must be authenticated.
+func (h *cloudEventsHandler) requiresAuthentication() bool {
+	return len(h.basicAuths) != 0 || len(h.apiKeys) != 0 || h.tokens != nil
+}
+
+// handleAuthentication authenticates the caller of each request, and enforces
+// the policy associated with that caller, if any.
 func (h *cloudEventsHandler) handleAuthentication(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		username, password, ok := r.BasicAuth()
-
-		if ok {
-			// reduce brute-force guessing attacks with constant-time comparisons
-			usernameHash := sha256.Sum256([]byte(username))
-			passwordHash := sha256.Sum256([]byte(password))
-
-			for _, kv := range h.basicAuths {
-				p, err := h.cfw.GetContent(kv.MountedValueFile)
-				if err != nil {
-					h.logger.Errorw(
-						fmt.Sprintf("Could not retrieve password for user %q", kv.Key),
-						zap.Error(err))
-					continue
-				}
-
-				expectedUsernameHash := sha256.Sum256([]byte(kv.Key))
-				expectedPasswordHash := sha256.Sum256(p)
-
-				usernameMatch := subtle.ConstantTimeCompare(usernameHash[:], expectedUsernameHash[:]) == 1
-				passwordMatch := subtle.ConstantTimeCompare(passwordHash[:], expectedPasswordHash[:]) == 1
-
-				if usernameMatch && passwordMatch {
-					next.ServeHTTP(w, r)
-					return
-				}
+		caller, ok := h.authenticate(r)
+		if !ok {
+			h.rr.ReportRejection(metrics.RejectReasonUnauthenticated, "")
+
+			if len(h.basicAuths) != 0 {
+				w.Header().Add("WWW-Authenticate", `Basic realm="restricted", charset="UTF-8"`)
+			}
+			if h.tokens != nil {
+				w.Header().Add("WWW-Authenticate", `Bearer realm="restricted"`)
 			}
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
 		}
 
-		w.Header().Set("WWW-Authenticate", `Basic realm="restricted", charset="UTF-8"`)
-		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		p, hasPolicy := h.policies[caller]
+		if !hasPolicy {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if reason, code, retryAfter := p.enforce(r); reason != "" {
+			h.rr.ReportRejection(reason, callerKind(caller))
+
+			if retryAfter != 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			}
+			http.Error(w, http.StatusText(code), code)
+			return
+		}
+
+		next.ServeHTTP(w, r)
 	})
 }
+
+// authenticate returns the identifier of the caller which sent the given
+// request, and whether that caller could be authenticated.
+func (h *cloudEventsHandler) authenticate(r *http.Request) (string /*caller*/, bool) {
+	if token, ok := bearerToken(r); ok && h.tokens != nil {
+		return h.authenticateToken(r.Context(), token)
+	}
+	if key := r.Header.Get(headerAPIKey); key != "" && len(h.apiKeys) != 0 {
+		return h.authenticateAPIKey(key)
+	}
+	if username, password, ok := r.BasicAuth(); ok && len(h.basicAuths) != 0 {
+		return h.authenticateBasicAuth(username, password)
+	}
+	return "", false
+}
+
+// code based on VMware's VEBA's webhook:
+// https://github.com/vmware-samples/vcenter-event-broker-appliance/blob/e91e4bd8a17dad6ce4fe370c42a15694c03dac88/vmware-event-router/internal/provider/webhook/webhook.go#L167-L189
+func (h *cloudEventsHandler) authenticateBasicAuth(username, password string) (string, bool) {
+	// reduce brute-force guessing attacks with constant-time comparisons
+	usernameHash := sha256.Sum256([]byte(username))
+	passwordHash := sha256.Sum256([]byte(password))
+
+	for _, kv := range h.basicAuths {
+		p, err := h.cfw.GetContent(kv.MountedValueFile)
+		if err != nil {
+			h.logger.Errorw(
+				fmt.Sprintf("Could not retrieve password for user %q", kv.Key),
+				zap.Error(err))
+			continue
+		}
+
+		expectedUsernameHash := sha256.Sum256([]byte(kv.Key))
+		expectedPasswordHash := sha256.Sum256(p)
+
+		usernameMatch := subtle.ConstantTimeCompare(usernameHash[:], expectedUsernameHash[:]) == 1
+		passwordMatch := subtle.ConstantTimeCompare(passwordHash[:], expectedPasswordHash[:]) == 1
+
+		if usernameMatch && passwordMatch {
+			return cereconciler.CallerPrefixBasicAuth + kv.Key, true
+		}
+	}
+
+	return "", false
+}
+
+func (h *cloudEventsHandler) authenticateAPIKey(key string) (string, bool) {
+	keyHash := sha256.Sum256([]byte(key))
+
+	for _, kv := range h.apiKeys {
+		k, err := h.cfw.GetContent(kv.MountedValueFile)
+		if err != nil {
+			h.logger.Errorw(
+				fmt.Sprintf("Could not retrieve API key %q", kv.Key),
+				zap.Error(err))
+			continue
+		}
+
+		expectedKeyHash := sha256.Sum256(k)
+
+		if subtle.ConstantTimeCompare(keyHash[:], expectedKeyHash[:]) == 1 {
+			return cereconciler.CallerPrefixAPIKey + kv.Key, true
+		}
+	}
+
+	return "", false
+}
+
+func (h *cloudEventsHandler) authenticateToken(ctx context.Context, token string) (string, bool) {
+	ks, err := h.tokens.KeySet(ctx)
+	if err != nil {
+		h.logger.Errorw("Could not retrieve the JSON Web Key Set", zap.Error(err))
+		return "", false
+	}
+
+	claims, err := jwks.Verify(ks, token)
+	if errors.Is(err, jwks.ErrUnknownKeyID) {
+		// keys may have been rotated since the key set was retrieved
+		if ks, err = h.tokens.Refresh(ctx); err != nil {
+			h.logger.Errorw("Could not refresh the JSON Web Key Set", zap.Error(err))
+			return "", false
+		}
+		claims, err = jwks.Verify(ks, token)
+	}
+	if err != nil {
+		h.logger.Debugw("Rejected invalid bearer token", zap.Error(err))
+		return "", false
+	}
+
+	if h.jwtIssuer != "" && !claims.VerifyIssuer(h.jwtIssuer, true) {
+		h.logger.Debugw("Rejected bearer token with unexpected issuer", zap.Any("iss", claims["iss"]))
+		return "", false
+	}
+	if h.jwtAudience != "" && !claims.VerifyAudience(h.jwtAudience, true) {
+		h.logger.Debugw("Rejected bearer token with unexpected audience", zap.Any("aud", claims["aud"]))
+		return "", false
+	}
+
+	caller, ok := claims[h.jwtCallerClaim].(string)
+	if !ok || caller == "" {
+		h.logger.Debugw("Rejected bearer token without caller claim", zap.String("claim", h.jwtCallerClaim))
+		return "", false
+	}
+
+	return cereconciler.CallerPrefixToken + caller, true
+}
+
+// bearerToken returns the bearer token passed in the Authorization header of
+// the given request, if any.
+func bearerToken(r *http.Request) (string, bool) {
+	const prefix = "Bearer "
+
+	auth := r.Header.Get("Authorization")
+	if len(auth) <= len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return "", false
+	}
+	return auth[len(prefix):], true
+}
+
+// callerPolicy enforces the policy of an authenticated caller.
+type callerPolicy struct {
+	rateLimiter cehttp.RateLimiter
+	quota       cehttp.RateLimiter
+
+	allowedTypes   []string
+	allowedSources []string
+}
+
+// newCallerPolicy returns a callerPolicy for the given policy spec.
+func newCallerPolicy(p *v1alpha1.CallerPolicy) (*callerPolicy, error) {
+	cp := &callerPolicy{
+		allowedTypes:   p.AllowedTypes,
+		allowedSources: p.AllowedSources,
+	}
+
+	var err error
+
+	if rl := p.RateLimiter; rl != nil {
+		if cp.rateLimiter, err = ratelimiter.New(uint64(rl.RequestsPerSecond)); err != nil {
+			return nil, fmt.Errorf("creating rate limiter: %w", err)
+		}
+	}
+	if q := p.Quota; q != nil {
+		if cp.quota, err = ratelimiter.NewQuota(uint64(q.Events), time.Duration(q.Period)); err != nil {
+			return nil, fmt.Errorf("creating quota: %w", err)
+		}
+	}
+
+	return cp, nil
+}
+
+// enforce verifies that the given request complies with the policy. When it
+// doesn't, the reason of the rejection is returned together with the HTTP
+// status code to respond with, and the number of seconds after which the
+// caller may retry.
+func (p *callerPolicy) enforce(r *http.Request) (reason string, code, retryAfter int) {
+	ctx := r.Context()
+
+	// constraints on events are verified first so that rejected events
+	// don't consume any rate limiting or quota token
+	if len(p.allowedTypes) != 0 || len(p.allowedSources) != 0 {
+		e, err := peekEvent(r)
+		switch {
+		case errors.Is(err, errBodyTooLarge):
+			return metrics.RejectReasonInvalidEvent, http.StatusRequestEntityTooLarge, 0
+		case errors.Is(err, errBatchUnsupported):
+			return metrics.RejectReasonInvalidEvent, http.StatusUnsupportedMediaType, 0
+		case err != nil:
+			return metrics.RejectReasonInvalidEvent, http.StatusBadRequest, 0
+		}
+		if !matchesAny(e.Type(), p.allowedTypes) || !matchesAny(e.Source(), p.allowedSources) {
+			return metrics.RejectReasonForbiddenEvent, http.StatusForbidden, 0
+		}
+	}
+
+	if p.rateLimiter != nil {
+		ok, reset, err := p.rateLimiter.Allow(ctx, r)
+		if err != nil {
+			return metrics.RejectReasonRateLimited, http.StatusInternalServerError, 0
+		}
+		if !ok {
+			return metrics.RejectReasonRateLimited, http.StatusTooManyRequests, secondsUntil(reset)
+		}
+	}
+
+	if p.quota != nil {
+		ok, reset, err := p.quota.Allow(ctx, r)
+		if err != nil {
+			return metrics.RejectReasonQuotaExceeded, http.StatusInternalServerError, 0
+		}
+		if !ok {
+			return metrics.RejectReasonQuotaExceeded, http.StatusTooManyRequests, secondsUntil(reset)
+		}
+	}
+
+	return "", 0, 0
+}
+
+// peekEvent decodes the CloudEvent contained in the given request without
+// consuming its body. Batches of events are rejected, since the policies of
+// callers apply to individual events.
+func peekEvent(r *http.Request) (*event.Event, error) {
+	if mt, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mt == event.ApplicationCloudEventsBatchJSON {
+		return nil, errBatchUnsupported
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxPeekedBodySize))
+	if err != nil {
+		if len(body) >= maxPeekedBodySize {
+			return nil, errBodyTooLarge
+		}
+		return nil, fmt.Errorf("reading request body: %w", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	peekReq := r.Clone(r.Context())
+	peekReq.Body = io.NopCloser(bytes.NewReader(body))
+
+	msg := cehttp.NewMessageFromHttpRequest(peekReq)
+	defer func() { _ = msg.Finish(nil) }()
+
+	return binding.ToEvent(r.Context(), msg)
+}
+
+// callerKind returns the kind of the given caller, which is used instead of
+// the caller identifier in metrics to keep their cardinality bounded.
+func callerKind(caller string) string {
+	kind, _, _ := strings.Cut(caller, "/")
+	return kind
+}
+
+// matchesAny returns whether the given CloudEvent attribute value matches any
+// of the given patterns. A trailing '*' in a pattern matches any suffix.
+// Any value matches an empty list of patterns.
+func matchesAny(val string, patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	for _, p := range patterns {
+		if prefix := strings.TrimSuffix(p, "*"); prefix != p {
+			if strings.HasPrefix(val, prefix) {
+				return true
+			}
+			continue
+		}
+		if val == p {
+			return true
+		}
+	}
+
+	return false
+}
+
+// secondsUntil returns the number of seconds, rounded up, until the given
+// time expressed as nanoseconds since the Unix epoch.
+func secondsUntil(unixNano uint64) int {
+	d := time.Until(time.Unix(0, int64(unixNano)))
+	if d <= 0 {
+		return 0
+	}
+	return int(math.Ceil(d.Seconds()))
+}
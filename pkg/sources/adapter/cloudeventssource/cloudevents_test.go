@@ -34,19 +34,34 @@ THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS" AND
 package cloudeventssource
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
 	loggingtesting "knative.dev/pkg/logging/testing"
 
 	fakefs "github.com/triggermesh/triggermesh/pkg/adapter/fs/fake"
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/cloudeventssource/jwks"
 )
 
 const (
@@ -166,6 +181,7 @@ func TestCloudEventsSourceAuthentication(t *testing.T) {
 
 				cfw:    cfw,
 				logger: logger,
+				rr:     metrics.MustNewRequestRejectionStatsReporter(&pkgadapter.MetricTag{}),
 			}
 
 			h := handler.handleAuthentication(
@@ -189,3 +205,355 @@ func TestCloudEventsSourceAuthentication(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudEventsSourceAPIKeyAuthentication(t *testing.T) {
+	logger := loggingtesting.TestLogger(t)
+
+	tc := map[string]struct {
+		requestKey string
+		expectCode int
+	}{
+		"no key sent": {
+			expectCode: http.StatusUnauthorized,
+		},
+		"valid key": {
+			requestKey: tSecret2,
+			expectCode: http.StatusOK,
+		},
+		"wrong key": {
+			requestKey: tSecret2 + "saltpepper",
+			expectCode: http.StatusUnauthorized,
+		},
+	}
+
+	for name, c := range tc {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			handler := &cloudEventsHandler{
+				apiKeys: KeyMountedValues{{
+					Key:              "partner",
+					MountedValueFile: tSecret2Path,
+				}},
+
+				cfw:    newTestFileWatcher(t),
+				logger: logger,
+				rr:     metrics.MustNewRequestRejectionStatsReporter(&pkgadapter.MetricTag{}),
+			}
+
+			req := newTestRequest(t, nil)
+			if c.requestKey != "" {
+				req.Header.Set(headerAPIKey, c.requestKey)
+			}
+
+			assert.Equal(t, c.expectCode, serveTestRequest(t, handler, req), "Unexpected status code")
+		})
+	}
+}
+
+func TestCloudEventsSourceTokenAuthentication(t *testing.T) {
+	logger := loggingtesting.TestLogger(t)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err, "Could not generate RSA key")
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err, "Could not generate RSA key")
+
+	keys, err := jwks.NewStaticProvider(testJWKS(t, "key1", &key.PublicKey))
+	require.NoError(t, err, "Could not create key set")
+
+	exp := time.Now().Add(time.Hour).Unix()
+
+	tc := map[string]struct {
+		signingKey *rsa.PrivateKey
+		claims     jwt.MapClaims
+		expectCode int
+	}{
+		"valid token": {
+			signingKey: key,
+			claims:     jwt.MapClaims{"sub": "partner", "iss": "test-issuer", "exp": exp},
+			expectCode: http.StatusOK,
+		},
+		"token without expiration": {
+			signingKey: key,
+			claims:     jwt.MapClaims{"sub": "partner", "iss": "test-issuer"},
+			expectCode: http.StatusUnauthorized,
+		},
+		"expired token": {
+			signingKey: key,
+			claims: jwt.MapClaims{"sub": "partner", "iss": "test-issuer",
+				"exp": time.Now().Add(-time.Minute).Unix()},
+			expectCode: http.StatusUnauthorized,
+		},
+		"wrong issuer": {
+			signingKey: key,
+			claims:     jwt.MapClaims{"sub": "partner", "iss": "other-issuer", "exp": exp},
+			expectCode: http.StatusUnauthorized,
+		},
+		"missing caller claim": {
+			signingKey: key,
+			claims:     jwt.MapClaims{"iss": "test-issuer", "exp": exp},
+			expectCode: http.StatusUnauthorized,
+		},
+		"unknown signing key": {
+			signingKey: otherKey,
+			claims:     jwt.MapClaims{"sub": "partner", "iss": "test-issuer", "exp": exp},
+			expectCode: http.StatusUnauthorized,
+		},
+	}
+
+	for name, c := range tc {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			handler := &cloudEventsHandler{
+				tokens:         keys,
+				jwtIssuer:      "test-issuer",
+				jwtCallerClaim: "sub",
+
+				cfw:    newTestFileWatcher(t),
+				logger: logger,
+				rr:     metrics.MustNewRequestRejectionStatsReporter(&pkgadapter.MetricTag{}),
+			}
+
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, c.claims)
+			token.Header["kid"] = "key1"
+			signed, err := token.SignedString(c.signingKey)
+			require.NoError(t, err, "Could not sign token")
+
+			req := newTestRequest(t, nil)
+			req.Header.Set("Authorization", "Bearer "+signed)
+
+			assert.Equal(t, c.expectCode, serveTestRequest(t, handler, req), "Unexpected status code")
+		})
+	}
+}
+
+func TestCloudEventsSourceCallerPolicy(t *testing.T) {
+	logger := loggingtesting.TestLogger(t)
+
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetID("id")
+	event.SetType("com.partner.order.created")
+	event.SetSource("partner")
+
+	otherEvent := event.Clone()
+	otherEvent.SetType("com.other.order.created")
+
+	tc := map[string]struct {
+		policy     v1alpha1.CallerPolicy
+		events     []cloudevents.Event
+		expectCode []int
+	}{
+		"allowed type": {
+			policy: v1alpha1.CallerPolicy{
+				AllowedTypes: []string{"com.partner.*"},
+			},
+			events:     []cloudevents.Event{event},
+			expectCode: []int{http.StatusOK},
+		},
+		"forbidden type": {
+			policy: v1alpha1.CallerPolicy{
+				AllowedTypes: []string{"com.partner.*"},
+			},
+			events:     []cloudevents.Event{otherEvent},
+			expectCode: []int{http.StatusForbidden},
+		},
+		"forbidden source": {
+			policy: v1alpha1.CallerPolicy{
+				AllowedSources: []string{"other"},
+			},
+			events:     []cloudevents.Event{event},
+			expectCode: []int{http.StatusForbidden},
+		},
+		"quota exceeded": {
+			policy: v1alpha1.CallerPolicy{
+				Quota: &v1alpha1.Quota{
+					Events: 1,
+					Period: apis.Duration(time.Hour),
+				},
+			},
+			events:     []cloudevents.Event{event, event},
+			expectCode: []int{http.StatusOK, http.StatusTooManyRequests},
+		},
+	}
+
+	for name, c := range tc {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			p, err := newCallerPolicy(&c.policy)
+			require.NoError(t, err, "Could not create caller policy")
+
+			handler := &cloudEventsHandler{
+				basicAuths: basicAuths,
+
+				policies: map[string]*callerPolicy{
+					"basicauth/" + tUser: p,
+				},
+
+				cfw:    newTestFileWatcher(t),
+				logger: logger,
+				rr:     metrics.MustNewRequestRejectionStatsReporter(&pkgadapter.MetricTag{}),
+			}
+
+			for i, e := range c.events {
+				body, err := json.Marshal(e)
+				require.NoError(t, err, "Could not encode event")
+
+				req := newTestRequest(t, body)
+				req.Header.Set("Content-Type", cloudevents.ApplicationCloudEventsJSON)
+				req.SetBasicAuth(tUser, tSecret1)
+
+				assert.Equal(t, c.expectCode[i], serveTestRequest(t, handler, req),
+					"Unexpected status code for request %d", i)
+			}
+		})
+	}
+}
+
+func TestCloudEventsSourceKeyRotation(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err, "Could not generate RSA key")
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err, "Could not generate RSA key")
+
+	var requests int32
+	jwksSet := testJWKS(t, "key1", &oldKey.PublicKey)
+	var jwksMu sync.Mutex
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		jwksMu.Lock()
+		defer jwksMu.Unlock()
+		_, _ = w.Write(jwksSet)
+	}))
+	defer srv.Close()
+
+	handler := &cloudEventsHandler{
+		tokens:         jwks.NewURLProvider(srv.Client(), srv.URL, time.Hour),
+		jwtCallerClaim: "sub",
+
+		cfw:    newTestFileWatcher(t),
+		logger: loggingtesting.TestLogger(t),
+		rr:     metrics.MustNewRequestRejectionStatsReporter(&pkgadapter.MetricTag{}),
+	}
+
+	sign := func(kid string, key *rsa.PrivateKey) *http.Request {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"sub": "partner",
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key)
+		require.NoError(t, err, "Could not sign token")
+
+		req := newTestRequest(t, nil)
+		req.Header.Set("Authorization", "Bearer "+signed)
+		return req
+	}
+
+	assert.Equal(t, http.StatusOK, serveTestRequest(t, handler, sign("key1", oldKey)))
+
+	jwksMu.Lock()
+	jwksSet = testJWKS(t, "key2", &newKey.PublicKey)
+	jwksMu.Unlock()
+
+	// the key set was retrieved too recently to be refreshed
+	assert.Equal(t, http.StatusUnauthorized, serveTestRequest(t, handler, sign("key2", newKey)))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests), "Unexpected number of key set retrievals")
+}
+
+func TestCloudEventsSourcePeekEvent(t *testing.T) {
+	p, err := newCallerPolicy(&v1alpha1.CallerPolicy{
+		AllowedTypes: []string{"com.partner.*"},
+	})
+	require.NoError(t, err, "Could not create caller policy")
+
+	handler := &cloudEventsHandler{
+		basicAuths: basicAuths,
+		policies: map[string]*callerPolicy{
+			"basicauth/" + tUser: p,
+		},
+
+		cfw:    newTestFileWatcher(t),
+		logger: loggingtesting.TestLogger(t),
+		rr:     metrics.MustNewRequestRejectionStatsReporter(&pkgadapter.MetricTag{}),
+	}
+
+	t.Run("batch", func(t *testing.T) {
+		req := newTestRequest(t, []byte(`[{"specversion":"1.0","id":"id","type":"com.partner.order.created","source":"partner"}]`))
+		req.Header.Set("Content-Type", cloudevents.ApplicationCloudEventsBatchJSON)
+		req.SetBasicAuth(tUser, tSecret1)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, serveTestRequest(t, handler, req))
+	})
+
+	t.Run("body too large", func(t *testing.T) {
+		req := newTestRequest(t, bytes.Repeat([]byte{' '}, maxPeekedBodySize+1))
+		req.Header.Set("Content-Type", cloudevents.ApplicationCloudEventsJSON)
+		req.SetBasicAuth(tUser, tSecret1)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, serveTestRequest(t, handler, req))
+	})
+}
+
+// newTestFileWatcher returns a fake file watcher populated with test secrets.
+func newTestFileWatcher(t *testing.T) fakefs.FakeCachedFileWatcher {
+	t.Helper()
+
+	cfw := fakefs.NewCachedFileWatcher()
+
+	for path, content := range map[string]string{tSecret1Path: tSecret1, tSecret2Path: tSecret2} {
+		err := cfw.Add(path)
+		require.NoError(t, err, "Could not set watch on secret path %s", path)
+		err = cfw.SetContent(path, []byte(content))
+		require.NoError(t, err, "Could not set content on secret path %s", path)
+	}
+
+	return cfw
+}
+
+// newTestRequest returns a test request with the given body.
+func newTestRequest(t *testing.T, body []byte) *http.Request {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+	require.NoError(t, err, "Could not create test request")
+
+	return req
+}
+
+// serveTestRequest serves the given request using the authentication
+// middleware of the given handler, and returns the response status code.
+func serveTestRequest(t *testing.T, handler *cloudEventsHandler, req *http.Request) int {
+	t.Helper()
+
+	h := handler.handleAuthentication(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	return rec.Code
+}
+
+// testJWKS returns a JSON Web Key Set containing the given RSA public key.
+func testJWKS(t *testing.T, kid string, key *rsa.PublicKey) []byte {
+	t.Helper()
+
+	enc := base64.RawURLEncoding
+
+	set, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"n":   enc.EncodeToString(key.N.Bytes()),
+			"e":   enc.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	require.NoError(t, err, "Could not encode key set")
+
+	return set
+}
@@ -19,6 +19,7 @@ package cloudeventssource
 import (
 	"encoding/json"
 
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	cereconciler "github.com/triggermesh/triggermesh/pkg/sources/reconciler/cloudeventssource"
 	"knative.dev/eventing/pkg/adapter/v2"
 )
@@ -40,10 +41,33 @@ func (is *KeyMountedValues) Decode(value string) error {
 	return nil
 }
 
+// CallerPolicies contains a set of caller policies by caller identifier.
+type CallerPolicies map[string]v1alpha1.CallerPolicy
+
+// Decode a map of CallerPolicies
+func (cp *CallerPolicies) Decode(value string) error {
+	if err := json.Unmarshal([]byte(value), cp); err != nil {
+		return err
+	}
+	return nil
+}
+
 type envAccessor struct {
 	adapter.EnvConfig
 
 	Path              string           `envconfig:"CLOUDEVENTS_PATH"`
 	BasicAuths        KeyMountedValues `envconfig:"CLOUDEVENTS_BASICAUTH_CREDENTIALS"`
+	APIKeys           KeyMountedValues `envconfig:"CLOUDEVENTS_APIKEY_CREDENTIALS"`
 	RequestsPerSecond uint64           `envconfig:"CLOUDEVENTS_RATELIMITER_RPS"`
+
+	// Exactly one of the following is set when bearer tokens are accepted.
+	JWKS     string `envconfig:"CLOUDEVENTS_JWKS"`
+	JWKSFile string `envconfig:"CLOUDEVENTS_JWKS_FILE"`
+	JWKSURL  string `envconfig:"CLOUDEVENTS_JWKS_URL"`
+
+	JWTIssuer      string `envconfig:"CLOUDEVENTS_JWT_ISSUER"`
+	JWTAudience    string `envconfig:"CLOUDEVENTS_JWT_AUDIENCE"`
+	JWTCallerClaim string `envconfig:"CLOUDEVENTS_JWT_CALLER_CLAIM" default:"sub"`
+
+	CallerPolicies CallerPolicies `envconfig:"CLOUDEVENTS_CALLER_POLICIES"`
 }
@@ -0,0 +1,270 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package jwks parses JSON Web Key Sets (JWKS) and uses them to verify the
+// signature of JSON Web Tokens (JWT).
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// KeySet is a set of public keys.
+type KeySet struct {
+	// keys indexed by key ID
+	keys map[string]crypto.PublicKey
+	// keys in the order they appear in the set, used to verify tokens which
+	// do not reference any key ID
+	all []crypto.PublicKey
+}
+
+// jsonWebKey is the JSON representation of a public JSON Web Key, as defined
+// in RFC 7517 and RFC 7518.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// Parse parses the JSON representation of a JSON Web Key Set. Keys which are
+// not used for signatures, or of an unsupported type, are ignored.
+func Parse(data []byte) (*KeySet, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("decoding key set: %w", err)
+	}
+
+	ks := &KeySet{
+		keys: make(map[string]crypto.PublicKey, len(set.Keys)),
+	}
+
+	for i, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		var pub crypto.PublicKey
+		var err error
+
+		switch k.Kty {
+		case "RSA":
+			pub, err = k.rsaPublicKey()
+		case "EC":
+			pub, err = k.ecdsaPublicKey()
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing key %d: %w", i, err)
+		}
+
+		if k.Kid != "" {
+			ks.keys[k.Kid] = pub
+		}
+		ks.all = append(ks.all, pub)
+	}
+
+	if len(ks.all) == 0 {
+		return nil, errors.New("key set contains no signature key")
+	}
+
+	return ks, nil
+}
+
+func (k *jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := decodeInt(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("decoding modulus: %w", err)
+	}
+	e, err := decodeInt(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("decoding exponent: %w", err)
+	}
+	if !e.IsInt64() {
+		return nil, errors.New("exponent is too large")
+	}
+
+	return &rsa.PublicKey{
+		N: n,
+		E: int(e.Int64()),
+	}, nil
+}
+
+func (k *jsonWebKey) ecdsaPublicKey() (*ecdsa.PublicKey, error) {
+	var crv elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		crv = elliptic.P256()
+	case "P-384":
+		crv = elliptic.P384()
+	case "P-521":
+		crv = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+	}
+
+	x, err := decodeInt(k.X)
+	if err != nil {
+		return nil, fmt.Errorf("decoding x coordinate: %w", err)
+	}
+	y, err := decodeInt(k.Y)
+	if err != nil {
+		return nil, fmt.Errorf("decoding y coordinate: %w", err)
+	}
+	if !crv.IsOnCurve(x, y) {
+		return nil, errors.New("point is not on curve")
+	}
+
+	return &ecdsa.PublicKey{
+		Curve: crv,
+		X:     x,
+		Y:     y,
+	}, nil
+}
+
+// decodeInt decodes a base64url-encoded big-endian integer.
+func decodeInt(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, errors.New("value is empty")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// Provider provides the current version of a KeySet.
+type Provider interface {
+	// KeySet returns the current KeySet.
+	KeySet(context.Context) (*KeySet, error)
+	// Refresh returns a KeySet retrieved again from its origin, when the
+	// origin supports it. It is used when a token references an unknown
+	// key, in case keys were rotated.
+	Refresh(context.Context) (*KeySet, error)
+}
+
+// ErrUnknownKeyID is returned by Verify when a token references a key which
+// is not part of the KeySet.
+var ErrUnknownKeyID = errors.New("unknown key ID")
+
+// clockSkew is the tolerated difference between the clocks of the issuer of
+// a token and the local clock, when validating time-based claims.
+const clockSkew = time.Minute
+
+// signingMethods are the signing algorithms accepted by Verify.
+var signingMethods = []string{
+	jwt.SigningMethodRS256.Alg(), jwt.SigningMethodRS384.Alg(), jwt.SigningMethodRS512.Alg(),
+	jwt.SigningMethodPS256.Alg(), jwt.SigningMethodPS384.Alg(), jwt.SigningMethodPS512.Alg(),
+	jwt.SigningMethodES256.Alg(), jwt.SigningMethodES384.Alg(), jwt.SigningMethodES512.Alg(),
+}
+
+// Verify parses the given token and verifies its signature against the keys
+// of the given KeySet, as well as the validity of its time-based claims.
+// Tokens must have an expiration time.
+func Verify(ks *KeySet, token string) (jwt.MapClaims, error) {
+	claims, err := verifySignature(ks, token)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTimes(claims, time.Now()); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// verifySignature parses the given token and verifies its signature against
+// the keys of the given KeySet.
+func verifySignature(ks *KeySet, token string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	// time-based claims are validated separately, with a bounded skew
+	parser := jwt.NewParser(jwt.WithValidMethods(signingMethods), jwt.WithoutClaimsValidation())
+
+	kid, hasKid := "", false
+	t, _, err := parser.ParseUnverified(token, claims)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := t.Header["kid"].(string); ok {
+		kid, hasKid = v, true
+	}
+
+	if hasKid {
+		key, ok := ks.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("%w %q", ErrUnknownKeyID, kid)
+		}
+		if _, err := parser.ParseWithClaims(token, claims, staticKey(key)); err != nil {
+			return nil, err
+		}
+		return claims, nil
+	}
+
+	// without key ID, the token is valid if any key of the set verifies it
+	for _, key := range ks.all {
+		if _, err = parser.ParseWithClaims(token, claims, staticKey(key)); err == nil {
+			return claims, nil
+		}
+	}
+	return nil, err
+}
+
+// validateTimes validates the time-based claims of a token at the given time.
+func validateTimes(claims jwt.MapClaims, now time.Time) error {
+	if _, ok := claims["exp"]; !ok {
+		return errors.New("token has no expiration time")
+	}
+	if !claims.VerifyExpiresAt(now.Add(-clockSkew).Unix(), true) {
+		return errors.New("token is expired")
+	}
+	if !claims.VerifyNotBefore(now.Add(clockSkew).Unix(), false) {
+		return errors.New("token is not valid yet")
+	}
+	if !claims.VerifyIssuedAt(now.Add(clockSkew).Unix(), false) {
+		return errors.New("token was issued in the future")
+	}
+	return nil
+}
+
+// staticKey returns a jwt.Keyfunc which always returns the given key.
+func staticKey(key crypto.PublicKey) jwt.Keyfunc {
+	return func(*jwt.Token) (interface{}, error) {
+		return key, nil
+	}
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jwks
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	fakefs "github.com/triggermesh/triggermesh/pkg/adapter/fs/fake"
+)
+
+func TestParse(t *testing.T) {
+	testCases := map[string]struct {
+		set       string
+		expectErr string
+	}{
+		"not JSON": {
+			set:       "{",
+			expectErr: "decoding key set: unexpected end of JSON input",
+		},
+		"no signature key": {
+			set:       `{"keys":[{"kty":"RSA","use":"enc","n":"AQAB","e":"AQAB"},{"kty":"oct","k":"c2VjcmV0"}]}`,
+			expectErr: "key set contains no signature key",
+		},
+		"invalid RSA key": {
+			set:       `{"keys":[{"kty":"RSA","n":"AQAB"}]}`,
+			expectErr: "parsing key 0: decoding exponent: value is empty",
+		},
+		"unsupported curve": {
+			set:       `{"keys":[{"kty":"EC","crv":"P-192","x":"AQ","y":"AQ"}]}`,
+			expectErr: `parsing key 0: unsupported curve "P-192"`,
+		},
+		"point not on curve": {
+			set:       `{"keys":[{"kty":"EC","crv":"P-256","x":"AQ","y":"AQ"}]}`,
+			expectErr: "parsing key 0: point is not on curve",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(tc.set))
+			assert.EqualError(t, err, tc.expectErr)
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ks, err := Parse(testKeySet(t, "key1", &key.PublicKey))
+	require.NoError(t, err)
+
+	sign := func(kid string, claims jwt.MapClaims) string {
+		tk := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+		if kid != "" {
+			tk.Header["kid"] = kid
+		}
+		s, err := tk.SignedString(key)
+		require.NoError(t, err)
+		return s
+	}
+
+	exp := time.Now().Add(time.Hour).Unix()
+
+	t.Run("with key ID", func(t *testing.T) {
+		claims, err := Verify(ks, sign("key1", jwt.MapClaims{"sub": "me", "exp": exp}))
+		require.NoError(t, err)
+		assert.Equal(t, "me", claims["sub"])
+	})
+
+	t.Run("without key ID", func(t *testing.T) {
+		_, err := Verify(ks, sign("", jwt.MapClaims{"sub": "me", "exp": exp}))
+		assert.NoError(t, err)
+	})
+
+	t.Run("unknown key ID", func(t *testing.T) {
+		_, err := Verify(ks, sign("key2", jwt.MapClaims{"sub": "me", "exp": exp}))
+		assert.EqualError(t, err, `unknown key ID "key2"`)
+		assert.ErrorIs(t, err, ErrUnknownKeyID)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		_, err := Verify(ks, sign("key1", jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()}))
+		assert.Error(t, err)
+	})
+
+	t.Run("no expiration", func(t *testing.T) {
+		_, err := Verify(ks, sign("key1", jwt.MapClaims{"sub": "me"}))
+		assert.EqualError(t, err, "token has no expiration time")
+	})
+
+	t.Run("expired within clock skew", func(t *testing.T) {
+		_, err := Verify(ks, sign("key1", jwt.MapClaims{"exp": time.Now().Add(-clockSkew / 2).Unix()}))
+		assert.NoError(t, err)
+	})
+
+	t.Run("not yet valid", func(t *testing.T) {
+		_, err := Verify(ks, sign("key1", jwt.MapClaims{"exp": exp, "nbf": time.Now().Add(2 * clockSkew).Unix()}))
+		assert.Error(t, err)
+	})
+
+	t.Run("issued in the future", func(t *testing.T) {
+		_, err := Verify(ks, sign("key1", jwt.MapClaims{"exp": exp, "iat": time.Now().Add(2 * clockSkew).Unix()}))
+		assert.Error(t, err)
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		tk := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{"sub": "me"})
+		s, err := tk.SignedString(jwt.UnsafeAllowNoneSignatureType)
+		require.NoError(t, err)
+
+		_, err = Verify(ks, s)
+		assert.Error(t, err)
+	})
+}
+
+func TestProviders(t *testing.T) {
+	key1, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	key2, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	set1 := testKeySet(t, "key1", &key1.PublicKey)
+	set2 := testKeySet(t, "key2", &key2.PublicKey)
+
+	ctx := context.Background()
+
+	t.Run("file", func(t *testing.T) {
+		const path = "/test/jwks"
+
+		cfw := fakefs.NewCachedFileWatcher()
+		require.NoError(t, cfw.Add(path))
+		require.NoError(t, cfw.SetContent(path, set1))
+
+		p := NewFileProvider(cfw, path)
+
+		ks, err := p.KeySet(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, ks.keys, "key1")
+
+		require.NoError(t, cfw.SetContent(path, set2))
+
+		ks, err = p.KeySet(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, ks.keys, "key2")
+	})
+
+	t.Run("URL", func(t *testing.T) {
+		var requests int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			_, _ = w.Write(set1)
+		}))
+		defer srv.Close()
+
+		p := NewURLProvider(srv.Client(), srv.URL, time.Hour)
+
+		for i := 0; i < 2; i++ {
+			ks, err := p.KeySet(ctx)
+			require.NoError(t, err)
+			assert.Contains(t, ks.keys, "key1")
+		}
+		assert.Equal(t, 1, requests, "Key set was not cached")
+
+		_, err := p.Refresh(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, requests, "Refresh was not rate-limited")
+
+		p.(*urlProvider).fetched = time.Now().Add(-urlMinRefreshInterval)
+
+		_, err = p.Refresh(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, requests, "Key set was not refreshed")
+	})
+
+	t.Run("URL unavailable", func(t *testing.T) {
+		var requests int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		p := NewURLProvider(srv.Client(), srv.URL, time.Hour)
+
+		for i := 0; i < 2; i++ {
+			_, err := p.KeySet(ctx)
+			assert.Error(t, err)
+		}
+		assert.Equal(t, 1, requests, "Retrieval was not backed off after a failure")
+	})
+
+	t.Run("URL serves stale keys", func(t *testing.T) {
+		fail := false
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if fail {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write(set1)
+		}))
+		defer srv.Close()
+
+		p := NewURLProvider(srv.Client(), srv.URL, time.Hour)
+
+		_, err := p.KeySet(ctx)
+		require.NoError(t, err)
+
+		fail = true
+		p.(*urlProvider).fetched = time.Time{}
+
+		ks, err := p.KeySet(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, ks.keys, "key1")
+		assert.Equal(t, 1, p.(*urlProvider).failures, "Failure was not recorded")
+	})
+}
+
+func TestRetryBackoff(t *testing.T) {
+	assert.Equal(t, urlMinRetryBackoff, retryBackoff(1))
+	assert.Equal(t, 4*urlMinRetryBackoff, retryBackoff(3))
+	assert.Equal(t, urlMaxRetryBackoff, retryBackoff(100))
+}
+
+// testKeySet returns a JSON Web Key Set containing the given EC public key.
+func testKeySet(t *testing.T, kid string, key *ecdsa.PublicKey) []byte {
+	t.Helper()
+
+	enc := base64.RawURLEncoding
+
+	set, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "EC",
+			"kid": kid,
+			"crv": "P-256",
+			"x":   enc.EncodeToString(key.X.Bytes()),
+			"y":   enc.EncodeToString(key.Y.Bytes()),
+		}},
+	})
+	require.NoError(t, err)
+
+	return set
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jwks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/fs"
+)
+
+// NewStaticProvider returns a Provider for the given, immutable, JSON Web Key
+// Set.
+func NewStaticProvider(data []byte) (Provider, error) {
+	ks, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return staticProvider{ks: ks}, nil
+}
+
+type staticProvider struct {
+	ks *KeySet
+}
+
+// KeySet implements Provider.
+func (p staticProvider) KeySet(context.Context) (*KeySet, error) {
+	return p.ks, nil
+}
+
+// Refresh implements Provider.
+func (p staticProvider) Refresh(ctx context.Context) (*KeySet, error) {
+	return p.KeySet(ctx)
+}
+
+// NewFileProvider returns a Provider for the JSON Web Key Set contained in
+// the file at the given path, which must be watched by the given
+// CachedFileWatcher.
+func NewFileProvider(cfw fs.CachedFileWatcher, path string) Provider {
+	return &fileProvider{
+		cfw:  cfw,
+		path: path,
+	}
+}
+
+type fileProvider struct {
+	cfw  fs.CachedFileWatcher
+	path string
+
+	mu  sync.Mutex
+	raw []byte
+	ks  *KeySet
+}
+
+// KeySet implements Provider.
+func (p *fileProvider) KeySet(context.Context) (*KeySet, error) {
+	data, err := p.cfw.GetContent(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("reading key set file: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// only parse the key set again when the contents of the file changed
+	if p.ks != nil && bytes.Equal(data, p.raw) {
+		return p.ks, nil
+	}
+
+	ks, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	p.raw, p.ks = data, ks
+
+	return ks, nil
+}
+
+// Refresh implements Provider.
+// The file is watched for changes, so its contents are always up-to-date.
+func (p *fileProvider) Refresh(ctx context.Context) (*KeySet, error) {
+	return p.KeySet(ctx)
+}
+
+const (
+	// urlFetchTimeout is the maximum duration of a key set retrieval.
+	urlFetchTimeout = 10 * time.Second
+	// urlMinRefreshInterval is the minimum interval between two retrievals
+	// of a key set which are forced by tokens referencing unknown keys.
+	urlMinRefreshInterval = 30 * time.Second
+	// urlMinRetryBackoff and urlMaxRetryBackoff bound the exponential
+	// backoff applied to retrievals after a failure.
+	urlMinRetryBackoff = time.Second
+	urlMaxRetryBackoff = 5 * time.Minute
+)
+
+// NewURLProvider returns a Provider for the JSON Web Key Set served at the
+// given URL. The key set is retrieved again once it is older than the given
+// refresh interval.
+func NewURLProvider(client *http.Client, url string, refresh time.Duration) Provider {
+	return &urlProvider{
+		client:  client,
+		url:     url,
+		refresh: refresh,
+	}
+}
+
+type urlProvider struct {
+	client  *http.Client
+	url     string
+	refresh time.Duration
+
+	// ensures a single retrieval is in flight at any time
+	fetches singleflight.Group
+
+	mu       sync.Mutex
+	ks       *KeySet
+	fetched  time.Time
+	err      error
+	failures int
+	retryAt  time.Time
+}
+
+// KeySet implements Provider.
+func (p *urlProvider) KeySet(ctx context.Context) (*KeySet, error) {
+	p.mu.Lock()
+	ks, fresh := p.ks, time.Since(p.fetched) < p.refresh
+	p.mu.Unlock()
+
+	if ks != nil && fresh {
+		return ks, nil
+	}
+
+	return p.load(ctx)
+}
+
+// Refresh implements Provider.
+// Retrievals are rate-limited, so the current key set is returned if it was
+// retrieved recently.
+func (p *urlProvider) Refresh(ctx context.Context) (*KeySet, error) {
+	p.mu.Lock()
+	ks, recent := p.ks, time.Since(p.fetched) < urlMinRefreshInterval
+	p.mu.Unlock()
+
+	if ks != nil && recent {
+		return ks, nil
+	}
+
+	return p.load(ctx)
+}
+
+// load retrieves the key set, unless a previous failure is still being
+// backed off from. The last known key set is returned if the endpoint is
+// unavailable.
+func (p *urlProvider) load(ctx context.Context) (*KeySet, error) {
+	p.mu.Lock()
+	if time.Now().Before(p.retryAt) {
+		ks, err := p.ks, p.err
+		p.mu.Unlock()
+		if ks != nil {
+			return ks, nil
+		}
+		return nil, err
+	}
+	p.mu.Unlock()
+
+	// The retrieval is shared between concurrent callers, so it must not
+	// be bound to the context of any of them.
+	ch := p.fetches.DoChan("", func() (interface{}, error) {
+		return p.fetchAndStore()
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*KeySet), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fetchAndStore retrieves the key set and records the outcome of the
+// retrieval.
+func (p *urlProvider) fetchAndStore() (*KeySet, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), urlFetchTimeout)
+	defer cancel()
+
+	ks, err := p.fetch(ctx)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err != nil {
+		p.err = err
+		p.failures++
+		p.retryAt = time.Now().Add(retryBackoff(p.failures))
+
+		// keep serving the last known key set if the endpoint is
+		// temporarily unavailable
+		if p.ks != nil {
+			return p.ks, nil
+		}
+		return nil, err
+	}
+
+	p.ks, p.fetched = ks, time.Now()
+	p.err, p.failures, p.retryAt = nil, 0, time.Time{}
+
+	return ks, nil
+}
+
+// retryBackoff returns the duration to wait before retrying a retrieval
+// after the given number of consecutive failures.
+func retryBackoff(failures int) time.Duration {
+	backoff := urlMinRetryBackoff
+	for i := 1; i < failures && backoff < urlMaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > urlMaxRetryBackoff {
+		backoff = urlMaxRetryBackoff
+	}
+	return backoff
+}
+
+// fetch retrieves and parses the key set.
+func (p *urlProvider) fetch(ctx context.Context) (*KeySet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving key set: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("retrieving key set: unexpected status code %d", res.StatusCode)
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading key set: %w", err)
+	}
+
+	return Parse(data)
+}
@@ -37,9 +37,21 @@ type rateLimiter struct {
 
 // New creates a new rate limiter.
 func New(rps uint64) (cehttp.RateLimiter, error) {
+	return newWithInterval(rps, time.Second)
+}
+
+// NewQuota creates a new rate limiter which accepts the given number of
+// requests during each period.
+func NewQuota(requests uint64, period time.Duration) (cehttp.RateLimiter, error) {
+	return newWithInterval(requests, period)
+}
+
+// newWithInterval creates a new rate limiter which accepts the given number of
+// tokens during each interval.
+func newWithInterval(tokens uint64, interval time.Duration) (cehttp.RateLimiter, error) {
 	if store, err := memorystore.New(&memorystore.Config{
-		Tokens:   rps,
-		Interval: time.Second,
+		Tokens:   tokens,
+		Interval: interval,
 	}); err != nil {
 		return nil, err
 	} else {
@@ -51,3 +51,24 @@ func TestRateLimiter(t *testing.T) {
 	require.NoError(t, err, "Error calling rate limiter Allow method")
 	assert.False(t, ok, "request spaced less than 1 second was accepted")
 }
+
+func TestQuota(t *testing.T) {
+	ctx := context.Background()
+
+	// allow no more than 2 requests per hour.
+	rl, err := NewQuota(2, time.Hour)
+
+	require.NoError(t, err, "Error creating rate limiter")
+	defer rl.Close(ctx)
+
+	for i := 0; i < 2; i++ {
+		ok, _, err := rl.Allow(ctx, nil)
+		require.NoError(t, err, "Error calling rate limiter Allow method")
+		assert.True(t, ok, "request within quota was not accepted")
+	}
+
+	ok, reset, err := rl.Allow(ctx, nil)
+	require.NoError(t, err, "Error calling rate limiter Allow method")
+	assert.False(t, ok, "request exceeding quota was accepted")
+	assert.Greater(t, time.Until(time.Unix(0, int64(reset))), 59*time.Minute, "quota replenished too early")
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jirasource
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+const (
+	serverPort                uint16 = 8080
+	serverShutdownGracePeriod        = time.Second * 10
+)
+
+const (
+	// signatureHeader contains the HMAC signature of requests sent by
+	// webhooks which are configured with a secret.
+	signatureHeader = "X-Hub-Signature"
+	// webhookIdentifierHeader contains an identifier of the webhook
+	// delivery, which is preserved across retries.
+	webhookIdentifierHeader = "X-Atlassian-Webhook-Identifier"
+)
+
+// webhookEventCeExtension contains the name of the Jira webhook event.
+const webhookEventCeExtension = "comatlassianjirawebhookevent"
+
+// eventTypes maps Jira webhook events to CloudEvent types.
+var eventTypes = map[string]string{
+	"jira:issue_created": v1alpha1.JiraIssueCreatedEventType,
+	"jira:issue_updated": v1alpha1.JiraIssueUpdatedEventType,
+	"jira:issue_deleted": v1alpha1.JiraIssueDeletedEventType,
+	"comment_created":    v1alpha1.JiraCommentCreatedEventType,
+	"comment_updated":    v1alpha1.JiraCommentUpdatedEventType,
+	"comment_deleted":    v1alpha1.JiraCommentDeletedEventType,
+}
+
+// WebhookEvent contains the attributes of a Jira webhook payload which are
+// used to build a CloudEvent.
+// See https://developer.atlassian.com/server/jira/platform/webhooks/
+type WebhookEvent struct {
+	WebhookEvent string `json:"webhookEvent"`
+	Timestamp    int64  `json:"timestamp"`
+
+	Issue *struct {
+		ID  string `json:"id"`
+		Key string `json:"key"`
+	} `json:"issue"`
+}
+
+type webhookHandler struct {
+	eventSource   string
+	webhookSecret string
+	events        map[string]struct{}
+
+	ceClient cloudevents.Client
+	logger   *zap.SugaredLogger
+	mt       *pkgadapter.MetricTag
+}
+
+// Start implements pkgadapter.Adapter.
+// Runs the server for receiving Jira webhooks until ctx gets cancelled.
+func (h *webhookHandler) Start(ctx context.Context) error {
+	ctx = pkgadapter.ContextWithMetricTag(ctx, h.mt)
+
+	m := http.NewServeMux()
+	m.HandleFunc("/", h.handleAll(ctx))
+	m.HandleFunc("/health", healthCheckHandler)
+
+	s := &http.Server{
+		Addr:    fmt.Sprintf(":%d", serverPort),
+		Handler: m,
+	}
+
+	return runHandler(ctx, s)
+}
+
+// runHandler runs the HTTP event handler until ctx get cancelled.
+func runHandler(ctx context.Context, s *http.Server) error {
+	logging.FromContext(ctx).Info("Starting Jira webhook handler")
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- s.ListenAndServe()
+	}()
+
+	handleServerError := func(err error) error {
+		if err != http.ErrServerClosed {
+			return fmt.Errorf("during server runtime: %w", err)
+		}
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		logging.FromContext(ctx).Info("HTTP event handler is shutting down")
+
+		ctx, cancel := context.WithTimeout(context.Background(), serverShutdownGracePeriod)
+		defer cancel()
+
+		if err := s.Shutdown(ctx); err != nil {
+			return fmt.Errorf("during server shutdown: %w", err)
+		}
+
+		return handleServerError(<-errCh)
+
+	case err := <-errCh:
+		return handleServerError(err)
+	}
+}
+
+// handleAll receives all Jira webhook events, and sends them as CloudEvents
+// typed after the webhook event.
+func (h *webhookHandler) handleAll(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Body == nil {
+			h.handleError(errors.New("request without body not supported"), http.StatusBadRequest, w)
+			return
+		}
+
+		defer r.Body.Close()
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			h.handleError(err, http.StatusInternalServerError, w)
+			return
+		}
+
+		if h.webhookSecret != "" {
+			if err := verifySignature(r.Header.Get(signatureHeader), body, h.webhookSecret); err != nil {
+				h.handleError(err, http.StatusUnauthorized, w)
+				return
+			}
+		}
+
+		we := &WebhookEvent{}
+		if err := json.Unmarshal(body, we); err != nil {
+			h.handleError(fmt.Errorf("could not unmarshal JSON request: %w", err), http.StatusBadRequest, w)
+			return
+		}
+
+		if we.WebhookEvent == "" {
+			h.handleError(errors.New("request is not a Jira webhook event"), http.StatusBadRequest, w)
+			return
+		}
+
+		// Events which are not selected are acknowledged, otherwise Jira
+		// would keep retrying their delivery.
+		if len(h.events) > 0 {
+			if _, ok := h.events[we.WebhookEvent]; !ok {
+				return
+			}
+		}
+
+		event, err := h.cloudEventFromWebhookEvent(we, r.Header.Get(webhookIdentifierHeader), body)
+		if err != nil {
+			h.handleError(err, http.StatusInternalServerError, w)
+			return
+		}
+
+		if result := h.ceClient.Send(ctx, *event); !cloudevents.IsACK(result) {
+			h.handleError(fmt.Errorf("could not send Cloud Event: %w", result), http.StatusInternalServerError, w)
+		}
+	}
+}
+
+func (h *webhookHandler) cloudEventFromWebhookEvent(we *WebhookEvent, id string, body []byte) (*cloudevents.Event, error) {
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	eventType, ok := eventTypes[we.WebhookEvent]
+	if !ok {
+		eventType = v1alpha1.JiraGenericEventType
+	}
+
+	event.SetID(id)
+	event.SetType(eventType)
+	event.SetSource(h.eventSource)
+	event.SetExtension(webhookEventCeExtension, we.WebhookEvent)
+
+	if we.Timestamp != 0 {
+		event.SetTime(time.UnixMilli(we.Timestamp))
+	}
+	if we.Issue != nil {
+		event.SetSubject(we.Issue.Key)
+	}
+
+	if err := event.SetData(cloudevents.ApplicationJSON, json.RawMessage(body)); err != nil {
+		return nil, fmt.Errorf("failed to set event data: %w", err)
+	}
+
+	return &event, nil
+}
+
+// verifySignature checks the HMAC signature of a webhook request.
+// The signature header has the format "<method>=<signature>", where the only
+// supported method is sha256.
+func verifySignature(header string, body []byte, secret string) error {
+	if header == "" {
+		return errors.New("empty signature header")
+	}
+
+	method, signature, ok := strings.Cut(header, "=")
+	if !ok || method != "sha256" {
+		return errors.New(`signature header format does not begin with "sha256="`)
+	}
+
+	hm := hmac.New(sha256.New, []byte(secret))
+	if _, err := hm.Write(body); err != nil {
+		return fmt.Errorf("error writing body into hmac: %w", err)
+	}
+
+	expected := hex.EncodeToString(hm.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return errors.New("received wrong signature")
+	}
+
+	return nil
+}
+
+func (h *webhookHandler) handleError(err error, code int, w http.ResponseWriter) {
+	h.logger.Errorw("An error ocurred", zap.Error(err))
+	http.Error(w, err.Error(), code)
+}
+
+func healthCheckHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jirasource
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	zapt "go.uber.org/zap/zaptest"
+
+	cloudeventst "github.com/cloudevents/sdk-go/v2/client/test"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+const (
+	tEventSource = "jira/test/source"
+	tSecret      = "s3cr3t"
+
+	tIssueUpdated = `{"timestamp":1593192794000,"webhookEvent":"jira:issue_updated",` +
+		`"issue_event_type_name":"issue_generic","issue":{"id":"10002","key":"EX-1"},` +
+		`"changelog":{"items":[{"field":"status","fromString":"To Do","toString":"Done"}]}}`
+	tWorklogCreated = `{"timestamp":1593192794000,"webhookEvent":"worklog_created","worklog":{"id":"100028"}}`
+)
+
+func TestJiraWebhook(t *testing.T) {
+
+	logger := zapt.NewLogger(t).Sugar()
+
+	tc := map[string]struct {
+		body    io.Reader
+		headers map[string]string
+		secret  string
+		events  []string
+
+		expectedCode             int
+		expectedResponseContains string
+
+		expectedEventID      string
+		expectedEventType    string
+		expectedEventSubject string
+		expectedEventData    string
+	}{
+		"nil body": {
+			body: nil,
+
+			expectedCode:             http.StatusBadRequest,
+			expectedResponseContains: "request without body not supported",
+		},
+
+		"not a JSON message": {
+			body: read("this is not an expected message"),
+
+			expectedCode:             http.StatusBadRequest,
+			expectedResponseContains: "could not unmarshal JSON request:",
+		},
+
+		"not a webhook event": {
+			body: read(`{"hello":"world"}`),
+
+			expectedCode:             http.StatusBadRequest,
+			expectedResponseContains: "request is not a Jira webhook event",
+		},
+
+		"issue updated": {
+			body:    read(tIssueUpdated),
+			headers: map[string]string{webhookIdentifierHeader: "1234"},
+
+			expectedCode:         http.StatusOK,
+			expectedEventID:      "1234",
+			expectedEventType:    v1alpha1.JiraIssueUpdatedEventType,
+			expectedEventSubject: "EX-1",
+			expectedEventData:    tIssueUpdated,
+		},
+
+		"event without dedicated type": {
+			body:    read(tWorklogCreated),
+			headers: map[string]string{webhookIdentifierHeader: "1234"},
+
+			expectedCode:      http.StatusOK,
+			expectedEventID:   "1234",
+			expectedEventType: v1alpha1.JiraGenericEventType,
+			expectedEventData: tWorklogCreated,
+		},
+
+		"event not selected": {
+			body:   read(tIssueUpdated),
+			events: []string{"jira:issue_created"},
+
+			expectedCode: http.StatusOK,
+		},
+
+		"event selected": {
+			body:    read(tIssueUpdated),
+			headers: map[string]string{webhookIdentifierHeader: "1234"},
+			events:  []string{"jira:issue_created", "jira:issue_updated"},
+
+			expectedCode:         http.StatusOK,
+			expectedEventID:      "1234",
+			expectedEventType:    v1alpha1.JiraIssueUpdatedEventType,
+			expectedEventSubject: "EX-1",
+			expectedEventData:    tIssueUpdated,
+		},
+
+		"missing signature": {
+			body:   read(tIssueUpdated),
+			secret: tSecret,
+
+			expectedCode:             http.StatusUnauthorized,
+			expectedResponseContains: "empty signature header",
+		},
+
+		"unsupported signature method": {
+			body:    read(tIssueUpdated),
+			secret:  tSecret,
+			headers: map[string]string{signatureHeader: "sha1=abcd"},
+
+			expectedCode:             http.StatusUnauthorized,
+			expectedResponseContains: `signature header format does not begin with "sha256="`,
+		},
+
+		"wrong signature": {
+			body:    read(tIssueUpdated),
+			secret:  tSecret,
+			headers: map[string]string{signatureHeader: "sha256=" + sign(tWorklogCreated)},
+
+			expectedCode:             http.StatusUnauthorized,
+			expectedResponseContains: "received wrong signature",
+		},
+
+		"signed event": {
+			body:   read(tIssueUpdated),
+			secret: tSecret,
+			headers: map[string]string{
+				signatureHeader:         "sha256=" + sign(tIssueUpdated),
+				webhookIdentifierHeader: "1234",
+			},
+
+			expectedCode:         http.StatusOK,
+			expectedEventID:      "1234",
+			expectedEventType:    v1alpha1.JiraIssueUpdatedEventType,
+			expectedEventSubject: "EX-1",
+			expectedEventData:    tIssueUpdated,
+		},
+	}
+
+	for name, c := range tc {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			ceClient, chEvent := cloudeventst.NewMockSenderClient(t, 1)
+
+			events := make(map[string]struct{}, len(c.events))
+			for _, e := range c.events {
+				events[e] = struct{}{}
+			}
+
+			handler := &webhookHandler{
+				eventSource:   tEventSource,
+				webhookSecret: c.secret,
+				events:        events,
+
+				ceClient: ceClient,
+				logger:   logger,
+			}
+
+			req, _ := http.NewRequest(http.MethodPost, "/", c.body)
+			for k, v := range c.headers {
+				req.Header.Add(k, v)
+			}
+
+			th := http.HandlerFunc(handler.handleAll(context.Background()))
+
+			rr := httptest.NewRecorder()
+
+			th.ServeHTTP(rr, req)
+
+			assert.Equal(t, c.expectedCode, rr.Code, "unexpected response code")
+			assert.Contains(t, rr.Body.String(), c.expectedResponseContains, "could not find expected response")
+
+			if c.expectedEventID == "" {
+				select {
+				case event := <-chEvent:
+					assert.Fail(t, "unexpected cloud event was sent", "event: %s", event)
+				default:
+				}
+				return
+			}
+
+			select {
+			case event := <-chEvent:
+				assert.Equal(t, c.expectedEventID, event.ID(), "event ID does not match")
+				assert.Equal(t, c.expectedEventType, event.Type(), "event type does not match")
+				assert.Equal(t, tEventSource, event.Source(), "event source does not match")
+				assert.Equal(t, c.expectedEventSubject, event.Subject(), "event subject does not match")
+				assert.Equal(t, time.UnixMilli(1593192794000).UTC(), event.Time().UTC(), "event time does not match")
+				assert.Equal(t, c.expectedEventData, string(event.Data()), "event data does not match")
+
+			case <-time.After(1 * time.Second):
+				assert.Fail(t, "expected cloud event was not sent", "event ID: %s", c.expectedEventID)
+			}
+		})
+	}
+}
+
+func sign(body string) string {
+	hm := hmac.New(sha256.New, []byte(tSecret))
+	_, _ = hm.Write([]byte(body))
+	return hex.EncodeToString(hm.Sum(nil))
+}
+
+func read(s string) io.Reader {
+	return strings.NewReader(s)
+}
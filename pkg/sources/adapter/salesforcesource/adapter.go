@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/google/uuid"
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/logging"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
@@ -36,10 +38,18 @@ import (
 
 const eventType = "com.salesforce.stream.message"
 
+const (
+	// replayFlushInterval is the interval at which replay IDs are persisted.
+	replayFlushInterval = 10 * time.Second
+	// replayFlushTimeout is the maximum duration of the final flush of
+	// replay IDs upon shutdown.
+	replayFlushTimeout = 10 * time.Second
+)
+
 type salesforceAdapter struct {
-	sfVersion         string
-	sfChannel         string
-	sfInitialReplayID int
+	sfVersion       string
+	sfSubscriptions []sfclient.Subscription
+	sfReplayStore   *configMapReplayStore
 
 	sfAuth auth.Authenticator
 
@@ -49,7 +59,9 @@ type salesforceAdapter struct {
 }
 
 type eventDispatcher struct {
-	eventSource string
+	// prefix of the source attribute of events, completed by the channel
+	// each event was received from
+	eventSourcePrefix string
 
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
@@ -70,16 +82,10 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	env := envAcc.(*envAccessor)
 
-	source := env.Name
-	if env.SubscriptionChannel[0] != '/' {
-		source += "/"
-	}
-	source += env.SubscriptionChannel
-
 	dispatcher := &eventDispatcher{
-		eventSource: source,
-		ceClient:    ceClient,
-		logger:      logger.Named("dispatcher"),
+		eventSourcePrefix: env.Name,
+		ceClient:          ceClient,
+		logger:            logger.Named("dispatcher"),
 	}
 
 	jwtAuth, err := auth.NewJWTAuthenticator(env.CertKey, env.ClientID, env.User, env.AuthServer, http.DefaultClient, logger.Named("authenticator"))
@@ -87,11 +93,17 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Panic(err)
 	}
 
+	var replayStore *configMapReplayStore
+	if cmName := env.ReplayStoreConfigMap; cmName != "" {
+		cmCli := kubeclient.Get(ctx).CoreV1().ConfigMaps(env.Namespace)
+		replayStore = newConfigMapReplayStore(cmCli, cmName)
+	}
+
 	adapter := &salesforceAdapter{
-		sfVersion:         env.Version,
-		sfChannel:         env.SubscriptionChannel,
-		sfInitialReplayID: env.SubscriptionReplayID,
-		sfAuth:            jwtAuth,
+		sfVersion:       env.Version,
+		sfSubscriptions: env.Subscriptions,
+		sfReplayStore:   replayStore,
+		sfAuth:          jwtAuth,
 
 		dispatcher: dispatcher,
 		logger:     logger,
@@ -103,44 +115,67 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 // Start runs the handler.
 func (a *salesforceAdapter) Start(ctx context.Context) (err error) {
-	replayID := a.sfInitialReplayID
+	// avoid passing a typed nil to the client
+	var replayStore sfclient.ReplayStore
+	if a.sfReplayStore != nil {
+		replayStore = a.sfReplayStore
 
-	subs := []sfclient.Subscription{
-		{
-			Channel:  a.sfChannel,
-			ReplayID: replayID,
-		},
+		go a.sfReplayStore.Run(ctx, replayFlushInterval, a.logger)
+		defer a.flushReplays()
 	}
 
-	client := sfclient.NewBayeux(a.sfVersion, subs, a.sfAuth, a.dispatcher, http.DefaultClient, a.logger.Named("bayeux"))
+	client := sfclient.NewBayeux(a.sfVersion, a.sfSubscriptions, replayStore, a.sfAuth, a.dispatcher,
+		http.DefaultClient, a.logger.Named("bayeux"))
 
 	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
 
 	return client.Start(ctx)
 }
 
-func (e *eventDispatcher) DispatchEvent(ctx context.Context, msg *sfclient.ConnectResponse) {
+// flushReplays persists the replay IDs which were stored since the last
+// periodic flush. It is called upon shutdown, once the main context is
+// already cancelled.
+func (a *salesforceAdapter) flushReplays() {
+	ctx, cancel := context.WithTimeout(context.Background(), replayFlushTimeout)
+	defer cancel()
+
+	if err := a.sfReplayStore.Flush(ctx); err != nil {
+		a.logger.Errorw("Unable to persist replay IDs upon shutdown", zap.Error(err))
+	}
+}
+
+func (e *eventDispatcher) DispatchEvent(ctx context.Context, msg *sfclient.ConnectResponse) error {
 	event := cloudevents.NewEvent(cloudevents.VersionV1)
 
 	event.SetType(eventType)
-	event.SetSource(e.eventSource)
+	event.SetSource(eventSource(e.eventSourcePrefix, msg.Channel))
 	event.SetID(uuid.New().String())
 	event.SetSubject(subjectNameFromConnectResponse(msg))
 	if err := event.SetData(cloudevents.ApplicationJSON, msg.Data); err != nil {
 		e.logger.Error("Failed to set event data: %w", err)
-		return
+		return err
 	}
 
 	if result := e.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
 		e.logger.Errorf("Could not send CloudEvent %s: %v", event.Subject(), result)
-		return
+		return result
 	}
+
+	return nil
 }
 
 func (e *eventDispatcher) DispatchError(err error) {
 	e.logger.Errorf("Error receiving events: %v", err)
 }
 
+// eventSource returns the source attribute of events received from the given channel.
+func eventSource(prefix, channel string) string {
+	if channel == "" || channel[0] != '/' {
+		return prefix + "/" + channel
+	}
+	return prefix + channel
+}
+
 func subjectNameFromConnectResponse(msg *sfclient.ConnectResponse) string {
 
 	// if ChangeDataCapture look for entity/operation
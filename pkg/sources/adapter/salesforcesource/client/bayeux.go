@@ -72,6 +72,8 @@ type bayeux struct {
 
 	// store replayID per subscription
 	subsReplays map[string]*int64
+	// persist replayID per subscription across restarts (optional)
+	replayStore ReplayStore
 
 	dispatcher EventDispatcher
 
@@ -81,7 +83,8 @@ type bayeux struct {
 }
 
 // NewBayeux creates a Bayeux client for Salesforce Streaming API consumption.
-func NewBayeux(apiVersion string, subscriptions []Subscription, authenticator auth.Authenticator, dispatcher EventDispatcher, client *http.Client, logger *zap.SugaredLogger) Bayeux {
+// The replay store is optional, when nil replay IDs are only kept in memory.
+func NewBayeux(apiVersion string, subscriptions []Subscription, replayStore ReplayStore, authenticator auth.Authenticator, dispatcher EventDispatcher, client *http.Client, logger *zap.SugaredLogger) Bayeux {
 
 	// replayID is stored in a map and will keep track of the latest event received,
 	// we copy the configured value for initialization.
@@ -104,6 +107,7 @@ func NewBayeux(apiVersion string, subscriptions []Subscription, authenticator au
 
 		dispatcher:  dispatcher,
 		subsReplays: sr,
+		replayStore: replayStore,
 
 		logger: logger,
 	}
@@ -166,6 +170,10 @@ func (b *bayeux) Start(ctx context.Context) error {
 	b.ctx = ctx
 	b.mutex.Unlock()
 
+	if err := b.restoreReplays(ctx); err != nil {
+		return err
+	}
+
 	bom := wait.NewExponentialBackoffManager(time.Second, time.Second*60, time.Second*100, 2, 0, &clock.RealClock{})
 
 	// Connect loop will run until context is done
@@ -254,10 +262,15 @@ func (b *bayeux) Start(ctx context.Context) error {
 	for {
 		select {
 		case msg := <-b.msgCh:
-			b.dispatcher.DispatchEvent(b.ctx, msg)
+			// failures are reported by the dispatcher, the subscription
+			// is only advanced past events which were delivered
+			if err := b.dispatcher.DispatchEvent(b.ctx, msg); err != nil {
+				continue
+			}
 			r, ok := b.subsReplays[msg.Channel]
 			if ok {
 				atomic.StoreInt64(r, msg.Data.Event.ReplayID)
+				b.persistReplay(msg.Channel, msg.Data.Event.ReplayID)
 			}
 		case err := <-b.errCh:
 			b.dispatcher.DispatchError(err)
@@ -275,7 +288,7 @@ func (b *bayeux) manageMeta(cr *ConnectResponse) {
 
 	b.logger.Warnf("meta channel (channel: %s client: %s) was not successful: %+v", cr.Channel, cr.ClientID, *cr)
 
-	if cr.Advice.Reconnect == "handshake" {
+	if cr.Advice.Reconnect == "handshake" || requiresHandshake(cr.Error) {
 		b.logger.Infof("marking handshake needed as advised by channel response")
 		b.mutex.Lock()
 		defer b.mutex.Unlock()
@@ -283,6 +296,48 @@ func (b *bayeux) manageMeta(cr *ConnectResponse) {
 	}
 }
 
+// requiresHandshake returns whether the given Bayeux error indicates that the
+// client is no longer known to the server, or that its session is no longer
+// valid. Salesforce may return such errors without a handshake advice.
+// e.g. "403::Unknown client", "401::Authentication invalid"
+func requiresHandshake(bayeuxErr string) bool {
+	return strings.HasPrefix(bayeuxErr, "403::") || strings.HasPrefix(bayeuxErr, "401::")
+}
+
+// restoreReplays overrides the configured replayID of each subscription with
+// the one persisted in the replay store, if any.
+func (b *bayeux) restoreReplays(ctx context.Context) error {
+	if b.replayStore == nil {
+		return nil
+	}
+
+	replays, err := b.replayStore.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("loading persisted replay IDs: %w", err)
+	}
+
+	for channel, replayID := range replays {
+		if r, ok := b.subsReplays[channel]; ok {
+			b.logger.Infof("resuming subscription to channel %s after replay ID %d", channel, replayID)
+			atomic.StoreInt64(r, replayID)
+		}
+	}
+
+	return nil
+}
+
+// persistReplay stores the replayID of the last delivered event of a channel
+// in the replay store, if any.
+func (b *bayeux) persistReplay(channel string, replayID int64) {
+	if b.replayStore == nil {
+		return
+	}
+
+	if err := b.replayStore.Store(b.ctx, channel, replayID); err != nil {
+		b.dispatcher.DispatchError(fmt.Errorf("could not persist replay ID %d for channel %s: %w", replayID, channel, err))
+	}
+}
+
 // handshake should only be called when a new session is needed, which
 // will be when starting the stream process and when auth errors are
 // received from any call.
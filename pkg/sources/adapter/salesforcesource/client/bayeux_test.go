@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -136,6 +137,24 @@ func TestBayeux(t *testing.T) {
 			expectedConnectErrors:    0,
 		},
 
+		"connect, with meta unknown client error without advice": {
+			responses: []response{
+				{handshake: handshakeResponse()},
+				{subscribe: subscribeResponse()},
+				{connect: connectResponse(
+					connectWithChannel(tMetaChannel),
+					connectWithSuccessful(false),
+					connectWithError("403::Unknown client"),
+				)},
+				{handshake: handshakeResponse()},
+				{subscribe: subscribeResponse()},
+				{connect: connectResponse()},
+			},
+
+			expectedConnectResponses: 1,
+			expectedConnectErrors:    0,
+		},
+
 		"connect, with meta advicing handshake and 2 messages": {
 			responses: []response{
 				{handshake: handshakeResponse()},
@@ -157,9 +176,10 @@ func TestBayeux(t *testing.T) {
 	}
 
 	for name, tc := range testCases {
+		//nolint:scopelint
 		t.Run(name, func(t *testing.T) {
 
-			sf := httptest.NewServer(mockBayeuxServerHandler(tc.responses))
+			sf := httptest.NewServer(mockBayeuxServerHandler(tc.responses, nil))
 			defer sf.Close()
 
 			dispatcher := &eventDispatcher{
@@ -169,7 +189,7 @@ func TestBayeux(t *testing.T) {
 				InstanceURL: sf.URL,
 			})
 
-			b := NewBayeux(tAPIVersion, tSubscription, authenticator, dispatcher, sf.Client(), logger)
+			b := NewBayeux(tAPIVersion, tSubscription, nil, authenticator, dispatcher, sf.Client(), logger)
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
@@ -192,6 +212,88 @@ func TestBayeux(t *testing.T) {
 	}
 }
 
+func TestBayeuxReplayStore(t *testing.T) {
+	logger := zapt.NewLogger(t).Sugar()
+
+	const otherChannel = "/test/otherchannel"
+
+	subs := []Subscription{
+		{Channel: tResponseChannel, ReplayID: -1},
+		{Channel: otherChannel, ReplayID: -2},
+	}
+
+	store := &replayStore{
+		replays: map[string]int64{
+			tResponseChannel:     42,
+			"/test/unsubscribed": 1,
+		},
+	}
+
+	responses := []response{
+		{handshake: handshakeResponse()},
+		{subscribe: subscribeResponse()},
+		{subscribe: subscribeResponse()},
+		{connect: connectResponse(connectWithReplayID(43))},
+		{connect: connectResponse(connectWithReplayID(44))},
+		{connect: connectResponse(connectWithReplayID(45))},
+	}
+
+	reqs := &requestRecorder{}
+
+	sf := httptest.NewServer(mockBayeuxServerHandler(responses, reqs))
+	defer sf.Close()
+
+	dispatcher := &eventDispatcher{
+		eof:           make(chan struct{}),
+		nackReplayIDs: map[int64]bool{45: true},
+	}
+	authenticator := fake.NewFakeAuthenticator(auth.Credentials{
+		InstanceURL: sf.URL,
+	})
+
+	b := NewBayeux(tAPIVersion, subs, store, authenticator, dispatcher, sf.Client(), logger)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		clientErr := b.Start(ctx)
+		require.Nil(t, clientErr, "The bayeux client failed")
+	}()
+
+	select {
+	case <-time.After(4000 * time.Millisecond):
+		t.Fatal("Test timed out.")
+
+	case <-dispatcher.eof:
+	}
+
+	require.Equal(t, 3, len(dispatcher.dispatchedEvents), "Unexpected number of responses from connect")
+	require.Empty(t, dispatcher.dispatchedErrors, "Unexpected errors from dispatcher")
+
+	subscribeReplays := make(map[string]int64)
+	for _, rb := range reqs.requests() {
+		if rb["channel"] != subscribeChannel {
+			continue
+		}
+		ext := rb["ext"].(map[string]interface{})
+		for ch, r := range ext["replay"].(map[string]interface{}) {
+			subscribeReplays[ch] = int64(r.(float64))
+		}
+	}
+
+	expectSubscribeReplays := map[string]int64{
+		tResponseChannel: 42, // persisted replay ID takes precedence
+		otherChannel:     -2, // nothing persisted, initial replay ID
+	}
+	require.Equal(t, expectSubscribeReplays, subscribeReplays, "Unexpected replay IDs at subscription")
+
+	expectStoredReplays := map[string]int64{
+		tResponseChannel:     44, // event 45 was not delivered
+		"/test/unsubscribed": 1,
+	}
+	require.Equal(t, expectStoredReplays, store.replays, "Unexpected persisted replay IDs")
+}
+
 func handshakeResponse() []HandshakeResponse {
 	return []HandshakeResponse{{
 		CommonResponse: CommonResponse{
@@ -240,6 +342,18 @@ func connectWithSuccessful(success bool) connectResponseOption {
 	}
 }
 
+func connectWithError(err string) connectResponseOption {
+	return func(cr *ConnectResponse) {
+		cr.Error = err
+	}
+}
+
+func connectWithReplayID(replayID int64) connectResponseOption {
+	return func(cr *ConnectResponse) {
+		cr.Data.Event.ReplayID = replayID
+	}
+}
+
 func connectWithAdviceReconnect(reconnect string) connectResponseOption {
 	return func(cr *ConnectResponse) {
 		cr.Advice.Reconnect = reconnect
@@ -252,21 +366,74 @@ type eventDispatcher struct {
 	eof              chan struct{}
 	dispatchedEvents []*ConnectResponse
 	dispatchedErrors []error
+
+	// replay IDs of events which fail to be delivered
+	nackReplayIDs map[int64]bool
 }
 
-func (e *eventDispatcher) DispatchEvent(ctx context.Context, res *ConnectResponse) {
+func (e *eventDispatcher) DispatchEvent(ctx context.Context, res *ConnectResponse) error {
 	if res.Channel == tResponseFinishChannel {
 		close(e.eof)
-		return
+		return nil
 	}
 	e.dispatchedEvents = append(e.dispatchedEvents, res)
+
+	if e.nackReplayIDs[res.Data.Event.ReplayID] {
+		return fmt.Errorf("event %d was not acknowledged", res.Data.Event.ReplayID)
+	}
+	return nil
 }
 
 func (e *eventDispatcher) DispatchError(err error) {
 	e.dispatchedErrors = append(e.dispatchedErrors, err)
 }
 
-func mockBayeuxServerHandler(responses []response) http.HandlerFunc {
+var _ ReplayStore = (*replayStore)(nil)
+
+type replayStore struct {
+	mu      sync.Mutex
+	replays map[string]int64
+}
+
+func (s *replayStore) Load(context.Context) (map[string]int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	replays := make(map[string]int64, len(s.replays))
+	for ch, r := range s.replays {
+		replays[ch] = r
+	}
+	return replays, nil
+}
+
+func (s *replayStore) Store(_ context.Context, channel string, replayID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.replays[channel] = replayID
+	return nil
+}
+
+// requestRecorder records the decoded body of requests received by the mock
+// Bayeux server.
+type requestRecorder struct {
+	mu   sync.Mutex
+	reqs []map[string]interface{}
+}
+
+func (r *requestRecorder) record(req map[string]interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reqs = append(r.reqs, req)
+}
+
+func (r *requestRecorder) requests() []map[string]interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.reqs
+}
+
+func mockBayeuxServerHandler(responses []response, reqs *requestRecorder) http.HandlerFunc {
 	// responseIndex tracks the ordered responses for
 	// expected requests as defined by the test case.
 	responseIndex := 0
@@ -310,6 +477,10 @@ func mockBayeuxServerHandler(responses []response) http.HandlerFunc {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 
+		if reqs != nil {
+			reqs.record(rb)
+		}
+
 		channel, ok := rb["channel"].(string)
 		if !ok {
 			http.Error(w, "unkwown channel format at request", http.StatusInternalServerError)
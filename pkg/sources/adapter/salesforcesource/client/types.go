@@ -87,8 +87,10 @@ type PushTopicSObject struct {
 
 // EventDispatcher is an object that can dispatch messages and "non managed" errors
 // received through the stream.
+// DispatchEvent returns an error when the message could not be delivered, in
+// which case the replay ID of the subscription is not advanced.
 type EventDispatcher interface {
-	DispatchEvent(context.Context, *ConnectResponse)
+	DispatchEvent(context.Context, *ConnectResponse) error
 	DispatchError(error)
 }
 
@@ -99,6 +101,16 @@ type EventDispatcher interface {
 //		replayID for receiving events after that replayID event.
 // See: https://developer.salesforce.com/docs/atlas.en-us.api_streaming.meta/api_streaming/using_streaming_api_durability.htm
 type Subscription struct {
-	Channel  string
-	ReplayID int
+	Channel  string `json:"channel"`
+	ReplayID int    `json:"replayID"`
+}
+
+// ReplayStore persists the replay ID of the last processed event of each
+// channel, so that subscriptions can be resumed from that event.
+type ReplayStore interface {
+	// Load returns the persisted replay IDs, indexed by channel.
+	Load(context.Context) (map[string]int64, error)
+	// Store records the replay ID of the last delivered event of a
+	// channel. Implementations may defer persisting it.
+	Store(ctx context.Context, channel string, replayID int64) error
 }
@@ -16,7 +16,15 @@ limitations under the License.
 
 package salesforcesource
 
-import "knative.dev/eventing/pkg/adapter/v2"
+import (
+	"encoding/json"
+
+	"github.com/kelseyhightower/envconfig"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+
+	sfclient "github.com/triggermesh/triggermesh/pkg/sources/adapter/salesforcesource/client"
+)
 
 // NewEnvConfig satisfies pkgadapter.EnvConfigConstructor.
 func NewEnvConfig() adapter.EnvConfigAccessor {
@@ -32,7 +40,18 @@ type envAccessor struct {
 	CertKey    string `envconfig:"SALESFORCE_AUTH_CERT_KEY" required:"true"`
 	Version    string `envconfig:"SALESFORCE_API_VERSION" default:"48.0"`
 
-	// We are supporting only one subscription + replayID per source instance
-	SubscriptionChannel  string `envconfig:"SALESFORCE_SUBCRIPTION_CHANNEL" required:"true"`
-	SubscriptionReplayID int    `envconfig:"SALESFORCE_SUBCRIPTION_REPLAY_ID" default:"-1"`
+	Subscriptions subscriptions `envconfig:"SALESFORCE_SUBSCRIPTIONS" required:"true"`
+
+	// Name of the ConfigMap in which replay IDs are persisted (optional)
+	ReplayStoreConfigMap string `envconfig:"SALESFORCE_REPLAY_STORE_CONFIGMAP"`
+}
+
+// subscriptions is a list of channel subscriptions, decoded from JSON.
+type subscriptions []sfclient.Subscription
+
+var _ envconfig.Decoder = (*subscriptions)(nil)
+
+// Decode implements envconfig.Decoder.
+func (s *subscriptions) Decode(value string) error {
+	return json.Unmarshal([]byte(value), s)
 }
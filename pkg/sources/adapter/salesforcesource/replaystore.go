@@ -0,0 +1,214 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package salesforcesource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+
+	sfclient "github.com/triggermesh/triggermesh/pkg/sources/adapter/salesforcesource/client"
+)
+
+// replayIDsKey is the key of the ConfigMap entry which contains replay IDs,
+// serialized as a JSON object indexed by channel.
+const replayIDsKey = "replayIDs"
+
+// configMapReplayStore is a sfclient.ReplayStore which persists replay IDs
+// in a Kubernetes ConfigMap.
+//
+// Stored replay IDs are kept in memory and written to the ConfigMap in
+// batches by Flush, to keep Kubernetes API calls off the path of event
+// delivery.
+type configMapReplayStore struct {
+	cmCli corev1client.ConfigMapInterface
+	name  string
+
+	// replay IDs which haven't been written to the ConfigMap yet
+	mu      sync.Mutex
+	pending map[string]int64
+
+	// serializes updates of the ConfigMap
+	flushMu sync.Mutex
+}
+
+var _ sfclient.ReplayStore = (*configMapReplayStore)(nil)
+
+// newConfigMapReplayStore returns a replay store backed by the ConfigMap with
+// the given name. The ConfigMap is created upon the first write if it doesn't
+// exist.
+func newConfigMapReplayStore(cmCli corev1client.ConfigMapInterface, name string) *configMapReplayStore {
+	return &configMapReplayStore{
+		cmCli:   cmCli,
+		name:    name,
+		pending: make(map[string]int64),
+	}
+}
+
+// Load implements sfclient.ReplayStore.
+func (s *configMapReplayStore) Load(ctx context.Context) (map[string]int64, error) {
+	cm, err := s.cmCli.Get(ctx, s.name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("getting ConfigMap %q: %w", s.name, err)
+	}
+
+	return replayIDsFromConfigMap(cm)
+}
+
+// Store implements sfclient.ReplayStore.
+// The replay ID is persisted upon the next call to Flush.
+func (s *configMapReplayStore) Store(_ context.Context, channel string, replayID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending[channel] = replayID
+	return nil
+}
+
+// Run flushes stored replay IDs at the given interval until the context is
+// cancelled.
+func (s *configMapReplayStore) Run(ctx context.Context, interval time.Duration, logger *zap.SugaredLogger) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if err := s.Flush(ctx); err != nil {
+				logger.Errorw("Unable to persist replay IDs", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Flush writes the replay IDs stored since the last flush to the ConfigMap.
+// Replay IDs which couldn't be written are retried upon the next flush.
+func (s *configMapReplayStore) Flush(ctx context.Context) error {
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+
+	s.mu.Lock()
+	replays := s.pending
+	s.pending = make(map[string]int64, len(replays))
+	s.mu.Unlock()
+
+	if len(replays) == 0 {
+		return nil
+	}
+
+	if err := s.write(ctx, replays); err != nil {
+		s.mu.Lock()
+		for ch, r := range replays {
+			// do not override replay IDs stored during the flush
+			if _, ok := s.pending[ch]; !ok {
+				s.pending[ch] = r
+			}
+		}
+		s.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+// write merges the given replay IDs into the ConfigMap.
+func (s *configMapReplayStore) write(ctx context.Context, replays map[string]int64) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := s.cmCli.Get(ctx, s.name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: s.name,
+				},
+			}
+			if err := setReplayIDs(cm, replays); err != nil {
+				return err
+			}
+
+			_, err = s.cmCli.Create(ctx, cm, metav1.CreateOptions{})
+			return err
+
+		case err != nil:
+			return fmt.Errorf("getting ConfigMap %q: %w", s.name, err)
+		}
+
+		current, err := replayIDsFromConfigMap(cm)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			current = make(map[string]int64, len(replays))
+		}
+		for ch, r := range replays {
+			current[ch] = r
+		}
+
+		if err := setReplayIDs(cm, current); err != nil {
+			return err
+		}
+
+		_, err = s.cmCli.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// replayIDsFromConfigMap returns the replay IDs stored in the given ConfigMap.
+func replayIDsFromConfigMap(cm *corev1.ConfigMap) (map[string]int64, error) {
+	data, ok := cm.Data[replayIDsKey]
+	if !ok {
+		return nil, nil
+	}
+
+	var replays map[string]int64
+	if err := json.Unmarshal([]byte(data), &replays); err != nil {
+		return nil, fmt.Errorf("decoding replay IDs from ConfigMap %q: %w", cm.Name, err)
+	}
+
+	return replays, nil
+}
+
+// setReplayIDs writes the given replay IDs to a ConfigMap.
+func setReplayIDs(cm *corev1.ConfigMap, replays map[string]int64) error {
+	data, err := json.Marshal(replays)
+	if err != nil {
+		return fmt.Errorf("encoding replay IDs: %w", err)
+	}
+
+	if cm.Data == nil {
+		cm.Data = make(map[string]string, 1)
+	}
+	cm.Data[replayIDsKey] = string(data)
+
+	return nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package salesforcesource
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const (
+	tNamespace = "test-ns"
+	tCMName    = "test-replays"
+)
+
+func TestConfigMapReplayStore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ConfigMap does not exist", func(t *testing.T) {
+		cmCli := fake.NewSimpleClientset().CoreV1().ConfigMaps(tNamespace)
+		s := newConfigMapReplayStore(cmCli, tCMName)
+
+		replays, err := s.Load(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, replays)
+
+		require.NoError(t, s.Store(ctx, "/data/ChangeEvents", 9))
+		require.NoError(t, s.Store(ctx, "/data/ChangeEvents", 10))
+
+		_, err = cmCli.Get(ctx, tCMName, metav1.GetOptions{})
+		require.True(t, apierrors.IsNotFound(err), "ConfigMap should not be written before flush")
+
+		require.NoError(t, s.Flush(ctx))
+
+		cm, err := cmCli.Get(ctx, tCMName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"/data/ChangeEvents":10}`, cm.Data[replayIDsKey])
+	})
+
+	t.Run("ConfigMap exists", func(t *testing.T) {
+		cmCli := fake.NewSimpleClientset(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: tNamespace,
+				Name:      tCMName,
+			},
+			Data: map[string]string{
+				replayIDsKey: `{"/data/ChangeEvents":10,"/event/Test__e":3}`,
+				"unrelated":  "value",
+			},
+		}).CoreV1().ConfigMaps(tNamespace)
+		s := newConfigMapReplayStore(cmCli, tCMName)
+
+		replays, err := s.Load(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"/data/ChangeEvents": 10, "/event/Test__e": 3}, replays)
+
+		require.NoError(t, s.Store(ctx, "/data/ChangeEvents", 11))
+		require.NoError(t, s.Flush(ctx))
+
+		replays, err = s.Load(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"/data/ChangeEvents": 11, "/event/Test__e": 3}, replays)
+
+		cm, err := cmCli.Get(ctx, tCMName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "value", cm.Data["unrelated"], "Unrelated data should be preserved")
+	})
+
+	t.Run("Invalid data", func(t *testing.T) {
+		cmCli := fake.NewSimpleClientset(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: tNamespace,
+				Name:      tCMName,
+			},
+			Data: map[string]string{
+				replayIDsKey: `not JSON`,
+			},
+		}).CoreV1().ConfigMaps(tNamespace)
+		s := newConfigMapReplayStore(cmCli, tCMName)
+
+		_, err := s.Load(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("Failed flush", func(t *testing.T) {
+		cs := fake.NewSimpleClientset()
+		cmCli := cs.CoreV1().ConfigMaps(tNamespace)
+		s := newConfigMapReplayStore(cmCli, tCMName)
+
+		cs.PrependReactor("create", "configmaps", func(k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("fake error")
+		})
+
+		require.NoError(t, s.Store(ctx, "/data/ChangeEvents", 10))
+		require.NoError(t, s.Store(ctx, "/event/Test__e", 3))
+		assert.Error(t, s.Flush(ctx))
+
+		require.NoError(t, s.Store(ctx, "/event/Test__e", 4))
+
+		cs.ReactionChain = cs.ReactionChain[1:]
+		require.NoError(t, s.Flush(ctx))
+
+		replays, err := s.Load(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"/data/ChangeEvents": 10, "/event/Test__e": 4}, replays,
+			"Replay IDs should be retried, without overriding newer ones")
+	})
+}
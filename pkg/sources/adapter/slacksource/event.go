@@ -28,6 +28,21 @@ func (e SlackEvent) Type() string {
 	return s.(string)
 }
 
+// correlation returns the channel, timestamp and thread timestamp of the
+// message the event relates to, if any. Events such as reaction_added refer
+// to a message through their "item" attribute.
+func (e SlackEvent) correlation() (channel, ts, threadTS string) {
+	attrs := map[string]interface{}(e)
+	if item, ok := e["item"].(map[string]interface{}); ok {
+		attrs = item
+	}
+
+	channel, _ = attrs["channel"].(string)
+	ts, _ = attrs["ts"].(string)
+	threadTS, _ = attrs["thread_ts"].(string)
+	return
+}
+
 // SlackEventWrapper contains a common wrapper for all events.
 // See https://api.slack.com/types/event for reference.
 type SlackEventWrapper struct {
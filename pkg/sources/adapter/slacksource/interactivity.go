@@ -0,0 +1,176 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slacksource
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// interactivityPath is the path Slack sends interaction payloads to. It must
+// be configured as the Request URL of the Slack app's Interactivity settings.
+const interactivityPath = "/interactivity"
+
+// SlackInteraction contains the attributes of an interaction payload which
+// are used to build a CloudEvent.
+// See https://api.slack.com/reference/interaction-payloads for reference.
+type SlackInteraction struct {
+	Type      string `json:"type"`
+	TriggerID string `json:"trigger_id"`
+	APIAppID  string `json:"api_app_id"`
+	ActionTS  string `json:"action_ts"`
+
+	Team struct {
+		ID string `json:"id"`
+	} `json:"team"`
+
+	Channel *struct {
+		ID string `json:"id"`
+	} `json:"channel"`
+
+	Container *struct {
+		ChannelID string `json:"channel_id"`
+		MessageTS string `json:"message_ts"`
+		ThreadTS  string `json:"thread_ts"`
+	} `json:"container"`
+
+	Message *struct {
+		TS       string `json:"ts"`
+		ThreadTS string `json:"thread_ts"`
+	} `json:"message"`
+}
+
+// handleInteractivity receives interaction payloads, such as button clicks
+// and modal submissions, and sends them as CloudEvents.
+func (h *slackEventAPIHandler) handleInteractivity(ctx context.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Body == nil {
+			h.handleError(errors.New("request without body not supported"), http.StatusBadRequest, w)
+			return
+		}
+
+		defer r.Body.Close()
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			h.handleError(err, http.StatusInternalServerError, w)
+			return
+		}
+
+		if h.signingSecret != "" {
+			err = h.verifySigning(r.Header, body)
+			if err != nil {
+				h.handleError(err, http.StatusUnauthorized, w)
+				return
+			}
+		}
+
+		// Interaction payloads are sent as the "payload" parameter of
+		// an application/x-www-form-urlencoded body.
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			h.handleError(fmt.Errorf("could not parse form request: %w", err), http.StatusBadRequest, w)
+			return
+		}
+
+		payload := []byte(form.Get("payload"))
+		if len(payload) == 0 {
+			h.handleError(errors.New("request does not contain an interaction payload"), http.StatusBadRequest, w)
+			return
+		}
+
+		interaction := &SlackInteraction{}
+		if err := json.Unmarshal(payload, interaction); err != nil {
+			h.handleError(fmt.Errorf("could not unmarshal JSON interaction payload: %w", err), http.StatusBadRequest, w)
+			return
+		}
+
+		// Interactions from other apps are acknowledged, as they would
+		// otherwise be reported as failures to the user.
+		if h.appID != "" && interaction.APIAppID != h.appID {
+			return
+		}
+
+		h.logger.Info("interaction received: ", sanitizeUserInput(interaction.Type))
+
+		event, err := cloudEventFromInteraction(interaction, payload, h.time.Now())
+		if err != nil {
+			h.handleError(err, http.StatusBadRequest, w)
+			return
+		}
+
+		// An empty response with a 200 status code acknowledges the
+		// interaction, and closes modals upon submission.
+		// See: https://api.slack.com/interactivity/handling#acknowledgment_response
+		if result := h.ceClient.Send(ctx, *event); !cloudevents.IsACK(result) {
+			h.handleError(result, http.StatusInternalServerError, w)
+		}
+	}
+}
+
+func cloudEventFromInteraction(i *SlackInteraction, payload []byte, now time.Time) (*cloudevents.Event, error) {
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+
+	// view_closed payloads do not contain a trigger ID
+	id := i.TriggerID
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	event.SetID(id)
+	event.SetType(v1alpha1.SlackInteractionEventType)
+	event.SetSource(i.Team.ID)
+	event.SetExtension(apiAppIDCeExtension, i.APIAppID)
+	event.SetTime(now)
+	event.SetSubject(i.Type)
+
+	var channel, ts, threadTS string
+	if i.Channel != nil {
+		channel = i.Channel.ID
+	}
+	if c := i.Container; c != nil {
+		if c.ChannelID != "" {
+			channel = c.ChannelID
+		}
+		ts, threadTS = c.MessageTS, c.ThreadTS
+	}
+	if m := i.Message; m != nil {
+		if ts == "" {
+			ts = m.TS
+		}
+		if threadTS == "" {
+			threadTS = m.ThreadTS
+		}
+	}
+	setCorrelationExtensions(&event, channel, ts, threadTS)
+
+	if err := event.SetData(cloudevents.ApplicationJSON, json.RawMessage(payload)); err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
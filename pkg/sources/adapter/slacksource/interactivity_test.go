@@ -0,0 +1,209 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slacksource
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	zapt "go.uber.org/zap/zaptest"
+
+	cloudeventst "github.com/cloudevents/sdk-go/v2/client/test"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+func TestSlackInteraction(t *testing.T) {
+
+	logger := zapt.NewLogger(t).Sugar()
+
+	const blockActions = `{"type":"block_actions","trigger_id":"123.456.abc","api_app_id":"AXXXXXXXXX",` +
+		`"team":{"id":"TXXXXXXXX"},"container":{"type":"message","channel_id":"CXXXXXXXX","message_ts":"1593192794.008000"},` +
+		`"actions":[{"action_id":"approve","value":"yes"}]}`
+
+	const viewSubmission = `{"type":"view_submission","trigger_id":"789.012.def","api_app_id":"AXXXXXXXXX",` +
+		`"team":{"id":"TXXXXXXXX"},"view":{"id":"VXXXXXXXX","state":{"values":{}}}}`
+
+	tc := map[string]struct {
+		body  io.Reader
+		appID string
+
+		expectedCode     int
+		expectedContains string
+
+		expectedEventID         string
+		expectedEventSubject    string
+		expectedEventData       string
+		expectedEventExtensions map[string]interface{}
+	}{
+		"nil body": {
+			body: nil,
+
+			expectedCode:     http.StatusBadRequest,
+			expectedContains: "request without body not supported",
+		},
+
+		"missing payload": {
+			body: read("foo=bar"),
+
+			expectedCode:     http.StatusBadRequest,
+			expectedContains: "request does not contain an interaction payload",
+		},
+
+		"payload is not JSON": {
+			body: read(form("this is not JSON")),
+
+			expectedCode:     http.StatusBadRequest,
+			expectedContains: "could not unmarshal JSON interaction payload:",
+		},
+
+		"wrong App ID": {
+			body:  read(form(blockActions)),
+			appID: "ZYYYYYYYYYY",
+
+			expectedCode: http.StatusOK,
+		},
+
+		"block actions": {
+			body: read(form(blockActions)),
+
+			expectedCode:         http.StatusOK,
+			expectedEventID:      "123.456.abc",
+			expectedEventSubject: "block_actions",
+			expectedEventData:    blockActions,
+			expectedEventExtensions: map[string]interface{}{
+				apiAppIDCeExtension: "AXXXXXXXXX",
+				channelCeExtension:  "CXXXXXXXX",
+				tsCeExtension:       "1593192794.008000",
+				threadTSCeExtension: "1593192794.008000",
+			},
+		},
+
+		"view submission": {
+			body: read(form(viewSubmission)),
+
+			expectedCode:         http.StatusOK,
+			expectedEventID:      "789.012.def",
+			expectedEventSubject: "view_submission",
+			expectedEventData:    viewSubmission,
+			expectedEventExtensions: map[string]interface{}{
+				apiAppIDCeExtension: "AXXXXXXXXX",
+			},
+		},
+	}
+
+	for name, c := range tc {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			ceClient, chEvent := cloudeventst.NewMockSenderClient(t, 1)
+
+			handler := &slackEventAPIHandler{
+				appID:    c.appID,
+				ceClient: ceClient,
+				logger:   logger,
+				time:     standardTime{},
+			}
+
+			req, _ := http.NewRequest("POST", interactivityPath, c.body)
+
+			th := http.HandlerFunc(handler.handleInteractivity(context.Background()))
+
+			rr := httptest.NewRecorder()
+
+			th.ServeHTTP(rr, req)
+
+			assert.Equal(t, c.expectedCode, rr.Code, "unexpected response code")
+			assert.Contains(t, rr.Body.String(), c.expectedContains, "could not find expected response")
+
+			if c.expectedEventID != "" {
+				select {
+				case event := <-chEvent:
+					assert.Equal(t, c.expectedEventID, event.ID(), "event ID does not match")
+					assert.Equal(t, v1alpha1.SlackInteractionEventType, event.Type(), "event type does not match")
+					assert.Equal(t, "TXXXXXXXX", event.Source(), "event source does not match")
+					assert.Equal(t, c.expectedEventSubject, event.Subject(), "event subject does not match")
+					assert.Equal(t, c.expectedEventData, string(event.Data()), "event Data does not match")
+					assert.Equal(t, c.expectedEventExtensions, event.Extensions(), "event extensions do not match")
+
+				case <-time.After(1 * time.Second):
+					assert.Fail(t, "expected cloud event by ID %q was not sent", c.expectedEventID)
+				}
+			}
+		})
+	}
+}
+
+func TestCorrelationExtensions(t *testing.T) {
+	tc := map[string]struct {
+		event SlackEvent
+
+		expectedExtensions map[string]interface{}
+	}{
+		"message": {
+			event: SlackEvent{"type": "message", "channel": "C1", "ts": "1.1"},
+			expectedExtensions: map[string]interface{}{
+				channelCeExtension:  "C1",
+				tsCeExtension:       "1.1",
+				threadTSCeExtension: "1.1",
+			},
+		},
+		"threaded message": {
+			event: SlackEvent{"type": "message", "channel": "C1", "ts": "1.2", "thread_ts": "1.1"},
+			expectedExtensions: map[string]interface{}{
+				channelCeExtension:  "C1",
+				tsCeExtension:       "1.2",
+				threadTSCeExtension: "1.1",
+			},
+		},
+		"reaction to a message": {
+			event: SlackEvent{"type": "reaction_added", "item": map[string]interface{}{
+				"type": "message", "channel": "C1", "ts": "1.1"}},
+			expectedExtensions: map[string]interface{}{
+				channelCeExtension:  "C1",
+				tsCeExtension:       "1.1",
+				threadTSCeExtension: "1.1",
+			},
+		},
+		"not related to a message": {
+			event:              SlackEvent{"type": "team_join"},
+			expectedExtensions: map[string]interface{}{},
+		},
+	}
+
+	for name, c := range tc {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			event, err := cloudEventFromEventWrapper(&SlackEventWrapper{Event: c.event})
+			assert.NoError(t, err)
+
+			ext := event.Extensions()
+			delete(ext, apiAppIDCeExtension)
+			assert.Equal(t, c.expectedExtensions, ext)
+		})
+	}
+}
+
+func form(payload string) string {
+	return url.Values{"payload": []string{payload}}.Encode()
+}
@@ -33,7 +33,16 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 )
 
-const apiAppIDCeExtension = "comslackapiappid"
+// CloudEvent extensions set on events.
+const (
+	apiAppIDCeExtension = "comslackapiappid"
+
+	// The following extensions allow targets to correlate replies with
+	// the message, and thread, an event originated from.
+	channelCeExtension  = "comslackchannel"
+	tsCeExtension       = "comslackts"
+	threadTSCeExtension = "comslackthreadts"
+)
 
 // SlackEventAPIHandler listen for Slack API Events
 type SlackEventAPIHandler interface {
@@ -72,6 +81,7 @@ func (h *slackEventAPIHandler) Start(ctx context.Context) error {
 
 	m := http.NewServeMux()
 	m.HandleFunc("/", h.handleAll(ctx))
+	m.HandleFunc(interactivityPath, h.handleInteractivity(ctx))
 
 	h.srv = &http.Server{
 		Addr:    ":" + strconv.Itoa(h.port),
@@ -216,6 +226,8 @@ func cloudEventFromEventWrapper(wrapper *SlackEventWrapper) (*cloudevents.Event,
 	event.SetExtension(apiAppIDCeExtension, wrapper.APIAppID)
 	event.SetTime(time.Unix(int64(wrapper.EventTime), 0))
 	event.SetSubject(wrapper.Event.Type())
+	channel, ts, threadTS := wrapper.Event.correlation()
+	setCorrelationExtensions(&event, channel, ts, threadTS)
 	if err := event.SetData(cloudevents.ApplicationJSON, wrapper.Event); err != nil {
 		return nil, err
 	}
@@ -223,6 +235,22 @@ func cloudEventFromEventWrapper(wrapper *SlackEventWrapper) (*cloudevents.Event,
 	return &event, nil
 }
 
+// setCorrelationExtensions sets the extensions which identify the message, and
+// its thread, an event relates to. Messages which are not part of a thread
+// are the root of their own thread.
+func setCorrelationExtensions(event *cloudevents.Event, channel, ts, threadTS string) {
+	if channel == "" || ts == "" {
+		return
+	}
+	if threadTS == "" {
+		threadTS = ts
+	}
+
+	event.SetExtension(channelCeExtension, channel)
+	event.SetExtension(tsCeExtension, ts)
+	event.SetExtension(threadTSCeExtension, threadTS)
+}
+
 var newlineToSpace = strings.NewReplacer("\n", " ", "\r", " ")
 
 // sanitizeUserInput removes unwanted characters from the given string.
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"go.uber.org/zap"
@@ -38,17 +39,34 @@ const (
 	serverShutdownGracePeriod        = time.Second * 10
 )
 
+// correlationIDParam is the query parameter which TwilioTarget sets in
+// status callback URLs to the ID of the event that triggered a message.
+const correlationIDParam = "ceid"
+
+// correlationIDExtension is the CloudEvent extension which carries the ID of
+// the event that triggered a message in status events.
+const correlationIDExtension = "correlationid"
+
 // adapter implements the source's adapter.
 type adapter struct {
 	ceClient    cloudevents.Client
 	eventsource string
 	logger      *zap.SugaredLogger
 	mt          *pkgadapter.MetricTag
+
+	// authToken verifies the signature of incoming requests, if set.
+	authToken string
+}
+
+type envAccessor struct {
+	pkgadapter.EnvConfig
+
+	AuthToken string `envconfig:"TWILIO_AUTH_TOKEN"`
 }
 
 // NewEnvConfig satisfies pkgadapter.EnvConfigConstructor.
 func NewEnvConfig() pkgadapter.EnvConfigAccessor {
-	return &pkgadapter.EnvConfig{}
+	return &envAccessor{}
 }
 
 // NewAdapter satisfies pkgadapter.AdapterConstructor.
@@ -59,11 +77,14 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		Name:          envAcc.GetName(),
 	}
 
+	env := envAcc.(*envAccessor)
+
 	return &adapter{
 		ceClient:    ceClient,
 		eventsource: v1alpha1.TwilioSourceName(envAcc.GetNamespace(), envAcc.GetName()),
 		logger:      logging.FromContext(ctx),
 		mt:          mt,
+		authToken:   env.AuthToken,
 	}
 }
 
@@ -124,24 +145,76 @@ func (h *adapter) handleRoot(ctx context.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
 		h.logger.Debug("Got request: ", *req)
 
-		m := parseFormToMessage(req)
+		if err := req.ParseForm(); err != nil {
+			http.Error(w, "Invalid form data: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if h.authToken != "" {
+			if err := verifySignature(req, h.authToken); err != nil {
+				h.logger.Warnw("Rejecting request", zap.Error(err))
+				http.Error(w, "Invalid request signature", http.StatusUnauthorized)
+				return
+			}
+		}
+
+		var event cloudevents.Event
+		var err error
 
-		if err := h.sendCloudEvent(ctx, m); err != nil {
+		// Twilio sends status callbacks to the URL set when creating a
+		// message, which includes a "MessageStatus" parameter.
+		if req.PostForm.Get("MessageStatus") != "" {
+			event, err = h.newStatusEvent(parseFormToMessageStatus(req), req.URL.Query().Get(correlationIDParam))
+		} else {
+			event, err = h.newMessageEvent(parseFormToMessage(req))
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		if err := h.sendCloudEvent(ctx, event); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 		}
 	}
 }
 
-func (h *adapter) sendCloudEvent(ctx context.Context, m *Message) error {
-	h.logger.Debug("Sending CloudEvent")
-
+// newMessageEvent returns a CloudEvent representing an incoming message.
+func (h *adapter) newMessageEvent(m *Message) (cloudevents.Event, error) {
 	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetID(m.MessageSid)
 	event.SetType(v1alpha1.TwilioSourceGenericEventType)
 	event.SetSource(h.eventsource)
+	event.SetSubject(m.MessageSid)
 	if err := event.SetData(cloudevents.ApplicationJSON, m); err != nil {
-		return fmt.Errorf("failed to set event data: %w", err)
+		return event, fmt.Errorf("failed to set event data: %w", err)
 	}
 
+	return event, nil
+}
+
+// newStatusEvent returns a CloudEvent representing a status update of a sent
+// message. Status events are correlated with the event which triggered the
+// message when its ID is known.
+func (h *adapter) newStatusEvent(s *MessageStatus, correlationID string) (cloudevents.Event, error) {
+	event := cloudevents.NewEvent(cloudevents.VersionV1)
+	event.SetID(s.MessageSid + "-" + s.MessageStatus)
+	event.SetType(v1alpha1.TwilioSourceStatusEventType)
+	event.SetSource(h.eventsource)
+	event.SetSubject(s.MessageSid)
+	if correlationID != "" {
+		event.SetExtension(correlationIDExtension, correlationID)
+	}
+	if err := event.SetData(cloudevents.ApplicationJSON, s); err != nil {
+		return event, fmt.Errorf("failed to set event data: %w", err)
+	}
+
+	return event, nil
+}
+
+func (h *adapter) sendCloudEvent(ctx context.Context, event cloudevents.Event) error {
+	h.logger.Debug("Sending CloudEvent")
+
 	if result := h.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
 		return fmt.Errorf("failed to send CloudEvent: %w", result)
 	}
@@ -175,5 +248,26 @@ func parseFormToMessage(req *http.Request) *Message {
 	m.FromCity = req.FormValue("FromCity")
 	m.ToState = req.FormValue("ToState")
 
+	if n, err := strconv.Atoi(req.FormValue("NumMedia")); err == nil {
+		for i := 0; i < n; i++ {
+			m.MediaURLs = append(m.MediaURLs, req.FormValue("MediaUrl"+strconv.Itoa(i)))
+		}
+	}
+
 	return m
 }
+
+func parseFormToMessageStatus(req *http.Request) *MessageStatus {
+	s := &MessageStatus{}
+
+	s.MessageSid = req.PostFormValue("MessageSid")
+	s.MessageStatus = req.PostFormValue("MessageStatus")
+	s.ErrorCode = req.PostFormValue("ErrorCode")
+	s.AccountSid = req.PostFormValue("AccountSid")
+	s.MessagingServiceSid = req.PostFormValue("MessagingServiceSid")
+	s.From = req.PostFormValue("From")
+	s.To = req.PostFormValue("To")
+	s.APIVersion = req.PostFormValue("ApiVersion")
+
+	return s
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package twiliosource
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	zapt "go.uber.org/zap/zaptest"
+
+	cloudeventst "github.com/cloudevents/sdk-go/v2/client/test"
+
+	twilio "github.com/kevinburke/twilio-go"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+const (
+	tEventSource = "io.triggermesh.twilio/test/source"
+	tAuthToken   = "s3cr3t"
+	tHost        = "twiliosource.example.com"
+)
+
+func TestHandleRoot(t *testing.T) {
+	incomingMessage := url.Values{
+		"MessageSid": {"SM1"},
+		"From":       {"+15550001"},
+		"To":         {"+15550002"},
+		"Body":       {"Hello"},
+		"NumMedia":   {"2"},
+		"MediaUrl0":  {"https://api.twilio.com/media/0"},
+		"MediaUrl1":  {"https://api.twilio.com/media/1"},
+	}
+
+	statusCallback := url.Values{
+		"MessageSid":    {"SM2"},
+		"MessageStatus": {"undelivered"},
+		"ErrorCode":     {"30003"},
+		"From":          {"whatsapp:+15550001"},
+		"To":            {"whatsapp:+15550002"},
+	}
+
+	tc := map[string]struct {
+		path      string
+		form      url.Values
+		authToken string
+		// signature computed with this token, if not empty
+		signToken string
+
+		expectedCode          int
+		expectedEventType     string
+		expectedEventID       string
+		expectedSubject       string
+		expectedCorrelationID string
+		expectedData          string
+	}{
+		"incoming message": {
+			path: "/",
+			form: incomingMessage,
+
+			expectedCode:      http.StatusOK,
+			expectedEventType: v1alpha1.TwilioSourceGenericEventType,
+			expectedEventID:   "SM1",
+			expectedSubject:   "SM1",
+			expectedData:      `"media_urls":["https://api.twilio.com/media/0","https://api.twilio.com/media/1"]`,
+		},
+		"status callback": {
+			path: "/?ceid=1234",
+			form: statusCallback,
+
+			expectedCode:          http.StatusOK,
+			expectedEventType:     v1alpha1.TwilioSourceStatusEventType,
+			expectedEventID:       "SM2-undelivered",
+			expectedSubject:       "SM2",
+			expectedCorrelationID: "1234",
+			expectedData:          `"message_status":"undelivered","error_code":"30003"`,
+		},
+		"status callback without correlation": {
+			path: "/",
+			form: statusCallback,
+
+			expectedCode:      http.StatusOK,
+			expectedEventType: v1alpha1.TwilioSourceStatusEventType,
+			expectedEventID:   "SM2-undelivered",
+			expectedSubject:   "SM2",
+		},
+		"signed request": {
+			path:      "/?ceid=1234",
+			form:      statusCallback,
+			authToken: tAuthToken,
+			signToken: tAuthToken,
+
+			expectedCode:          http.StatusOK,
+			expectedEventType:     v1alpha1.TwilioSourceStatusEventType,
+			expectedEventID:       "SM2-undelivered",
+			expectedSubject:       "SM2",
+			expectedCorrelationID: "1234",
+		},
+		"missing signature": {
+			path:      "/",
+			form:      incomingMessage,
+			authToken: tAuthToken,
+
+			expectedCode: http.StatusUnauthorized,
+		},
+		"wrong signature": {
+			path:      "/",
+			form:      incomingMessage,
+			authToken: tAuthToken,
+			signToken: "wrong",
+
+			expectedCode: http.StatusUnauthorized,
+		},
+	}
+
+	for name, c := range tc {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			ceClient, chEvent := cloudeventst.NewMockSenderClient(t, 1)
+
+			a := &adapter{
+				ceClient:    ceClient,
+				eventsource: tEventSource,
+				logger:      zapt.NewLogger(t).Sugar(),
+				authToken:   c.authToken,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, c.path, strings.NewReader(c.form.Encode()))
+			req.Host = tHost
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.Header.Set("X-Forwarded-Proto", "https")
+			if c.signToken != "" {
+				req.Header.Set(signatureHeader,
+					twilio.GetExpectedTwilioSignature("https://"+tHost, c.signToken, c.path, c.form))
+			}
+
+			rr := httptest.NewRecorder()
+			a.handleRoot(context.Background()).ServeHTTP(rr, req)
+
+			require.Equal(t, c.expectedCode, rr.Code, "unexpected response code: %s", rr.Body)
+
+			if c.expectedEventType == "" {
+				select {
+				case event := <-chEvent:
+					assert.Fail(t, "unexpected cloud event was sent", "event: %s", event)
+				default:
+				}
+				return
+			}
+
+			select {
+			case event := <-chEvent:
+				assert.Equal(t, c.expectedEventType, event.Type())
+				assert.Equal(t, tEventSource, event.Source())
+				assert.Equal(t, c.expectedEventID, event.ID())
+				assert.Equal(t, c.expectedSubject, event.Subject())
+
+				correlationID, _ := event.Extensions()[correlationIDExtension].(string)
+				assert.Equal(t, c.expectedCorrelationID, correlationID)
+
+				assert.Contains(t, string(event.Data()), c.expectedData)
+
+			case <-time.After(1 * time.Second):
+				assert.Fail(t, "expected cloud event was not sent")
+			}
+		})
+	}
+}
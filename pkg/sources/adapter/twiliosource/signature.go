@@ -0,0 +1,59 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package twiliosource
+
+import (
+	"crypto/hmac"
+	"errors"
+	"net/http"
+
+	twilio "github.com/kevinburke/twilio-go"
+)
+
+const signatureHeader = "X-Twilio-Signature"
+
+// verifySignature checks that a request was sent by Twilio, as documented at
+// https://www.twilio.com/docs/usage/security#validating-requests
+// The form of the request must have been parsed.
+func verifySignature(req *http.Request, authToken string) error {
+	signature := req.Header.Get(signatureHeader)
+	if signature == "" {
+		return errors.New("missing " + signatureHeader + " header")
+	}
+
+	expected := twilio.GetExpectedTwilioSignature("", authToken, requestURL(req), req.PostForm)
+
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+// requestURL returns the URL Twilio sent the request to. TLS is usually
+// terminated by an ingress gateway, which reports the original scheme in
+// the X-Forwarded-Proto header.
+func requestURL(req *http.Request) string {
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	if proto := req.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+
+	return scheme + "://" + req.Host + req.URL.RequestURI()
+}
@@ -37,4 +37,18 @@ type Message struct {
 	FromCity      string `json:"from_city"`
 	To            string `json:"to"`
 	ToState       string `json:"to_state"`
+
+	MediaURLs []string `json:"media_urls,omitempty"`
+}
+
+// MessageStatus represents a status update of a message sent via Twilio.
+type MessageStatus struct {
+	MessageSid          string `json:"message_sid"`
+	MessageStatus       string `json:"message_status"`
+	ErrorCode           string `json:"error_code,omitempty"`
+	AccountSid          string `json:"account_sid"`
+	MessagingServiceSid string `json:"messaging_service_sid,omitempty"`
+	From                string `json:"from"`
+	To                  string `json:"to"`
+	APIVersion          string `json:"api_version"`
 }
@@ -17,6 +17,9 @@ limitations under the License.
 package awssqssource
 
 import (
+	"strconv"
+	"time"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
@@ -30,7 +33,12 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler"
 )
 
-const envMessageProcessor = "SQS_MESSAGE_PROCESSOR"
+const (
+	envMessageProcessor  = "SQS_MESSAGE_PROCESSOR"
+	envVisibilityTimeout = "SQS_VISIBILITY_TIMEOUT"
+	envRetryBackoff      = "SQS_RETRY_BACKOFF"
+	envMaxReceiveCount   = "SQS_MAX_RECEIVE_COUNT"
+)
 
 const healthPortName = "health"
 
@@ -52,6 +60,7 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 
 	var optEnvs []corev1.EnvVar
 	optEnvs = maybeSetMessageProcessor(optEnvs, typedSrc)
+	optEnvs = maybeSetReceiveOptions(optEnvs, typedSrc)
 
 	return common.NewAdapterDeployment(src, sinkURI,
 		resource.Image(r.adapterCfg.Image),
@@ -71,7 +80,7 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 // maybeSetMessageProcessor conditionally sets the envMessageProcessor
 // environment variable.
 func maybeSetMessageProcessor(envs []corev1.EnvVar, src *v1alpha1.AWSSQSSource) []corev1.EnvVar {
-	if mp := src.Spec.MessageProcessor; mp != nil && *mp == "s3" {
+	if mp := src.Spec.MessageProcessor; mp != nil && *mp != "default" {
 		envs = append(envs, corev1.EnvVar{
 			Name:  envMessageProcessor,
 			Value: *mp,
@@ -80,3 +89,35 @@ func maybeSetMessageProcessor(envs []corev1.EnvVar, src *v1alpha1.AWSSQSSource)
 
 	return envs
 }
+
+// maybeSetReceiveOptions conditionally sets environment variables which
+// control the behavior of message receivers.
+func maybeSetReceiveOptions(envs []corev1.EnvVar, src *v1alpha1.AWSSQSSource) []corev1.EnvVar {
+	ro := src.Spec.ReceiveOptions
+	if ro == nil {
+		return envs
+	}
+
+	if vt := ro.VisibilityTimeout; vt != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envVisibilityTimeout,
+			Value: time.Duration(*vt).String(),
+		})
+	}
+
+	if rb := ro.RetryBackoff; rb != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envRetryBackoff,
+			Value: time.Duration(*rb).String(),
+		})
+	}
+
+	if mrc := ro.MaxReceiveCount; mrc != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envMaxReceiveCount,
+			Value: strconv.Itoa(*mrc),
+		})
+	}
+
+	return envs
+}
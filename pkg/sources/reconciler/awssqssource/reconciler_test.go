@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -39,6 +40,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sqs"
 
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	fakeinjectionclient "github.com/triggermesh/triggermesh/pkg/client/generated/injection/client/fake"
@@ -85,6 +87,11 @@ func newEventSource() *v1alpha1.AWSSQSSource {
 	src := &v1alpha1.AWSSQSSource{
 		Spec: v1alpha1.AWSSQSSourceSpec{
 			ARN: NewARN(sqs.ServiceName, "triggermeshtest"),
+			ReceiveOptions: &v1alpha1.AWSSQSSourceReceiveOptions{
+				VisibilityTimeout: durationPtr(2 * time.Minute),
+				RetryBackoff:      durationPtr(10 * time.Second),
+				MaxReceiveCount:   intPtr(5),
+			},
 		},
 	}
 
@@ -289,3 +296,14 @@ func updateAdapterEvent(name string) string {
 	return eventtesting.Eventf(corev1.EventTypeNormal, common.ReasonAdapterUpdate,
 		"Updated adapter Deployment %q", name)
 }
+
+/* Helpers */
+
+func durationPtr(d time.Duration) *tmapis.Duration {
+	ad := tmapis.Duration(d)
+	return &ad
+}
+
+func intPtr(i int) *int {
+	return &i
+}
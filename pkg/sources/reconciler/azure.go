@@ -0,0 +1,67 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/reconciler"
+)
+
+// MakeAzureServiceBusReceiveOptionsEnvVars returns environment variables for
+// the given Azure Service Bus receive options.
+func MakeAzureServiceBusReceiveOptionsEnvVars(opts *v1alpha1.AzureServiceBusReceiveOptions) []corev1.EnvVar {
+	if opts == nil {
+		return nil
+	}
+
+	var optsEnvVars []corev1.EnvVar
+
+	if mc := opts.MaxConcurrency; mc != nil {
+		optsEnvVars = append(optsEnvVars, corev1.EnvVar{
+			Name:  reconciler.EnvServiceBusMaxConcurrency,
+			Value: strconv.Itoa(*mc),
+		})
+	}
+
+	if rb := opts.RetryBackoff; rb != nil {
+		optsEnvVars = append(optsEnvVars, corev1.EnvVar{
+			Name:  reconciler.EnvServiceBusRetryBackoff,
+			Value: time.Duration(*rb).String(),
+		})
+	}
+
+	if mda := opts.MaxDeliveryAttempts; mda != nil {
+		optsEnvVars = append(optsEnvVars, corev1.EnvVar{
+			Name:  reconciler.EnvServiceBusMaxDeliveries,
+			Value: strconv.Itoa(*mda),
+		})
+	}
+
+	if s := opts.Sessions; s != nil && *s {
+		optsEnvVars = append(optsEnvVars, corev1.EnvVar{
+			Name:  reconciler.EnvServiceBusSessions,
+			Value: strconv.FormatBool(*s),
+		})
+	}
+
+	return optsEnvVars
+}
@@ -31,6 +31,14 @@ import (
 
 const healthPortName = "health"
 
+const (
+	envHubConsumerGroup        = "EVENTHUB_CONSUMER_GROUP"
+	envHubStartPosition        = "EVENTHUB_START_POSITION"
+	envCheckpointBlobConnStr   = "EVENTHUB_CHECKPOINT_BLOB_CONNECTION_STRING"
+	envCheckpointBlobContainer = "EVENTHUB_CHECKPOINT_BLOB_CONTAINER"
+	envCheckpointConfigMap     = "EVENTHUB_CHECKPOINT_CONFIGMAP"
+)
+
 // adapterConfig contains properties used to configure the source's adapter.
 // These are automatically populated by envconfig.
 type adapterConfig struct {
@@ -59,6 +67,35 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 		hubEnvs = common.MaybeAppendValueFromEnvVar(hubEnvs, common.EnvAADClientSecret, spAuth.ClientSecret)
 	}
 
+	if cg := typedSrc.Spec.ConsumerGroup; cg != nil {
+		hubEnvs = append(hubEnvs, corev1.EnvVar{
+			Name:  envHubConsumerGroup,
+			Value: *cg,
+		})
+	}
+	if sp := typedSrc.Spec.StartPosition; sp != nil {
+		hubEnvs = append(hubEnvs, corev1.EnvVar{
+			Name:  envHubStartPosition,
+			Value: string(*sp),
+		})
+	}
+
+	if cs := typedSrc.Spec.CheckpointStore; cs != nil {
+		if blob := cs.Blob; blob != nil {
+			hubEnvs = common.MaybeAppendValueFromEnvVar(hubEnvs, envCheckpointBlobConnStr, blob.ConnectionString)
+			hubEnvs = append(hubEnvs, corev1.EnvVar{
+				Name:  envCheckpointBlobContainer,
+				Value: blob.Container,
+			})
+		}
+		if cm := cs.ConfigMap; cm != nil {
+			hubEnvs = append(hubEnvs, corev1.EnvVar{
+				Name:  envCheckpointConfigMap,
+				Value: cm.Name,
+			})
+		}
+	}
+
 	return common.NewAdapterDeployment(src, sinkURI,
 		resource.Image(r.adapterCfg.Image),
 
@@ -27,6 +27,7 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	common "github.com/triggermesh/triggermesh/pkg/reconciler"
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
+	"github.com/triggermesh/triggermesh/pkg/sources/reconciler"
 )
 
 // adapterConfig contains properties used to configure the source's adapter.
@@ -63,6 +64,7 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 
 		resource.EnvVar(common.EnvServiceBusEntityResourceID, subsID),
 		resource.EnvVars(authEnvs...),
+		resource.EnvVars(reconciler.MakeAzureServiceBusReceiveOptionsEnvVars(typedSrc.Spec.ReceiveOptions)...),
 		resource.EnvVars(r.adapterCfg.configs.ToEnvVars()...),
 	), nil
 }
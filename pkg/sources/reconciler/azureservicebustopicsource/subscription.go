@@ -79,8 +79,14 @@ func ensureSubscription(ctx context.Context, cli servicebustopics.SubscriptionsC
 	}
 
 	if subsExists := currentSubs.ID != nil; !subsExists {
-		// use Azure's defaults
+		// use Azure's defaults, except for sessions which can only be
+		// enabled at creation time
 		desiredSubs := servicebus.SBSubscription{}
+		if ro := typedSrc.Spec.ReceiveOptions; ro != nil && ro.Sessions != nil && *ro.Sessions {
+			desiredSubs.SBSubscriptionProperties = &servicebus.SBSubscriptionProperties{
+				RequiresSession: ro.Sessions,
+			}
+		}
 
 		restCtx, cancel = context.WithTimeout(ctx, crudTimeout)
 		defer cancel()
@@ -40,9 +40,31 @@ import (
 const (
 	envCloudEventsPath                 = "CLOUDEVENTS_PATH"
 	envCloudEventsBasicAuthCredentials = "CLOUDEVENTS_BASICAUTH_CREDENTIALS"
+	envCloudEventsAPIKeyCredentials    = "CLOUDEVENTS_APIKEY_CREDENTIALS"
+	envCloudEventsJWKS                 = "CLOUDEVENTS_JWKS"
+	envCloudEventsJWKSFile             = "CLOUDEVENTS_JWKS_FILE"
+	envCloudEventsJWKSURL              = "CLOUDEVENTS_JWKS_URL"
+	envCloudEventsJWTIssuer            = "CLOUDEVENTS_JWT_ISSUER"
+	envCloudEventsJWTAudience          = "CLOUDEVENTS_JWT_AUDIENCE"
+	envCloudEventsJWTCallerClaim       = "CLOUDEVENTS_JWT_CALLER_CLAIM"
+	envCloudEventsCallerPolicies       = "CLOUDEVENTS_CALLER_POLICIES"
 	envCloudEventsRateLimiterRPS       = "CLOUDEVENTS_RATELIMITER_RPS"
 )
 
+// Prefixes of the caller identifiers used as keys of the caller policies,
+// by authentication scheme.
+const (
+	CallerPrefixBasicAuth = "basicauth/"
+	CallerPrefixAPIKey    = "apikey/"
+	CallerPrefixToken     = "token/"
+)
+
+// Parameters of the files in which secrets are mounted.
+const (
+	secretBasePath = "/opt"
+	secretFileName = "cesource"
+)
+
 // adapterConfig contains properties used to configure the source's adapter.
 // These are automatically populated by envconfig.
 type adapterConfig struct {
@@ -63,47 +85,98 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 	var authVolumeMounts []corev1.VolumeMount
 	var authEnvs []corev1.EnvVar
 
-	if typedSrc.Spec.Credentials != nil {
+	if creds := typedSrc.Spec.Credentials; creds != nil {
 		// For each BasicAuth credentials a secret is mounted and a tuple
 		// key/mounted-file pair is added to the environment variable.
-		kvs := []KeyMountedValue{}
+		// The same applies to API keys.
+		basicAuthSecrets := make([]keySecretRef, 0, len(creds.BasicAuths))
+		for _, ba := range creds.BasicAuths {
+			basicAuthSecrets = append(basicAuthSecrets, keySecretRef{key: ba.Username, ref: ba.Password.ValueFromSecret})
+		}
 
-		const (
-			secretArrayNamePrefix = "basicauths"
-			secretBasePath        = "/opt"
-			secretFileName        = "cesource"
-		)
+		apiKeySecrets := make([]keySecretRef, 0, len(creds.APIKeys))
+		for _, k := range creds.APIKeys {
+			apiKeySecrets = append(apiKeySecrets, keySecretRef{key: k.Name, ref: k.Key.ValueFromSecret})
+		}
+
+		for _, sec := range []struct {
+			namePrefix string
+			envName    string
+			refs       []keySecretRef
+		}{
+			{namePrefix: "basicauths", envName: envCloudEventsBasicAuthCredentials, refs: basicAuthSecrets},
+			{namePrefix: "apikeys", envName: envCloudEventsAPIKeyCredentials, refs: apiKeySecrets},
+		} {
+			vs, vms, kvs := mountKeySecrets(sec.namePrefix, sec.refs)
+			authVolumes = append(authVolumes, vs...)
+			authVolumeMounts = append(authVolumeMounts, vms...)
+
+			if len(kvs) > 0 {
+				s, err := json.Marshal(kvs)
+				if err != nil {
+					return nil, fmt.Errorf("serializing keyMountedValues to JSON: %w", err)
+				}
+
+				authEnvs = append(authEnvs, corev1.EnvVar{
+					Name:  sec.envName,
+					Value: string(s),
+				})
+			}
+		}
 
-		for i, ba := range typedSrc.Spec.Credentials.BasicAuths {
-			if ba.Password.ValueFromSecret != nil {
-				secretName := fmt.Sprintf("%s%d", secretArrayNamePrefix, i)
+		if t := creds.Tokens; t != nil {
+			switch {
+			case t.JWKS != nil && t.JWKS.ValueFromSecret != nil:
+				const secretName = "jwks"
 				secretPath := filepath.Join(secretBasePath, secretName)
 
 				v, vm := secretVolumeAndMountAtPath(
 					secretName,
 					secretPath,
 					secretFileName,
-					ba.Password.ValueFromSecret.Name,
-					ba.Password.ValueFromSecret.Key,
+					t.JWKS.ValueFromSecret.Name,
+					t.JWKS.ValueFromSecret.Key,
 				)
 				authVolumes = append(authVolumes, v)
 				authVolumeMounts = append(authVolumeMounts, vm)
 
-				kvs = append(kvs, KeyMountedValue{
-					Key:              ba.Username,
-					MountedValueFile: path.Join(secretPath, secretFileName),
+				authEnvs = append(authEnvs, corev1.EnvVar{
+					Name:  envCloudEventsJWKSFile,
+					Value: path.Join(secretPath, secretFileName),
+				})
+
+			case t.JWKS != nil:
+				authEnvs = append(authEnvs, corev1.EnvVar{
+					Name:  envCloudEventsJWKS,
+					Value: t.JWKS.Value,
+				})
+
+			case t.JWKSURL != nil:
+				authEnvs = append(authEnvs, corev1.EnvVar{
+					Name:  envCloudEventsJWKSURL,
+					Value: t.JWKSURL.String(),
 				})
 			}
+
+			if t.Issuer != nil {
+				authEnvs = append(authEnvs, corev1.EnvVar{Name: envCloudEventsJWTIssuer, Value: *t.Issuer})
+			}
+			if t.Audience != nil {
+				authEnvs = append(authEnvs, corev1.EnvVar{Name: envCloudEventsJWTAudience, Value: *t.Audience})
+			}
+			if t.CallerClaim != nil {
+				authEnvs = append(authEnvs, corev1.EnvVar{Name: envCloudEventsJWTCallerClaim, Value: *t.CallerClaim})
+			}
 		}
 
-		if len(kvs) > 0 {
-			s, err := json.Marshal(kvs)
+		if policies := callerPolicies(creds); len(policies) > 0 {
+			s, err := json.Marshal(policies)
 			if err != nil {
-				return nil, fmt.Errorf("serializing keyMountedValues to JSON: %w", err)
+				return nil, fmt.Errorf("serializing caller policies to JSON: %w", err)
 			}
 
 			authEnvs = append(authEnvs, corev1.EnvVar{
-				Name:  envCloudEventsBasicAuthCredentials,
+				Name:  envCloudEventsCallerPolicies,
 				Value: string(s),
 			})
 		}
@@ -138,6 +211,71 @@ func (kmv *KeyMountedValue) Decode(value string) error {
 	return nil
 }
 
+// keySecretRef associates a key with a reference to a secret.
+type keySecretRef struct {
+	key string
+	ref *corev1.SecretKeySelector
+}
+
+// mountKeySecrets returns the volumes and volume mounts for the given secret
+// references, alongside the key/mounted-file pairs describing them.
+// References to literal values are ignored.
+func mountKeySecrets(namePrefix string, refs []keySecretRef) ([]corev1.Volume, []corev1.VolumeMount, []KeyMountedValue) {
+	var vs []corev1.Volume
+	var vms []corev1.VolumeMount
+	var kvs []KeyMountedValue
+
+	for i, r := range refs {
+		if r.ref == nil {
+			continue
+		}
+
+		secretName := fmt.Sprintf("%s%d", namePrefix, i)
+		secretPath := filepath.Join(secretBasePath, secretName)
+
+		v, vm := secretVolumeAndMountAtPath(
+			secretName,
+			secretPath,
+			secretFileName,
+			r.ref.Name,
+			r.ref.Key,
+		)
+		vs = append(vs, v)
+		vms = append(vms, vm)
+
+		kvs = append(kvs, KeyMountedValue{
+			Key:              r.key,
+			MountedValueFile: path.Join(secretPath, secretFileName),
+		})
+	}
+
+	return vs, vms, kvs
+}
+
+// callerPolicies returns the policies defined for callers in the given
+// credentials, keyed by caller identifier.
+func callerPolicies(creds *v1alpha1.HTTPCredentials) map[string]v1alpha1.CallerPolicy {
+	policies := make(map[string]v1alpha1.CallerPolicy)
+
+	for _, ba := range creds.BasicAuths {
+		if ba.Policy != nil {
+			policies[CallerPrefixBasicAuth+ba.Username] = *ba.Policy
+		}
+	}
+	for _, k := range creds.APIKeys {
+		if k.Policy != nil {
+			policies[CallerPrefixAPIKey+k.Name] = *k.Policy
+		}
+	}
+	if creds.Tokens != nil {
+		for _, c := range creds.Tokens.Callers {
+			policies[CallerPrefixToken+c.Name] = c.Policy
+		}
+	}
+
+	return policies
+}
+
 // makeAppEnv creates the environment variables specific to this adapter component.
 func makeAppEnv(o *v1alpha1.CloudEventsSource) []corev1.EnvVar {
 	envs := []corev1.EnvVar{
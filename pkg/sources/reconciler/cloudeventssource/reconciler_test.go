@@ -69,6 +69,7 @@ func reconcilerCtor(cfg *adapterConfig) Ctor {
 // newEventSource returns a test source object with a minimal set of pre-filled attributes.
 func newEventSource() *v1alpha1.CloudEventsSource {
 	path := "testpath"
+	issuer := "https://idp.example.com"
 
 	src := &v1alpha1.CloudEventsSource{
 		Spec: v1alpha1.CloudEventsSourceSpec{
@@ -83,6 +84,30 @@ func newEventSource() *v1alpha1.CloudEventsSource {
 						},
 					},
 				},
+				APIKeys: []v1alpha1.HTTPAPIKey{
+					{
+						Name: "partner",
+						Key: commonv1alpha1.ValueFromField{
+							ValueFromSecret: &corev1.SecretKeySelector{
+								Key: "key2",
+							},
+						},
+						Policy: &v1alpha1.CallerPolicy{
+							RateLimiter: &v1alpha1.RateLimiter{
+								RequestsPerSecond: 10,
+							},
+							AllowedTypes: []string{"com.partner.*"},
+						},
+					},
+				},
+				Tokens: &v1alpha1.HTTPTokenAuth{
+					JWKS: &commonv1alpha1.ValueFromField{
+						ValueFromSecret: &corev1.SecretKeySelector{
+							Key: "jwks",
+						},
+					},
+					Issuer: &issuer,
+				},
 			},
 			Path: &path,
 			RateLimiter: &v1alpha1.RateLimiter{
@@ -0,0 +1,87 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jirasource
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/eventing/pkg/reconciler/source"
+	"knative.dev/pkg/apis"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	common "github.com/triggermesh/triggermesh/pkg/reconciler"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
+)
+
+const (
+	envJiraEventSource   = "JIRA_EVENT_SOURCE"
+	envJiraWebhookSecret = "JIRA_WEBHOOK_SECRET"
+	envJiraEvents        = "JIRA_EVENTS"
+)
+
+// adapterConfig contains properties used to configure the source's adapter.
+// These are automatically populated by envconfig.
+type adapterConfig struct {
+	// Container image
+	Image string `default:"gcr.io/triggermesh/jirasource-adapter"`
+
+	// Configuration accessor for logging/metrics/tracing
+	configs source.ConfigAccessor
+}
+
+// Verify that Reconciler implements common.AdapterBuilder.
+var _ common.AdapterBuilder[*servingv1.Service] = (*Reconciler)(nil)
+
+// BuildAdapter implements common.AdapterBuilder.
+func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis.URL) (*servingv1.Service, error) {
+	typedSrc := src.(*v1alpha1.JiraSource)
+
+	return common.NewAdapterKnService(src, sinkURI,
+		resource.Image(r.adapterCfg.Image),
+
+		resource.VisibilityPublic,
+
+		resource.EnvVars(makeJiraEnvs(typedSrc)...),
+		resource.EnvVars(r.adapterCfg.configs.ToEnvVars()...),
+	), nil
+}
+
+func makeJiraEnvs(src *v1alpha1.JiraSource) []corev1.EnvVar {
+	envs := []corev1.EnvVar{{
+		Name:  envJiraEventSource,
+		Value: src.AsEventSource(),
+	}}
+
+	if events := src.Spec.Events; len(events) > 0 {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envJiraEvents,
+			Value: strings.Join(events, ","),
+		})
+	}
+
+	if secret := src.Spec.WebhookSecret; secret != nil {
+		envs = common.MaybeAppendValueFromEnvVar(envs,
+			envJiraWebhookSecret, *secret,
+		)
+	}
+
+	return envs
+}
@@ -17,7 +17,8 @@ limitations under the License.
 package salesforcesource
 
 import (
-	"strconv"
+	"encoding/json"
+	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -32,13 +33,13 @@ import (
 )
 
 const (
-	envSalesforceAuthClientID = "SALESFORCE_AUTH_CLIENT_ID"
-	envSalesforceAuthServer   = "SALESFORCE_AUTH_SERVER"
-	envSalesforceAuthUser     = "SALESFORCE_AUTH_USER"
-	envSalesforceAuthCertKey  = "SALESFORCE_AUTH_CERT_KEY"
-	envSalesforceAPIVersion   = "SALESFORCE_API_VERSION"
-	envSalesforceChannel      = "SALESFORCE_SUBCRIPTION_CHANNEL"
-	envSalesforceReplayID     = "SALESFORCE_SUBCRIPTION_REPLAY_ID"
+	envSalesforceAuthClientID  = "SALESFORCE_AUTH_CLIENT_ID"
+	envSalesforceAuthServer    = "SALESFORCE_AUTH_SERVER"
+	envSalesforceAuthUser      = "SALESFORCE_AUTH_USER"
+	envSalesforceAuthCertKey   = "SALESFORCE_AUTH_CERT_KEY"
+	envSalesforceAPIVersion    = "SALESFORCE_API_VERSION"
+	envSalesforceSubscriptions = "SALESFORCE_SUBSCRIPTIONS"
+	envSalesforceReplayStoreCM = "SALESFORCE_REPLAY_STORE_CONFIGMAP"
 )
 
 // adapterConfig contains properties used to configure the source's adapter.
@@ -70,20 +71,22 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 			Name:  envSalesforceAuthUser,
 			Value: typedSrc.Spec.Auth.User,
 		},
-		{
-			Name:  envSalesforceChannel,
-			Value: typedSrc.Spec.Subscription.Channel,
-		},
 	}
 
 	appEnv = common.MaybeAppendValueFromEnvVar(appEnv,
 		envSalesforceAuthCertKey, typedSrc.Spec.Auth.CertKey,
 	)
 
-	if typedSrc.Spec.Subscription.ReplayID != nil {
+	subsEnv, err := makeSubscriptionsEnvVar(typedSrc.Spec.GetSubscriptions())
+	if err != nil {
+		return nil, fmt.Errorf("serializing subscriptions: %w", err)
+	}
+	appEnv = append(appEnv, *subsEnv)
+
+	if rs := typedSrc.Spec.ReplayStore; rs != nil && rs.ConfigMap != nil {
 		appEnv = append(appEnv, corev1.EnvVar{
-			Name:  envSalesforceReplayID,
-			Value: strconv.Itoa(*typedSrc.Spec.Subscription.ReplayID),
+			Name:  envSalesforceReplayStoreCM,
+			Value: rs.ConfigMap.Name,
 		})
 	}
 
@@ -101,3 +104,41 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 		resource.EnvVars(r.adapterCfg.configs.ToEnvVars()...),
 	), nil
 }
+
+// subscription is the JSON representation of a channel subscription, as
+// expected by the adapter.
+type subscription struct {
+	Channel  string `json:"channel"`
+	ReplayID int    `json:"replayID"`
+}
+
+// defaultReplayID is the replay ID of subscriptions which don't specify one,
+// and causes only new events to be received.
+const defaultReplayID = -1
+
+// makeSubscriptionsEnvVar returns an environment variable containing the
+// given subscriptions in JSON format.
+func makeSubscriptionsEnvVar(subs []v1alpha1.SalesforceSubscription) (*corev1.EnvVar, error) {
+	adapterSubs := make([]subscription, 0, len(subs))
+	for _, s := range subs {
+		replayID := defaultReplayID
+		if s.ReplayID != nil {
+			replayID = *s.ReplayID
+		}
+
+		adapterSubs = append(adapterSubs, subscription{
+			Channel:  s.Channel,
+			ReplayID: replayID,
+		})
+	}
+
+	subsJSON, err := json.Marshal(adapterSubs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.EnvVar{
+		Name:  envSalesforceSubscriptions,
+		Value: string(subsJSON),
+	}, nil
+}
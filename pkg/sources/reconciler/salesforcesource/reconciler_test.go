@@ -49,6 +49,19 @@ func TestReconcileSource(t *testing.T) {
 	TestReconcileAdapter(t, ctor, src, ab)
 }
 
+func TestReconcileSourceWithSubscriptions(t *testing.T) {
+	adapterCfg := &adapterConfig{
+		Image:   "registry/image:tag",
+		configs: &source.EmptyVarsGenerator{},
+	}
+
+	ctor := reconcilerCtor(adapterCfg)
+	src := newEventSourceWithSubscriptions()
+	ab := adapterBuilder(adapterCfg)
+
+	TestReconcileAdapter(t, ctor, src, ab)
+}
+
 // reconcilerCtor returns a Ctor for a source Reconciler.
 func reconcilerCtor(cfg *adapterConfig) Ctor {
 	return func(t *testing.T, ctx context.Context, _ *rt.TableRow, ls *Listers) controller.Reconciler {
@@ -66,30 +79,17 @@ func reconcilerCtor(cfg *adapterConfig) Ctor {
 	}
 }
 
-// newEventSource returns a populated source object.
+// newEventSource returns a populated source object which subscribes to a
+// single channel using the legacy "subscription" attribute.
 func newEventSource() *v1alpha1.SalesforceSource {
 	replayID := -1
 
 	src := &v1alpha1.SalesforceSource{
-		Spec: v1alpha1.SalesforceSourceSpec{
-			Auth: v1alpha1.SalesforceAuth{
-				ClientID: "Cl13nt.X_X.1D",
-				Server:   "https://login.salesforce.com",
-				User:     "janedoe@example.com",
-				CertKey: commonv1alpha1.ValueFromField{
-					ValueFromSecret: &corev1.SecretKeySelector{
-						LocalObjectReference: corev1.LocalObjectReference{
-							Name: "test-secret",
-						},
-						Key: "secret",
-					},
-				},
-			},
-			Subscription: v1alpha1.SalesforceSubscription{
-				Channel:  "/data/mychannel",
-				ReplayID: &replayID,
-			},
-		},
+		Spec: newEventSourceSpec(),
+	}
+	src.Spec.Subscription = &v1alpha1.SalesforceSubscription{
+		Channel:  "/data/mychannel",
+		ReplayID: &replayID,
 	}
 
 	Populate(src)
@@ -97,6 +97,45 @@ func newEventSource() *v1alpha1.SalesforceSource {
 	return src
 }
 
+// newEventSourceWithSubscriptions returns a populated source object which
+// subscribes to multiple channels.
+func newEventSourceWithSubscriptions() *v1alpha1.SalesforceSource {
+	replayID := -2
+
+	src := &v1alpha1.SalesforceSource{
+		Spec: newEventSourceSpec(),
+	}
+	src.Spec.Subscriptions = []v1alpha1.SalesforceSubscription{{
+		Channel: "/data/mychannel",
+	}, {
+		Channel:  "/event/My_Event__e",
+		ReplayID: &replayID,
+	}}
+
+	Populate(src)
+
+	return src
+}
+
+// newEventSourceSpec returns a source spec without subscription.
+func newEventSourceSpec() v1alpha1.SalesforceSourceSpec {
+	return v1alpha1.SalesforceSourceSpec{
+		Auth: v1alpha1.SalesforceAuth{
+			ClientID: "Cl13nt.X_X.1D",
+			Server:   "https://login.salesforce.com",
+			User:     "janedoe@example.com",
+			CertKey: commonv1alpha1.ValueFromField{
+				ValueFromSecret: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: "test-secret",
+					},
+					Key: "secret",
+				},
+			},
+		},
+	}
+}
+
 // adapterBuilder returns a slim Reconciler containing only the fields accessed
 // by r.BuildAdapter().
 func adapterBuilder(cfg *adapterConfig) common.AdapterBuilder[*appsv1.Deployment] {
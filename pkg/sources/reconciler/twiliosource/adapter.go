@@ -17,15 +17,20 @@ limitations under the License.
 package twiliosource
 
 import (
+	corev1 "k8s.io/api/core/v1"
+
 	"knative.dev/eventing/pkg/reconciler/source"
 	"knative.dev/pkg/apis"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	common "github.com/triggermesh/triggermesh/pkg/reconciler"
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
+const envTwilioAuthToken = "TWILIO_AUTH_TOKEN"
+
 // adapterConfig contains properties used to configure the adapter.
 // These are automatically populated by envconfig.
 type adapterConfig struct {
@@ -40,11 +45,26 @@ var _ common.AdapterBuilder[*servingv1.Service] = (*Reconciler)(nil)
 
 // BuildAdapter implements common.AdapterBuilder.
 func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis.URL) (*servingv1.Service, error) {
+	typedSrc := src.(*v1alpha1.TwilioSource)
+
 	return common.NewAdapterKnService(src, sinkURI,
 		resource.Image(r.adapterCfg.Image),
 
 		resource.VisibilityPublic,
 
+		resource.EnvVars(makeTwilioEnvs(typedSrc)...),
 		resource.EnvVars(r.adapterCfg.configs.ToEnvVars()...),
 	), nil
 }
+
+func makeTwilioEnvs(src *v1alpha1.TwilioSource) []corev1.EnvVar {
+	var envs []corev1.EnvVar
+
+	if token := src.Spec.AuthToken; token != nil {
+		envs = common.MaybeAppendValueFromEnvVar(envs,
+			envTwilioAuthToken, *token,
+		)
+	}
+
+	return envs
+}
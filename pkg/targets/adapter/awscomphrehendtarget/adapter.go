@@ -31,6 +31,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/comprehend"
 	"github.com/aws/aws-sdk-go/service/comprehend/comprehendiface"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsauth"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
@@ -61,7 +62,8 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 	}
 
 	return &comprehendAdapter{
-		config: env.GetAwsConfig(env.Region),
+		config: aws.NewConfig().WithRegion(env.Region),
+		auth:   env.Config,
 
 		language: env.Language,
 		ceClient: ceClient,
@@ -80,6 +82,7 @@ type comprehendAdapter struct {
 
 	replier  *targetce.Replier
 	config   *aws.Config
+	auth     awsauth.Config
 	session  *session.Session
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
@@ -90,7 +93,7 @@ type comprehendAdapter struct {
 // Start implements pkgadapter.Adapter.
 func (a *comprehendAdapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting The AWS Comprehend Target Adapter")
-	s := session.Must(session.NewSession(a.config))
+	s := session.Must(a.auth.NewSession(a.config))
 	a.session = s
 	a.comprehend = comprehend.New(s)
 	if err := a.ceClient.StartReceiver(ctx, a.dispatch); err != nil {
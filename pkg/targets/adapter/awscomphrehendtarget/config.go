@@ -17,9 +17,9 @@ limitations under the License.
 package awscomphrehendtarget
 
 import (
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/credentials"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsauth"
 )
 
 // EnvAccessorCtor for configuration parameters
@@ -29,10 +29,7 @@ func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
 
 type envAccessor struct {
 	pkgadapter.EnvConfig
-
-	AWSApiKey string `envconfig:"AWS_ACCESS_KEY_ID" required:"true"`
-
-	AWSApiSecret string `envconfig:"AWS_SECRET_ACCESS_KEY" required:"true"`
+	awsauth.Config
 
 	Region string `envconfig:"COMPREHEND_REGION" required:"true"`
 
@@ -44,12 +41,3 @@ type envAccessor struct {
 	// BridgeIdentifier is the name of the bridge workflow this target is part of
 	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
 }
-
-func (e *envAccessor) GetAwsConfig(region string) *aws.Config {
-	creds := credentials.NewStaticCredentials(e.AWSApiKey, e.AWSApiSecret, "")
-	config := aws.NewConfig()
-	config.WithRegion(region)
-	config.WithCredentials(creds)
-
-	return config
-}
@@ -28,6 +28,7 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
@@ -54,8 +55,8 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 	a := MustParseARN(env.AwsTargetArn)
 
-	session := session.Must(session.NewSession(
-		env.GetAwsConfig().
+	session := session.Must(env.NewSession(
+		aws.NewConfig().
 			WithRegion(a.Region).
 			WithMaxRetries(5)))
 
@@ -64,11 +65,17 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		dynamodbTable = MustParseDynamoDBResource(a.Resource)
 	}
 
+	ops, err := newOperations(env.Operations)
+	if err != nil {
+		logger.Panicf("Invalid operations: %v", err)
+	}
+
 	return &adapter{
 		awsArnString:         env.AwsTargetArn,
 		awsArn:               a,
 		awsDynamoDBTableName: dynamodbTable,
 		dynamoDBClient:       dynamodb.New(session),
+		operations:           ops,
 
 		discardCEContext: env.DiscardCEContext,
 		ceClient:         ceClient,
@@ -85,6 +92,8 @@ type adapter struct {
 	awsArn               arn.ARN
 	awsDynamoDBTableName string
 	dynamoDBClient       *dynamodb.DynamoDB
+	// operations by event type
+	operations map[string]*operation
 
 	discardCEContext bool
 	ceClient         cloudevents.Client
@@ -100,6 +109,10 @@ func (a *adapter) Start(ctx context.Context) error {
 
 // Parse and send the aws event
 func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	if op, ok := a.operations[event.Type()]; ok {
+		return a.performOperation(op, event)
+	}
+
 	var eventJSONMap map[string]interface{}
 
 	if a.discardCEContext {
@@ -131,18 +144,27 @@ func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudev
 		return a.reportError("Error invoking DynamoDB", err)
 	}
 
+	return a.respond(v1alpha1.EventTypeAWSDynamoDBResult, resp)
+}
+
+// respond returns a response event of the given type containing the given data.
+func (a *adapter) respond(typ string, data interface{}) (*cloudevents.Event, cloudevents.Result) {
 	responseEvent := cloudevents.NewEvent(cloudevents.VersionV1)
-	err = responseEvent.SetData(cloudevents.ApplicationJSON, resp)
+	err := responseEvent.SetData(cloudevents.ApplicationJSON, data)
 	if err != nil {
 		return a.reportError("error generating response event", err)
 	}
 
-	responseEvent.SetType(v1alpha1.EventTypeAWSDynamoDBResult)
+	responseEvent.SetType(typ)
 	responseEvent.SetSource(a.awsArnString)
 	return &responseEvent, cloudevents.ResultACK
 }
 
 func (a *adapter) reportError(msg string, err error) (*cloudevents.Event, cloudevents.Result) {
+	return a.reportErrorWithStatus(http.StatusInternalServerError, msg, err)
+}
+
+func (a *adapter) reportErrorWithStatus(status int, msg string, err error) (*cloudevents.Event, cloudevents.Result) {
 	a.logger.Errorw(msg, zap.Error(err))
-	return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, msg)
+	return nil, cloudevents.NewHTTPResult(status, msg)
 }
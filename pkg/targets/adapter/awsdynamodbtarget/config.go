@@ -17,10 +17,12 @@ limitations under the License.
 package awsdynamodbtarget
 
 import (
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/credentials"
+	"encoding/json"
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsauth"
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 )
 
 // NewEnvConfig for configuration parameters
@@ -30,19 +32,19 @@ func NewEnvConfig() pkgadapter.EnvConfigAccessor {
 
 type envAccessor struct {
 	pkgadapter.EnvConfig
+	awsauth.Config
 
-	AWSApiKey    string `envconfig:"AWS_ACCESS_KEY_ID" required:"true"`
-	AWSApiSecret string `envconfig:"AWS_SECRET_ACCESS_KEY" required:"true"`
 	AwsTargetArn string `envconfig:"ARN" required:"true"`
 
 	DiscardCEContext bool `envconfig:"AWS_DISCARD_CE_CONTEXT"`
-}
 
-func (e *envAccessor) GetAwsConfig() *aws.Config {
-	creds := credentials.NewStaticCredentials(e.AWSApiKey, e.AWSApiSecret, "")
-	config := aws.NewConfig()
+	Operations operations `envconfig:"AWS_DYNAMODB_OPERATIONS"`
+}
 
-	config.WithCredentials(creds)
+// operations is a list of DynamoDB operations.
+type operations []v1alpha1.AWSDynamoDBOperation
 
-	return config
+// Decode implements envconfig.Decoder.
+func (o *operations) Decode(value string) error {
+	return json.Unmarshal([]byte(value), o)
 }
@@ -0,0 +1,369 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsdynamodbtarget
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/eventvalue"
+)
+
+// Prefixes of the expression placeholders generated by the adapter.
+const (
+	namePlaceholderPrefix  = "#tmn"
+	valuePlaceholderPrefix = ":tmv"
+)
+
+// operation is a DynamoDB operation performed for events of a given type.
+type operation struct {
+	v1alpha1.AWSDynamoDBOperation
+
+	key             []attribute
+	conditionValues []attribute
+}
+
+// attribute associates an attribute name or expression placeholder with a
+// value read from events.
+type attribute struct {
+	name  string
+	value *eventvalue.Selector
+}
+
+// newOperations returns the operations described in the given specs, indexed
+// by event type.
+func newOperations(specs []v1alpha1.AWSDynamoDBOperation) (map[string]*operation, error) {
+	ops := make(map[string]*operation, len(specs))
+
+	for _, spec := range specs {
+		key, err := newAttributes(spec.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key of the operation for events of type %q: %w", spec.EventType, err)
+		}
+
+		condVals, err := newAttributes(spec.ConditionValues)
+		if err != nil {
+			return nil, fmt.Errorf("invalid condition values of the operation for events of type %q: %w", spec.EventType, err)
+		}
+
+		ops[spec.EventType] = &operation{
+			AWSDynamoDBOperation: spec,
+			key:                  key,
+			conditionValues:      condVals,
+		}
+	}
+
+	return ops, nil
+}
+
+// newAttributes returns the attributes described in the given specs.
+func newAttributes(specs []v1alpha1.AWSDynamoDBAttribute) ([]attribute, error) {
+	attrs := make([]attribute, 0, len(specs))
+
+	for _, spec := range specs {
+		sel, err := eventvalue.New(spec.ValueFrom.Attribute, spec.ValueFrom.JSONPath)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q: %w", spec.Name, err)
+		}
+		attrs = append(attrs, attribute{name: spec.Name, value: sel})
+	}
+
+	return attrs, nil
+}
+
+// attributeValues returns the DynamoDB values of the given attributes, read
+// from the event.
+func attributeValues(attrs []attribute, event *cloudevents.Event) (map[string]*dynamodb.AttributeValue, error) {
+	if len(attrs) == 0 {
+		return nil, nil
+	}
+
+	avs := make(map[string]*dynamodb.AttributeValue, len(attrs))
+
+	for _, attr := range attrs {
+		v, err := attr.value.Value(event)
+		if err != nil {
+			return nil, fmt.Errorf("reading value of %q: %w", attr.name, err)
+		}
+
+		av, err := marshalValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling value of %q: %w", attr.name, err)
+		}
+		avs[attr.name] = av
+	}
+
+	return avs, nil
+}
+
+// marshalValue converts a value read from an event to a DynamoDB attribute value.
+func marshalValue(v interface{}) (*dynamodb.AttributeValue, error) {
+	// json.Number is a string type that dynamodbattribute would encode as a
+	// string instead of a number.
+	if n, ok := v.(json.Number); ok {
+		return &dynamodb.AttributeValue{N: aws.String(n.String())}, nil
+	}
+	return dynamodbattribute.Marshal(v)
+}
+
+// performOperation performs the given operation on the item referenced by the event.
+func (a *adapter) performOperation(op *operation, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	key, err := attributeValues(op.key, &event)
+	if err != nil {
+		return a.reportErrorWithStatus(http.StatusBadRequest, "Error reading item key from event", err)
+	}
+
+	condVals, err := attributeValues(op.conditionValues, &event)
+	if err != nil {
+		return a.reportErrorWithStatus(http.StatusBadRequest, "Error reading condition values from event", err)
+	}
+
+	var resp interface{}
+
+	switch op.Operation {
+	case v1alpha1.AWSDynamoDBOperationGet:
+		out, err := a.dynamoDBClient.GetItem(&dynamodb.GetItemInput{
+			TableName: &a.awsDynamoDBTableName,
+			Key:       key,
+		})
+		if err != nil {
+			return a.reportAWSError("Error getting item from DynamoDB", err)
+		}
+		if out.Item == nil {
+			return a.reportErrorWithStatus(http.StatusNotFound, "Item not found", errors.New("no item matches the given key"))
+		}
+
+		var item map[string]interface{}
+		if err := dynamodbattribute.UnmarshalMap(out.Item, &item); err != nil {
+			return a.reportError("Error unmarshalling item", err)
+		}
+
+		return a.respond(v1alpha1.EventTypeAWSDynamoDBItem, item)
+
+	case v1alpha1.AWSDynamoDBOperationDelete:
+		resp, err = a.dynamoDBClient.DeleteItem(&dynamodb.DeleteItemInput{
+			TableName:                 &a.awsDynamoDBTableName,
+			Key:                       key,
+			ConditionExpression:       op.ConditionExpression,
+			ExpressionAttributeValues: condVals,
+		})
+
+	default:
+		var data map[string]interface{}
+		if err := event.DataAs(&data); err != nil {
+			return a.reportErrorWithStatus(http.StatusBadRequest, "Error deserializing event data to map", err)
+		}
+
+		switch op.Operation {
+		case v1alpha1.AWSDynamoDBOperationPut:
+			var item map[string]*dynamodb.AttributeValue
+			if item, err = dynamodbattribute.MarshalMap(data); err != nil {
+				return a.reportError("Error marshalling attribute", err)
+			}
+			for k, v := range key {
+				item[k] = v
+			}
+
+			resp, err = a.dynamoDBClient.PutItem(&dynamodb.PutItemInput{
+				TableName:                 &a.awsDynamoDBTableName,
+				Item:                      item,
+				ConditionExpression:       op.ConditionExpression,
+				ExpressionAttributeValues: condVals,
+			})
+
+		case v1alpha1.AWSDynamoDBOperationUpdate, v1alpha1.AWSDynamoDBOperationUpsert:
+			var in *dynamodb.UpdateItemInput
+			if in, err = updateItemInput(op, data, key, condVals); err != nil {
+				return a.reportErrorWithStatus(http.StatusBadRequest, "Error building update expression", err)
+			}
+			in.TableName = &a.awsDynamoDBTableName
+
+			resp, err = a.dynamoDBClient.UpdateItem(in)
+
+		default:
+			err = fmt.Errorf("unsupported operation %q", op.Operation)
+		}
+	}
+
+	if err != nil {
+		return a.reportAWSError("Error invoking DynamoDB", err)
+	}
+
+	return a.respond(v1alpha1.EventTypeAWSDynamoDBResult, resp)
+}
+
+// updateItemInput returns the input of an UpdateItem request which writes
+// the attributes of data selected by the operation to the item with the
+// given key.
+func updateItemInput(op *operation, data map[string]interface{}, key,
+	condVals map[string]*dynamodb.AttributeValue) (*dynamodb.UpdateItemInput, error) {
+
+	paths := op.UpdateAttributes
+	if len(paths) == 0 {
+		paths = make([]string, 0, len(data))
+		for k := range data {
+			if _, isKey := key[k]; !isKey {
+				paths = append(paths, k)
+			}
+		}
+		sort.Strings(paths)
+	}
+
+	b := newExpressionBuilder()
+
+	updExpr, err := b.update(data, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var condExpr string
+	if op.Operation == v1alpha1.AWSDynamoDBOperationUpdate {
+		// assert the existence of the item by checking its partition key
+		condExpr = "attribute_exists(" + b.name(op.key[0].name) + ")"
+	}
+	if op.ConditionExpression != nil {
+		if condExpr != "" {
+			condExpr = condExpr + " AND (" + *op.ConditionExpression + ")"
+		} else {
+			condExpr = *op.ConditionExpression
+		}
+	}
+
+	for k, v := range condVals {
+		b.values[k] = v
+	}
+
+	in := &dynamodb.UpdateItemInput{
+		Key:          key,
+		ReturnValues: aws.String(dynamodb.ReturnValueAllNew),
+	}
+	if updExpr != "" {
+		in.UpdateExpression = &updExpr
+	}
+	if condExpr != "" {
+		in.ConditionExpression = &condExpr
+	}
+	if len(b.names) > 0 {
+		in.ExpressionAttributeNames = b.names
+	}
+	if len(b.values) > 0 {
+		in.ExpressionAttributeValues = b.values
+	}
+
+	return in, nil
+}
+
+// expressionBuilder composes DynamoDB expressions using placeholders for
+// attribute names and values.
+type expressionBuilder struct {
+	names  map[string]*string
+	values map[string]*dynamodb.AttributeValue
+
+	// placeholders by attribute name
+	namePlaceholders map[string]string
+}
+
+func newExpressionBuilder() *expressionBuilder {
+	return &expressionBuilder{
+		names:            make(map[string]*string),
+		values:           make(map[string]*dynamodb.AttributeValue),
+		namePlaceholders: make(map[string]string),
+	}
+}
+
+// name returns the placeholder of the given attribute name.
+func (b *expressionBuilder) name(n string) string {
+	if p, ok := b.namePlaceholders[n]; ok {
+		return p
+	}
+
+	p := namePlaceholderPrefix + strconv.Itoa(len(b.namePlaceholders))
+	b.namePlaceholders[n] = p
+	b.names[p] = aws.String(n)
+	return p
+}
+
+// path returns the placeholder representation of the given document path.
+func (b *expressionBuilder) path(p string) string {
+	fields := strings.Split(p, ".")
+	for i, f := range fields {
+		fields[i] = b.name(f)
+	}
+	return strings.Join(fields, ".")
+}
+
+// value returns the placeholder of the given attribute value.
+func (b *expressionBuilder) value(av *dynamodb.AttributeValue) string {
+	p := valuePlaceholderPrefix + strconv.Itoa(len(b.values))
+	b.values[p] = av
+	return p
+}
+
+// update returns an update expression which sets the attributes at the given
+// paths to their value in data, and removes the ones that are absent from data.
+func (b *expressionBuilder) update(data map[string]interface{}, paths []string) (string, error) {
+	var set, remove []string
+
+	for _, p := range paths {
+		v, ok := eventvalue.Lookup(data, strings.Split(p, "."))
+		if !ok {
+			remove = append(remove, b.path(p))
+			continue
+		}
+
+		av, err := dynamodbattribute.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("marshaling value of %q: %w", p, err)
+		}
+		set = append(set, b.path(p)+" = "+b.value(av))
+	}
+
+	var clauses []string
+	if len(set) > 0 {
+		clauses = append(clauses, "SET "+strings.Join(set, ", "))
+	}
+	if len(remove) > 0 {
+		clauses = append(clauses, "REMOVE "+strings.Join(remove, ", "))
+	}
+
+	return strings.Join(clauses, " "), nil
+}
+
+// reportAWSError reports an error returned by the DynamoDB API. Failed
+// condition checks are reported as client errors, since retrying the
+// request would produce the same outcome.
+func (a *adapter) reportAWSError(msg string, err error) (*cloudevents.Event, cloudevents.Result) {
+	if aerr := awserr.Error(nil); errors.As(err, &aerr) &&
+		aerr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+
+		return a.reportErrorWithStatus(http.StatusPreconditionFailed, msg, err)
+	}
+	return a.reportError(msg, err)
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsdynamodbtarget
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+func TestUpdateItemInput(t *testing.T) {
+	data := map[string]interface{}{
+		"id":     "o-1",
+		"status": "shipped",
+		"shipping": map[string]interface{}{
+			"carrier": "acme",
+		},
+	}
+
+	key := map[string]*dynamodb.AttributeValue{
+		"id": {S: aws.String("o-1")},
+	}
+
+	testCases := map[string]struct {
+		op     v1alpha1.AWSDynamoDBOperation
+		expect *dynamodb.UpdateItemInput
+	}{
+		"upsert all attributes": {
+			op: v1alpha1.AWSDynamoDBOperation{
+				Operation: v1alpha1.AWSDynamoDBOperationUpsert,
+				Key:       []v1alpha1.AWSDynamoDBAttribute{{Name: "id"}},
+			},
+			expect: &dynamodb.UpdateItemInput{
+				Key:              key,
+				UpdateExpression: aws.String("SET #tmn0 = :tmv0, #tmn1 = :tmv1"),
+				ExpressionAttributeNames: map[string]*string{
+					"#tmn0": aws.String("shipping"),
+					"#tmn1": aws.String("status"),
+				},
+				ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+					":tmv0": {M: map[string]*dynamodb.AttributeValue{"carrier": {S: aws.String("acme")}}},
+					":tmv1": {S: aws.String("shipped")},
+				},
+				ReturnValues: aws.String(dynamodb.ReturnValueAllNew),
+			},
+		},
+		"update with mask and condition": {
+			op: v1alpha1.AWSDynamoDBOperation{
+				Operation:           v1alpha1.AWSDynamoDBOperationUpdate,
+				Key:                 []v1alpha1.AWSDynamoDBAttribute{{Name: "id"}},
+				UpdateAttributes:    []string{"shipping.carrier", "shipping.tracking"},
+				ConditionExpression: aws.String("version < :version"),
+			},
+			expect: &dynamodb.UpdateItemInput{
+				Key:                 key,
+				UpdateExpression:    aws.String("SET #tmn0.#tmn1 = :tmv0 REMOVE #tmn0.#tmn2"),
+				ConditionExpression: aws.String("attribute_exists(#tmn3) AND (version < :version)"),
+				ExpressionAttributeNames: map[string]*string{
+					"#tmn0": aws.String("shipping"),
+					"#tmn1": aws.String("carrier"),
+					"#tmn2": aws.String("tracking"),
+					"#tmn3": aws.String("id"),
+				},
+				ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+					":tmv0":    {S: aws.String("acme")},
+					":version": {N: aws.String("3")},
+				},
+				ReturnValues: aws.String(dynamodb.ReturnValueAllNew),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			ops, err := newOperations([]v1alpha1.AWSDynamoDBOperation{withSelectors(tc.op)})
+			require.NoError(t, err)
+
+			var condVals map[string]*dynamodb.AttributeValue
+			if tc.op.ConditionExpression != nil {
+				condVals = map[string]*dynamodb.AttributeValue{":version": {N: aws.String("3")}}
+			}
+
+			in, err := updateItemInput(ops[""], data, key, condVals)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, in)
+		})
+	}
+}
+
+func TestAttributeValues(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetSubject("o-1")
+	err := event.SetData(cloudevents.ApplicationJSON, json.RawMessage(`{"customer":{"id":42}}`))
+	require.NoError(t, err)
+
+	attrs, err := newAttributes([]v1alpha1.AWSDynamoDBAttribute{
+		{Name: "order", ValueFrom: v1alpha1.EventValueSelector{Attribute: aws.String("subject")}},
+		{Name: "customer", ValueFrom: v1alpha1.EventValueSelector{JSONPath: aws.String("$.customer.id")}},
+	})
+	require.NoError(t, err)
+
+	avs, err := attributeValues(attrs, &event)
+	require.NoError(t, err)
+
+	expect := map[string]*dynamodb.AttributeValue{
+		"order":    {S: aws.String("o-1")},
+		"customer": {N: aws.String("42")},
+	}
+	assert.Equal(t, expect, avs)
+
+	attrs, err = newAttributes([]v1alpha1.AWSDynamoDBAttribute{
+		{Name: "customer", ValueFrom: v1alpha1.EventValueSelector{JSONPath: aws.String("$.customer.name")}},
+	})
+	require.NoError(t, err)
+
+	_, err = attributeValues(attrs, &event)
+	assert.EqualError(t, err, `reading value of "customer": no value found at "$.customer.name" in the event data`)
+}
+
+// withSelectors sets a selector on all key attributes of the given operation.
+func withSelectors(op v1alpha1.AWSDynamoDBOperation) v1alpha1.AWSDynamoDBOperation {
+	for i := range op.Key {
+		op.Key[i].ValueFrom.Attribute = aws.String("subject")
+	}
+	return op
+}
@@ -53,8 +53,8 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 	a := MustParseARN(env.AwsTargetArn)
 
-	eventBridgeSession := session.Must(session.NewSession(
-		env.GetAwsConfig().
+	eventBridgeSession := session.Must(env.NewSession(
+		aws.NewConfig().
 			WithRegion(a.Region).
 			WithMaxRetries(5)))
 
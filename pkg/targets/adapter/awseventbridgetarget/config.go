@@ -17,10 +17,9 @@ limitations under the License.
 package awseventbridgetarget
 
 import (
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/credentials"
-
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsauth"
 )
 
 // NewEnvConfig for configuration parameters
@@ -30,19 +29,9 @@ func NewEnvConfig() pkgadapter.EnvConfigAccessor {
 
 type envAccessor struct {
 	pkgadapter.EnvConfig
+	awsauth.Config
 
-	AWSApiKey    string `envconfig:"AWS_ACCESS_KEY_ID" required:"true"`
-	AWSApiSecret string `envconfig:"AWS_SECRET_ACCESS_KEY" required:"true"`
 	AwsTargetArn string `envconfig:"ARN" required:"true"`
 
 	DiscardCEContext bool `envconfig:"AWS_DISCARD_CE_CONTEXT"`
 }
-
-func (e *envAccessor) GetAwsConfig() *aws.Config {
-	creds := credentials.NewStaticCredentials(e.AWSApiKey, e.AWSApiSecret, "")
-	config := aws.NewConfig()
-
-	config.WithCredentials(creds)
-
-	return config
-}
@@ -29,6 +29,7 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/kinesis"
@@ -54,8 +55,8 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 	a := MustParseARN(env.AwsTargetArn)
 
-	session := session.Must(session.NewSession(
-		env.GetAwsConfig().
+	session := session.Must(env.NewSession(
+		aws.NewConfig().
 			WithRegion(a.Region).
 			WithMaxRetries(5)))
 
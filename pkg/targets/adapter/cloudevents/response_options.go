@@ -44,6 +44,13 @@ func ResponseWithSubject(subject string) EventResponseOption {
 	}
 }
 
+// ResponseWithType is an option for modifying returned event type.
+func ResponseWithType(typ string) EventResponseOption {
+	return func(in, out *cloudevents.Event) error {
+		return out.Context.SetType(typ)
+	}
+}
+
 // ResponseWithID is an option for modifying returned event ID.
 func ResponseWithID(ID string) EventResponseOption {
 	return func(in, out *cloudevents.Event) error {
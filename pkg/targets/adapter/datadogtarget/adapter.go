@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"sync"
 
 	"go.uber.org/zap"
 
@@ -30,6 +31,7 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/fs"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
@@ -69,9 +71,7 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		logger.Panicf("Error creating CloudEvents replier: %v", err)
 	}
 
-	return &datadogAdapter{
-		apiKey: env.APIKey,
-
+	a := &datadogAdapter{
 		replier:    replier,
 		httpClient: http.DefaultClient,
 		ceClient:   ceClient,
@@ -79,12 +79,39 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
+
+	switch {
+	case env.APIKeyFile != "":
+		if a.fw, err = fs.NewWatcher(logger); err != nil {
+			logger.Panicw("Could not create a file watcher", zap.Error(err))
+		}
+
+		err := fs.WatchContent(a.fw, env.APIKeyFile, func(apiKey []byte) {
+			logger.Info("Using API key read from " + env.APIKeyFile)
+			a.setAPIKey(string(apiKey))
+		}, logger)
+		if err != nil {
+			logger.Panicw("Could not watch the API key file", zap.Error(err))
+		}
+
+	case env.APIKey != "":
+		a.apiKey = env.APIKey
+
+	default:
+		logger.Panic("One of DD_CLIENT_API_KEY or DD_CLIENT_API_KEY_FILE must be set")
+	}
+
+	return a
 }
 
 var _ pkgadapter.Adapter = (*datadogAdapter)(nil)
 
 type datadogAdapter struct {
-	apiKey string
+	apiKeyMu sync.RWMutex
+	apiKey   string
+
+	// fw watches the file containing the API key, if set.
+	fw fs.FileWatcher
 
 	replier    *targetce.Replier
 	httpClient *http.Client
@@ -97,6 +124,11 @@ type datadogAdapter struct {
 // Returns if stopCh is closed or Send() returns an error.
 func (a *datadogAdapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting Datadog adapter")
+
+	if a.fw != nil {
+		a.fw.Start(ctx)
+	}
+
 	return a.ceClient.StartReceiver(ctx, a.dispatch)
 }
 
@@ -118,7 +150,7 @@ func (a *datadogAdapter) postLog(event cloudevents.Event) (*cloudevents.Event, c
 		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
 	}
 
-	request, err := newLogsAPIRequest("/v1/input", a.apiKey, event.Data())
+	request, err := newLogsAPIRequest("/v1/input", a.getAPIKey(), event.Data())
 	if err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 
@@ -149,7 +181,7 @@ func (a *datadogAdapter) postEvent(event cloudevents.Event) (*cloudevents.Event,
 		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
 	}
 
-	request, err := newAPIRequest("/api/v1/events", a.apiKey, event.Data())
+	request, err := newAPIRequest("/api/v1/events", a.getAPIKey(), event.Data())
 	if err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 
@@ -180,7 +212,7 @@ func (a *datadogAdapter) postMetric(event cloudevents.Event) (*cloudevents.Event
 		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
 	}
 
-	request, err := newAPIRequest("/api/v1/series", a.apiKey, event.Data())
+	request, err := newAPIRequest("/api/v1/series", a.getAPIKey(), event.Data())
 	if err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 	}
@@ -205,6 +237,20 @@ func (a *datadogAdapter) postMetric(event cloudevents.Event) (*cloudevents.Event
 	return a.replier.Ok(&event, resBody)
 }
 
+// getAPIKey returns the current Datadog API key.
+func (a *datadogAdapter) getAPIKey() string {
+	a.apiKeyMu.RLock()
+	defer a.apiKeyMu.RUnlock()
+	return a.apiKey
+}
+
+// setAPIKey replaces the current Datadog API key.
+func (a *datadogAdapter) setAPIKey(apiKey string) {
+	a.apiKeyMu.Lock()
+	defer a.apiKeyMu.Unlock()
+	a.apiKey = apiKey
+}
+
 // newAPIRequest returns a POST http.Request that is ready to send to the Datadog general-purpose API.
 func newAPIRequest(path, apiKey string, body []byte) (*http.Request, error) {
 	return newAPIRequestWithHost(apiBaseURL, path, apiKey, body)
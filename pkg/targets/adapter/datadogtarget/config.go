@@ -28,7 +28,10 @@ func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
 type envAccessor struct {
 	pkgadapter.EnvConfig
 	// APIKey defines a Datadog API key to be used for authentication
-	APIKey string `envconfig:"DD_CLIENT_API_KEY" required:"true"`
+	APIKey string `envconfig:"DD_CLIENT_API_KEY"`
+	// APIKeyFile is the path of a file containing the Datadog API key,
+	// watched for changes. Takes precedence over APIKey.
+	APIKeyFile string `envconfig:"DD_CLIENT_API_KEY_FILE"`
 
 	// CloudEvents responses parametrization
 	CloudEventPayloadPolicy string `envconfig:"EVENTS_PAYLOAD_POLICY" default:"error"`
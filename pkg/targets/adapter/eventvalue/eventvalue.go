@@ -0,0 +1,253 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eventvalue extracts values from CloudEvents, either from their
+// context attributes or from fields of their JSON data.
+package eventvalue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/tidwall/gjson"
+)
+
+// Selector reads a value from a CloudEvent.
+type Selector struct {
+	attribute string
+	// path of the selected field in the syntax of the gjson library.
+	path string
+	// original JSONPath expression, for error reporting.
+	expr string
+}
+
+// New returns a Selector that reads either the given context attribute or
+// the field of the event data selected by the given JSONPath expression.
+// Exactly one of attribute and jsonPath must be set.
+func New(attribute, jsonPath *string) (*Selector, error) {
+	switch {
+	case attribute != nil && jsonPath != nil:
+		return nil, errors.New("only one of attribute or JSONPath can be set")
+	case attribute != nil:
+		if *attribute == "" {
+			return nil, errors.New("attribute name is empty")
+		}
+		return &Selector{attribute: *attribute}, nil
+	case jsonPath != nil:
+		p, err := ParseJSONPath(*jsonPath)
+		if err != nil {
+			return nil, err
+		}
+		return &Selector{path: p, expr: *jsonPath}, nil
+	default:
+		return nil, errors.New("one of attribute or JSONPath must be set")
+	}
+}
+
+// Value returns the selected value. Values read from context attributes are
+// always strings. Values read from the event data are returned as a string,
+// a json.Number, a bool, nil, or as a map or slice for composite values.
+func (s *Selector) Value(event *cloudevents.Event) (interface{}, error) {
+	if s.attribute != "" {
+		return attributeValue(event, s.attribute)
+	}
+
+	res, err := s.result(event)
+	if err != nil {
+		return nil, err
+	}
+
+	switch res.Type {
+	case gjson.String:
+		return res.Str, nil
+	case gjson.Number:
+		return json.Number(res.Raw), nil
+	case gjson.True, gjson.False:
+		return res.Bool(), nil
+	case gjson.Null:
+		return nil, nil
+	default:
+		return res.Value(), nil
+	}
+}
+
+// String returns the selected value as a string. Only scalar values can be
+// represented as strings.
+func (s *Selector) String(event *cloudevents.Event) (string, error) {
+	if s.attribute != "" {
+		return attributeValue(event, s.attribute)
+	}
+
+	res, err := s.result(event)
+	if err != nil {
+		return "", err
+	}
+
+	switch res.Type {
+	case gjson.String:
+		return res.Str, nil
+	case gjson.Number, gjson.True, gjson.False:
+		return res.Raw, nil
+	default:
+		return "", fmt.Errorf("value at %q is not a scalar", s.expr)
+	}
+}
+
+// result evaluates the selector's path against the event data.
+func (s *Selector) result(event *cloudevents.Event) (*gjson.Result, error) {
+	data := event.Data()
+	if !gjson.ValidBytes(data) {
+		return nil, errors.New("event data is not valid JSON")
+	}
+
+	res := gjson.GetBytes(data, s.path)
+	if !res.Exists() {
+		return nil, fmt.Errorf("no value found at %q in the event data", s.expr)
+	}
+	return &res, nil
+}
+
+// Lookup returns the value at the given path in a nested map, such as JSON
+// data decoded to a map[string]interface{}.
+func Lookup(data map[string]interface{}, path []string) (interface{}, bool) {
+	var v interface{} = data
+
+	for _, f := range path {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = m[f]; !ok {
+			return nil, false
+		}
+	}
+
+	return v, true
+}
+
+// attributeValue returns the value of the given context attribute of a CloudEvent.
+func attributeValue(event *cloudevents.Event, name string) (string, error) {
+	var v string
+
+	switch name {
+	case "specversion":
+		v = event.SpecVersion()
+	case "id":
+		v = event.ID()
+	case "source":
+		v = event.Source()
+	case "type":
+		v = event.Type()
+	case "subject":
+		v = event.Subject()
+	case "datacontenttype":
+		v = event.DataContentType()
+	case "dataschema":
+		v = event.DataSchema()
+	case "time":
+		if t := event.Time(); !t.IsZero() {
+			v = types.FormatTime(t)
+		}
+	default:
+		ext, ok := event.Extensions()[name]
+		if !ok {
+			return "", fmt.Errorf("event has no attribute %q", name)
+		}
+		s, err := types.Format(ext)
+		if err != nil {
+			return "", fmt.Errorf("formatting value of attribute %q: %w", name, err)
+		}
+		v = s
+	}
+
+	if v == "" {
+		return "", fmt.Errorf("event has no attribute %q", name)
+	}
+	return v, nil
+}
+
+// ParseJSONPath converts a JSONPath expression to the path syntax of the
+// gjson library. Only the subset of JSONPath that selects a single value is
+// supported: the root "$" followed by any combination of dot-notation
+// members (".name"), bracket-notation members ("['name']") and array
+// indexes ("[0]").
+func ParseJSONPath(expr string) (string, error) {
+	if !strings.HasPrefix(expr, "$") {
+		return "", fmt.Errorf("JSONPath expression %q must start with '$'", expr)
+	}
+
+	var elems []string
+
+	for rest := expr[1:]; rest != ""; {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end == -1 {
+				end = len(rest) - 1
+			}
+			name := rest[1 : end+1]
+			if name == "" || name == "*" {
+				return "", fmt.Errorf("invalid member name in JSONPath expression %q", expr)
+			}
+			elems = append(elems, escape(name))
+			rest = rest[end+1:]
+
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return "", fmt.Errorf("unterminated bracket in JSONPath expression %q", expr)
+			}
+			sel := rest[1:end]
+
+			switch {
+			case len(sel) >= 2 && (sel[0] == '\'' || sel[0] == '"') && sel[len(sel)-1] == sel[0]:
+				elems = append(elems, escape(sel[1:len(sel)-1]))
+			default:
+				if _, err := strconv.ParseUint(sel, 10, 0); err != nil {
+					return "", fmt.Errorf("invalid selector %q in JSONPath expression %q", sel, expr)
+				}
+				elems = append(elems, sel)
+			}
+			rest = rest[end+1:]
+
+		default:
+			return "", fmt.Errorf("unexpected character %q in JSONPath expression %q", rest[0], expr)
+		}
+	}
+
+	if len(elems) == 0 {
+		return "", fmt.Errorf("JSONPath expression %q does not select any field", expr)
+	}
+
+	return strings.Join(elems, "."), nil
+}
+
+// escape escapes the characters that have a special meaning in gjson paths.
+func escape(name string) string {
+	var sb strings.Builder
+	for _, r := range name {
+		if strings.ContainsRune(`.*?|#@!=<>%\`, r) {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventvalue
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+func TestParseJSONPath(t *testing.T) {
+	testCases := map[string]struct {
+		expr      string
+		expect    string
+		expectErr string
+	}{
+		"dot notation": {
+			expr:   "$.order.id",
+			expect: "order.id",
+		},
+		"bracket notation and index": {
+			expr:   "$['order'].items[2].sku",
+			expect: "order.items.2.sku",
+		},
+		"special characters": {
+			expr:   `$["a.b"]['c*']`,
+			expect: `a\.b.c\*`,
+		},
+		"missing root": {
+			expr:      "order.id",
+			expectErr: `JSONPath expression "order.id" must start with '$'`,
+		},
+		"root only": {
+			expr:      "$",
+			expectErr: `JSONPath expression "$" does not select any field`,
+		},
+		"wildcard": {
+			expr:      "$.items.*",
+			expectErr: `invalid member name in JSONPath expression "$.items.*"`,
+		},
+		"filter": {
+			expr:      "$.items[?(@.a)]",
+			expectErr: `invalid selector "?(@.a)" in JSONPath expression "$.items[?(@.a)]"`,
+		},
+		"unterminated bracket": {
+			expr:      "$.items[0",
+			expectErr: `unterminated bracket in JSONPath expression "$.items[0"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			p, err := ParseJSONPath(tc.expr)
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expect, p)
+		})
+	}
+}
+
+func TestSelector(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetID("1234")
+	event.SetType("test.type")
+	event.SetSource("test.source")
+	event.SetExtension("tenant", "acme")
+	err := event.SetData(cloudevents.ApplicationJSON, json.RawMessage(
+		`{"order":{"id":"o-1","total":1000000000000001,"paid":true,"items":[{"sku":"a"}]}}`))
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		attribute   *string
+		jsonPath    *string
+		expectValue interface{}
+		expectStr   string
+		expectErr   string
+	}{
+		"context attribute": {
+			attribute:   strPtr("id"),
+			expectValue: "1234",
+			expectStr:   "1234",
+		},
+		"extension": {
+			attribute:   strPtr("tenant"),
+			expectValue: "acme",
+			expectStr:   "acme",
+		},
+		"missing attribute": {
+			attribute: strPtr("subject"),
+			expectErr: `event has no attribute "subject"`,
+		},
+		"string field": {
+			jsonPath:    strPtr("$.order.id"),
+			expectValue: "o-1",
+			expectStr:   "o-1",
+		},
+		"number field": {
+			jsonPath:    strPtr("$.order.total"),
+			expectValue: json.Number("1000000000000001"),
+			expectStr:   "1000000000000001",
+		},
+		"bool field": {
+			jsonPath:    strPtr("$.order.paid"),
+			expectValue: true,
+			expectStr:   "true",
+		},
+		"array element": {
+			jsonPath:    strPtr("$.order.items[0].sku"),
+			expectValue: "a",
+			expectStr:   "a",
+		},
+		"missing field": {
+			jsonPath:  strPtr("$.order.customer"),
+			expectErr: `no value found at "$.order.customer" in the event data`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			s, err := New(tc.attribute, tc.jsonPath)
+			require.NoError(t, err)
+
+			v, err := s.Value(&event)
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectValue, v)
+
+			str, err := s.String(&event)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectStr, str)
+		})
+	}
+
+	t.Run("composite value as string", func(t *testing.T) {
+		s, err := New(nil, strPtr("$.order.items"))
+		require.NoError(t, err)
+
+		_, err = s.String(&event)
+		assert.EqualError(t, err, `value at "$.order.items" is not a scalar`)
+	})
+
+	t.Run("invalid selector", func(t *testing.T) {
+		_, err := New(strPtr("id"), strPtr("$.id"))
+		assert.EqualError(t, err, "only one of attribute or JSONPath can be set")
+
+		_, err = New(nil, nil)
+		assert.EqualError(t, err, "one of attribute or JSONPath must be set")
+	})
+}
+
+func TestLookup(t *testing.T) {
+	data := map[string]interface{}{
+		"order": map[string]interface{}{
+			"id":    "o-1",
+			"items": []interface{}{"a"},
+		},
+	}
+
+	v, ok := Lookup(data, []string{"order", "id"})
+	assert.True(t, ok)
+	assert.Equal(t, "o-1", v)
+
+	v, ok = Lookup(data, []string{"order"})
+	assert.True(t, ok)
+	assert.Equal(t, data["order"], v)
+
+	_, ok = Lookup(data, []string{"order", "customer"})
+	assert.False(t, ok)
+
+	_, ok = Lookup(data, []string{"order", "id", "value"})
+	assert.False(t, ok, "Scalar values have no fields")
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -60,6 +60,11 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		logger.Panicf("Failed to create client: %v", err)
 	}
 
+	ops, err := newOperations(env.Operations, env.DefaultCollection)
+	if err != nil {
+		logger.Panicf("Invalid operations: %v", err)
+	}
+
 	replier, err := targetce.New(env.Component, logger.Named("replier"),
 		targetce.ReplierWithStatefulHeaders(env.BridgeIdentifier),
 		targetce.ReplierWithStaticResponseType(v1alpha1.EventTypeGoogleCloudFirestoreWriteResponse),
@@ -72,6 +77,7 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		client:            client,
 		defaultCollection: env.DefaultCollection,
 		discardCEContext:  env.DiscardCEContext,
+		operations:        ops,
 
 		replier:  replier,
 		ceClient: ceClient,
@@ -88,6 +94,8 @@ type googlecloudFirestoreAdapter struct {
 
 	defaultCollection string
 	discardCEContext  bool
+	// operations by event type
+	operations map[string]*operation
 
 	replier  *targetce.Replier
 	ceClient cloudevents.Client
@@ -103,6 +111,9 @@ func (a *googlecloudFirestoreAdapter) Start(ctx context.Context) error {
 }
 
 func (a *googlecloudFirestoreAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	if op, ok := a.operations[event.Type()]; ok {
+		return a.performOperation(ctx, op, event)
+	}
 
 	switch typ := event.Type(); typ {
 	case v1alpha1.EventTypeGoogleCloudFirestoreWrite:
@@ -17,7 +17,11 @@ limitations under the License.
 package googlecloudfirestoretarget
 
 import (
+	"encoding/json"
+
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 )
 
 // EnvAccessorCtor for configuration parameters
@@ -27,12 +31,21 @@ func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
 
 type envAccessor struct {
 	pkgadapter.EnvConfig
-	DefaultCollection string `envconfig:"GOOGLE_FIRESTORE_DEFAULT_COLLECTION" required:"true"`
-	Credentials       string `envconfig:"GCLOUD_SERVICEACCOUNT_KEY" required:"true"`
-	ProjectID         string `envconfig:"GOOGLE_FIRESTORE_PROJECT_ID" required:"true"`
-	DiscardCEContext  bool   `envconfig:"DISCARD_CE_CONTEXT"`
+	DefaultCollection string     `envconfig:"GOOGLE_FIRESTORE_DEFAULT_COLLECTION" required:"true"`
+	Credentials       string     `envconfig:"GCLOUD_SERVICEACCOUNT_KEY" required:"true"`
+	ProjectID         string     `envconfig:"GOOGLE_FIRESTORE_PROJECT_ID" required:"true"`
+	DiscardCEContext  bool       `envconfig:"DISCARD_CE_CONTEXT"`
+	Operations        operations `envconfig:"GOOGLE_FIRESTORE_OPERATIONS"`
 	// CloudEvents responses parametrization
 	CloudEventPayloadPolicy string `envconfig:"EVENTS_PAYLOAD_POLICY" default:"always"`
 	// BridgeIdentifier is the name of the bridge workflow this target is part of
 	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
 }
+
+// operations is a list of Firestore operations.
+type operations []v1alpha1.GoogleCloudFirestoreOperation
+
+// Decode implements envconfig.Decoder.
+func (o *operations) Decode(value string) error {
+	return json.Unmarshal([]byte(value), o)
+}
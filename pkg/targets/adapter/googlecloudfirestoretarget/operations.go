@@ -0,0 +1,234 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecloudfirestoretarget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/eventvalue"
+)
+
+// operation is a Firestore operation performed for events of a given type.
+type operation struct {
+	v1alpha1.GoogleCloudFirestoreOperation
+
+	collection string
+	documentID *eventvalue.Selector
+}
+
+// newOperations returns the operations described in the given specs, indexed
+// by event type.
+func newOperations(specs []v1alpha1.GoogleCloudFirestoreOperation, defaultCollection string) (map[string]*operation, error) {
+	ops := make(map[string]*operation, len(specs))
+
+	for _, spec := range specs {
+		documentID, err := eventvalue.New(spec.DocumentID.Attribute, spec.DocumentID.JSONPath)
+		if err != nil {
+			return nil, fmt.Errorf("invalid document ID of the operation for events of type %q: %w", spec.EventType, err)
+		}
+
+		collection := defaultCollection
+		if spec.Collection != nil {
+			collection = *spec.Collection
+		}
+
+		ops[spec.EventType] = &operation{
+			GoogleCloudFirestoreOperation: spec,
+			collection:                    collection,
+			documentID:                    documentID,
+		}
+	}
+
+	return ops, nil
+}
+
+// performOperation performs the given operation on the document referenced by the event.
+func (a *googlecloudFirestoreAdapter) performOperation(ctx context.Context, op *operation,
+	event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+
+	id, err := op.documentID.String(&event)
+	if err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation, fmt.Errorf("reading document ID: %w", err), nil)
+	}
+
+	doc := a.client.Collection(op.collection).Doc(id)
+	if doc == nil {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation, fmt.Errorf("invalid document reference %s/%s", op.collection, id), nil)
+	}
+
+	switch op.Operation {
+	case v1alpha1.GoogleCloudFirestoreOperationGet:
+		dsnap, err := doc.Get(ctx)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return a.replier.Error(&event, targetce.ErrorCodeRequestValidation, fmt.Errorf("document %q not found", doc.Path), nil)
+			}
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
+		}
+
+		return a.replier.Ok(&event, dsnap.Data(), targetce.ResponseWithType(v1alpha1.EventTypeGoogleCloudFirestoreDocument))
+
+	case v1alpha1.GoogleCloudFirestoreOperationDelete:
+		var preconds []firestore.Precondition
+		if op.Condition != nil && op.Condition.Exists {
+			preconds = append(preconds, firestore.Exists)
+		}
+
+		wr, err := doc.Delete(ctx, preconds...)
+		if err != nil {
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
+		}
+
+		return a.replier.Ok(&event, wr)
+	}
+
+	var data map[string]interface{}
+	if err := event.DataAs(&data); err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	var wr *firestore.WriteResult
+
+	switch op.Operation {
+	case v1alpha1.GoogleCloudFirestoreOperationPut:
+		wr, err = put(ctx, a.client, doc, data, op.Condition)
+	case v1alpha1.GoogleCloudFirestoreOperationUpdate:
+		wr, err = doc.Update(ctx, updates(data, op.UpdateMask))
+	case v1alpha1.GoogleCloudFirestoreOperationUpsert:
+		wr, err = upsert(ctx, doc, data, op.UpdateMask)
+	default:
+		err = fmt.Errorf("unsupported operation %q", op.Operation)
+	}
+
+	if err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
+	}
+
+	return a.replier.Ok(&event, wr)
+}
+
+// put writes data as the content of the given document, optionally
+// conditioned by the existence of that document.
+func put(ctx context.Context, client *firestore.Client, doc *firestore.DocumentRef,
+	data map[string]interface{}, cond *v1alpha1.GoogleCloudFirestoreCondition) (*firestore.WriteResult, error) {
+
+	switch {
+	case cond == nil:
+		return doc.Set(ctx, data)
+	case !cond.Exists:
+		return doc.Create(ctx, data)
+	}
+
+	// Set operations do not support preconditions, so the existence of the
+	// document is asserted inside a transaction.
+	err := client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		if _, err := tx.Get(doc); err != nil {
+			return err
+		}
+		return tx.Set(doc, data)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Transactions do not return individual write results.
+	return &firestore.WriteResult{}, nil
+}
+
+// upsert writes the fields of data selected by the given mask to the given
+// document, creating the document if it does not exist.
+func upsert(ctx context.Context, doc *firestore.DocumentRef,
+	data map[string]interface{}, mask []string) (*firestore.WriteResult, error) {
+
+	if len(mask) == 0 {
+		return doc.Set(ctx, data, firestore.MergeAll)
+	}
+
+	masked := make(map[string]interface{})
+	paths := make([]firestore.FieldPath, 0, len(mask))
+
+	for _, p := range mask {
+		fp := strings.Split(p, ".")
+		paths = append(paths, fp)
+
+		v, ok := eventvalue.Lookup(data, fp)
+		if !ok {
+			v = firestore.Delete
+		}
+		if err := insert(masked, fp, v); err != nil {
+			return nil, err
+		}
+	}
+
+	return doc.Set(ctx, masked, firestore.Merge(paths...))
+}
+
+// updates returns the field updates of an update operation. Fields listed in
+// the mask but absent from data are deleted.
+func updates(data map[string]interface{}, mask []string) []firestore.Update {
+	if len(mask) == 0 {
+		upd := make([]firestore.Update, 0, len(data))
+		for k, v := range data {
+			upd = append(upd, firestore.Update{FieldPath: firestore.FieldPath{k}, Value: v})
+		}
+		return upd
+	}
+
+	upd := make([]firestore.Update, 0, len(mask))
+	for _, p := range mask {
+		fp := strings.Split(p, ".")
+
+		v, ok := eventvalue.Lookup(data, fp)
+		if !ok {
+			v = firestore.Delete
+		}
+		upd = append(upd, firestore.Update{FieldPath: fp, Value: v})
+	}
+	return upd
+}
+
+// insert sets the value at the given path in a nested map, creating
+// intermediate maps as needed.
+func insert(data map[string]interface{}, path []string, v interface{}) error {
+	m := data
+
+	for _, f := range path[:len(path)-1] {
+		next, ok := m[f]
+		if !ok {
+			next = make(map[string]interface{})
+			m[f] = next
+		}
+
+		if m, ok = next.(map[string]interface{}); !ok {
+			return errors.New("field path " + strings.Join(path, ".") + " overlaps with another field of the mask")
+		}
+	}
+
+	m[path[len(path)-1]] = v
+	return nil
+}
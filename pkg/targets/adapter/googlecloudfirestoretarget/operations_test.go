@@ -0,0 +1,66 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecloudfirestoretarget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"cloud.google.com/go/firestore"
+)
+
+func TestUpdates(t *testing.T) {
+	data := map[string]interface{}{
+		"status": "shipped",
+		"shipping": map[string]interface{}{
+			"carrier": "acme",
+		},
+	}
+
+	t.Run("without mask", func(t *testing.T) {
+		upd := updates(data, nil)
+		assert.ElementsMatch(t, []firestore.Update{
+			{FieldPath: firestore.FieldPath{"status"}, Value: "shipped"},
+			{FieldPath: firestore.FieldPath{"shipping"}, Value: data["shipping"]},
+		}, upd)
+	})
+
+	t.Run("with mask", func(t *testing.T) {
+		upd := updates(data, []string{"shipping.carrier", "shipping.tracking"})
+		assert.Equal(t, []firestore.Update{
+			{FieldPath: firestore.FieldPath{"shipping", "carrier"}, Value: "acme"},
+			{FieldPath: firestore.FieldPath{"shipping", "tracking"}, Value: firestore.Delete},
+		}, upd)
+	})
+}
+
+func TestInsert(t *testing.T) {
+	m := make(map[string]interface{})
+
+	assert.NoError(t, insert(m, []string{"shipping", "carrier"}, "acme"))
+	assert.NoError(t, insert(m, []string{"status"}, "shipped"))
+	assert.Equal(t, map[string]interface{}{
+		"status": "shipped",
+		"shipping": map[string]interface{}{
+			"carrier": "acme",
+		},
+	}, m)
+
+	assert.EqualError(t, insert(m, []string{"status", "code"}, 1),
+		"field path status.code overlaps with another field of the mask")
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 )
 
 const maxSheetRow = 100
@@ -59,16 +60,33 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		logger.Fatalw("Error creating sheets client", zap.Error(err))
 	}
 
-	return &googleSheetAdapter{
+	replier, err := targetce.New(env.Component, logger.Named("replier"),
+		targetce.ReplierWithStatefulHeaders(env.BridgeIdentifier),
+		targetce.ReplierWithPayloadPolicy(targetce.PayloadPolicy(env.CloudEventPayloadPolicy)),
+		targetce.ReplierWithMappedResponseType(map[string]string{
+			v1alpha1.EventTypeGoogleSheetRead:   v1alpha1.EventTypeGoogleSheetValues,
+			v1alpha1.EventTypeGoogleSheetUpdate: v1alpha1.EventTypeGoogleSheetValues,
+			v1alpha1.EventTypeGoogleSheetUpsert: v1alpha1.EventTypeGoogleSheetValues,
+			v1alpha1.EventTypeGoogleSheetClear:  v1alpha1.EventTypeGoogleSheetValues,
+		}))
+	if err != nil {
+		logger.Panicf("Error creating CloudEvents replier: %v", err)
+	}
+
+	a := &googleSheetAdapter{
 		client:             sheetsService,
 		sheetID:            env.SheetID,
 		defaultSheetPrefix: env.DefaultSheetPrefix,
 
+		replier:  replier,
 		ceClient: ceClient,
 		logger:   logger,
 
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
+	a.batcher = newAppendBatcher(env.BatchMaxRows, env.BatchInterval, a.appendRows)
+
+	return a
 }
 
 var _ pkgadapter.Adapter = (*googleSheetAdapter)(nil)
@@ -78,6 +96,12 @@ type googleSheetAdapter struct {
 	sheetID            string
 	defaultSheetPrefix string
 
+	batcher *appendBatcher
+	// serializes upserts within each sheet, which read the sheet before
+	// writing to it
+	upsertLocks keyedMutex
+
+	replier  *targetce.Replier
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
 
@@ -88,6 +112,8 @@ type googleSheetAdapter struct {
 func (a *googleSheetAdapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting Google Sheet Adapter")
 
+	go a.batcher.Run(ctx)
+
 	if err := a.ceClient.StartReceiver(ctx, a.dispatch); err != nil {
 		return err
 	}
@@ -95,7 +121,24 @@ func (a *googleSheetAdapter) Start(ctx context.Context) error {
 	return nil
 }
 
-func (a *googleSheetAdapter) dispatch(e cloudevents.Event) cloudevents.Result {
+func (a *googleSheetAdapter) dispatch(ctx context.Context, e cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	switch e.Type() {
+	case v1alpha1.EventTypeGoogleSheetRead:
+		return a.readRange(&e)
+	case v1alpha1.EventTypeGoogleSheetUpdate:
+		return a.updateRange(&e)
+	case v1alpha1.EventTypeGoogleSheetUpsert:
+		return a.upsertRow(&e)
+	case v1alpha1.EventTypeGoogleSheetClear:
+		return a.clearRange(&e)
+	}
+
+	return nil, a.appendEvent(ctx, e)
+}
+
+// appendEvent appends the rows of an event of type
+// "io.triggermesh.googlesheet.append", or the event itself otherwise.
+func (a *googleSheetAdapter) appendEvent(ctx context.Context, e cloudevents.Event) cloudevents.Result {
 	var sheetName string
 	var rows []string
 
@@ -114,36 +157,56 @@ func (a *googleSheetAdapter) dispatch(e cloudevents.Event) cloudevents.Result {
 		rows = append(rows, e.String())
 	}
 
+	if err := a.batcher.Append(ctx, sheetName, rows); err != nil {
+		return err
+	}
+
+	a.logger.Debug("Successfully updated sheet")
+
+	return cloudevents.ResultACK
+}
+
+// appendRows appends rows to the last sheet matching the given name.
+func (a *googleSheetAdapter) appendRows(sheetName string, rows [][]string) error {
 	sheet, err := a.getOrCreateSheet(sheetName)
 	if err != nil {
 		return fmt.Errorf("error getting/creating sheet: %w", err)
 	}
 
-	if err := a.appendDataToSheet(sheet, rows); err != nil {
+	if err := a.appendRowsToSheet(sheet, rows); err != nil {
 		return fmt.Errorf("error appending new values to sheet: %w", err)
 	}
 
-	a.logger.Debug("Successfully updated sheet")
-
-	return cloudevents.ResultACK
+	return nil
 }
 
 func (a *googleSheetAdapter) appendDataToSheet(sheet *sheets.Sheet, rows []string) error {
+	return a.appendRowsToSheet(sheet, [][]string{rows})
+}
+
+// appendRowsToSheet appends multiple rows to a sheet using a single request.
+func (a *googleSheetAdapter) appendRowsToSheet(sheet *sheets.Sheet, rows [][]string) error {
 	if sheet.Properties == nil || sheet.Properties.SheetId == 0 {
 		return errors.New("sheet without SheetId can't be updated")
 	}
 
-	var valuesData []*sheets.CellData
+	rowsData := make([]*sheets.RowData, 0, len(rows))
+
+	for _, row := range rows {
+		var valuesData []*sheets.CellData
+
+		for i := range row {
+			valuesData = append(valuesData, &sheets.CellData{
+				UserEnteredValue: &sheets.ExtendedValue{StringValue: &row[i]},
+			})
+		}
 
-	for i := range rows {
-		valuesData = append(valuesData, &sheets.CellData{
-			UserEnteredValue: &sheets.ExtendedValue{StringValue: &rows[i]},
-		})
+		rowsData = append(rowsData, &sheets.RowData{Values: valuesData})
 	}
 
 	addValues := sheets.AppendCellsRequest{
 		Fields:  "*",
-		Rows:    []*sheets.RowData{{Values: valuesData}},
+		Rows:    rowsData,
 		SheetId: sheet.Properties.SheetId,
 	}
 
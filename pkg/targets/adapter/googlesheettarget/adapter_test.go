@@ -34,6 +34,9 @@ import (
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
 	"google.golang.org/api/sheets/v4"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 )
 
 const sheetID = "1241k3jl1234hlk1234c1234ln125kch1"
@@ -417,9 +420,23 @@ func newAdapter(t *testing.T) *googleSheetAdapter {
 		t.Fatal("Failed to create Google Sheet Service: " + err.Error())
 	}
 
+	logger := logtesting.TestLogger(t)
+
+	replier, err := targetce.New("test", logger,
+		targetce.ReplierWithMappedResponseType(map[string]string{
+			v1alpha1.EventTypeGoogleSheetRead:   v1alpha1.EventTypeGoogleSheetValues,
+			v1alpha1.EventTypeGoogleSheetUpdate: v1alpha1.EventTypeGoogleSheetValues,
+			v1alpha1.EventTypeGoogleSheetUpsert: v1alpha1.EventTypeGoogleSheetValues,
+			v1alpha1.EventTypeGoogleSheetClear:  v1alpha1.EventTypeGoogleSheetValues,
+		}))
+	if err != nil {
+		t.Fatal("Failed to create CloudEvents replier: " + err.Error())
+	}
+
 	return &googleSheetAdapter{
 		client:  client,
 		sheetID: sheetID,
-		logger:  logtesting.TestLogger(t),
+		replier: replier,
+		logger:  logger,
 	}
 }
@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlesheettarget
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// appendFunc appends rows to the sheet with the given name.
+type appendFunc func(sheetName string, rows [][]string) error
+
+// States of an appendRequest.
+const (
+	// waiting to be written
+	appendQueued int32 = iota
+	// withdrawn by its caller before being written
+	appendCancelled
+	// being written
+	appendWriting
+)
+
+// appendRequest is a row waiting to be appended to a sheet.
+type appendRequest struct {
+	sheetName string
+	row       []string
+	errCh     chan error
+
+	state int32
+}
+
+// cancel withdraws the request from the queue, and returns whether it was
+// withdrawn before being written.
+func (r *appendRequest) cancel() bool {
+	return atomic.CompareAndSwapInt32(&r.state, appendQueued, appendCancelled)
+}
+
+// take marks the request as being written, and returns whether it was still
+// queued.
+func (r *appendRequest) take() bool {
+	return atomic.CompareAndSwapInt32(&r.state, appendQueued, appendWriting)
+}
+
+// appendBatcher buffers the rows to append to sheets, and writes them using
+// a single API call per sheet once either the maximum number of rows or the
+// batching interval is reached.
+// Callers are blocked until their row is written, so that events are only
+// acknowledged once their data is persisted.
+type appendBatcher struct {
+	maxRows  int
+	interval time.Duration
+	append   appendFunc
+
+	reqs chan *appendRequest
+}
+
+func newAppendBatcher(maxRows int, interval time.Duration, fn appendFunc) *appendBatcher {
+	if maxRows < 1 {
+		maxRows = 1
+	}
+
+	return &appendBatcher{
+		maxRows:  maxRows,
+		interval: interval,
+		append:   fn,
+		reqs:     make(chan *appendRequest),
+	}
+}
+
+// Append queues a row for being appended to the sheet with the given name,
+// and waits until it is written.
+// If ctx is cancelled while the row is queued, the row is withdrawn and never
+// written. Once the row is being written, Append waits for the outcome of the
+// write regardless of ctx, so that the returned error always reflects whether
+// the row was persisted.
+func (b *appendBatcher) Append(ctx context.Context, sheetName string, row []string) error {
+	req := &appendRequest{
+		sheetName: sheetName,
+		row:       row,
+		errCh:     make(chan error, 1),
+	}
+
+	select {
+	case b.reqs <- req:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-req.errCh:
+		return err
+	case <-ctx.Done():
+		if req.cancel() {
+			return ctx.Err()
+		}
+		return <-req.errCh
+	}
+}
+
+// Run buffers rows until ctx is cancelled, at which point the buffered rows
+// are written before returning.
+func (b *appendBatcher) Run(ctx context.Context) {
+	pending := make([]*appendRequest, 0, b.maxRows)
+
+	timer := time.NewTimer(b.interval)
+	if !timer.Stop() {
+		<-timer.C
+	}
+	timerActive := false
+
+	flush := func() {
+		if timerActive && !timer.Stop() {
+			<-timer.C
+		}
+		timerActive = false
+
+		b.flush(pending)
+		pending = pending[:0]
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			if len(pending) > 0 {
+				flush()
+			}
+			return
+
+		case req := <-b.reqs:
+			pending = append(pending, req)
+
+			if len(pending) >= b.maxRows {
+				flush()
+				continue
+			}
+
+			if !timerActive {
+				timer.Reset(b.interval)
+				timerActive = true
+			}
+
+		case <-timer.C:
+			timerActive = false
+			b.flush(pending)
+			pending = pending[:0]
+		}
+	}
+}
+
+// flush appends the given rows, grouped by sheet in order of arrival, and
+// reports the outcome to each caller. Rows withdrawn by their caller are
+// skipped.
+func (b *appendBatcher) flush(reqs []*appendRequest) {
+	var sheetNames []string
+	bySheet := make(map[string][]*appendRequest)
+
+	for _, r := range reqs {
+		if !r.take() {
+			continue
+		}
+		if _, ok := bySheet[r.sheetName]; !ok {
+			sheetNames = append(sheetNames, r.sheetName)
+		}
+		bySheet[r.sheetName] = append(bySheet[r.sheetName], r)
+	}
+
+	for _, name := range sheetNames {
+		sheetReqs := bySheet[name]
+
+		rows := make([][]string, len(sheetReqs))
+		for i, r := range sheetReqs {
+			rows[i] = r.row
+		}
+
+		err := b.append(name, rows)
+		for _, r := range sheetReqs {
+			r.errCh <- err
+		}
+	}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlesheettarget
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendBatcher(t *testing.T) {
+	errAppend := errors.New("append failed")
+
+	testCases := map[string]struct {
+		maxRows  int
+		interval time.Duration
+		rows     map[string][]string // row value -> sheet name
+
+		expectedCalls  map[string][]string // sheet name -> row values
+		expectedErrors map[string]error    // row value -> error
+	}{
+		"flush on max rows": {
+			maxRows:  3,
+			interval: time.Hour,
+			rows: map[string][]string{
+				"a": {"r1", "r2", "r3"},
+			},
+			expectedCalls: map[string][]string{
+				"a": {"r1", "r2", "r3"},
+			},
+		},
+		"flush on interval": {
+			maxRows:  10,
+			interval: 10 * time.Millisecond,
+			rows: map[string][]string{
+				"a": {"r1", "r2"},
+			},
+			expectedCalls: map[string][]string{
+				"a": {"r1", "r2"},
+			},
+		},
+		"rows grouped by sheet": {
+			maxRows:  4,
+			interval: time.Hour,
+			rows: map[string][]string{
+				"a": {"r1", "r2"},
+				"b": {"r3", "r4"},
+			},
+			expectedCalls: map[string][]string{
+				"a": {"r1", "r2"},
+				"b": {"r3", "r4"},
+			},
+		},
+		"errors reported to the rows of the failed sheet": {
+			maxRows:  4,
+			interval: time.Hour,
+			rows: map[string][]string{
+				"a":    {"r1", "r2"},
+				"fail": {"r3", "r4"},
+			},
+			expectedCalls: map[string][]string{
+				"a":    {"r1", "r2"},
+				"fail": {"r3", "r4"},
+			},
+			expectedErrors: map[string]error{
+				"r3": errAppend,
+				"r4": errAppend,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			calls := make(map[string][]string)
+			numCalls := 0
+
+			b := newAppendBatcher(tc.maxRows, tc.interval, func(sheetName string, rows [][]string) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				numCalls++
+				for _, r := range rows {
+					calls[sheetName] = append(calls[sheetName], r[0])
+				}
+
+				if sheetName == "fail" {
+					return errAppend
+				}
+				return nil
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			go b.Run(ctx)
+
+			var wg sync.WaitGroup
+			errs := make(map[string]error)
+
+			for sheetName, rows := range tc.rows {
+				for _, r := range rows {
+					wg.Add(1)
+					go func(sheetName, r string) {
+						defer wg.Done()
+
+						err := b.Append(ctx, sheetName, []string{r})
+
+						mu.Lock()
+						errs[r] = err
+						mu.Unlock()
+					}(sheetName, r)
+				}
+			}
+
+			wg.Wait()
+
+			assert.Len(t, calls, len(tc.expectedCalls))
+			for sheetName, rows := range tc.expectedCalls {
+				assert.ElementsMatch(t, rows, calls[sheetName], "unexpected rows for sheet %q", sheetName)
+			}
+			assert.Equal(t, len(tc.expectedCalls), numCalls, "rows were not appended in a single call per sheet")
+
+			for r, err := range errs {
+				assert.Equal(t, tc.expectedErrors[r], err, "unexpected error for row %q", r)
+			}
+		})
+	}
+}
+
+func TestAppendBatcherCancel(t *testing.T) {
+	t.Run("queued row is withdrawn", func(t *testing.T) {
+		var appended [][]string
+
+		b := newAppendBatcher(10, time.Hour, func(_ string, rows [][]string) error {
+			appended = append(appended, rows...)
+			return nil
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- b.Append(ctx, "a", []string{"r1"})
+		}()
+
+		// receive the row in place of the batcher's run loop
+		req := <-b.reqs
+
+		cancel()
+		assert.ErrorIs(t, <-errCh, context.Canceled)
+
+		b.flush([]*appendRequest{req})
+		assert.Empty(t, appended, "a withdrawn row was written")
+	})
+
+	t.Run("row being written reports the outcome of the write", func(t *testing.T) {
+		writing := make(chan struct{})
+		release := make(chan struct{})
+
+		b := newAppendBatcher(1, time.Hour, func(string, [][]string) error {
+			close(writing)
+			<-release
+			return nil
+		})
+
+		runCtx, stopRun := context.WithCancel(context.Background())
+		defer stopRun()
+		go b.Run(runCtx)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- b.Append(ctx, "a", []string{"r1"})
+		}()
+
+		<-writing
+		cancel()
+		close(release)
+
+		assert.NoError(t, <-errCh)
+	})
+}
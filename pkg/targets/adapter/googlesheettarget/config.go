@@ -17,6 +17,8 @@ limitations under the License.
 package googlesheettarget
 
 import (
+	"time"
+
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
@@ -30,4 +32,14 @@ type envAccessor struct {
 	SheetID            string `envconfig:"SHEET_ID" required:"true"`
 	CredentialJSON     string `envconfig:"GCLOUD_SERVICEACCOUNT_KEY" required:"true"`
 	DefaultSheetPrefix string `envconfig:"DEFAULT_SHEET_PREFIX" required:"true"`
+
+	// Appended rows are buffered until either the maximum number of rows
+	// or the batching interval is reached. A maximum of 1 disables batching.
+	BatchMaxRows  int           `envconfig:"SHEET_BATCH_MAX_ROWS" default:"1"`
+	BatchInterval time.Duration `envconfig:"SHEET_BATCH_INTERVAL" default:"1s"`
+
+	// BridgeIdentifier is the name of the bridge workflow this target is part of
+	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
+	// CloudEvents responses parametrization
+	CloudEventPayloadPolicy string `envconfig:"EVENTS_PAYLOAD_POLICY" default:"always"`
 }
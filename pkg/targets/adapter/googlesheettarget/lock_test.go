@@ -0,0 +1,77 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlesheettarget
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyedMutex(t *testing.T) {
+	var m keyedMutex
+
+	// operations sharing a key never overlap
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			unlock := m.Lock("sheet")
+			defer unlock()
+
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, maxInFlight, "operations on the same key overlapped")
+
+	// operations on distinct keys don't wait for each other
+	unlockA := m.Lock("a")
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		m.Lock("b")()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("lock of a distinct key was blocked")
+	}
+	unlockA()
+
+	assert.Empty(t, m.locks, "released locks were kept in memory")
+}
@@ -22,3 +22,32 @@ type SpreadsheetEvent struct {
 	Message   string   `json:"message,omitempty"`
 	Rows      []string `json:"rows,omitempty"`
 }
+
+// RangeRequest represents the expected data payload for events of type
+// "io.triggermesh.googlesheet.read" and "io.triggermesh.googlesheet.clear".
+// The range is expressed using the A1 notation, e.g. "Sheet1!A1:C10".
+type RangeRequest struct {
+	Range             string `json:"range"`
+	MajorDimension    string `json:"major_dimension,omitempty"`
+	ValueRenderOption string `json:"value_render_option,omitempty"`
+}
+
+// UpdateRequest represents the expected data payload for an event of type
+// "io.triggermesh.googlesheet.update".
+type UpdateRequest struct {
+	Range            string          `json:"range"`
+	Values           [][]interface{} `json:"values"`
+	ValueInputOption string          `json:"value_input_option,omitempty"`
+}
+
+// UpsertRequest represents the expected data payload for an event of type
+// "io.triggermesh.googlesheet.upsert". The row whose cell at the key column
+// (e.g. "A") matches the key is replaced by the values, which start at the
+// first column of the sheet.
+type UpsertRequest struct {
+	SheetName        string        `json:"sheet_name"`
+	KeyColumn        string        `json:"key_column"`
+	Key              string        `json:"key"`
+	Values           []interface{} `json:"values"`
+	ValueInputOption string        `json:"value_input_option,omitempty"`
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlesheettarget
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"google.golang.org/api/sheets/v4"
+
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+const (
+	valueInputOptionUserEntered = "USER_ENTERED"
+	insertDataOptionInsertRows  = "INSERT_ROWS"
+)
+
+var columnRegexp = regexp.MustCompile(`^[A-Z]{1,3}$`)
+
+// readRange replies with the values of a range of cells.
+func (a *googleSheetAdapter) readRange(event *cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	req := &RangeRequest{}
+	if err := event.DataAs(req); err != nil {
+		return a.replier.Error(event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	if req.Range == "" {
+		return a.replier.Error(event, targetce.ErrorCodeRequestValidation, errRangeRequired, nil)
+	}
+
+	call := a.client.Spreadsheets.Values.Get(a.sheetID, req.Range)
+	if req.MajorDimension != "" {
+		call = call.MajorDimension(req.MajorDimension)
+	}
+	if req.ValueRenderOption != "" {
+		call = call.ValueRenderOption(req.ValueRenderOption)
+	}
+
+	vr, err := call.Do()
+	if err != nil {
+		return a.replier.Error(event, targetce.ErrorCodeAdapterProcess, err, nil)
+	}
+
+	return a.replier.Ok(event, vr)
+}
+
+// updateRange sets the values of a range of cells, and replies with the
+// updated values.
+func (a *googleSheetAdapter) updateRange(event *cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	req := &UpdateRequest{}
+	if err := event.DataAs(req); err != nil {
+		return a.replier.Error(event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	switch {
+	case req.Range == "":
+		return a.replier.Error(event, targetce.ErrorCodeRequestValidation, errRangeRequired, nil)
+	case len(req.Values) == 0:
+		return a.replier.Error(event, targetce.ErrorCodeRequestValidation, errValuesRequired, nil)
+	}
+
+	res, err := a.client.Spreadsheets.Values.Update(a.sheetID, req.Range, &sheets.ValueRange{Values: req.Values}).
+		ValueInputOption(valueInputOption(req.ValueInputOption)).
+		IncludeValuesInResponse(true).
+		Do()
+	if err != nil {
+		return a.replier.Error(event, targetce.ErrorCodeAdapterProcess, err, nil)
+	}
+
+	return a.replier.Ok(event, res.UpdatedData)
+}
+
+// upsertRow replaces the row matching the request's key, or appends it to
+// the sheet when no row matches, and replies with the resulting row.
+// Upserts to the same sheet are serialized within the adapter.
+func (a *googleSheetAdapter) upsertRow(event *cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	req := &UpsertRequest{}
+	if err := event.DataAs(req); err != nil {
+		return a.replier.Error(event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	switch {
+	case req.SheetName == "":
+		return a.replier.Error(event, targetce.ErrorCodeRequestValidation, errors.New("a sheet name is required"), nil)
+	case !columnRegexp.MatchString(req.KeyColumn):
+		return a.replier.Error(event, targetce.ErrorCodeRequestValidation,
+			fmt.Errorf("key column %q is not a valid column, e.g. \"A\"", req.KeyColumn), nil)
+	case req.Key == "":
+		return a.replier.Error(event, targetce.ErrorCodeRequestValidation, errors.New("a key is required"), nil)
+	case len(req.Values) == 0:
+		return a.replier.Error(event, targetce.ErrorCodeRequestValidation, errValuesRequired, nil)
+	}
+
+	// Prevent concurrent upserts of the same key from both appending a
+	// row, by serializing the lookup and the write of the row.
+	unlock := a.upsertLocks.Lock(a.sheetID + "/" + req.SheetName)
+	defer unlock()
+
+	sheetName := quoteSheetName(req.SheetName)
+
+	keys, err := a.client.Spreadsheets.Values.Get(a.sheetID, sheetName+"!"+req.KeyColumn+":"+req.KeyColumn).
+		MajorDimension("COLUMNS").
+		Do()
+	if err != nil {
+		return a.replier.Error(event, targetce.ErrorCodeAdapterProcess, err, nil)
+	}
+
+	vr := &sheets.ValueRange{Values: [][]interface{}{req.Values}}
+	inputOpt := valueInputOption(req.ValueInputOption)
+
+	if row := findKey(keys, req.Key); row > 0 {
+		res, err := a.client.Spreadsheets.Values.Update(a.sheetID, fmt.Sprintf("%s!A%d", sheetName, row), vr).
+			ValueInputOption(inputOpt).
+			IncludeValuesInResponse(true).
+			Do()
+		if err != nil {
+			return a.replier.Error(event, targetce.ErrorCodeAdapterProcess, err, nil)
+		}
+
+		return a.replier.Ok(event, res.UpdatedData)
+	}
+
+	res, err := a.client.Spreadsheets.Values.Append(a.sheetID, sheetName, vr).
+		ValueInputOption(inputOpt).
+		InsertDataOption(insertDataOptionInsertRows).
+		IncludeValuesInResponse(true).
+		Do()
+	if err != nil {
+		return a.replier.Error(event, targetce.ErrorCodeAdapterProcess, err, nil)
+	}
+
+	var updated *sheets.ValueRange
+	if res.Updates != nil {
+		updated = res.Updates.UpdatedData
+	}
+
+	return a.replier.Ok(event, updated)
+}
+
+// clearRange clears the values of a range of cells, and replies with the
+// cleared range.
+func (a *googleSheetAdapter) clearRange(event *cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	req := &RangeRequest{}
+	if err := event.DataAs(req); err != nil {
+		return a.replier.Error(event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	if req.Range == "" {
+		return a.replier.Error(event, targetce.ErrorCodeRequestValidation, errRangeRequired, nil)
+	}
+
+	res, err := a.client.Spreadsheets.Values.Clear(a.sheetID, req.Range, &sheets.ClearValuesRequest{}).Do()
+	if err != nil {
+		return a.replier.Error(event, targetce.ErrorCodeAdapterProcess, err, nil)
+	}
+
+	return a.replier.Ok(event, &sheets.ValueRange{Range: res.ClearedRange})
+}
+
+// findKey returns the 1-based index of the row containing the given key in a
+// single column range, or 0 if the key can't be found.
+func findKey(column *sheets.ValueRange, key string) int {
+	if len(column.Values) == 0 {
+		return 0
+	}
+
+	for i, v := range column.Values[0] {
+		if fmt.Sprint(v) == key {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+// quoteSheetName quotes the name of a sheet for use in the A1 notation.
+func quoteSheetName(name string) string {
+	return "'" + strings.ReplaceAll(name, "'", "''") + "'"
+}
+
+// valueInputOption returns how input data should be interpreted, which
+// defaults to parsing values as if they were typed in by a user.
+func valueInputOption(opt string) string {
+	if opt == "" {
+		return valueInputOptionUserEntered
+	}
+	return opt
+}
+
+var (
+	errRangeRequired  = errors.New("a range is required")
+	errValuesRequired = errors.New("values are required")
+)
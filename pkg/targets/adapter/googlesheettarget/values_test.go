@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlesheettarget
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"google.golang.org/api/sheets/v4"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+func TestValueOperations(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterNoResponder(valuesResponder)
+
+	adapter := newAdapter(t)
+
+	testCases := map[string]struct {
+		inType string
+		inData string
+
+		expectedRange     string
+		expectedValues    [][]interface{}
+		expectedErrorCode string
+	}{
+		"Read range": {
+			inType:         v1alpha1.EventTypeGoogleSheetRead,
+			inData:         `{"range": "Sheet1!A1:B2"}`,
+			expectedRange:  "Sheet1!A1:B2",
+			expectedValues: [][]interface{}{{"id", "name"}, {"k1", "first"}},
+		},
+		"Read without range": {
+			inType:            v1alpha1.EventTypeGoogleSheetRead,
+			inData:            `{}`,
+			expectedErrorCode: targetce.ErrorCodeRequestValidation,
+		},
+		"Read API error": {
+			inType:            v1alpha1.EventTypeGoogleSheetRead,
+			inData:            `{"range": "Missing!A1"}`,
+			expectedErrorCode: targetce.ErrorCodeAdapterProcess,
+		},
+		"Update range": {
+			inType:         v1alpha1.EventTypeGoogleSheetUpdate,
+			inData:         `{"range": "Sheet1!B2", "values": [["updated"]]}`,
+			expectedRange:  "Sheet1!B2",
+			expectedValues: [][]interface{}{{"updated"}},
+		},
+		"Update without values": {
+			inType:            v1alpha1.EventTypeGoogleSheetUpdate,
+			inData:            `{"range": "Sheet1!B2"}`,
+			expectedErrorCode: targetce.ErrorCodeRequestValidation,
+		},
+		"Upsert existing row": {
+			inType:         v1alpha1.EventTypeGoogleSheetUpsert,
+			inData:         `{"sheet_name": "Sheet1", "key_column": "A", "key": "k2", "values": ["k2", "second v2"]}`,
+			expectedRange:  "'Sheet1'!A3",
+			expectedValues: [][]interface{}{{"k2", "second v2"}},
+		},
+		"Upsert new row": {
+			inType:         v1alpha1.EventTypeGoogleSheetUpsert,
+			inData:         `{"sheet_name": "Sheet1", "key_column": "A", "key": "k3", "values": ["k3", "third"]}`,
+			expectedRange:  "'Sheet1'!A4:B4",
+			expectedValues: [][]interface{}{{"k3", "third"}},
+		},
+		"Upsert with invalid key column": {
+			inType:            v1alpha1.EventTypeGoogleSheetUpsert,
+			inData:            `{"sheet_name": "Sheet1", "key_column": "id", "key": "k3", "values": ["k3"]}`,
+			expectedErrorCode: targetce.ErrorCodeRequestValidation,
+		},
+		"Clear range": {
+			inType:        v1alpha1.EventTypeGoogleSheetClear,
+			inData:        `{"range": "Sheet1!A2:B3"}`,
+			expectedRange: "Sheet1!A2:B3",
+		},
+		"Malformed request": {
+			inType:            v1alpha1.EventTypeGoogleSheetClear,
+			inData:            `[]`,
+			expectedErrorCode: targetce.ErrorCodeRequestParsing,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			in := cloudevents.NewEvent()
+			in.SetID("123")
+			in.SetSource("test")
+			in.SetType(tc.inType)
+			require.NoError(t, in.SetData(cloudevents.ApplicationJSON, []byte(tc.inData)))
+
+			out, _ := adapter.dispatch(context.Background(), in)
+			require.NotNil(t, out, "Expected a response event")
+
+			assert.Equal(t, v1alpha1.EventTypeGoogleSheetValues, out.Type())
+
+			if tc.expectedErrorCode != "" {
+				assert.Equal(t, targetce.ExtensionCategoryValueError, out.Extensions()[targetce.ExtensionCategory])
+
+				evErr := &targetce.EventError{}
+				require.NoError(t, out.DataAs(evErr))
+				assert.Equal(t, tc.expectedErrorCode, evErr.Code)
+				return
+			}
+
+			assert.Equal(t, targetce.ExtensionCategoryValueSuccess, out.Extensions()[targetce.ExtensionCategory])
+
+			vr := &sheets.ValueRange{}
+			require.NoError(t, out.DataAs(vr))
+			assert.Equal(t, tc.expectedRange, vr.Range)
+			assert.Equal(t, tc.expectedValues, vr.Values)
+		})
+	}
+}
+
+// valuesResponder mocks the "spreadsheets.values" endpoints of the Google
+// Sheets API for a sheet containing the following values:
+//
+//	id | name
+//	k1 | first
+//	k2 | second
+func valuesResponder(r *http.Request) (*http.Response, error) {
+	const pathPrefix = "/v4/spreadsheets/" + sheetID + "/values/"
+
+	rng := strings.TrimPrefix(r.URL.Path, pathPrefix)
+
+	switch {
+	case r.Method == http.MethodGet && rng == "Sheet1!A1:B2":
+		return httpmock.NewJsonResponse(http.StatusOK, &sheets.ValueRange{
+			Range:  rng,
+			Values: [][]interface{}{{"id", "name"}, {"k1", "first"}},
+		})
+
+	case r.Method == http.MethodGet && rng == "'Sheet1'!A:A":
+		return httpmock.NewJsonResponse(http.StatusOK, &sheets.ValueRange{
+			Range:          "'Sheet1'!A1:A3",
+			MajorDimension: "COLUMNS",
+			Values:         [][]interface{}{{"id", "k1", "k2"}},
+		})
+
+	case r.Method == http.MethodPut:
+		vr := &sheets.ValueRange{}
+		if err := json.NewDecoder(r.Body).Decode(vr); err != nil {
+			return httpmock.NewStringResponse(http.StatusBadRequest, "{}"), nil
+		}
+		vr.Range = rng
+
+		return httpmock.NewJsonResponse(http.StatusOK, &sheets.UpdateValuesResponse{UpdatedData: vr})
+
+	case r.Method == http.MethodPost && strings.HasSuffix(rng, ":append"):
+		vr := &sheets.ValueRange{}
+		if err := json.NewDecoder(r.Body).Decode(vr); err != nil {
+			return httpmock.NewStringResponse(http.StatusBadRequest, "{}"), nil
+		}
+		vr.Range = "'Sheet1'!A4:B4"
+
+		return httpmock.NewJsonResponse(http.StatusOK, &sheets.AppendValuesResponse{
+			Updates: &sheets.UpdateValuesResponse{UpdatedData: vr},
+		})
+
+	case r.Method == http.MethodPost && strings.HasSuffix(rng, ":clear"):
+		return httpmock.NewJsonResponse(http.StatusOK, &sheets.ClearValuesResponse{
+			ClearedRange: strings.TrimSuffix(rng, ":clear"),
+		})
+	}
+
+	return httpmock.NewStringResponse(http.StatusNotFound, "{}"), nil
+}
@@ -102,6 +102,18 @@ func (a *jiraAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*c
 		return a.jiraIssueCreate(ctx, event)
 	case v1alpha1.EventTypeJiraIssueGet:
 		return a.jiraIssueGet(ctx, event)
+	case v1alpha1.EventTypeJiraIssueUpdate:
+		return a.jiraIssueUpdate(ctx, event)
+	case v1alpha1.EventTypeJiraIssueTransition:
+		return a.jiraIssueTransition(ctx, event)
+	case v1alpha1.EventTypeJiraIssueComment:
+		return a.jiraIssueComment(ctx, event)
+	case v1alpha1.EventTypeJiraIssueAttach:
+		return a.jiraIssueAttach(ctx, event)
+	case v1alpha1.EventTypeJiraIssueLink:
+		return a.jiraIssueLink(ctx, event)
+	case v1alpha1.EventTypeJiraIssueSearch:
+		return a.jiraIssueSearch(ctx, event)
 	case v1alpha1.EventTypeJiraCustom:
 		return a.jiraCustomRequest(ctx, event)
 	}
@@ -124,17 +136,7 @@ func (a *jiraAdapter) jiraIssueCreate(ctx context.Context, event cloudevents.Eve
 		return nil, cloudevents.ResultACK
 	}
 
-	out := cloudevents.NewEvent()
-	if err := out.SetData(cloudevents.ApplicationJSON, issue); err != nil {
-		a.logger.Errorw("Error generating response event", zap.Error(err))
-		return nil, cloudevents.ResultACK
-	}
-
-	out.SetID(uuid.New().String())
-	out.SetType(v1alpha1.EventTypeJiraIssue)
-	out.SetSource(a.resSource)
-
-	return &out, cloudevents.ResultACK
+	return a.newResponse(v1alpha1.EventTypeJiraIssue, issue)
 }
 
 func (a *jiraAdapter) jiraIssueGet(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
@@ -151,17 +153,7 @@ func (a *jiraAdapter) jiraIssueGet(ctx context.Context, event cloudevents.Event)
 		return nil, cloudevents.ResultACK
 	}
 
-	out := cloudevents.NewEvent()
-	if err := out.SetData(cloudevents.ApplicationJSON, issue); err != nil {
-		a.logger.Errorw("Error generating response event", zap.Error(err))
-		return nil, cloudevents.ResultACK
-	}
-
-	out.SetID(uuid.New().String())
-	out.SetType(v1alpha1.EventTypeJiraIssue)
-	out.SetSource(a.resSource)
-
-	return &out, cloudevents.ResultACK
+	return a.newResponse(v1alpha1.EventTypeJiraIssue, issue)
 }
 
 func (a *jiraAdapter) jiraCustomRequest(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
@@ -206,14 +198,19 @@ func (a *jiraAdapter) jiraCustomRequest(ctx context.Context, event cloudevents.E
 		return nil, cloudevents.ResultACK
 	}
 
+	return a.newResponse(event.Type()+".response", resBody)
+}
+
+// newResponse returns a response event of the given type.
+func (a *jiraAdapter) newResponse(eventType string, data interface{}) (*cloudevents.Event, cloudevents.Result) {
 	out := cloudevents.NewEvent()
-	if err := out.SetData(cloudevents.ApplicationJSON, resBody); err != nil {
+	if err := out.SetData(cloudevents.ApplicationJSON, data); err != nil {
 		a.logger.Errorw("Error generating response event", zap.Error(err))
 		return nil, cloudevents.ResultACK
 	}
 
 	out.SetID(uuid.New().String())
-	out.SetType(event.Type() + ".response")
+	out.SetType(eventType)
 	out.SetSource(a.resSource)
 
 	return &out, cloudevents.ResultACK
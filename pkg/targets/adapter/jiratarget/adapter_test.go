@@ -210,6 +210,38 @@ const (
 			 }
 		}
  ]`
+	tTransitions = `
+	{
+		"transitions":[
+			 {"id":"11","name":"To Do"},
+			 {"id":"21","name":"In Progress"},
+			 {"id":"31","name":"Done"}
+		]
+	}`
+	tComment = `
+	{
+		"self":"http://www.example.com/jira/rest/api/2/issue/10010/comment/10000",
+		"id":"10000",
+		"body":"Lorem ipsum dolor sit amet"
+	}`
+	tAttachments = `
+	[
+		{
+			 "self":"http://www.example.com/jira/rest/api/2/attachments/10000",
+			 "id":"10000",
+			 "filename":"report.txt",
+			 "size":5
+		}
+	]`
+	tSearchResult = `
+	{
+		"startAt":0,
+		"maxResults":50,
+		"total":1,
+		"issues":[
+			 {"id":"10002","key":"EX-1"}
+		]
+	}`
 )
 
 func TestJiraEvents(t *testing.T) {
@@ -257,6 +289,77 @@ func TestJiraEvents(t *testing.T) {
 			outType: v1alpha1.EventTypeJiraIssue,
 		},
 
+		"update issue": {
+			inType:  v1alpha1.EventTypeJiraIssueUpdate,
+			inData:  `{"id":"` + tIssueID + `", "fields": {"summary": "new summary"}}`,
+			outType: v1alpha1.EventTypeJiraIssue,
+		},
+
+		"update issue - missing ID": {
+			inType: v1alpha1.EventTypeJiraIssueUpdate,
+			inData: `{"fields": {"summary": "new summary"}}`,
+
+			noPayload: true,
+		},
+
+		"transition issue by name": {
+			inType:  v1alpha1.EventTypeJiraIssueTransition,
+			inData:  `{"id":"` + tIssueID + `", "transition": "done", "comment": "closing"}`,
+			outType: v1alpha1.EventTypeJiraIssue,
+		},
+
+		"transition issue by ID": {
+			inType:  v1alpha1.EventTypeJiraIssueTransition,
+			inData:  `{"id":"` + tIssueID + `", "transition": "31"}`,
+			outType: v1alpha1.EventTypeJiraIssue,
+		},
+
+		"transition issue - unknown transition": {
+			inType: v1alpha1.EventTypeJiraIssueTransition,
+			inData: `{"id":"` + tIssueID + `", "transition": "Rejected"}`,
+
+			noPayload: true,
+		},
+
+		"comment issue": {
+			inType:  v1alpha1.EventTypeJiraIssueComment,
+			inData:  `{"id":"` + tIssueID + `", "body": "Lorem ipsum dolor sit amet"}`,
+			outType: v1alpha1.EventTypeJiraComment,
+		},
+
+		"attach file": {
+			inType:  v1alpha1.EventTypeJiraIssueAttach,
+			inData:  `{"id":"` + tIssueID + `", "filename": "report.txt", "content": "aGVsbG8="}`,
+			outType: v1alpha1.EventTypeJiraAttachments,
+		},
+
+		"attach file - missing filename": {
+			inType: v1alpha1.EventTypeJiraIssueAttach,
+			inData: `{"id":"` + tIssueID + `", "content": "aGVsbG8="}`,
+
+			noPayload: true,
+		},
+
+		"link issues": {
+			inType: v1alpha1.EventTypeJiraIssueLink,
+			inData: `{"type": "Blocks", "inwardIssue": "EX-1", "outwardIssue": "EX-2"}`,
+
+			noPayload: true,
+		},
+
+		"search issues": {
+			inType:  v1alpha1.EventTypeJiraIssueSearch,
+			inData:  `{"jql": "project = EX"}`,
+			outType: v1alpha1.EventTypeJiraIssues,
+		},
+
+		"search issues - missing JQL": {
+			inType: v1alpha1.EventTypeJiraIssueSearch,
+			inData: `{"maxResults": 10}`,
+
+			noPayload: true,
+		},
+
 		"list projects": {
 			inType:  v1alpha1.EventTypeJiraCustom,
 			inData:  `{"method":"GET", "path":"/rest/api/3/project"}`,
@@ -364,6 +467,95 @@ func newJiraMockedServer() *httptest.Server {
 		switch r.Method {
 		case http.MethodGet:
 			fmt.Fprint(w, tIssue)
+		case http.MethodPut:
+			data := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&data); err != nil || len(data) == 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	testMux.HandleFunc("/rest/api/2/issue/"+tIssueID+"/transitions", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, tTransitions)
+		case http.MethodPost:
+			payload := &struct {
+				Transition struct {
+					ID string `json:"id"`
+				} `json:"transition"`
+			}{}
+			if err := json.NewDecoder(r.Body).Decode(payload); err != nil || payload.Transition.ID != "31" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	testMux.HandleFunc("/rest/api/2/issue/"+tIssueID+"/comment", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			c := &jira.Comment{}
+			if err := json.NewDecoder(r.Body).Decode(c); err != nil || c.Body == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, tComment)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	testMux.HandleFunc("/rest/api/2/issue/"+tIssueID+"/attachments", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			f, h, err := r.FormFile("file")
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			defer f.Close()
+
+			if h.Filename != "report.txt" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, tAttachments)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	testMux.HandleFunc("/rest/api/2/issueLink", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			l := &jira.IssueLink{}
+			if err := json.NewDecoder(r.Body).Decode(l); err != nil || l.Type.Name == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Query().Get("jql") == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, tSearchResult)
 		default:
 			w.WriteHeader(http.StatusBadRequest)
 		}
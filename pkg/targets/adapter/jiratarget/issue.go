@@ -0,0 +1,266 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jiratarget
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/andygrunwald/go-jira"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+func (a *jiraAdapter) jiraIssueUpdate(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	j := &IssueUpdateRequest{}
+	if err := event.DataAs(j); err != nil {
+		a.logger.Errorw("Error processing incoming event data as IssueUpdateRequest", zap.Error(err))
+		return nil, cloudevents.ResultACK
+	}
+
+	if j.ID == "" {
+		a.logger.Errorw("Invalid issue update request", zap.Error(errIssueIDRequired))
+		return nil, cloudevents.ResultACK
+	}
+
+	data := make(map[string]interface{}, 2)
+	if len(j.Fields) != 0 {
+		data["fields"] = j.Fields
+	}
+	if len(j.Update) != 0 {
+		data["update"] = j.Update
+	}
+
+	res, err := a.jiraClient.Issue.UpdateIssueWithContext(ctx, j.ID, data)
+	if err != nil {
+		respErr := jira.NewJiraError(res, err)
+		a.logger.Errorw("Error requesting Jira API", zap.Error(respErr))
+		return nil, cloudevents.ResultACK
+	}
+
+	return a.respondWithIssue(ctx, j.ID)
+}
+
+func (a *jiraAdapter) jiraIssueTransition(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	j := &IssueTransitionRequest{}
+	if err := event.DataAs(j); err != nil {
+		a.logger.Errorw("Error processing incoming event data as IssueTransitionRequest", zap.Error(err))
+		return nil, cloudevents.ResultACK
+	}
+
+	if j.ID == "" {
+		a.logger.Errorw("Invalid issue transition request", zap.Error(errIssueIDRequired))
+		return nil, cloudevents.ResultACK
+	}
+
+	transitions, res, err := a.jiraClient.Issue.GetTransitionsWithContext(ctx, j.ID)
+	if err != nil {
+		respErr := jira.NewJiraError(res, err)
+		a.logger.Errorw("Error requesting Jira API", zap.Error(respErr))
+		return nil, cloudevents.ResultACK
+	}
+
+	transitionID, err := findTransition(transitions, j.Transition)
+	if err != nil {
+		a.logger.Errorw("Invalid issue transition request", zap.Error(err))
+		return nil, cloudevents.ResultACK
+	}
+
+	payload := map[string]interface{}{
+		"transition": map[string]string{"id": transitionID},
+	}
+	if len(j.Fields) != 0 {
+		payload["fields"] = j.Fields
+	}
+	if j.Comment != "" {
+		payload["update"] = map[string]interface{}{
+			"comment": []interface{}{
+				map[string]interface{}{"add": map[string]string{"body": j.Comment}},
+			},
+		}
+	}
+
+	res, err = a.jiraClient.Issue.DoTransitionWithPayloadWithContext(ctx, j.ID, payload)
+	if err != nil {
+		respErr := jira.NewJiraError(res, err)
+		a.logger.Errorw("Error requesting Jira API", zap.Error(respErr))
+		return nil, cloudevents.ResultACK
+	}
+
+	return a.respondWithIssue(ctx, j.ID)
+}
+
+// findTransition returns the ID of the transition referenced either by ID
+// or by name, among those available for an issue.
+func findTransition(transitions []jira.Transition, ref string) (string, error) {
+	if ref == "" {
+		return "", errors.New("a transition is required")
+	}
+
+	names := make([]string, 0, len(transitions))
+	for _, t := range transitions {
+		if t.ID == ref || strings.EqualFold(t.Name, ref) {
+			return t.ID, nil
+		}
+		names = append(names, t.Name)
+	}
+
+	return "", fmt.Errorf("transition %q is not available for the issue. Available transitions: %s",
+		ref, strings.Join(names, ", "))
+}
+
+func (a *jiraAdapter) jiraIssueComment(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	j := &IssueCommentRequest{}
+	if err := event.DataAs(j); err != nil {
+		a.logger.Errorw("Error processing incoming event data as IssueCommentRequest", zap.Error(err))
+		return nil, cloudevents.ResultACK
+	}
+
+	if j.ID == "" {
+		a.logger.Errorw("Invalid issue comment request", zap.Error(errIssueIDRequired))
+		return nil, cloudevents.ResultACK
+	}
+
+	c := &jira.Comment{Body: j.Body}
+	if j.Visibility != nil {
+		c.Visibility = *j.Visibility
+	}
+
+	comment, res, err := a.jiraClient.Issue.AddCommentWithContext(ctx, j.ID, c)
+	if err != nil {
+		respErr := jira.NewJiraError(res, err)
+		a.logger.Errorw("Error requesting Jira API", zap.Error(respErr))
+		return nil, cloudevents.ResultACK
+	}
+
+	return a.newResponse(v1alpha1.EventTypeJiraComment, comment)
+}
+
+func (a *jiraAdapter) jiraIssueAttach(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	j := &IssueAttachRequest{}
+	if err := event.DataAs(j); err != nil {
+		a.logger.Errorw("Error processing incoming event data as IssueAttachRequest", zap.Error(err))
+		return nil, cloudevents.ResultACK
+	}
+
+	switch {
+	case j.ID == "":
+		a.logger.Errorw("Invalid issue attach request", zap.Error(errIssueIDRequired))
+		return nil, cloudevents.ResultACK
+	case j.Filename == "":
+		a.logger.Errorw("Invalid issue attach request", zap.Error(errors.New("a filename is required")))
+		return nil, cloudevents.ResultACK
+	}
+
+	attachments, res, err := a.jiraClient.Issue.PostAttachmentWithContext(ctx, j.ID, bytes.NewReader(j.Content), j.Filename)
+	if err != nil {
+		respErr := jira.NewJiraError(res, err)
+		a.logger.Errorw("Error requesting Jira API", zap.Error(respErr))
+		return nil, cloudevents.ResultACK
+	}
+
+	return a.newResponse(v1alpha1.EventTypeJiraAttachments, attachments)
+}
+
+// jiraIssueLink links two issues. The Jira API does not return any content
+// upon success, hence no response event is produced.
+func (a *jiraAdapter) jiraIssueLink(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	j := &IssueLinkRequest{}
+	if err := event.DataAs(j); err != nil {
+		a.logger.Errorw("Error processing incoming event data as IssueLinkRequest", zap.Error(err))
+		return nil, cloudevents.ResultACK
+	}
+
+	if j.Type == "" || j.InwardIssue == "" || j.OutwardIssue == "" {
+		a.logger.Errorw("Invalid issue link request",
+			zap.Error(errors.New("a link type, an inward issue and an outward issue are required")))
+		return nil, cloudevents.ResultACK
+	}
+
+	link := &jira.IssueLink{
+		Type:         jira.IssueLinkType{Name: j.Type},
+		InwardIssue:  &jira.Issue{Key: j.InwardIssue},
+		OutwardIssue: &jira.Issue{Key: j.OutwardIssue},
+	}
+	if j.Comment != "" {
+		link.Comment = &jira.Comment{Body: j.Comment}
+	}
+
+	res, err := a.jiraClient.Issue.AddLinkWithContext(ctx, link)
+	if err != nil {
+		respErr := jira.NewJiraError(res, err)
+		a.logger.Errorw("Error requesting Jira API", zap.Error(respErr))
+	}
+
+	return nil, cloudevents.ResultACK
+}
+
+func (a *jiraAdapter) jiraIssueSearch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	j := &IssueSearchRequest{}
+	if err := event.DataAs(j); err != nil {
+		a.logger.Errorw("Error processing incoming event data as IssueSearchRequest", zap.Error(err))
+		return nil, cloudevents.ResultACK
+	}
+
+	if j.JQL == "" {
+		a.logger.Errorw("Invalid issue search request", zap.Error(errors.New("a JQL query is required")))
+		return nil, cloudevents.ResultACK
+	}
+
+	opts := &jira.SearchOptions{
+		StartAt:    j.StartAt,
+		MaxResults: j.MaxResults,
+		Fields:     j.Fields,
+		Expand:     j.Expand,
+	}
+
+	issues, res, err := a.jiraClient.Issue.SearchWithContext(ctx, j.JQL, opts)
+	if err != nil {
+		respErr := jira.NewJiraError(res, err)
+		a.logger.Errorw("Error requesting Jira API", zap.Error(respErr))
+		return nil, cloudevents.ResultACK
+	}
+
+	return a.newResponse(v1alpha1.EventTypeJiraIssues, &IssueSearchResult{
+		Issues:     issues,
+		StartAt:    res.StartAt,
+		MaxResults: res.MaxResults,
+		Total:      res.Total,
+	})
+}
+
+// respondWithIssue retrieves an issue after it has been modified, and returns
+// it as a response event.
+func (a *jiraAdapter) respondWithIssue(ctx context.Context, id string) (*cloudevents.Event, cloudevents.Result) {
+	issue, res, err := a.jiraClient.Issue.GetWithContext(ctx, id, nil)
+	if err != nil {
+		respErr := jira.NewJiraError(res, err)
+		a.logger.Errorw("Error requesting Jira API", zap.Error(respErr))
+		return nil, cloudevents.ResultACK
+	}
+
+	return a.newResponse(v1alpha1.EventTypeJiraIssue, issue)
+}
+
+var errIssueIDRequired = errors.New("an issue ID is required")
@@ -49,3 +49,62 @@ type IssueGetRequest struct {
 	ID      string               `json:"id"`
 	Options jira.GetQueryOptions `json:"options"`
 }
+
+// IssueUpdateRequest contains parameters for issue edition. Fields are set
+// to the given values, while update contains the operations (add, set,
+// remove) to perform on fields.
+type IssueUpdateRequest struct {
+	ID     string                 `json:"id"`
+	Fields map[string]interface{} `json:"fields"`
+	Update map[string]interface{} `json:"update"`
+}
+
+// IssueTransitionRequest contains parameters for moving an issue through its
+// workflow. The transition can be referenced by ID or by name.
+type IssueTransitionRequest struct {
+	ID         string                 `json:"id"`
+	Transition string                 `json:"transition"`
+	Fields     map[string]interface{} `json:"fields"`
+	Comment    string                 `json:"comment"`
+}
+
+// IssueCommentRequest contains parameters for commenting on an issue.
+type IssueCommentRequest struct {
+	ID         string                  `json:"id"`
+	Body       string                  `json:"body"`
+	Visibility *jira.CommentVisibility `json:"visibility"`
+}
+
+// IssueAttachRequest contains parameters for attaching a file to an issue.
+// The content is expected to be base64 encoded.
+type IssueAttachRequest struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+	Content  []byte `json:"content"`
+}
+
+// IssueLinkRequest contains parameters for linking two issues.
+// The type is the name of the link type, e.g. "Blocks" or "Relates".
+type IssueLinkRequest struct {
+	Type         string `json:"type"`
+	InwardIssue  string `json:"inwardIssue"`
+	OutwardIssue string `json:"outwardIssue"`
+	Comment      string `json:"comment"`
+}
+
+// IssueSearchRequest contains parameters for searching issues using JQL.
+type IssueSearchRequest struct {
+	JQL        string   `json:"jql"`
+	StartAt    int      `json:"startAt"`
+	MaxResults int      `json:"maxResults"`
+	Fields     []string `json:"fields"`
+	Expand     string   `json:"expand"`
+}
+
+// IssueSearchResult contains a page of issues matching a JQL search.
+type IssueSearchResult struct {
+	Issues     []jira.Issue `json:"issues"`
+	StartAt    int          `json:"startAt"`
+	MaxResults int          `json:"maxResults"`
+	Total      int          `json:"total"`
+}
@@ -34,10 +34,12 @@ import (
 	// a replacement to send data to a collector which can then export with its PRW exporter."
 	"go.opentelemetry.io/contrib/exporters/metric/cortex" //nolint:staticcheck
 
-	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/number"
 	"go.opentelemetry.io/otel/metric/sdkapi"
 	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
@@ -45,7 +47,11 @@ import (
 	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 )
 
-var _ pkgadapter.Adapter = (*cortexAdapter)(nil)
+var _ pkgadapter.Adapter = (*opentelemetryAdapter)(nil)
+
+// errNoOTLPCollector is returned when spans or log records are received but
+// no OTLP collector is configured.
+var errNoOTLPCollector = errors.New("no OTLP collector is configured for exporting spans and log records")
 
 // instrumentRef is a reference to an instrument descriptor and its implementation.
 type instrumentRef struct {
@@ -54,7 +60,14 @@ type instrumentRef struct {
 }
 
 type opentelemetryAdapter struct {
-	instruments map[string]map[string]*instrumentRef
+	// Metrics are exported to Cortex, when instruments are configured.
+	cortexConfig *cortex.Config
+	instruments  map[string]map[string]*instrumentRef
+
+	// Spans and log records are exported to an OTLP collector, when
+	// one is configured.
+	otlp     otlpExporter
+	resource *resourcepb.Resource
 
 	replier  *targetce.Replier
 	ceClient cloudevents.Client
@@ -63,12 +76,6 @@ type opentelemetryAdapter struct {
 	sr *metrics.EventProcessingStatsReporter
 }
 
-type cortexAdapter struct {
-	opentelemetryAdapter
-
-	cortexConfig *cortex.Config
-}
-
 // NewTarget adapter implementation
 func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
 	logger := logging.FromContext(ctx)
@@ -90,73 +97,111 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		logger.Panicf("Error creating CloudEvents replier: %v", err)
 	}
 
-	if len(env.Instruments) == 0 {
-		logger.Panic("No instruments present")
+	if len(env.Instruments) == 0 && env.OTLPEndpoint == "" {
+		logger.Panic("No instruments nor OTLP collector present")
 	}
 
-	// instruments structure is a map nested with two keys.
-	//
-	// - Instrument name: this will usually be unique, but it could
-	//   happen that two different Instrument kinds share the same name
-	// - Instrument kind: there will usually be only one kind per name
-	// 	 but that is not guaranteed.
-	//
-	// We use it to keep the registered set of instruments in order to
-	// match with the incoming CloudEvent requests.
-	instruments := map[string]map[string]*instrumentRef{}
-	for _, i := range env.Instruments {
-		if _, ok := instruments[i.Name]; !ok {
-			instruments[i.Name] = map[string]*instrumentRef{}
-		}
-		instruments[i.Name][i.Instrument] = &instrumentRef{descriptor: i.Descriptor}
-	}
+	a := &opentelemetryAdapter{
+		replier:  replier,
+		ceClient: ceClient,
+		logger:   logger,
 
-	ccfg := &cortex.Config{
-		Endpoint:      env.CortexEndpoint,
-		BearerToken:   env.CortexBearerToken,
-		RemoteTimeout: env.CortexRemoteTimeout,
-		PushInterval:  env.CortexPushInterval,
+		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
 
-	if err = ccfg.Validate(); err != nil {
-		logger.Panicf("Error validating Cortex configuration: %v", err)
-	}
+	if len(env.Instruments) != 0 {
+		// instruments structure is a map nested with two keys.
+		//
+		// - Instrument name: this will usually be unique, but it could
+		//   happen that two different Instrument kinds share the same name
+		// - Instrument kind: there will usually be only one kind per name
+		// 	 but that is not guaranteed.
+		//
+		// We use it to keep the registered set of instruments in order to
+		// match with the incoming CloudEvent requests.
+		instruments := map[string]map[string]*instrumentRef{}
+		for _, i := range env.Instruments {
+			if _, ok := instruments[i.Name]; !ok {
+				instruments[i.Name] = map[string]*instrumentRef{}
+			}
+			instruments[i.Name][i.Instrument] = &instrumentRef{descriptor: i.Descriptor}
+		}
 
-	return &cortexAdapter{
-		cortexConfig: ccfg,
-		opentelemetryAdapter: opentelemetryAdapter{
-			instruments: instruments,
+		ccfg := &cortex.Config{
+			Endpoint:      env.CortexEndpoint,
+			BearerToken:   env.CortexBearerToken,
+			RemoteTimeout: env.CortexRemoteTimeout,
+			PushInterval:  env.CortexPushInterval,
+		}
+
+		if err = ccfg.Validate(); err != nil {
+			logger.Panicf("Error validating Cortex configuration: %v", err)
+		}
 
-			replier:  replier,
-			ceClient: ceClient,
-			logger:   logger,
+		a.instruments = instruments
+		a.cortexConfig = ccfg
+	}
+
+	if env.OTLPEndpoint != "" {
+		a.otlp, err = newOTLPExporter(&otlpConfig{
+			Endpoint: env.OTLPEndpoint,
+			Protocol: env.OTLPProtocol,
+			Insecure: env.OTLPInsecure,
+			Headers:  env.OTLPHeaders,
+			Timeout:  env.OTLPTimeout,
+		})
+		if err != nil {
+			logger.Panicf("Error creating OTLP exporter: %v", err)
+		}
 
-			sr: metrics.MustNewEventProcessingStatsReporter(mt),
-		},
+		a.resource = newResource(env.ServiceName)
 	}
+
+	return a
 }
 
 // Start is a blocking function and will return if an error occurs
 // or the context is cancelled.
-func (a *cortexAdapter) Start(ctx context.Context) error {
-	a.logger.Info("Starting Cortex adapter")
+func (a *opentelemetryAdapter) Start(ctx context.Context) error {
+	a.logger.Info("Starting OpenTelemetry adapter")
+
+	if a.otlp != nil {
+		defer func() {
+			if err := a.otlp.Close(); err != nil {
+				a.logger.Warnw("Error closing OTLP exporter", zap.Error(err))
+			}
+		}()
+	}
+
+	if a.cortexConfig != nil {
+		cortexctl, err := a.startCortex()
+		if err != nil {
+			return err
+		}
 
+		defer func() {
+			if err := cortexctl.Stop(ctx); err != nil {
+				// Warning only, this will be most of the time a context
+				// cancellation error, which is not an issue.
+				a.logger.Warnw("Error stopping Cortex controller", zap.Error(err))
+			}
+		}()
+	}
+
+	return a.ceClient.StartReceiver(ctx, a.dispatch)
+}
+
+// startCortex installs the Cortex export pipeline and creates the configured
+// instruments.
+func (a *opentelemetryAdapter) startCortex() (*controller.Controller, error) {
 	cortexctl, err := cortex.InstallNewPipeline(
 		*a.cortexConfig,
 		controller.WithCollectPeriod(a.cortexConfig.PushInterval),
 	)
 	if err != nil {
-		return fmt.Errorf("failed to create Cortex controller: %v", err)
+		return nil, fmt.Errorf("failed to create Cortex controller: %v", err)
 	}
 
-	defer func() {
-		if err := cortexctl.Stop(ctx); err != nil {
-			// Warning only, this will be most of the time a context
-			// cancellation error, which is not an issue.
-			a.logger.Warnw("Error stopping Cortex adapter", zap.Error(err))
-		}
-	}()
-
 	meter := cortexctl.Meter("TriggerMesh")
 
 	// Iterate over all instruments, create their instances and
@@ -167,27 +212,35 @@ func (a *cortexAdapter) Start(ctx context.Context) error {
 			if i.descriptor.InstrumentKind().Synchronous() {
 				i.sync, err = meter.MeterImpl().NewSyncInstrument(i.descriptor)
 				if err != nil {
-					return fmt.Errorf("failed to create sync instrument: %v", err)
+					return nil, fmt.Errorf("failed to create sync instrument: %v", err)
 				}
 				continue
 			}
 
 			if i.descriptor.InstrumentKind().Asynchronous() {
-				return fmt.Errorf("async instrument %s/%s not supported", name, kind)
+				return nil, fmt.Errorf("async instrument %s/%s not supported", name, kind)
 			}
-			return fmt.Errorf("cannot determine if the instrument %s/%s is sync or async", name, kind)
+			return nil, fmt.Errorf("cannot determine if the instrument %s/%s is sync or async", name, kind)
 		}
 	}
 
-	return a.ceClient.StartReceiver(ctx, a.dispatch)
+	return cortexctl, nil
 }
 
 func (a *opentelemetryAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
-
-	if typ := event.Type(); typ != v1alpha1.EventTypeOpenTelemetryMetricsPush {
+	switch typ := event.Type(); typ {
+	case v1alpha1.EventTypeOpenTelemetryMetricsPush:
+		return a.dispatchMetrics(ctx, event)
+	case v1alpha1.EventTypeOpenTelemetryTracesPush:
+		return a.dispatchTraces(ctx, event)
+	case v1alpha1.EventTypeOpenTelemetryLogsPush:
+		return a.dispatchLogs(ctx, event)
+	default:
 		return a.replier.Error(&event, targetce.ErrorCodeEventContext, fmt.Errorf("event type %q is not supported", typ), nil)
 	}
+}
 
+func (a *opentelemetryAdapter) dispatchMetrics(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	ms := []Measure{}
 	if err := event.DataAs(&ms); err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
@@ -208,14 +261,74 @@ func (a *opentelemetryAdapter) dispatch(ctx context.Context, event cloudevents.E
 	return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, kerrs, nil)
 }
 
-func (a *opentelemetryAdapter) processSingleMeasure(ctx context.Context, m Measure) error {
-	attrs := make([]attribute.KeyValue, len(m.Attributes))
-	for i := range m.Attributes {
-		attr, err := m.Attributes[i].ParseAttribute()
+func (a *opentelemetryAdapter) dispatchTraces(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	if a.otlp == nil {
+		return a.replier.Error(&event, targetce.ErrorCodeEventContext, errNoOTLPCollector, nil)
+	}
+
+	ss := []Span{}
+	if err := event.DataAs(&ss); err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	spans := make([]*tracepb.Span, 0, len(ss))
+	errs := []error{}
+	for i := range ss {
+		span, err := toOTLPSpan(&ss[i])
 		if err != nil {
-			return err
+			errs = append(errs, err)
+			continue
 		}
-		attrs[i] = *attr
+		spans = append(spans, span)
+	}
+
+	if len(errs) != 0 {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation, kerrors.NewAggregate(errs), nil)
+	}
+
+	if err := a.otlp.ExportTraces(ctx, newTraceRequest(a.resource, spans)); err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, fmt.Errorf("exporting spans: %w", err), nil)
+	}
+
+	return a.replier.Ack()
+}
+
+func (a *opentelemetryAdapter) dispatchLogs(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	if a.otlp == nil {
+		return a.replier.Error(&event, targetce.ErrorCodeEventContext, errNoOTLPCollector, nil)
+	}
+
+	ls := []LogRecord{}
+	if err := event.DataAs(&ls); err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	recs := make([]*logspb.LogRecord, 0, len(ls))
+	errs := []error{}
+	for i := range ls {
+		rec, err := toOTLPLogRecord(&ls[i])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		recs = append(recs, rec)
+	}
+
+	if len(errs) != 0 {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation, kerrors.NewAggregate(errs), nil)
+	}
+
+	if err := a.otlp.ExportLogs(ctx, newLogsRequest(a.resource, recs)); err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, fmt.Errorf("exporting log records: %w", err), nil)
+	}
+
+	return a.replier.Ack()
+}
+
+func (a *opentelemetryAdapter) processSingleMeasure(ctx context.Context, m Measure) error {
+	attrs, err := parseAttributes(m.Attributes)
+	if err != nil {
+		return err
 	}
 
 	// Match the measure with an instrument
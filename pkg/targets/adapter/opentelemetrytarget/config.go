@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	"go.opentelemetry.io/otel/metric/number"
@@ -96,6 +97,37 @@ func (is *Instruments) Decode(value string) error {
 	return nil
 }
 
+// OTLPHeaders are headers sent along with OTLP export requests.
+//
+// They are decoded from a JSON object which maps the name of each header to
+// the name of the environment variable containing its value, so that values
+// such as credentials can be read from Kubernetes Secrets.
+type OTLPHeaders map[string]string
+
+// Decode implements envconfig.Decoder.
+func (h *OTLPHeaders) Decode(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	var headerVars map[string]string
+	if err := json.Unmarshal([]byte(value), &headerVars); err != nil {
+		return err
+	}
+
+	headers := make(OTLPHeaders, len(headerVars))
+	for name, envVar := range headerVars {
+		val, ok := os.LookupEnv(envVar)
+		if !ok {
+			return fmt.Errorf("environment variable %q containing the value of header %q is not set", envVar, name)
+		}
+		headers[name] = val
+	}
+
+	*h = headers
+	return nil
+}
+
 type envAccessor struct {
 	pkgadapter.EnvConfig
 
@@ -105,8 +137,21 @@ type envAccessor struct {
 	CortexBearerToken   string        `envconfig:"OPENTELEMETRY_CORTEX_BEARER_TOKEN"`
 	CortexPushInterval  time.Duration `envconfig:"OPENTELEMETRY_CORTEX_PUSH_INTERVAL" default:"10s"`
 
+	// OTLP collector connection parameters, used for exporting spans and
+	// log records.
+	OTLPEndpoint string        `envconfig:"OPENTELEMETRY_OTLP_ENDPOINT"`
+	OTLPProtocol string        `envconfig:"OPENTELEMETRY_OTLP_PROTOCOL" default:"grpc"`
+	OTLPInsecure bool          `envconfig:"OPENTELEMETRY_OTLP_INSECURE"`
+	OTLPHeaders  OTLPHeaders   `envconfig:"OPENTELEMETRY_OTLP_HEADERS"`
+	OTLPTimeout  time.Duration `envconfig:"OPENTELEMETRY_OTLP_TIMEOUT" default:"10s"`
+
+	// Value of the "service.name" attribute of the resource exported
+	// spans and log records are associated with.
+	ServiceName string `envconfig:"OPENTELEMETRY_SERVICE_NAME" default:"triggermesh"`
+
 	// OpenTelemetry instruments information.
-	Instruments Instruments `envconfig:"OPENTELEMETRY_INSTRUMENTS" required:"true"`
+	// Can be omitted when the adapter only exports spans and log records.
+	Instruments Instruments `envconfig:"OPENTELEMETRY_INSTRUMENTS"`
 
 	// BridgeIdentifier is the name of the bridge workflow this target is part of
 	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
@@ -119,3 +119,34 @@ func TestEnvironmentInstruments(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentOTLPHeaders(t *testing.T) {
+	for k, v := range baseEnv {
+		t.Setenv(k, v)
+	}
+
+	t.Setenv("OPENTELEMETRY_OTLP_HEADER_0", "Bearer abc")
+	t.Setenv("OPENTELEMETRY_OTLP_HEADER_1", "acme")
+
+	t.Run("values read from variables", func(t *testing.T) {
+		t.Setenv("OPENTELEMETRY_OTLP_HEADERS",
+			`{"Authorization":"OPENTELEMETRY_OTLP_HEADER_0","X-Tenant":"OPENTELEMETRY_OTLP_HEADER_1"}`)
+
+		env := EnvAccessorCtor().(*envAccessor)
+		err := envconfig.Process("", env)
+
+		assert.NoError(t, err)
+		assert.Equal(t, OTLPHeaders{"Authorization": "Bearer abc", "X-Tenant": "acme"}, env.OTLPHeaders)
+	})
+
+	t.Run("variable not set", func(t *testing.T) {
+		t.Setenv("OPENTELEMETRY_OTLP_HEADERS", `{"Authorization":"OPENTELEMETRY_OTLP_HEADER_2"}`)
+
+		env := EnvAccessorCtor().(*envAccessor)
+		err := envconfig.Process("", env)
+
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), `environment variable "OPENTELEMETRY_OTLP_HEADER_2" containing the value of header "Authorization" is not set`)
+		}
+	})
+}
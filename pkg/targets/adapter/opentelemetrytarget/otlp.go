@@ -0,0 +1,527 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opentelemetrytarget
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+// Supported OTLP transport protocols.
+const (
+	otlpProtocolGRPC = "grpc"
+	otlpProtocolHTTP = "http/protobuf"
+)
+
+// Paths of the OTLP/HTTP endpoints.
+const (
+	otlpHTTPTracesPath = "/v1/traces"
+	otlpHTTPLogsPath   = "/v1/logs"
+)
+
+// Name of the instrumentation scope of exported spans and log records.
+const instrumentationScope = "io.triggermesh.opentelemetry"
+
+// otlpExporter exports telemetry signals to an OTLP collector.
+type otlpExporter interface {
+	ExportTraces(context.Context, *coltracepb.ExportTraceServiceRequest) error
+	ExportLogs(context.Context, *collogspb.ExportLogsServiceRequest) error
+	Close() error
+}
+
+// otlpConfig contains the parameters of an OTLP exporter.
+type otlpConfig struct {
+	Endpoint string
+	Protocol string
+	Insecure bool
+	Headers  map[string]string
+	Timeout  time.Duration
+}
+
+// newOTLPExporter returns an otlpExporter for the given configuration.
+func newOTLPExporter(cfg *otlpConfig) (otlpExporter, error) {
+	if cfg.Endpoint == "" {
+		return nil, errors.New("OTLP endpoint is empty")
+	}
+
+	switch cfg.Protocol {
+	case otlpProtocolGRPC:
+		return newGRPCExporter(cfg)
+	case otlpProtocolHTTP:
+		return newHTTPExporter(cfg), nil
+	default:
+		return nil, fmt.Errorf("unsupported OTLP protocol %q", cfg.Protocol)
+	}
+}
+
+// grpcExporter is an otlpExporter which uses the OTLP/gRPC transport.
+type grpcExporter struct {
+	conn   *grpc.ClientConn
+	traces coltracepb.TraceServiceClient
+	logs   collogspb.LogsServiceClient
+
+	headers metadata.MD
+	timeout time.Duration
+}
+
+var _ otlpExporter = (*grpcExporter)(nil)
+
+// newGRPCExporter returns an otlpExporter which uses the OTLP/gRPC transport.
+// The connection to the collector is established lazily.
+func newGRPCExporter(cfg *otlpConfig) (*grpcExporter, error) {
+	creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if cfg.Insecure {
+		creds = insecure.NewCredentials()
+	}
+
+	conn, err := grpc.Dial(cfg.Endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("creating gRPC client connection: %w", err)
+	}
+
+	return &grpcExporter{
+		conn:    conn,
+		traces:  coltracepb.NewTraceServiceClient(conn),
+		logs:    collogspb.NewLogsServiceClient(conn),
+		headers: metadata.New(cfg.Headers),
+		timeout: cfg.Timeout,
+	}, nil
+}
+
+// ExportTraces implements otlpExporter.
+func (e *grpcExporter) ExportTraces(ctx context.Context, req *coltracepb.ExportTraceServiceRequest) error {
+	ctx, cancel := e.requestContext(ctx)
+	defer cancel()
+
+	_, err := e.traces.Export(ctx, req)
+	return err
+}
+
+// ExportLogs implements otlpExporter.
+func (e *grpcExporter) ExportLogs(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	ctx, cancel := e.requestContext(ctx)
+	defer cancel()
+
+	_, err := e.logs.Export(ctx, req)
+	return err
+}
+
+// Close implements otlpExporter.
+func (e *grpcExporter) Close() error {
+	return e.conn.Close()
+}
+
+// requestContext returns a context which carries the configured headers and
+// timeout.
+func (e *grpcExporter) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if len(e.headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, e.headers)
+	}
+	return context.WithTimeout(ctx, e.timeout)
+}
+
+// httpExporter is an otlpExporter which uses the OTLP/HTTP transport with
+// binary Protobuf encoding.
+type httpExporter struct {
+	client   *http.Client
+	endpoint string
+	headers  map[string]string
+}
+
+var _ otlpExporter = (*httpExporter)(nil)
+
+// newHTTPExporter returns an otlpExporter which uses the OTLP/HTTP transport.
+func newHTTPExporter(cfg *otlpConfig) *httpExporter {
+	endpoint := strings.TrimSuffix(cfg.Endpoint, "/")
+	if !strings.Contains(endpoint, "://") {
+		scheme := "https://"
+		if cfg.Insecure {
+			scheme = "http://"
+		}
+		endpoint = scheme + endpoint
+	}
+
+	return &httpExporter{
+		client:   &http.Client{Timeout: cfg.Timeout},
+		endpoint: endpoint,
+		headers:  cfg.Headers,
+	}
+}
+
+// ExportTraces implements otlpExporter.
+func (e *httpExporter) ExportTraces(ctx context.Context, req *coltracepb.ExportTraceServiceRequest) error {
+	return e.export(ctx, otlpHTTPTracesPath, req)
+}
+
+// ExportLogs implements otlpExporter.
+func (e *httpExporter) ExportLogs(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	return e.export(ctx, otlpHTTPLogsPath, req)
+}
+
+// Close implements otlpExporter.
+func (e *httpExporter) Close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
+
+// export sends the given OTLP request to the collector's endpoint at the
+// given path.
+func (e *httpExporter) export(ctx context.Context, path string, msg proto.Message) error {
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("serializing OTLP request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating HTTP request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending HTTP request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		resBody, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("collector responded with status %d: %s", res.StatusCode, resBody)
+	}
+
+	return nil
+}
+
+// MAINTENANCE: spanKinds needs to be kept in sync with the
+// tracepb.Span_SpanKind enum.
+var spanKinds = map[string]tracepb.Span_SpanKind{
+	"":         tracepb.Span_SPAN_KIND_INTERNAL,
+	"Internal": tracepb.Span_SPAN_KIND_INTERNAL,
+	"Server":   tracepb.Span_SPAN_KIND_SERVER,
+	"Client":   tracepb.Span_SPAN_KIND_CLIENT,
+	"Producer": tracepb.Span_SPAN_KIND_PRODUCER,
+	"Consumer": tracepb.Span_SPAN_KIND_CONSUMER,
+}
+
+// MAINTENANCE: statusCodes needs to be kept in sync with the
+// tracepb.Status_StatusCode enum.
+var statusCodes = map[string]tracepb.Status_StatusCode{
+	"":      tracepb.Status_STATUS_CODE_UNSET,
+	"Unset": tracepb.Status_STATUS_CODE_UNSET,
+	"Ok":    tracepb.Status_STATUS_CODE_OK,
+	"Error": tracepb.Status_STATUS_CODE_ERROR,
+}
+
+// MAINTENANCE: severities needs to be kept in sync with the
+// logspb.SeverityNumber enum.
+var severities = map[string]logspb.SeverityNumber{
+	"":      logspb.SeverityNumber_SEVERITY_NUMBER_UNSPECIFIED,
+	"TRACE": logspb.SeverityNumber_SEVERITY_NUMBER_TRACE,
+	"DEBUG": logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG,
+	"INFO":  logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+	"WARN":  logspb.SeverityNumber_SEVERITY_NUMBER_WARN,
+	"ERROR": logspb.SeverityNumber_SEVERITY_NUMBER_ERROR,
+	"FATAL": logspb.SeverityNumber_SEVERITY_NUMBER_FATAL,
+}
+
+// toOTLPSpan converts the given Span to its OTLP representation.
+func toOTLPSpan(s *Span) (*tracepb.Span, error) {
+	if s.Name == "" {
+		return nil, errors.New("spans must include a name")
+	}
+
+	kind, ok := spanKinds[s.Kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown span kind %q", s.Kind)
+	}
+
+	traceID, err := parseTraceID(s.TraceID)
+	if err != nil {
+		return nil, err
+	}
+	if !traceID.IsValid() {
+		if _, err := rand.Read(traceID[:]); err != nil {
+			return nil, fmt.Errorf("generating trace ID: %w", err)
+		}
+	}
+
+	spanID, err := parseSpanID(s.SpanID)
+	if err != nil {
+		return nil, err
+	}
+	if !spanID.IsValid() {
+		if _, err := rand.Read(spanID[:]); err != nil {
+			return nil, fmt.Errorf("generating span ID: %w", err)
+		}
+	}
+
+	parentSpanID, err := parseSpanID(s.ParentSpanID)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, err := parseAttributes(s.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := s.StartTime
+	if startTime.IsZero() {
+		startTime = time.Now()
+	}
+	endTime := s.EndTime
+	if endTime.IsZero() {
+		endTime = startTime
+	}
+	if endTime.Before(startTime) {
+		return nil, fmt.Errorf("span %q ends before it starts", s.Name)
+	}
+
+	span := &tracepb.Span{
+		TraceId:           traceID[:],
+		SpanId:            spanID[:],
+		Name:              s.Name,
+		Kind:              kind,
+		StartTimeUnixNano: uint64(startTime.UnixNano()),
+		EndTimeUnixNano:   uint64(endTime.UnixNano()),
+		Attributes:        toOTLPAttributes(attrs),
+	}
+
+	if parentSpanID.IsValid() {
+		span.ParentSpanId = parentSpanID[:]
+	}
+
+	if st := s.Status; st != nil {
+		code, ok := statusCodes[st.Code]
+		if !ok {
+			return nil, fmt.Errorf("unknown span status code %q", st.Code)
+		}
+		span.Status = &tracepb.Status{
+			Code:    code,
+			Message: st.Message,
+		}
+	}
+
+	return span, nil
+}
+
+// toOTLPLogRecord converts the given LogRecord to its OTLP representation.
+func toOTLPLogRecord(l *LogRecord) (*logspb.LogRecord, error) {
+	severity, ok := severities[strings.ToUpper(l.Severity)]
+	if !ok {
+		return nil, fmt.Errorf("unknown log severity %q", l.Severity)
+	}
+
+	traceID, err := parseTraceID(l.TraceID)
+	if err != nil {
+		return nil, err
+	}
+
+	spanID, err := parseSpanID(l.SpanID)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, err := parseAttributes(l.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	var body *commonpb.AnyValue
+	if len(l.Body) > 0 {
+		var v interface{}
+		if err := json.Unmarshal(l.Body, &v); err != nil {
+			return nil, fmt.Errorf("log body is not valid JSON: %w", err)
+		}
+		body = toOTLPAnyValue(v)
+	}
+
+	now := time.Now()
+	timestamp := l.Time
+	if timestamp.IsZero() {
+		timestamp = now
+	}
+
+	rec := &logspb.LogRecord{
+		TimeUnixNano:         uint64(timestamp.UnixNano()),
+		ObservedTimeUnixNano: uint64(now.UnixNano()),
+		SeverityNumber:       severity,
+		SeverityText:         l.Severity,
+		Body:                 body,
+		Attributes:           toOTLPAttributes(attrs),
+	}
+
+	if traceID.IsValid() {
+		rec.TraceId = traceID[:]
+	}
+	if spanID.IsValid() {
+		rec.SpanId = spanID[:]
+	}
+
+	return rec, nil
+}
+
+// newTraceRequest returns an OTLP request for exporting the given spans.
+func newTraceRequest(res *resourcepb.Resource, spans []*tracepb.Span) *coltracepb.ExportTraceServiceRequest {
+	return &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{{
+			Resource: res,
+			ScopeSpans: []*tracepb.ScopeSpans{{
+				Scope: &commonpb.InstrumentationScope{Name: instrumentationScope},
+				Spans: spans,
+			}},
+		}},
+	}
+}
+
+// newLogsRequest returns an OTLP request for exporting the given log records.
+func newLogsRequest(res *resourcepb.Resource, recs []*logspb.LogRecord) *collogspb.ExportLogsServiceRequest {
+	return &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: res,
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &commonpb.InstrumentationScope{Name: instrumentationScope},
+				LogRecords: recs,
+			}},
+		}},
+	}
+}
+
+// newResource returns an OTLP resource with the given service name.
+func newResource(serviceName string) *resourcepb.Resource {
+	return &resourcepb.Resource{
+		Attributes: []*commonpb.KeyValue{{
+			Key:   "service.name",
+			Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: serviceName}},
+		}},
+	}
+}
+
+// toOTLPAttributes converts the given attributes to their OTLP representation.
+func toOTLPAttributes(attrs []attribute.KeyValue) []*commonpb.KeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	kvs := make([]*commonpb.KeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		var v commonpb.AnyValue
+
+		switch a.Value.Type() {
+		case attribute.BOOL:
+			v.Value = &commonpb.AnyValue_BoolValue{BoolValue: a.Value.AsBool()}
+		case attribute.INT64:
+			v.Value = &commonpb.AnyValue_IntValue{IntValue: a.Value.AsInt64()}
+		case attribute.FLOAT64:
+			v.Value = &commonpb.AnyValue_DoubleValue{DoubleValue: a.Value.AsFloat64()}
+		default:
+			v.Value = &commonpb.AnyValue_StringValue{StringValue: a.Value.Emit()}
+		}
+
+		kvs = append(kvs, &commonpb.KeyValue{Key: string(a.Key), Value: &v})
+	}
+
+	return kvs
+}
+
+// toOTLPAnyValue converts a deserialized JSON value to its OTLP representation.
+func toOTLPAnyValue(v interface{}) *commonpb.AnyValue {
+	switch tv := v.(type) {
+	case string:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: tv}}
+	case bool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: tv}}
+	case float64:
+		if i := int64(tv); float64(i) == tv {
+			return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: i}}
+		}
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: tv}}
+	case []interface{}:
+		vals := make([]*commonpb.AnyValue, 0, len(tv))
+		for _, e := range tv {
+			vals = append(vals, toOTLPAnyValue(e))
+		}
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{
+			ArrayValue: &commonpb.ArrayValue{Values: vals},
+		}}
+	case map[string]interface{}:
+		kvs := make([]*commonpb.KeyValue, 0, len(tv))
+		for k, e := range tv {
+			kvs = append(kvs, &commonpb.KeyValue{Key: k, Value: toOTLPAnyValue(e)})
+		}
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_KvlistValue{
+			KvlistValue: &commonpb.KeyValueList{Values: kvs},
+		}}
+	default:
+		// JSON null
+		return nil
+	}
+}
+
+// parseTraceID parses an optional hex-encoded trace ID.
+func parseTraceID(s string) (trace.TraceID, error) {
+	if s == "" {
+		return trace.TraceID{}, nil
+	}
+
+	id, err := trace.TraceIDFromHex(s)
+	if err != nil {
+		return trace.TraceID{}, fmt.Errorf("invalid trace ID %q: %w", s, err)
+	}
+	return id, nil
+}
+
+// parseSpanID parses an optional hex-encoded span ID.
+func parseSpanID(s string) (trace.SpanID, error) {
+	if s == "" {
+		return trace.SpanID{}, nil
+	}
+
+	id, err := trace.SpanIDFromHex(s)
+	if err != nil {
+		return trace.SpanID{}, fmt.Errorf("invalid span ID %q: %w", s, err)
+	}
+	return id, nil
+}
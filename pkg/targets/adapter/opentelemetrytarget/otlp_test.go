@@ -0,0 +1,492 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opentelemetrytarget
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+const (
+	tTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	tSpanID  = "00f067aa0ba902b7"
+	tParent  = "53995c3f42cd8ad8"
+)
+
+func TestToOTLPSpan(t *testing.T) {
+	start := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(time.Second)
+
+	testCases := map[string]struct {
+		in          string
+		expectSpan  *tracepb.Span
+		expectErr   string
+		generateIDs bool
+	}{
+		"complete span": {
+			in: `{
+				"name": "checkout",
+				"kind": "Server",
+				"traceId": "` + tTraceID + `",
+				"spanId": "` + tSpanID + `",
+				"parentSpanId": "` + tParent + `",
+				"startTime": "2022-06-01T12:00:00Z",
+				"endTime": "2022-06-01T12:00:01Z",
+				"status": {"code": "Error", "message": "out of stock"},
+				"attributes": [
+					{"key": "cart_size", "value": 3, "type": "int"}
+				]
+			}`,
+			expectSpan: &tracepb.Span{
+				TraceId:           mustDecodeHex(t, tTraceID),
+				SpanId:            mustDecodeHex(t, tSpanID),
+				ParentSpanId:      mustDecodeHex(t, tParent),
+				Name:              "checkout",
+				Kind:              tracepb.Span_SPAN_KIND_SERVER,
+				StartTimeUnixNano: uint64(start.UnixNano()),
+				EndTimeUnixNano:   uint64(end.UnixNano()),
+				Status: &tracepb.Status{
+					Code:    tracepb.Status_STATUS_CODE_ERROR,
+					Message: "out of stock",
+				},
+				Attributes: []*commonpb.KeyValue{{
+					Key:   "cart_size",
+					Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: 3}},
+				}},
+			},
+		},
+		"generated identifiers": {
+			in:          `{"name": "checkout", "startTime": "2022-06-01T12:00:00Z"}`,
+			generateIDs: true,
+			expectSpan: &tracepb.Span{
+				Name:              "checkout",
+				Kind:              tracepb.Span_SPAN_KIND_INTERNAL,
+				StartTimeUnixNano: uint64(start.UnixNano()),
+				EndTimeUnixNano:   uint64(start.UnixNano()),
+			},
+		},
+		"missing name": {
+			in:        `{"kind": "Server"}`,
+			expectErr: "spans must include a name",
+		},
+		"unknown kind": {
+			in:        `{"name": "checkout", "kind": "Unknown"}`,
+			expectErr: `unknown span kind "Unknown"`,
+		},
+		"invalid trace ID": {
+			in:        `{"name": "checkout", "traceId": "xyz"}`,
+			expectErr: `invalid trace ID "xyz"`,
+		},
+		"ends before start": {
+			in:        `{"name": "checkout", "startTime": "2022-06-01T12:00:01Z", "endTime": "2022-06-01T12:00:00Z"}`,
+			expectErr: "ends before it starts",
+		},
+		"invalid attribute": {
+			in:        `{"name": "checkout", "attributes": [{"key": "size", "value": "large", "type": "int"}]}`,
+			expectErr: "does not match type",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			s := &Span{}
+			require.NoError(t, json.Unmarshal([]byte(tc.in), s))
+
+			span, err := toOTLPSpan(s)
+
+			if tc.expectErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.generateIDs {
+				assert.Len(t, span.TraceId, 16)
+				assert.NotEqual(t, make([]byte, 16), span.TraceId)
+				assert.Len(t, span.SpanId, 8)
+				assert.NotEqual(t, make([]byte, 8), span.SpanId)
+				span.TraceId, span.SpanId = nil, nil
+			}
+
+			assert.True(t, proto.Equal(tc.expectSpan, span), "Unexpected span: %v", span)
+		})
+	}
+}
+
+func TestToOTLPLogRecord(t *testing.T) {
+	timestamp := time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		in        string
+		expectRec *logspb.LogRecord
+		expectErr string
+	}{
+		"string body": {
+			in: `{
+				"time": "2022-06-01T12:00:00Z",
+				"severity": "WARN",
+				"body": "disk almost full",
+				"traceId": "` + tTraceID + `",
+				"spanId": "` + tSpanID + `",
+				"attributes": [
+					{"key": "host", "value": "tm1", "type": "string"}
+				]
+			}`,
+			expectRec: &logspb.LogRecord{
+				TimeUnixNano:   uint64(timestamp.UnixNano()),
+				SeverityNumber: logspb.SeverityNumber_SEVERITY_NUMBER_WARN,
+				SeverityText:   "WARN",
+				Body:           &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: "disk almost full"}},
+				TraceId:        mustDecodeHex(t, tTraceID),
+				SpanId:         mustDecodeHex(t, tSpanID),
+				Attributes: []*commonpb.KeyValue{{
+					Key:   "host",
+					Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: "tm1"}},
+				}},
+			},
+		},
+		"structured body": {
+			in: `{"time": "2022-06-01T12:00:00Z", "severity": "info", "body": {"usage": [0.5, 2]}}`,
+			expectRec: &logspb.LogRecord{
+				TimeUnixNano:   uint64(timestamp.UnixNano()),
+				SeverityNumber: logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+				SeverityText:   "info",
+				Body: &commonpb.AnyValue{Value: &commonpb.AnyValue_KvlistValue{KvlistValue: &commonpb.KeyValueList{
+					Values: []*commonpb.KeyValue{{
+						Key: "usage",
+						Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{
+							Values: []*commonpb.AnyValue{
+								{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: 0.5}},
+								{Value: &commonpb.AnyValue_IntValue{IntValue: 2}},
+							},
+						}}},
+					}},
+				}}},
+			},
+		},
+		"unknown severity": {
+			in:        `{"severity": "LOUD"}`,
+			expectErr: `unknown log severity "LOUD"`,
+		},
+		"invalid span ID": {
+			in:        `{"spanId": "00"}`,
+			expectErr: `invalid span ID "00"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			l := &LogRecord{}
+			require.NoError(t, json.Unmarshal([]byte(tc.in), l))
+
+			rec, err := toOTLPLogRecord(l)
+
+			if tc.expectErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.NotZero(t, rec.ObservedTimeUnixNano)
+			rec.ObservedTimeUnixNano = 0
+
+			assert.True(t, proto.Equal(tc.expectRec, rec), "Unexpected log record: %v", rec)
+		})
+	}
+}
+
+func TestOTLPExporters(t *testing.T) {
+	const headerKey = "x-api-key"
+	const headerVal = "secret"
+
+	traceReq := newTraceRequest(newResource("test"), []*tracepb.Span{{Name: "checkout"}})
+	logsReq := newLogsRequest(newResource("test"), []*logspb.LogRecord{{SeverityText: "INFO"}})
+
+	testCases := map[string]func(*testing.T, *fakeCollector) *otlpConfig{
+		"gRPC": func(t *testing.T, c *fakeCollector) *otlpConfig {
+			return &otlpConfig{
+				Endpoint: c.serveGRPC(t),
+				Protocol: otlpProtocolGRPC,
+				Insecure: true,
+			}
+		},
+		"HTTP": func(t *testing.T, c *fakeCollector) *otlpConfig {
+			return &otlpConfig{
+				Endpoint: c.serveHTTP(t),
+				Protocol: otlpProtocolHTTP,
+			}
+		},
+	}
+
+	for name, newCfg := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			c := &fakeCollector{}
+
+			cfg := newCfg(t, c)
+			cfg.Headers = map[string]string{headerKey: headerVal}
+			cfg.Timeout = 5 * time.Second
+
+			exp, err := newOTLPExporter(cfg)
+			require.NoError(t, err)
+			defer exp.Close()
+
+			ctx := context.Background()
+
+			require.NoError(t, exp.ExportTraces(ctx, traceReq))
+			require.NoError(t, exp.ExportLogs(ctx, logsReq))
+
+			require.Len(t, c.traces, 1)
+			assert.True(t, proto.Equal(traceReq, c.traces[0]), "Unexpected trace request: %v", c.traces[0])
+			require.Len(t, c.logs, 1)
+			assert.True(t, proto.Equal(logsReq, c.logs[0]), "Unexpected logs request: %v", c.logs[0])
+
+			assert.Equal(t, []string{headerVal, headerVal}, c.headers[headerKey])
+		})
+	}
+}
+
+func TestDispatchSignals(t *testing.T) {
+	testCases := map[string]struct {
+		eventType    string
+		data         string
+		noCollector  bool
+		expectTraces int
+		expectLogs   int
+		expectErr    bool
+	}{
+		"spans": {
+			eventType:    v1alpha1.EventTypeOpenTelemetryTracesPush,
+			data:         `[{"name":"a"},{"name":"b"}]`,
+			expectTraces: 1,
+		},
+		"log records": {
+			eventType:  v1alpha1.EventTypeOpenTelemetryLogsPush,
+			data:       `[{"severity":"INFO","body":"hello"}]`,
+			expectLogs: 1,
+		},
+		"invalid span": {
+			eventType: v1alpha1.EventTypeOpenTelemetryTracesPush,
+			data:      `[{"name":"a"},{"kind":"Server"}]`,
+			expectErr: true,
+		},
+		"no collector": {
+			eventType:   v1alpha1.EventTypeOpenTelemetryLogsPush,
+			data:        `[{"severity":"INFO","body":"hello"}]`,
+			noCollector: true,
+			expectErr:   true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			logger := zap.NewNop().Sugar()
+
+			replier, err := targetce.New("test", logger)
+			require.NoError(t, err)
+
+			exp := &fakeCollector{}
+
+			a := &opentelemetryAdapter{
+				replier:  replier,
+				logger:   logger,
+				resource: newResource("test"),
+			}
+			if !tc.noCollector {
+				a.otlp = exp
+			}
+
+			event := cloudevents.NewEvent()
+			event.SetID("0")
+			event.SetSource("test")
+			event.SetType(tc.eventType)
+			require.NoError(t, event.SetData(cloudevents.ApplicationJSON, []byte(tc.data)))
+
+			out, res := a.dispatch(context.Background(), event)
+			assert.True(t, cloudevents.IsACK(res))
+
+			if tc.expectErr {
+				assert.NotNil(t, out, "Expected an error response")
+			} else {
+				assert.Nil(t, out, "Unexpected response: %v", out)
+			}
+
+			assert.Len(t, exp.traces, tc.expectTraces)
+			assert.Len(t, exp.logs, tc.expectLogs)
+		})
+	}
+}
+
+// fakeCollector is a local OTLP receiver which records the requests it
+// receives. It also implements otlpExporter to record requests without a
+// network round trip.
+type fakeCollector struct {
+	coltracepb.UnimplementedTraceServiceServer
+
+	traces  []*coltracepb.ExportTraceServiceRequest
+	logs    []*collogspb.ExportLogsServiceRequest
+	headers map[string][]string
+}
+
+var _ otlpExporter = (*fakeCollector)(nil)
+
+// Export implements coltracepb.TraceServiceServer.
+func (c *fakeCollector) Export(ctx context.Context, req *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	c.recordMetadata(ctx)
+	c.traces = append(c.traces, req)
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
+
+// logsServer wraps a fakeCollector to implement collogspb.LogsServiceServer,
+// which Export method conflicts with the one of coltracepb.TraceServiceServer.
+type logsServer struct {
+	collogspb.UnimplementedLogsServiceServer
+	c *fakeCollector
+}
+
+// Export implements collogspb.LogsServiceServer.
+func (s *logsServer) Export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	s.c.recordMetadata(ctx)
+	s.c.logs = append(s.c.logs, req)
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+func (c *fakeCollector) recordMetadata(ctx context.Context) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	c.recordHeaders(md)
+}
+
+func (c *fakeCollector) recordHeaders(h map[string][]string) {
+	if c.headers == nil {
+		c.headers = make(map[string][]string)
+	}
+	for k, v := range h {
+		c.headers[k] = append(c.headers[k], v...)
+	}
+}
+
+// serveGRPC serves the OTLP/gRPC protocol and returns the address of the
+// server.
+func (c *fakeCollector) serveGRPC(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+	coltracepb.RegisterTraceServiceServer(srv, c)
+	collogspb.RegisterLogsServiceServer(srv, &logsServer{c: c})
+
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+// serveHTTP serves the OTLP/HTTP protocol and returns the URL of the server.
+func (c *fakeCollector) serveHTTP(t *testing.T) string {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/x-protobuf" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch r.URL.Path {
+		case otlpHTTPTracesPath:
+			req := &coltracepb.ExportTraceServiceRequest{}
+			if err := proto.Unmarshal(body, req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			c.traces = append(c.traces, req)
+		case otlpHTTPLogsPath:
+			req := &collogspb.ExportLogsServiceRequest{}
+			if err := proto.Unmarshal(body, req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			c.logs = append(c.logs, req)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		c.recordHeaders(map[string][]string{
+			"x-api-key": r.Header.Values("X-Api-Key"),
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv.URL
+}
+
+// ExportTraces implements otlpExporter.
+func (c *fakeCollector) ExportTraces(_ context.Context, req *coltracepb.ExportTraceServiceRequest) error {
+	c.traces = append(c.traces, req)
+	return nil
+}
+
+// ExportLogs implements otlpExporter.
+func (c *fakeCollector) ExportLogs(_ context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	c.logs = append(c.logs, req)
+	return nil
+}
+
+// Close implements otlpExporter.
+func (*fakeCollector) Close() error {
+	return nil
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
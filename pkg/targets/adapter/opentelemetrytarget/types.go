@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -94,3 +95,55 @@ type Measure struct {
 
 	Attributes []Attribute
 }
+
+// Span is a span which is exported to an OTLP collector.
+type Span struct {
+	Name string
+	Kind string
+
+	// Hex-encoded identifiers. The trace and span IDs are generated when
+	// they are not provided.
+	TraceID      string
+	SpanID       string
+	ParentSpanID string
+
+	StartTime time.Time
+	EndTime   time.Time
+
+	Status *SpanStatus
+
+	Attributes []Attribute
+}
+
+// SpanStatus is the status of a Span.
+type SpanStatus struct {
+	Code    string
+	Message string
+}
+
+// LogRecord is a log record which is exported to an OTLP collector.
+type LogRecord struct {
+	Time     time.Time
+	Severity string
+	Body     json.RawMessage
+
+	// Hex-encoded identifiers of the span the log record is correlated
+	// with, if any.
+	TraceID string
+	SpanID  string
+
+	Attributes []Attribute
+}
+
+// parseAttributes parses the given list of attributes.
+func parseAttributes(attrs []Attribute) ([]attribute.KeyValue, error) {
+	kvs := make([]attribute.KeyValue, len(attrs))
+	for i := range attrs {
+		kv, err := attrs[i].ParseAttribute()
+		if err != nil {
+			return nil, err
+		}
+		kvs[i] = *kv
+	}
+	return kvs, nil
+}
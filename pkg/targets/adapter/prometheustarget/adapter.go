@@ -0,0 +1,234 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheustarget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+// metricsPath is the URL path series are exposed at.
+const metricsPath = "/metrics"
+
+// metricsShutdownTimeout is the time the metrics server is given to complete
+// in-flight scrapes when the adapter stops.
+const metricsShutdownTimeout = 5 * time.Second
+
+var _ pkgadapter.Adapter = (*prometheusAdapter)(nil)
+
+type prometheusAdapter struct {
+	store         *store
+	metricsServer *http.Server
+
+	// Series are pushed to a remote-write endpoint, when one is
+	// configured.
+	remoteWriter  *remoteWriter
+	writeInterval time.Duration
+
+	replier  *targetce.Replier
+	ceClient cloudevents.Client
+	logger   *zap.SugaredLogger
+}
+
+// NewTarget adapter implementation
+func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
+	logger := logging.FromContext(ctx)
+
+	env := envAcc.(*envAccessor)
+
+	replier, err := targetce.New(env.Component, logger.Named("replier"),
+		targetce.ReplierWithStatefulHeaders(env.BridgeIdentifier),
+		targetce.ReplierWithPayloadPolicy(targetce.PayloadPolicy(env.CloudEventPayloadPolicy)))
+	if err != nil {
+		logger.Panicf("Error creating CloudEvents replier: %v", err)
+	}
+
+	s := newStore(env.Instruments, env.MaxSeriesPerInstrument, env.MaxLabelsPerSeries)
+
+	reg := prometheus.NewRegistry()
+	if err := reg.Register(s); err != nil {
+		logger.Panicf("Error registering series collector: %v", err)
+	}
+
+	a := &prometheusAdapter{
+		store: s,
+		// Series are served on their own port, since the CloudEvents
+		// client provided by the adapter framework only handles events.
+		metricsServer: &http.Server{
+			Addr:              net.JoinHostPort("", strconv.Itoa(env.MetricsPort)),
+			Handler:           metricsHandler(reg),
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+
+		replier:  replier,
+		ceClient: ceClient,
+		logger:   logger,
+	}
+
+	if env.RemoteWriteURL != "" {
+		a.remoteWriter = &remoteWriter{
+			url:         env.RemoteWriteURL,
+			bearerToken: env.RemoteWriteBearerToken,
+			username:    env.RemoteWriteUsername,
+			password:    env.RemoteWritePassword,
+			client:      &http.Client{Timeout: env.RemoteWriteTimeout},
+		}
+		a.writeInterval = env.RemoteWriteInterval
+	}
+
+	return a
+}
+
+// Start is a blocking function and will return if an error occurs
+// or the context is cancelled.
+func (a *prometheusAdapter) Start(ctx context.Context) error {
+	a.logger.Info("Starting Prometheus adapter")
+
+	if a.remoteWriter != nil {
+		done := make(chan struct{})
+		defer func() { <-done }()
+
+		go func() {
+			defer close(done)
+			a.runRemoteWrite(ctx)
+		}()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	metricsErrCh := make(chan error, 1)
+	go func() {
+		defer cancel()
+		metricsErrCh <- a.serveMetrics(ctx)
+	}()
+
+	err := a.ceClient.StartReceiver(ctx, a.dispatch)
+	cancel()
+
+	if metricsErr := <-metricsErrCh; metricsErr != nil {
+		return metricsErr
+	}
+	return err
+}
+
+// runRemoteWrite pushes series to the remote-write endpoint at every interval
+// until the context is cancelled, then pushes them one last time.
+func (a *prometheusAdapter) runRemoteWrite(ctx context.Context) {
+	t := time.NewTicker(a.writeInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Use a fresh context to avoid losing the observations
+			// recorded since the last push.
+			a.pushSeries(context.Background())
+			return
+
+		case <-t.C:
+			a.pushSeries(ctx)
+		}
+	}
+}
+
+// pushSeries pushes all recorded series to the remote-write endpoint.
+func (a *prometheusAdapter) pushSeries(ctx context.Context) {
+	wr := a.store.writeRequest(time.Now())
+	if len(wr.Timeseries) == 0 {
+		return
+	}
+
+	if err := a.remoteWriter.Write(ctx, wr); err != nil && !errors.Is(err, context.Canceled) {
+		a.logger.Errorw("Error pushing series to the remote-write endpoint", zap.Error(err))
+	}
+}
+
+// metricsHandler returns a handler which exposes the series collected by the
+// given registry at metricsPath.
+func metricsHandler(reg *prometheus.Registry) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, promhttp.HandlerFor(reg, promhttp.HandlerOpts{
+		ErrorHandling: promhttp.ContinueOnError,
+	}))
+	return mux
+}
+
+// serveMetrics exposes recorded series in the Prometheus exposition format
+// until the context is cancelled.
+func (a *prometheusAdapter) serveMetrics(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- a.metricsServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("serving series: %w", err)
+
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), metricsShutdownTimeout)
+		defer cancel()
+
+		if err := a.metricsServer.Shutdown(shutdownCtx); err != nil {
+			return fmt.Errorf("shutting down series server: %w", err)
+		}
+		return nil
+	}
+}
+
+func (a *prometheusAdapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	if typ := event.Type(); typ != v1alpha1.EventTypeOpenTelemetryMetricsPush {
+		return a.replier.Error(&event, targetce.ErrorCodeEventContext, fmt.Errorf("event type %q is not supported", typ), nil)
+	}
+
+	ms := []Measure{}
+	if err := event.DataAs(&ms); err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	errs := []error{}
+	for i := range ms {
+		if err := a.store.record(&ms[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == 0 {
+		return a.replier.Ack()
+	}
+
+	return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, kerrors.NewAggregate(errs), nil)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheustarget
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// EnvAccessorCtor for configuration parameters
+func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
+	return &envAccessor{}
+}
+
+// Instruments is a list of instruments decoded from their JSON representation.
+type Instruments []v1alpha1.Instrument
+
+// Decode implements envconfig.Decoder.
+func (is *Instruments) Decode(value string) error {
+	if err := json.Unmarshal([]byte(value), is); err != nil {
+		return err
+	}
+
+	names := make(map[string]struct{}, len(*is))
+
+	for _, i := range *is {
+		if !model.IsValidMetricName(model.LabelValue(i.Name)) {
+			return fmt.Errorf("%q is not a valid Prometheus metric name", i.Name)
+		}
+		if _, ok := names[i.Name]; ok {
+			return fmt.Errorf("instrument %q is defined more than once", i.Name)
+		}
+		names[i.Name] = struct{}{}
+
+		switch i.Instrument {
+		case v1alpha1.InstrumentKindCounter,
+			v1alpha1.InstrumentKindUpDownCounter,
+			v1alpha1.InstrumentKindHistogram:
+		case "":
+			return errors.New("metrics must include an instrument kind")
+		default:
+			return fmt.Errorf("unknown metric instrument kind %q", i.Instrument)
+		}
+
+		switch i.Number {
+		case v1alpha1.NumberKindInt64,
+			v1alpha1.NumberKindFloat64:
+		case "":
+			return errors.New("metrics must include a number kind")
+		default:
+			return fmt.Errorf("unknown metric number kind %q", i.Number)
+		}
+	}
+
+	return nil
+}
+
+type envAccessor struct {
+	pkgadapter.EnvConfig
+
+	// Instruments series are recorded for.
+	Instruments Instruments `envconfig:"PROMETHEUS_INSTRUMENTS" required:"true"`
+
+	// Port series are exposed at for scraping.
+	MetricsPort int `envconfig:"PROMETHEUS_METRICS_PORT" default:"9090"`
+
+	// Remote-write endpoint parameters. Series are only pushed when an
+	// URL is set.
+	RemoteWriteURL         string        `envconfig:"PROMETHEUS_REMOTE_WRITE_URL"`
+	RemoteWriteBearerToken string        `envconfig:"PROMETHEUS_REMOTE_WRITE_BEARER_TOKEN"`
+	RemoteWriteUsername    string        `envconfig:"PROMETHEUS_REMOTE_WRITE_USERNAME"`
+	RemoteWritePassword    string        `envconfig:"PROMETHEUS_REMOTE_WRITE_PASSWORD"`
+	RemoteWriteInterval    time.Duration `envconfig:"PROMETHEUS_REMOTE_WRITE_INTERVAL" default:"30s"`
+	RemoteWriteTimeout     time.Duration `envconfig:"PROMETHEUS_REMOTE_WRITE_TIMEOUT" default:"10s"`
+
+	// Cardinality limits.
+	MaxSeriesPerInstrument int `envconfig:"PROMETHEUS_MAX_SERIES_PER_INSTRUMENT" default:"1000"`
+	MaxLabelsPerSeries     int `envconfig:"PROMETHEUS_MAX_LABELS_PER_SERIES" default:"16"`
+
+	// BridgeIdentifier is the name of the bridge workflow this target is part of
+	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
+	// CloudEvents responses parametrization
+	CloudEventPayloadPolicy string `envconfig:"EVENTS_PAYLOAD_POLICY" default:"error"`
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheustarget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeInstruments(t *testing.T) {
+	testCases := map[string]struct {
+		value       string
+		expectedErr string
+	}{
+		"valid instruments": {
+			value: `[{"name":"requests_total","instrument":"Counter","number":"Int64"},` +
+				`{"name":"request_duration_seconds","instrument":"Histogram","number":"Float64"}]`,
+		},
+		"invalid metric name": {
+			value:       `[{"name":"requests-total","instrument":"Counter","number":"Int64"}]`,
+			expectedErr: `"requests-total" is not a valid Prometheus metric name`,
+		},
+		"duplicate name": {
+			value: `[{"name":"requests_total","instrument":"Counter","number":"Int64"},` +
+				`{"name":"requests_total","instrument":"Histogram","number":"Int64"}]`,
+			expectedErr: `instrument "requests_total" is defined more than once`,
+		},
+		"missing instrument": {
+			value:       `[{"name":"requests_total","number":"Int64"}]`,
+			expectedErr: "metrics must include an instrument kind",
+		},
+		"unknown instrument": {
+			value:       `[{"name":"requests_total","instrument":"Gauge","number":"Int64"}]`,
+			expectedErr: `unknown metric instrument kind "Gauge"`,
+		},
+		"unknown number": {
+			value:       `[{"name":"requests_total","instrument":"Counter","number":"Int24"}]`,
+			expectedErr: `unknown metric number kind "Int24"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			is := Instruments{}
+			err := is.Decode(tc.value)
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
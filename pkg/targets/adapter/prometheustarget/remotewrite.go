@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheustarget
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/prompb"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// remoteWriteVersion is the version of the remote-write protocol implemented
+// by the remoteWriter.
+const remoteWriteVersion = "0.1.0"
+
+// remoteWriter pushes series to an endpoint which implements the Prometheus
+// remote-write protocol.
+type remoteWriter struct {
+	url string
+
+	bearerToken string
+	username    string
+	password    string
+
+	client *http.Client
+}
+
+// Write pushes the given request to the remote-write endpoint.
+func (w *remoteWriter) Write(ctx context.Context, wr *prompb.WriteRequest) error {
+	data, err := wr.Marshal()
+	if err != nil {
+		return fmt.Errorf("serializing write request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(snappy.Encode(nil, data)))
+	if err != nil {
+		return fmt.Errorf("creating HTTP request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", remoteWriteVersion)
+
+	switch {
+	case w.bearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+w.bearerToken)
+	case w.username != "":
+		req.SetBasicAuth(w.username, w.password)
+	}
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending write request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("remote-write endpoint responded with status %d: %s", res.StatusCode, bytes.TrimSpace(body))
+	}
+
+	return nil
+}
+
+// writeRequest returns a remote-write request containing a sample for each
+// series recorded in the store, timestamped at the given time.
+func (s *store) writeRequest(t time.Time) *prompb.WriteRequest {
+	ts := t.UnixNano() / int64(time.Millisecond)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wr := &prompb.WriteRequest{}
+
+	for _, ins := range s.instruments {
+		for _, ser := range ins.series {
+			if ins.Instrument.Instrument != v1alpha1.InstrumentKindHistogram {
+				wr.Timeseries = append(wr.Timeseries, newTimeSeries(ins.Name, ser.labels, ser.value, ts))
+				continue
+			}
+
+			for i, b := range s.buckets {
+				le := label{name: model.BucketLabel, value: strconv.FormatFloat(b, 'f', -1, 64)}
+				wr.Timeseries = append(wr.Timeseries,
+					newTimeSeries(ins.Name+"_bucket", ser.labels, float64(ser.buckets[i]), ts, le))
+			}
+			le := label{name: model.BucketLabel, value: "+Inf"}
+			wr.Timeseries = append(wr.Timeseries,
+				newTimeSeries(ins.Name+"_bucket", ser.labels, float64(ser.count), ts, le),
+				newTimeSeries(ins.Name+"_sum", ser.labels, ser.sum, ts),
+				newTimeSeries(ins.Name+"_count", ser.labels, float64(ser.count), ts),
+			)
+		}
+	}
+
+	return wr
+}
+
+// newTimeSeries returns a remote-write time series with a single sample.
+// Labels are sorted by name, as mandated by the remote-write protocol.
+func newTimeSeries(name string, lbls []label, value float64, ts int64, extra ...label) prompb.TimeSeries {
+	pl := make([]prompb.Label, 0, len(lbls)+len(extra)+1)
+
+	pl = append(pl, prompb.Label{Name: model.MetricNameLabel, Value: name})
+	for _, l := range lbls {
+		pl = append(pl, prompb.Label{Name: l.name, Value: l.value})
+	}
+	for _, l := range extra {
+		pl = append(pl, prompb.Label{Name: l.name, Value: l.value})
+	}
+
+	sort.Slice(pl, func(i, j int) bool { return pl[i].Name < pl[j].Name })
+
+	return prompb.TimeSeries{
+		Labels:  pl,
+		Samples: []prompb.Sample{{Value: value, Timestamp: ts}},
+	}
+}
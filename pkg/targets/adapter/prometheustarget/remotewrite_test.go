@@ -0,0 +1,105 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheustarget
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteWrite(t *testing.T) {
+	s := newTestStore()
+	require.NoError(t, s.record(&Measure{
+		Name:       "requests_total",
+		Value:      json.RawMessage("3"),
+		Attributes: []Attribute{{Key: "code", Value: json.RawMessage(`"200"`)}},
+	}))
+	require.NoError(t, s.record(&Measure{Name: "duration_seconds", Value: json.RawMessage("0.2")}))
+
+	var received prompb.WriteRequest
+	var header http.Header
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		data, err := snappy.Decode(nil, body)
+		require.NoError(t, err)
+		require.NoError(t, received.Unmarshal(data))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	w := &remoteWriter{
+		url:         srv.URL,
+		bearerToken: "s3cr3t",
+		client:      srv.Client(),
+	}
+
+	now := time.Unix(1650000000, 0)
+	require.NoError(t, w.Write(context.Background(), s.writeRequest(now)))
+
+	assert.Equal(t, "Bearer s3cr3t", header.Get("Authorization"))
+	assert.Equal(t, "snappy", header.Get("Content-Encoding"))
+	assert.Equal(t, remoteWriteVersion, header.Get("X-Prometheus-Remote-Write-Version"))
+
+	series := make(map[string]float64, len(received.Timeseries))
+	for _, ts := range received.Timeseries {
+		require.Len(t, ts.Samples, 1)
+		assert.Equal(t, now.UnixNano()/int64(time.Millisecond), ts.Samples[0].Timestamp)
+
+		var id string
+		for _, l := range ts.Labels {
+			id += l.Name + "=" + l.Value + ","
+		}
+		series[id] = ts.Samples[0].Value
+	}
+
+	assert.Equal(t, 3.0, series["__name__=requests_total,code=200,"])
+	assert.Equal(t, 1.0, series["__name__=duration_seconds_bucket,le=0.25,"])
+	assert.Equal(t, 0.0, series["__name__=duration_seconds_bucket,le=0.1,"])
+	assert.Equal(t, 1.0, series["__name__=duration_seconds_bucket,le=+Inf,"])
+	assert.Equal(t, 0.2, series["__name__=duration_seconds_sum,"])
+	assert.Equal(t, 1.0, series["__name__=duration_seconds_count,"])
+}
+
+func TestRemoteWriteError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "out of order sample", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	w := &remoteWriter{
+		url:    srv.URL,
+		client: srv.Client(),
+	}
+
+	err := w.Write(context.Background(), &prompb.WriteRequest{})
+	assert.EqualError(t, err, "remote-write endpoint responded with status 400: out of order sample")
+}
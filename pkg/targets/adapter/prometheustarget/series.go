@@ -0,0 +1,354 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheustarget
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// Measure is an observation recorded for an instrument.
+type Measure struct {
+	Name  string
+	Kind  string
+	Value json.RawMessage
+
+	Attributes []Attribute
+}
+
+// Attribute is a property of a Measure, recorded as a label of its series.
+// The Type is accepted for compatibility with the OpenTelemetry schema, but
+// all label values are strings.
+type Attribute struct {
+	Key   string
+	Type  string
+	Value json.RawMessage
+}
+
+// errSeriesLimit is returned when recording a measure would exceed the
+// maximum number of series of an instrument.
+var errSeriesLimit = errors.New("series limit reached")
+
+// label is a name/value pair identifying a series.
+type label struct {
+	name  string
+	value string
+}
+
+// series holds the state of a single labeled series.
+type series struct {
+	labels []label
+
+	// Value of counters and up-down counters.
+	value float64
+
+	// State of histograms. Bucket counts are cumulative.
+	count   uint64
+	sum     float64
+	buckets []uint64
+}
+
+// instrument holds the series recorded for a configured instrument.
+type instrument struct {
+	v1alpha1.Instrument
+
+	// series indexed by their labels signature.
+	series map[string]*series
+}
+
+// store records measures into series and exposes them to Prometheus.
+type store struct {
+	mu sync.Mutex
+
+	instruments map[string]*instrument
+	buckets     []float64
+
+	maxSeries int
+	maxLabels int
+}
+
+var _ prometheus.Collector = (*store)(nil)
+
+// newStore returns a store for the given instruments.
+func newStore(is []v1alpha1.Instrument, maxSeries, maxLabels int) *store {
+	s := &store{
+		instruments: make(map[string]*instrument, len(is)),
+		buckets:     prometheus.DefBuckets,
+		maxSeries:   maxSeries,
+		maxLabels:   maxLabels,
+	}
+
+	for _, i := range is {
+		s.instruments[i.Name] = &instrument{
+			Instrument: i,
+			series:     make(map[string]*series),
+		}
+	}
+
+	return s
+}
+
+// record applies a measure to the series matching its attributes.
+func (s *store) record(m *Measure) error {
+	ins, ok := s.instruments[m.Name]
+	if !ok {
+		return fmt.Errorf("instrument %q has not been configured", m.Name)
+	}
+	if m.Kind != "" && m.Kind != string(ins.Instrument.Instrument) {
+		return fmt.Errorf("undefined kind %q for instrument %q", m.Kind, m.Name)
+	}
+
+	value, err := parseValue(m.Value, ins.Number)
+	if err != nil {
+		return fmt.Errorf("value of %q: %w", m.Name, err)
+	}
+	if ins.Instrument.Instrument == v1alpha1.InstrumentKindCounter && value < 0 {
+		return fmt.Errorf("value of counter %q must not be negative", m.Name)
+	}
+
+	lbls, err := s.parseLabels(m.Attributes, ins.Instrument.Instrument)
+	if err != nil {
+		return fmt.Errorf("attributes of %q: %w", m.Name, err)
+	}
+
+	key := signature(lbls)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ser, ok := ins.series[key]
+	if !ok {
+		if s.maxSeries > 0 && len(ins.series) >= s.maxSeries {
+			return fmt.Errorf("instrument %q: %w (%d)", m.Name, errSeriesLimit, s.maxSeries)
+		}
+		ser = &series{labels: lbls}
+		if ins.Instrument.Instrument == v1alpha1.InstrumentKindHistogram {
+			ser.buckets = make([]uint64, len(s.buckets))
+		}
+		ins.series[key] = ser
+	}
+
+	switch ins.Instrument.Instrument {
+	case v1alpha1.InstrumentKindHistogram:
+		ser.count++
+		ser.sum += value
+		for i, b := range s.buckets {
+			if value <= b {
+				ser.buckets[i]++
+			}
+		}
+	default:
+		ser.value += value
+	}
+
+	return nil
+}
+
+// parseLabels converts the given attributes into a set of labels sorted by name.
+// Attributes with an empty value are omitted, as Prometheus considers empty
+// labels to be absent.
+func (s *store) parseLabels(attrs []Attribute, kind v1alpha1.InstrumentKind) ([]label, error) {
+	lbls := make([]label, 0, len(attrs))
+	names := make(map[string]struct{}, len(attrs))
+
+	for _, a := range attrs {
+		if !model.LabelName(a.Key).IsValid() || strings.HasPrefix(a.Key, model.ReservedLabelPrefix) {
+			return nil, fmt.Errorf("%q is not a valid label name", a.Key)
+		}
+		if kind == v1alpha1.InstrumentKindHistogram && a.Key == model.BucketLabel {
+			return nil, fmt.Errorf("label name %q is reserved for histograms", a.Key)
+		}
+		if _, ok := names[a.Key]; ok {
+			return nil, fmt.Errorf("attribute %q is set more than once", a.Key)
+		}
+		names[a.Key] = struct{}{}
+
+		v, err := labelValue(a.Value)
+		if err != nil {
+			return nil, fmt.Errorf("value of attribute %q: %w", a.Key, err)
+		}
+		if v == "" {
+			continue
+		}
+
+		lbls = append(lbls, label{name: a.Key, value: v})
+	}
+
+	if s.maxLabels > 0 && len(lbls) > s.maxLabels {
+		return nil, fmt.Errorf("%d labels exceed the limit of %d labels per series", len(lbls), s.maxLabels)
+	}
+
+	sort.Slice(lbls, func(i, j int) bool { return lbls[i].name < lbls[j].name })
+
+	return lbls, nil
+}
+
+// Describe implements prometheus.Collector.
+//
+// The label names of an instrument depend on the measures recorded for it,
+// so no description is sent and the store is registered as an unchecked
+// collector.
+func (*store) Describe(chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector.
+func (s *store) Collect(ch chan<- prometheus.Metric) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, ins := range s.instruments {
+		if len(ins.series) == 0 {
+			continue
+		}
+
+		// All series of a metric family must share the same label
+		// names. Series which lack one of these labels get an empty
+		// value, which Prometheus treats as an absent label.
+		names := ins.labelNames()
+
+		var help string
+		if ins.Description != nil {
+			help = *ins.Description
+		}
+		desc := prometheus.NewDesc(ins.Name, help, names, nil)
+
+		for _, ser := range ins.series {
+			values := ser.labelValues(names)
+
+			switch ins.Instrument.Instrument {
+			case v1alpha1.InstrumentKindCounter:
+				ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, ser.value, values...)
+
+			case v1alpha1.InstrumentKindUpDownCounter:
+				ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, ser.value, values...)
+
+			case v1alpha1.InstrumentKindHistogram:
+				buckets := make(map[float64]uint64, len(s.buckets))
+				for i, b := range s.buckets {
+					buckets[b] = ser.buckets[i]
+				}
+				ch <- prometheus.MustNewConstHistogram(desc, ser.count, ser.sum, buckets, values...)
+			}
+		}
+	}
+}
+
+// labelNames returns the sorted union of the label names of all series of
+// the instrument.
+func (ins *instrument) labelNames() []string {
+	set := make(map[string]struct{})
+	for _, ser := range ins.series {
+		for _, l := range ser.labels {
+			set[l.name] = struct{}{}
+		}
+	}
+
+	names := make([]string, 0, len(set))
+	for n := range set {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// labelValues returns the values of the series' labels in the order of the
+// given names.
+func (ser *series) labelValues(names []string) []string {
+	values := make([]string, len(names))
+
+	i := 0
+	for _, l := range ser.labels {
+		for names[i] != l.name {
+			i++
+		}
+		values[i] = l.value
+	}
+
+	return values
+}
+
+// signature returns a string which uniquely identifies a sorted set of labels.
+func signature(lbls []label) string {
+	var sb strings.Builder
+	for _, l := range lbls {
+		sb.WriteString(l.name)
+		sb.WriteByte(model.SeparatorByte)
+		sb.WriteString(l.value)
+		sb.WriteByte(model.SeparatorByte)
+	}
+	return sb.String()
+}
+
+// parseValue parses the value of a measure according to the number kind of
+// its instrument.
+func parseValue(raw json.RawMessage, kind v1alpha1.NumberKind) (float64, error) {
+	switch kind {
+	case v1alpha1.NumberKindInt64:
+		var v int64
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return 0, fmt.Errorf("cannot be parsed as int64: %w", err)
+		}
+		return float64(v), nil
+
+	default:
+		var v float64
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return 0, fmt.Errorf("cannot be parsed as float64: %w", err)
+		}
+		return v, nil
+	}
+}
+
+// labelValue returns the string representation of an attribute value.
+// Strings are used verbatim, other scalar values are formatted.
+func labelValue(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 {
+		return "", errors.New("field 'value' must be included in attributes")
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return "", err
+	}
+
+	switch tv := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return tv, nil
+	case bool:
+		return strconv.FormatBool(tv), nil
+	case json.Number:
+		return tv.String(), nil
+	default:
+		return "", errors.New("only scalar values can be used as labels")
+	}
+}
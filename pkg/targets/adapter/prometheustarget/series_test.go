@@ -0,0 +1,194 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheustarget
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"knative.dev/pkg/ptr"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+func TestRecord(t *testing.T) {
+	testCases := map[string]struct {
+		measures    []string
+		expectedErr string
+		expected    string
+	}{
+		"counter with labels": {
+			measures: []string{
+				`{"name":"requests_total","value":2,"attributes":[{"key":"code","value":"200"}]}`,
+				`{"name":"requests_total","value":3,"attributes":[{"key":"code","value":"200"}]}`,
+				`{"name":"requests_total","value":1,"attributes":[{"key":"code","value":"500"},{"key":"retry","value":true}]}`,
+			},
+			expected: `
+# HELP requests_total Total requests
+# TYPE requests_total counter
+requests_total{code="200",retry=""} 5
+requests_total{code="500",retry="true"} 1
+`,
+		},
+		"up-down counter": {
+			measures: []string{
+				`{"name":"in_flight","value":4}`,
+				`{"name":"in_flight","kind":"UpDownCounter","value":-1.5}`,
+			},
+			expected: `
+# HELP in_flight In flight requests
+# TYPE in_flight gauge
+in_flight 2.5
+`,
+		},
+		"histogram": {
+			measures: []string{
+				`{"name":"duration_seconds","value":0.2}`,
+				`{"name":"duration_seconds","value":3}`,
+			},
+			expected: `
+# HELP duration_seconds Request duration
+# TYPE duration_seconds histogram
+duration_seconds_bucket{le="0.005"} 0
+duration_seconds_bucket{le="0.01"} 0
+duration_seconds_bucket{le="0.025"} 0
+duration_seconds_bucket{le="0.05"} 0
+duration_seconds_bucket{le="0.1"} 0
+duration_seconds_bucket{le="0.25"} 1
+duration_seconds_bucket{le="0.5"} 1
+duration_seconds_bucket{le="1"} 1
+duration_seconds_bucket{le="2.5"} 1
+duration_seconds_bucket{le="5"} 2
+duration_seconds_bucket{le="10"} 2
+duration_seconds_bucket{le="+Inf"} 2
+duration_seconds_sum 3.2
+duration_seconds_count 2
+`,
+		},
+		"unknown instrument": {
+			measures:    []string{`{"name":"unknown","value":1}`},
+			expectedErr: `instrument "unknown" has not been configured`,
+		},
+		"mismatched kind": {
+			measures:    []string{`{"name":"requests_total","kind":"Histogram","value":1}`},
+			expectedErr: `undefined kind "Histogram" for instrument "requests_total"`,
+		},
+		"non integer value": {
+			measures:    []string{`{"name":"requests_total","value":1.5}`},
+			expectedErr: `value of "requests_total": cannot be parsed as int64`,
+		},
+		"negative counter": {
+			measures:    []string{`{"name":"requests_total","value":-1}`},
+			expectedErr: `value of counter "requests_total" must not be negative`,
+		},
+		"reserved label": {
+			measures:    []string{`{"name":"duration_seconds","value":1,"attributes":[{"key":"le","value":"1"}]}`},
+			expectedErr: `attributes of "duration_seconds": label name "le" is reserved for histograms`,
+		},
+		"invalid label": {
+			measures:    []string{`{"name":"requests_total","value":1,"attributes":[{"key":"__name__","value":"x"}]}`},
+			expectedErr: `attributes of "requests_total": "__name__" is not a valid label name`,
+		},
+		"too many labels": {
+			measures: []string{`{"name":"requests_total","value":1,"attributes":[` +
+				`{"key":"a","value":"1"},{"key":"b","value":"2"},{"key":"c","value":"3"}]}`},
+			expectedErr: `attributes of "requests_total": 3 labels exceed the limit of 2 labels per series`,
+		},
+		"series limit": {
+			measures: []string{
+				`{"name":"requests_total","value":1,"attributes":[{"key":"code","value":"200"}]}`,
+				`{"name":"requests_total","value":1,"attributes":[{"key":"code","value":"404"}]}`,
+				`{"name":"requests_total","value":1,"attributes":[{"key":"code","value":"500"}]}`,
+			},
+			expectedErr: `instrument "requests_total": series limit reached (2)`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			s := newTestStore()
+
+			var err error
+			for _, m := range tc.measures {
+				var ms Measure
+				require.NoError(t, json.Unmarshal([]byte(m), &ms))
+
+				if err = s.record(&ms); err != nil {
+					break
+				}
+			}
+
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.NoError(t, testutil.CollectAndCompare(s, strings.NewReader(tc.expected)))
+		})
+	}
+}
+
+func TestServeMetrics(t *testing.T) {
+	s := newTestStore()
+	require.NoError(t, s.record(&Measure{Name: "requests_total", Value: json.RawMessage("1")}))
+
+	reg := prometheus.NewRegistry()
+	require.NoError(t, reg.Register(s))
+
+	h := metricsHandler(reg)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, metricsPath, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "requests_total 1")
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// newTestStore returns a store with one instrument of each kind and low
+// cardinality limits.
+func newTestStore() *store {
+	return newStore([]v1alpha1.Instrument{{
+		Name:        "requests_total",
+		Description: ptr.String("Total requests"),
+		Instrument:  v1alpha1.InstrumentKindCounter,
+		Number:      v1alpha1.NumberKindInt64,
+	}, {
+		Name:        "in_flight",
+		Description: ptr.String("In flight requests"),
+		Instrument:  v1alpha1.InstrumentKindUpDownCounter,
+		Number:      v1alpha1.NumberKindFloat64,
+	}, {
+		Name:        "duration_seconds",
+		Description: ptr.String("Request duration"),
+		Instrument:  v1alpha1.InstrumentKindHistogram,
+		Number:      v1alpha1.NumberKindFloat64,
+	}}, 2, 2)
+}
@@ -18,7 +18,11 @@ package sendgridtarget
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -28,14 +32,17 @@ import (
 	"knative.dev/pkg/logging"
 
 	"github.com/sendgrid/sendgrid-go"
-	"github.com/sendgrid/sendgrid-go/helpers/mail"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/fs"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 )
 
+// Timeout of requests downloading attachments.
+const attachmentDownloadTimeout = 30 * time.Second
+
 // NewTarget adapter implementation
 func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
 	logger := logging.FromContext(ctx)
@@ -58,27 +65,67 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		logger.Panicf("Error creating CloudEvents replier: %v", err)
 	}
 
-	return &sendGridAdapter{
-		client:           sendgrid.NewSendClient(env.APIKey),
+	a := &sendGridAdapter{
 		defaultFromEmail: env.FromEmail,
 		defaultToEmail:   env.ToEmail,
 		defaultFromName:  env.FromName,
 		defaultToName:    env.ToName,
 		defaultMessage:   env.Message,
 		defaultSubject:   env.Subject,
-		replier:          replier,
-		ceClient:         ceClient,
-		logger:           logger,
+
+		defaultTemplateID:   env.TemplateID,
+		categoryAttributes:  env.CategoryAttributes,
+		customArgAttributes: env.CustomArgAttributes,
+
+		attachmentURLs: newURLAllowlist(env.AttachmentAllowedHosts, env.AttachmentAllowedSchemes),
+
+		replier:  replier,
+		ceClient: ceClient,
+		logger:   logger,
 
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
 
+	a.httpClient = &http.Client{
+		Timeout:       attachmentDownloadTimeout,
+		CheckRedirect: a.attachmentURLs.checkRedirect,
+	}
+
+	switch {
+	case env.APIKeyFile != "":
+		if a.fw, err = fs.NewWatcher(logger); err != nil {
+			logger.Panicw("Could not create a file watcher", zap.Error(err))
+		}
+
+		err := fs.WatchContent(a.fw, env.APIKeyFile, func(apiKey []byte) {
+			logger.Info("Using API key read from " + env.APIKeyFile)
+			a.setClient(sendgrid.NewSendClient(string(apiKey)))
+		}, logger)
+		if err != nil {
+			logger.Panicw("Could not watch the API key file", zap.Error(err))
+		}
+
+	case env.APIKey != "":
+		a.client = sendgrid.NewSendClient(env.APIKey)
+
+	default:
+		logger.Panic("One of SENDGRID_API_KEY or SENDGRID_API_KEY_FILE must be set")
+	}
+
+	return a
 }
 
 var _ pkgadapter.Adapter = (*sendGridAdapter)(nil)
 
 type sendGridAdapter struct {
-	client           *sendgrid.Client
+	// client is replaced whenever the API key is updated. Events which
+	// are being processed at that time keep using the previous client.
+	clientMu sync.RWMutex
+	client   *sendgrid.Client
+
+	// fw watches the file containing the API key, if set.
+	fw fs.FileWatcher
+
 	defaultFromEmail string
 	defaultToEmail   string
 	defaultFromName  string
@@ -86,6 +133,16 @@ type sendGridAdapter struct {
 	defaultMessage   string
 	defaultSubject   string
 
+	defaultTemplateID   string
+	categoryAttributes  []string
+	customArgAttributes map[string]string
+
+	// attachmentURLs restricts the URLs attachments are downloaded from.
+	// Downloads are disabled when nil.
+	attachmentURLs *urlAllowlist
+	// httpClient downloads attachments referenced by URL.
+	httpClient *http.Client
+
 	replier  *targetce.Replier
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
@@ -96,15 +153,30 @@ type sendGridAdapter struct {
 // Returns if stopCh is closed or Send() returns an error.
 func (a *sendGridAdapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting SendGrid adapter")
+
+	if a.fw != nil {
+		a.fw.Start(ctx)
+	}
+
 	return a.ceClient.StartReceiver(ctx, a.dispatch)
 }
 
-func (a *sendGridAdapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+func (a *sendGridAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	switch typ := event.Type(); typ {
 	case v1alpha1.EventTypeSendGridEmailSend:
-		resp, err := a.sendEmail(event)
+		email, err := a.defaultMessageData(event)
 		if err != nil {
-			return a.replier.Error(&event, targetce.ErrorCodeEventContext, err, nil)
+			return a.replier.Error(&event, targetce.ErrorCodeRequestParsing,
+				fmt.Errorf("error processing incoming message: %w", err), nil)
+		}
+
+		if err := email.validate(a.attachmentURLs); err != nil {
+			return a.replier.Error(&event, targetce.ErrorCodeRequestValidation, err, nil)
+		}
+
+		resp, err := a.sendEmail(ctx, &event, email)
+		if err != nil {
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 		}
 
 		return a.replier.Ok(&event, resp)
@@ -114,30 +186,49 @@ func (a *sendGridAdapter) dispatch(event cloudevents.Event) (*cloudevents.Event,
 	}
 }
 
-func (a *sendGridAdapter) sendEmail(event cloudevents.Event) (string, error) {
-	email, err := a.defaultMessageData(event)
+func (a *sendGridAdapter) sendEmail(ctx context.Context, event *cloudevents.Event, email *EmailMessage) (string, error) {
+	msg, err := a.buildMessage(ctx, event, email)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(msg)
 	if err != nil {
-		return "", fmt.Errorf("error processing incoming message")
+		return "", fmt.Errorf("serializing message: %w", err)
 	}
 
-	from := mail.NewEmail(email.FromName, email.FromEmail)
-	to := mail.NewEmail(email.ToName, email.ToEmail)
-	plainTextContent := email.Message //plain text content is not being sent in the message body.
-	htmlContent := "<strong>" + email.Message + "</strong>"
-	message := mail.NewSingleEmail(from, email.Subject, to, plainTextContent, htmlContent)
-	response, err := a.client.Send(message)
+	// The request is copied so that concurrent events don't share the
+	// same request body.
+	req := a.getClient().Request
+	req.Body = body
+
+	response, err := sendgrid.MakeRequestWithContext(ctx, req)
 	if err != nil {
 		return "", err
 	}
 
-	if response.StatusCode != 202 {
-		return "", fmt.Errorf(response.Body)
+	if response.StatusCode != http.StatusAccepted {
+		return "", fmt.Errorf("SendGrid API returned status %d: %s", response.StatusCode, response.Body)
 	}
 
 	a.logger.Infof("Sent message: %s", event.String())
 	return response.Body, nil
 }
 
+// getClient returns the current SendGrid client.
+func (a *sendGridAdapter) getClient() *sendgrid.Client {
+	a.clientMu.RLock()
+	defer a.clientMu.RUnlock()
+	return a.client
+}
+
+// setClient replaces the current SendGrid client.
+func (a *sendGridAdapter) setClient(c *sendgrid.Client) {
+	a.clientMu.Lock()
+	defer a.clientMu.Unlock()
+	a.client = c
+}
+
 // defaultMessageData populates our default data.
 func (a *sendGridAdapter) defaultMessageData(e cloudevents.Event) (*EmailMessage, error) {
 	m := &EmailMessage{}
@@ -163,5 +254,8 @@ func (a *sendGridAdapter) defaultMessageData(e cloudevents.Event) (*EmailMessage
 	if m.Subject == "" {
 		m.Subject = a.defaultSubject
 	}
+	if m.TemplateID == "" {
+		m.TemplateID = a.defaultTemplateID
+	}
 	return m, nil
 }
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sendgridtarget
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// defaultAllowedScheme is the only URL scheme attachments can be downloaded
+// with when no scheme is explicitly allowed.
+const defaultAllowedScheme = "https"
+
+// maxAttachmentRedirects is the maximum number of redirects followed while
+// downloading an attachment.
+const maxAttachmentRedirects = 5
+
+// urlAllowlist restricts the URLs attachments can be downloaded from.
+type urlAllowlist struct {
+	schemes map[string]struct{}
+	// hosts matched exactly
+	hosts map[string]struct{}
+	// parent domains of allowed subdomains, with a leading "."
+	domains []string
+}
+
+// newURLAllowlist returns an allowlist of the given hosts and schemes, or nil
+// if no host is allowed. Hosts prefixed with "*." match all their subdomains.
+func newURLAllowlist(hosts, schemes []string) *urlAllowlist {
+	if len(hosts) == 0 {
+		return nil
+	}
+
+	if len(schemes) == 0 {
+		schemes = []string{defaultAllowedScheme}
+	}
+
+	l := &urlAllowlist{
+		schemes: make(map[string]struct{}, len(schemes)),
+		hosts:   make(map[string]struct{}, len(hosts)),
+	}
+
+	for _, s := range schemes {
+		l.schemes[strings.ToLower(s)] = struct{}{}
+	}
+
+	for _, h := range hosts {
+		h = strings.ToLower(h)
+		if strings.HasPrefix(h, "*.") {
+			l.domains = append(l.domains, h[1:])
+			continue
+		}
+		l.hosts[h] = struct{}{}
+	}
+
+	return l
+}
+
+// check returns an error if the given URL isn't allowed.
+func (l *urlAllowlist) check(u *url.URL) error {
+	if l == nil {
+		return errors.New("attachments referenced by URL are not allowed")
+	}
+
+	if _, ok := l.schemes[strings.ToLower(u.Scheme)]; !ok {
+		return fmt.Errorf("URL scheme %q is not allowed", u.Scheme)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if _, ok := l.hosts[host]; ok {
+		return nil
+	}
+	for _, d := range l.domains {
+		if strings.HasSuffix(host, d) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("URL host %q is not allowed", u.Hostname())
+}
+
+// checkRedirect verifies that the redirects followed while downloading an
+// attachment only lead to allowed URLs. It satisfies the signature of
+// http.Client.CheckRedirect.
+func (l *urlAllowlist) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxAttachmentRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxAttachmentRedirects)
+	}
+	return l.check(req.URL)
+}
@@ -27,7 +27,7 @@ func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
 
 type envAccessor struct {
 	pkgadapter.EnvConfig
-	APIKey    string `envconfig:"SENDGRID_API_KEY" required:"true"`
+	APIKey    string `envconfig:"SENDGRID_API_KEY"`
 	FromEmail string `envconfig:"SENDGRID_DEFAULT_FROM_EMAIL" required:"false"`
 	ToEmail   string `envconfig:"SENDGRID_DEFAULT_TO_EMAIL" required:"false"`
 	FromName  string `envconfig:"SENDGRID_DEFAULT_FROM_NAME" required:"false"`
@@ -35,6 +35,27 @@ type envAccessor struct {
 	Message   string `envconfig:"SENDGRID_DEFAULT_MESSAGE" required:"false"`
 	Subject   string `envconfig:"SENDGRID_DEFAULT_SUBJECT" required:"false"`
 
+	// TemplateID is the ID of the dynamic template used when events don't set one.
+	TemplateID string `envconfig:"SENDGRID_DEFAULT_TEMPLATE_ID" required:"false"`
+
+	// CategoryAttributes are the CloudEvent context attributes whose
+	// values are added to the categories of sent emails.
+	CategoryAttributes []string `envconfig:"SENDGRID_CATEGORY_ATTRIBUTES" required:"false"`
+	// CustomArgAttributes maps custom arguments of sent emails to the
+	// CloudEvent context attributes their values are read from.
+	CustomArgAttributes map[string]string `envconfig:"SENDGRID_CUSTOM_ARG_ATTRIBUTES" required:"false"`
+
+	// AttachmentAllowedHosts are the hosts attachments referenced by URL
+	// can be downloaded from. Such attachments are rejected when empty.
+	AttachmentAllowedHosts []string `envconfig:"SENDGRID_ATTACHMENT_ALLOWED_HOSTS" required:"false"`
+	// AttachmentAllowedSchemes are the URL schemes attachments can be
+	// downloaded with. Only "https" is allowed when empty.
+	AttachmentAllowedSchemes []string `envconfig:"SENDGRID_ATTACHMENT_ALLOWED_SCHEMES" required:"false"`
+
+	// APIKeyFile is the path of a file containing the SendGrid API key,
+	// watched for changes. Takes precedence over APIKey.
+	APIKeyFile string `envconfig:"SENDGRID_API_KEY_FILE"`
+
 	// BridgeIdentifier is the name of the bridge workflow this target is part of
 	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
 
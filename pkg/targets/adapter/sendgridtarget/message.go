@@ -0,0 +1,329 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sendgridtarget
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/sendgrid/sendgrid-go/helpers/mail"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/eventvalue"
+)
+
+const (
+	// Maximum number of personalizations accepted by SendGrid in a single request.
+	maxPersonalizations = 1000
+	// Maximum size of an attachment downloaded from a URL. SendGrid
+	// rejects emails larger than 30MB, attachments included.
+	maxAttachmentSize = 20 << 20
+	// Name of downloaded attachments whose URL doesn't contain a file name.
+	defaultAttachmentFilename = "attachment"
+)
+
+// sendGridMessage is the body of a request to the SendGrid v3 Mail Send
+// API. It extends the message type of the SendGrid library with properties
+// that library doesn't support.
+type sendGridMessage struct {
+	*mail.SGMailV3
+	ReplyToList []*mail.Email `json:"reply_to_list,omitempty"`
+}
+
+// validate verifies that the email can be sent. Attachments referenced by URL
+// must be allowed by the given allowlist.
+func (m *EmailMessage) validate(urls *urlAllowlist) error {
+	if len(m.Personalizations) == 0 && m.ToEmail == "" {
+		return errors.New("the email has no recipient")
+	}
+
+	if len(m.Personalizations) > maxPersonalizations {
+		return fmt.Errorf("the number of personalizations exceeds the maximum of %d", maxPersonalizations)
+	}
+	for i, p := range m.Personalizations {
+		if len(p.To) == 0 {
+			return fmt.Errorf("personalization %d has no recipient", i)
+		}
+	}
+
+	for i, a := range m.Attachments {
+		if err := a.validate(urls); err != nil {
+			return fmt.Errorf("invalid attachment %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validate verifies that the content of the attachment can be resolved.
+func (a *Attachment) validate(urls *urlAllowlist) error {
+	switch {
+	case a.Content == "" && a.URL == "":
+		return errors.New("one of content or URL must be set")
+	case a.Content != "" && a.URL != "":
+		return errors.New("only one of content or URL can be set")
+	case a.Content != "":
+		if a.Filename == "" {
+			return errors.New("a file name is required")
+		}
+		if _, err := base64.StdEncoding.DecodeString(a.Content); err != nil {
+			return fmt.Errorf("content is not base64 encoded: %w", err)
+		}
+	default:
+		u, err := url.Parse(a.URL)
+		if err != nil {
+			return fmt.Errorf("invalid URL: %w", err)
+		}
+		if err := urls.check(u); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildMessage returns the SendGrid message corresponding to the given
+// email. Attachments referenced by URL are downloaded.
+func (a *sendGridAdapter) buildMessage(ctx context.Context, event *cloudevents.Event,
+	email *EmailMessage) (*sendGridMessage, error) {
+
+	m := mail.NewV3Mail()
+	m.SetFrom(mail.NewEmail(email.FromName, email.FromEmail))
+	m.Subject = email.Subject
+
+	// Dynamic templates carry their own content.
+	if email.TemplateID != "" {
+		m.SetTemplateID(email.TemplateID)
+	} else {
+		m.AddContent(
+			mail.NewContent("text/plain", email.Message),
+			mail.NewContent("text/html", "<strong>"+email.Message+"</strong>"),
+		)
+	}
+
+	m.AddPersonalizations(personalizations(email)...)
+
+	msg := &sendGridMessage{SGMailV3: m}
+
+	switch len(email.ReplyTo) {
+	case 0:
+	case 1:
+		m.SetReplyTo(newEmail(email.ReplyTo[0]))
+	default:
+		msg.ReplyToList = newEmails(email.ReplyTo)
+	}
+
+	for i := range email.Attachments {
+		att, err := a.attachment(ctx, &email.Attachments[i])
+		if err != nil {
+			return nil, fmt.Errorf("resolving attachment %d: %w", i, err)
+		}
+		m.AddAttachment(att)
+	}
+
+	if categories := a.categories(event, email.Categories); len(categories) > 0 {
+		m.AddCategories(categories...)
+	}
+
+	for name, value := range a.customArgs(event, email.CustomArgs) {
+		m.SetCustomArg(name, value)
+	}
+
+	return msg, nil
+}
+
+// personalizations returns the personalizations of the given email. An email
+// without personalizations is sent to its single default recipient.
+func personalizations(email *EmailMessage) []*mail.Personalization {
+	if len(email.Personalizations) == 0 {
+		p := mail.NewPersonalization()
+		p.AddTos(mail.NewEmail(email.ToName, email.ToEmail))
+		p.AddCCs(newEmails(email.CC)...)
+		p.AddBCCs(newEmails(email.BCC)...)
+		for k, v := range email.TemplateData {
+			p.SetDynamicTemplateData(k, v)
+		}
+		return []*mail.Personalization{p}
+	}
+
+	ps := make([]*mail.Personalization, len(email.Personalizations))
+
+	for i, ep := range email.Personalizations {
+		cc, bcc := ep.CC, ep.BCC
+		if cc == nil {
+			cc = email.CC
+		}
+		if bcc == nil {
+			bcc = email.BCC
+		}
+
+		p := mail.NewPersonalization()
+		p.AddTos(newEmails(ep.To)...)
+		p.AddCCs(newEmails(cc)...)
+		p.AddBCCs(newEmails(bcc)...)
+		p.Subject = ep.Subject
+
+		for k, v := range email.TemplateData {
+			p.SetDynamicTemplateData(k, v)
+		}
+		for k, v := range ep.TemplateData {
+			p.SetDynamicTemplateData(k, v)
+		}
+		for k, v := range ep.CustomArgs {
+			p.SetCustomArg(k, v)
+		}
+
+		ps[i] = p
+	}
+
+	return ps
+}
+
+// attachment returns the SendGrid attachment corresponding to the given
+// attachment, downloading its content if it is referenced by an allowed URL.
+func (a *sendGridAdapter) attachment(ctx context.Context, att *Attachment) (*mail.Attachment, error) {
+	sgAtt := mail.NewAttachment()
+	sgAtt.SetFilename(att.Filename)
+	sgAtt.SetType(att.Type)
+	sgAtt.SetDisposition(att.Disposition)
+	sgAtt.SetContentID(att.ContentID)
+
+	if att.Content != "" {
+		sgAtt.SetContent(att.Content)
+		return sgAtt, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, att.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating HTTP request: %w", err)
+	}
+	if err := a.attachmentURLs.check(req.URL); err != nil {
+		return nil, err
+	}
+
+	res, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("downloading attachment: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading attachment: unexpected status %s", res.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxAttachmentSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading attachment: %w", err)
+	}
+	if len(data) > maxAttachmentSize {
+		return nil, fmt.Errorf("attachment exceeds the maximum size of %d bytes", maxAttachmentSize)
+	}
+
+	sgAtt.SetContent(base64.StdEncoding.EncodeToString(data))
+
+	if att.Filename == "" {
+		sgAtt.SetFilename(defaultAttachmentFilename)
+		if name := path.Base(req.URL.Path); name != "/" && name != "." {
+			sgAtt.SetFilename(name)
+		}
+	}
+	if att.Type == "" {
+		sgAtt.SetType(res.Header.Get("Content-Type"))
+	}
+
+	return sgAtt, nil
+}
+
+// categories returns the categories of the email, completed with the values
+// of the configured CloudEvent attributes. Duplicates are ignored.
+func (a *sendGridAdapter) categories(event *cloudevents.Event, categories []string) []string {
+	var all []string
+	seen := make(map[string]struct{})
+
+	add := func(c string) {
+		if _, ok := seen[c]; ok || c == "" {
+			return
+		}
+		seen[c] = struct{}{}
+		all = append(all, c)
+	}
+
+	for _, attr := range a.categoryAttributes {
+		if v, ok := attributeValue(event, attr); ok {
+			add(v)
+		}
+	}
+	for _, c := range categories {
+		add(c)
+	}
+
+	return all
+}
+
+// customArgs returns the custom arguments of the email, completed with the
+// values of the configured CloudEvent attributes. Custom arguments set in
+// the email take precedence.
+func (a *sendGridAdapter) customArgs(event *cloudevents.Event, args map[string]string) map[string]string {
+	all := make(map[string]string, len(a.customArgAttributes)+len(args))
+
+	for name, attr := range a.customArgAttributes {
+		if v, ok := attributeValue(event, attr); ok {
+			all[name] = v
+		}
+	}
+	for name, v := range args {
+		all[name] = v
+	}
+
+	return all
+}
+
+// attributeValue returns the value of the given CloudEvent context
+// attribute, if the event has it.
+func attributeValue(event *cloudevents.Event, attr string) (string, bool) {
+	s, err := eventvalue.New(&attr, nil)
+	if err != nil {
+		return "", false
+	}
+
+	v, err := s.String(event)
+	if err != nil {
+		return "", false
+	}
+	return v, true
+}
+
+// newEmail returns the SendGrid email address of the given recipient.
+func newEmail(r Recipient) *mail.Email {
+	return mail.NewEmail(r.Name, r.Email)
+}
+
+// newEmails returns the SendGrid email addresses of the given recipients.
+func newEmails(rs []Recipient) []*mail.Email {
+	emails := make([]*mail.Email, len(rs))
+	for i, r := range rs {
+		emails[i] = newEmail(r)
+	}
+	return emails
+}
@@ -0,0 +1,503 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sendgridtarget
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	logtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/sendgrid/sendgrid-go"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+func TestEmailValidation(t *testing.T) {
+	urls := newURLAllowlist([]string{"example.com"}, nil)
+
+	testCases := map[string]struct {
+		email          EmailMessage
+		disallowedURLs bool
+		expectErr      string
+	}{
+		"Default recipient": {
+			email: EmailMessage{ToEmail: "jane@example.com"},
+		},
+		"Personalizations": {
+			email: EmailMessage{Personalizations: []Personalization{
+				{To: []Recipient{{Email: "jane@example.com"}}},
+				{To: []Recipient{{Email: "john@example.com"}}},
+			}},
+		},
+		"No recipient": {
+			email:     EmailMessage{},
+			expectErr: "the email has no recipient",
+		},
+		"Personalization without recipient": {
+			email: EmailMessage{Personalizations: []Personalization{
+				{To: []Recipient{{Email: "jane@example.com"}}},
+				{Subject: "Hi"},
+			}},
+			expectErr: "personalization 1 has no recipient",
+		},
+		"Too many personalizations": {
+			email: EmailMessage{
+				Personalizations: make([]Personalization, maxPersonalizations+1),
+			},
+			expectErr: "the number of personalizations exceeds the maximum of 1000",
+		},
+		"Attachment without content": {
+			email: EmailMessage{
+				ToEmail:     "jane@example.com",
+				Attachments: []Attachment{{Filename: "a.txt"}},
+			},
+			expectErr: "invalid attachment 0: one of content or URL must be set",
+		},
+		"Attachment with content and URL": {
+			email: EmailMessage{
+				ToEmail:     "jane@example.com",
+				Attachments: []Attachment{{Filename: "a.txt", Content: "aGk=", URL: "https://example.com/a.txt"}},
+			},
+			expectErr: "invalid attachment 0: only one of content or URL can be set",
+		},
+		"Attachment with invalid content": {
+			email: EmailMessage{
+				ToEmail:     "jane@example.com",
+				Attachments: []Attachment{{Filename: "a.txt", Content: "not base64!"}},
+			},
+			expectErr: "invalid attachment 0: content is not base64 encoded",
+		},
+		"Attachment with unsupported URL": {
+			email: EmailMessage{
+				ToEmail:     "jane@example.com",
+				Attachments: []Attachment{{URL: "file:///etc/passwd"}},
+			},
+			expectErr: `invalid attachment 0: URL scheme "file" is not allowed`,
+		},
+		"Attachment with allowed URL": {
+			email: EmailMessage{
+				ToEmail:     "jane@example.com",
+				Attachments: []Attachment{{URL: "https://example.com/a.txt"}},
+			},
+		},
+		"Attachment with disallowed host": {
+			email: EmailMessage{
+				ToEmail:     "jane@example.com",
+				Attachments: []Attachment{{URL: "https://169.254.169.254/latest/meta-data/"}},
+			},
+			expectErr: `invalid attachment 0: URL host "169.254.169.254" is not allowed`,
+		},
+		"Attachment with URL when downloads are disabled": {
+			email: EmailMessage{
+				ToEmail:     "jane@example.com",
+				Attachments: []Attachment{{URL: "https://example.com/a.txt"}},
+			},
+			disallowedURLs: true,
+			expectErr:      "invalid attachment 0: attachments referenced by URL are not allowed",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			allowlist := urls
+			if tc.disallowedURLs {
+				allowlist = nil
+			}
+
+			err := tc.email.validate(allowlist)
+			if tc.expectErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectErr)
+		})
+	}
+}
+
+func TestBuildMessage(t *testing.T) {
+	testCases := map[string]struct {
+		email EmailMessage
+		// JSON representation of the expected message
+		expect string
+	}{
+		"Plain message": {
+			email: EmailMessage{
+				FromEmail: "noreply@example.com",
+				ToEmail:   "jane@example.com",
+				ToName:    "Jane",
+				Subject:   "Hello",
+				Message:   "Hi there",
+			},
+			expect: `{
+				"from": {"email": "noreply@example.com"},
+				"subject": "Hello",
+				"personalizations": [{"to": [{"name": "Jane", "email": "jane@example.com"}]}],
+				"content": [
+					{"type": "text/plain", "value": "Hi there"},
+					{"type": "text/html", "value": "<strong>Hi there</strong>"}
+				],
+				"categories": ["io.triggermesh.sendgrid.email.send"],
+				"custom_args": {"event_id": "123"}
+			}`,
+		},
+		"Dynamic template with CC, BCC and reply-to list": {
+			email: EmailMessage{
+				FromEmail:    "noreply@example.com",
+				ToEmail:      "jane@example.com",
+				TemplateID:   "d-123",
+				TemplateData: map[string]interface{}{"order": "abc"},
+				CC:           []Recipient{{Email: "cc@example.com"}},
+				BCC:          []Recipient{{Email: "bcc@example.com"}},
+				ReplyTo:      []Recipient{{Email: "support@example.com"}, {Email: "sales@example.com"}},
+				Categories:   []string{"orders", "io.triggermesh.sendgrid.email.send"},
+				CustomArgs:   map[string]string{"event_id": "overridden"},
+			},
+			expect: `{
+				"from": {"email": "noreply@example.com"},
+				"template_id": "d-123",
+				"personalizations": [{
+					"to": [{"email": "jane@example.com"}],
+					"cc": [{"email": "cc@example.com"}],
+					"bcc": [{"email": "bcc@example.com"}],
+					"dynamic_template_data": {"order": "abc"}
+				}],
+				"reply_to_list": [{"email": "support@example.com"}, {"email": "sales@example.com"}],
+				"categories": ["io.triggermesh.sendgrid.email.send", "orders"],
+				"custom_args": {"event_id": "overridden"}
+			}`,
+		},
+		"Personalizations": {
+			email: EmailMessage{
+				FromEmail:    "noreply@example.com",
+				TemplateID:   "d-123",
+				TemplateData: map[string]interface{}{"shop": "TriggerMesh", "order": "none"},
+				CC:           []Recipient{{Email: "cc@example.com"}},
+				ReplyTo:      []Recipient{{Email: "support@example.com"}},
+				Personalizations: []Personalization{{
+					To:           []Recipient{{Email: "jane@example.com", Name: "Jane"}},
+					TemplateData: map[string]interface{}{"order": "abc"},
+					CustomArgs:   map[string]string{"customer": "jane"},
+				}, {
+					To:      []Recipient{{Email: "john@example.com"}},
+					CC:      []Recipient{},
+					Subject: "Your order",
+				}},
+			},
+			expect: `{
+				"from": {"email": "noreply@example.com"},
+				"template_id": "d-123",
+				"personalizations": [{
+					"to": [{"name": "Jane", "email": "jane@example.com"}],
+					"cc": [{"email": "cc@example.com"}],
+					"custom_args": {"customer": "jane"},
+					"dynamic_template_data": {"shop": "TriggerMesh", "order": "abc"}
+				}, {
+					"to": [{"email": "john@example.com"}],
+					"subject": "Your order",
+					"dynamic_template_data": {"shop": "TriggerMesh", "order": "none"}
+				}],
+				"reply_to": {"email": "support@example.com"},
+				"categories": ["io.triggermesh.sendgrid.email.send"],
+				"custom_args": {"event_id": "123"}
+			}`,
+		},
+		"Base64 attachment": {
+			email: EmailMessage{
+				FromEmail:  "noreply@example.com",
+				ToEmail:    "jane@example.com",
+				TemplateID: "d-123",
+				Attachments: []Attachment{{
+					Filename: "hello.txt",
+					Content:  "aGVsbG8=",
+					Type:     "text/plain",
+				}},
+			},
+			expect: `{
+				"from": {"email": "noreply@example.com"},
+				"template_id": "d-123",
+				"personalizations": [{"to": [{"email": "jane@example.com"}]}],
+				"attachments": [{"filename": "hello.txt", "content": "aGVsbG8=", "type": "text/plain"}],
+				"categories": ["io.triggermesh.sendgrid.email.send"],
+				"custom_args": {"event_id": "123"}
+			}`,
+		},
+	}
+
+	a := &sendGridAdapter{
+		categoryAttributes:  []string{"type", "nonexistent"},
+		customArgAttributes: map[string]string{"event_id": "id"},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			event := newEvent(t, nil)
+
+			msg, err := a.buildMessage(context.Background(), &event, &tc.email)
+			require.NoError(t, err)
+
+			b, err := json.Marshal(msg)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expect, string(b))
+		})
+	}
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	const fileContent = "id,name\n1,Jane\n"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/files/report.csv", "/":
+			w.Header().Set("Content-Type", "text/csv")
+			_, _ = io.WriteString(w, fileContent)
+		case "/large":
+			_, _ = w.Write(make([]byte, maxAttachmentSize+1))
+		case "/redirect":
+			// "localhost" is not part of the allowed hosts
+			http.Redirect(w, r, "http://"+strings.Replace(r.Host, "127.0.0.1", "localhost", 1)+"/files/report.csv", http.StatusFound)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	a := &sendGridAdapter{
+		attachmentURLs: newURLAllowlist([]string{"127.0.0.1"}, []string{"http"}),
+		httpClient:     srv.Client(),
+	}
+	a.httpClient.CheckRedirect = a.attachmentURLs.checkRedirect
+
+	testCases := map[string]struct {
+		attachment Attachment
+
+		expectFilename string
+		expectType     string
+		expectErr      string
+	}{
+		"Default file name and type": {
+			attachment:     Attachment{URL: srv.URL + "/files/report.csv"},
+			expectFilename: "report.csv",
+			expectType:     "text/csv",
+		},
+		"Explicit file name and type": {
+			attachment:     Attachment{URL: srv.URL + "/files/report.csv", Filename: "users.csv", Type: "application/csv"},
+			expectFilename: "users.csv",
+			expectType:     "application/csv",
+		},
+		"URL without file name": {
+			attachment:     Attachment{URL: srv.URL},
+			expectFilename: defaultAttachmentFilename,
+			expectType:     "text/csv",
+		},
+		"Not found": {
+			attachment: Attachment{URL: srv.URL + "/missing"},
+			expectErr:  "unexpected status 404 Not Found",
+		},
+		"Too large": {
+			attachment: Attachment{URL: srv.URL + "/large"},
+			expectErr:  "attachment exceeds the maximum size",
+		},
+		"Redirect to disallowed host": {
+			attachment: Attachment{URL: srv.URL + "/redirect"},
+			expectErr:  `URL host "localhost" is not allowed`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			att, err := a.attachment(context.Background(), &tc.attachment)
+			if tc.expectErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(fileContent)), att.Content)
+			assert.Equal(t, tc.expectFilename, att.Filename)
+			assert.Equal(t, tc.expectType, att.Type)
+		})
+	}
+}
+
+func TestSendEmail(t *testing.T) {
+	var reqBody map[string]interface{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v3/mail/send", r.URL.Path)
+		assert.Equal(t, "Bearer fake-key", r.Header.Get("Authorization"))
+
+		reqBody = nil
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&reqBody))
+
+		if strings.Contains(reqBody["subject"].(string), "fail") {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"errors":[{"message":"invalid"}]}`)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	client := &sendgrid.Client{Request: sendgrid.GetRequest("fake-key", "/v3/mail/send", srv.URL)}
+	client.Method = http.MethodPost
+
+	replier, err := targetce.New("test", logtesting.TestLogger(t),
+		targetce.ReplierWithStaticResponseType(v1alpha1.EventTypeSendGridEmailSendResponse))
+	require.NoError(t, err)
+
+	a := &sendGridAdapter{
+		client:            client,
+		defaultFromEmail:  "noreply@example.com",
+		defaultTemplateID: "d-default",
+		replier:           replier,
+		logger:            logtesting.TestLogger(t),
+	}
+
+	t.Run("Email is sent", func(t *testing.T) {
+		event := newEvent(t, &EmailMessage{
+			Subject:      "Welcome",
+			TemplateData: map[string]interface{}{"name": "Jane"},
+			Personalizations: []Personalization{
+				{To: []Recipient{{Email: "jane@example.com"}}},
+				{To: []Recipient{{Email: "john@example.com"}}},
+			},
+		})
+
+		out, res := a.dispatch(context.Background(), event)
+		assert.True(t, cloudevents.IsACK(res))
+		require.NotNil(t, out)
+		assert.Equal(t, targetce.ExtensionCategoryValueSuccess, out.Extensions()[targetce.ExtensionCategory])
+
+		assert.Equal(t, "noreply@example.com", reqBody["from"].(map[string]interface{})["email"])
+		assert.Equal(t, "d-default", reqBody["template_id"])
+		assert.Len(t, reqBody["personalizations"], 2)
+	})
+
+	t.Run("Invalid email", func(t *testing.T) {
+		event := newEvent(t, &EmailMessage{Subject: "Welcome"})
+
+		out, _ := a.dispatch(context.Background(), event)
+		require.NotNil(t, out)
+		assertErrorCode(t, out, targetce.ErrorCodeRequestValidation)
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		event := newEvent(t, &EmailMessage{ToEmail: "jane@example.com", Subject: "fail"})
+
+		out, _ := a.dispatch(context.Background(), event)
+		require.NotNil(t, out)
+		assertErrorCode(t, out, targetce.ErrorCodeAdapterProcess)
+	})
+}
+
+// newEvent returns a CloudEvent of type io.triggermesh.sendgrid.email.send
+// with the given email as data.
+func newEvent(t *testing.T, email *EmailMessage) cloudevents.Event {
+	t.Helper()
+
+	event := cloudevents.NewEvent()
+	event.SetID("123")
+	event.SetSource("test")
+	event.SetType(v1alpha1.EventTypeSendGridEmailSend)
+
+	if email != nil {
+		require.NoError(t, event.SetData(cloudevents.ApplicationJSON, email))
+	}
+
+	return event
+}
+
+// assertErrorCode asserts that the given response event reports an error
+// with the given code.
+func assertErrorCode(t *testing.T, event *cloudevents.Event, code string) {
+	t.Helper()
+
+	evErr := &targetce.EventError{}
+	require.NoError(t, event.DataAs(evErr))
+	assert.Equal(t, code, evErr.Code)
+}
+
+func TestURLAllowlist(t *testing.T) {
+	l := newURLAllowlist([]string{"files.example.com", "*.cdn.example.com"}, []string{"https"})
+
+	testCases := map[string]struct {
+		url       string
+		expectErr string
+	}{
+		"Exact host": {
+			url: "https://files.example.com/a.txt",
+		},
+		"Host with port": {
+			url: "https://files.example.com:8443/a.txt",
+		},
+		"Subdomain of wildcard host": {
+			url: "https://eu.cdn.example.com/a.txt",
+		},
+		"Parent of wildcard host": {
+			url:       "https://cdn.example.com/a.txt",
+			expectErr: `URL host "cdn.example.com" is not allowed`,
+		},
+		"Subdomain of exact host": {
+			url:       "https://a.files.example.com/a.txt",
+			expectErr: `URL host "a.files.example.com" is not allowed`,
+		},
+		"Host with allowed suffix": {
+			url:       "https://evilcdn.example.com/a.txt",
+			expectErr: `URL host "evilcdn.example.com" is not allowed`,
+		},
+		"Disallowed scheme": {
+			url:       "http://files.example.com/a.txt",
+			expectErr: `URL scheme "http" is not allowed`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			u, err := url.Parse(tc.url)
+			require.NoError(t, err)
+
+			err = l.check(u)
+			if tc.expectErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expectErr)
+		})
+	}
+
+	assert.Nil(t, newURLAllowlist(nil, []string{"https"}), "downloads enabled without allowed hosts")
+}
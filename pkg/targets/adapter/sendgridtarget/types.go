@@ -27,4 +27,51 @@ type EmailMessage struct {
 	ToName    string     `json:"toname,omitempty"`
 	ToEmail   string     `json:"toemail,omitempty"`
 	Subject   string     `json:"subject,omitempty"`
+
+	// Dynamic template used to render the email, with the data it is
+	// rendered with.
+	TemplateID   string                 `json:"template_id,omitempty"`
+	TemplateData map[string]interface{} `json:"template_data,omitempty"`
+
+	CC      []Recipient `json:"cc,omitempty"`
+	BCC     []Recipient `json:"bcc,omitempty"`
+	ReplyTo []Recipient `json:"reply_to,omitempty"`
+
+	Attachments []Attachment `json:"attachments,omitempty"`
+
+	// Personalizations allow sending the email to multiple recipients in a
+	// single request. When set, ToName and ToEmail are ignored.
+	Personalizations []Personalization `json:"personalizations,omitempty"`
+
+	Categories []string          `json:"categories,omitempty"`
+	CustomArgs map[string]string `json:"custom_args,omitempty"`
+}
+
+// Recipient of an email.
+type Recipient struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+// Attachment of an email. Its content is either provided as a base64
+// encoded string, or downloaded from a URL.
+type Attachment struct {
+	Filename    string `json:"filename,omitempty"`
+	Content     string `json:"content,omitempty"`
+	URL         string `json:"url,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Disposition string `json:"disposition,omitempty"`
+	ContentID   string `json:"content_id,omitempty"`
+}
+
+// Personalization of an email for a group of recipients. Unset CC and BCC
+// default to the ones of the email, and TemplateData is merged with the
+// template data of the email.
+type Personalization struct {
+	To           []Recipient            `json:"to"`
+	CC           []Recipient            `json:"cc,omitempty"`
+	BCC          []Recipient            `json:"bcc,omitempty"`
+	Subject      string                 `json:"subject,omitempty"`
+	TemplateData map[string]interface{} `json:"template_data,omitempty"`
+	CustomArgs   map[string]string      `json:"custom_args,omitempty"`
 }
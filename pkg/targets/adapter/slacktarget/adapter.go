@@ -29,7 +29,9 @@ import (
 	"knative.dev/pkg/logging"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/slacktarget/slack"
 )
 
@@ -61,8 +63,22 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 	// set of OAuth scopes that fit the users needs.
 	catalog := slack.GetFullCatalog(true)
 
+	replier, err := targetce.New(env.Component, logger.Named("replier"),
+		targetce.ReplierWithStatefulHeaders(env.BridgeIdentifier),
+		targetce.ReplierWithPayloadPolicy(targetce.PayloadPolicy(env.CloudEventPayloadPolicy)),
+		targetce.ReplierWithMappedResponseType(map[string]string{
+			v1alpha1.EventTypeSlackPostMessage:   v1alpha1.EventTypeSlackMessageResponse,
+			v1alpha1.EventTypeSlackUpdateMessage: v1alpha1.EventTypeSlackMessageResponse,
+			v1alpha1.EventTypeSlackDeleteMessage: v1alpha1.EventTypeSlackMessageResponse,
+			v1alpha1.EventTypeSlackUploadFile:    v1alpha1.EventTypeSlackFileResponse,
+		}))
+	if err != nil {
+		logger.Panicf("Error creating CloudEvents replier: %v", err)
+	}
+
 	return &slackAdapter{
 		slackClient: slack.NewWebAPIClient(env.Token, apiURL, &http.Client{}, catalog),
+		replier:     replier,
 		ceClient:    ceClient,
 		logger:      logger,
 
@@ -75,6 +91,7 @@ var _ pkgadapter.Adapter = (*slackAdapter)(nil)
 type slackAdapter struct {
 	slackClient slack.WebAPIClient
 
+	replier  *targetce.Replier
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
 
@@ -91,9 +108,24 @@ func (t *slackAdapter) Start(ctx context.Context) error {
 	return nil
 }
 
-func (t *slackAdapter) dispatch(event cloudevents.Event) cloudevents.Result {
-	// Take a cloud event as passed in, and submit a message
+func (t *slackAdapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	switch et := event.Type(); et {
+	case v1alpha1.EventTypeSlackPostMessage:
+		return t.postMessage(&event)
+	case v1alpha1.EventTypeSlackUpdateMessage:
+		return t.updateMessage(&event)
+	case v1alpha1.EventTypeSlackDeleteMessage:
+		return t.deleteMessage(&event)
+	case v1alpha1.EventTypeSlackUploadFile:
+		return t.uploadFile(&event)
+	}
+
+	return nil, t.dispatchWebAPI(event)
+}
 
+// dispatchWebAPI forwards the event's data to the Slack Web API method
+// matching the event type.
+func (t *slackAdapter) dispatchWebAPI(event cloudevents.Event) cloudevents.Result {
 	et := event.Type()
 	if !strings.HasPrefix(et, eventTypePrefix) {
 		t.logger.Errorf("Unsupported event type %q", et)
@@ -29,4 +29,9 @@ type envAccessor struct {
 	pkgadapter.EnvConfig
 
 	Token string `envconfig:"SLACK_TOKEN" required:"true"`
+
+	// BridgeIdentifier is the name of the bridge workflow this target is part of
+	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
+	// CloudEvents responses parametrization
+	CloudEventPayloadPolicy string `envconfig:"EVENTS_PAYLOAD_POLICY" default:"error"`
 }
@@ -0,0 +1,262 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slacktarget
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/slacktarget/slack"
+)
+
+// CloudEvents extensions which correlate events with a Slack message. They are
+// set by the SlackSource on events originating from messages and interactions,
+// and by this target on responses to message operations.
+const (
+	extensionChannel  = "comslackchannel"
+	extensionTS       = "comslackts"
+	extensionThreadTS = "comslackthreadts"
+)
+
+// messageRequest is the payload of a message operation. Fields are kept as raw
+// JSON so that any argument supported by the Slack API is passed through.
+type messageRequest map[string]json.RawMessage
+
+// get returns the value of a string field, or an empty string if the field is
+// not set or is not a string.
+func (r messageRequest) get(field string) string {
+	var v string
+	if raw, ok := r[field]; ok {
+		_ = json.Unmarshal(raw, &v)
+	}
+	return v
+}
+
+// setDefault sets the value of a string field if it is not already set.
+func (r messageRequest) setDefault(field, value string) {
+	if value == "" || r.get(field) != "" {
+		return
+	}
+	r[field], _ = json.Marshal(value)
+}
+
+// validateContent verifies that the request contains a valid message content.
+func (r messageRequest) validateContent() error {
+	if r.get("text") == "" && len(r["blocks"]) == 0 && len(r["attachments"]) == 0 {
+		return errors.New("a message requires a text, blocks or attachments")
+	}
+
+	if blocks, ok := r["blocks"]; ok {
+		if err := slack.ValidateBlocks(blocks); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// require verifies that the given string fields are set.
+func (r messageRequest) require(fields ...string) error {
+	for _, f := range fields {
+		if r.get(f) == "" {
+			return fmt.Errorf("field %q is required", f)
+		}
+	}
+	return nil
+}
+
+// postMessage posts a message. Unless the request specifies otherwise, the
+// message is posted to the channel and thread the event is correlated with.
+func (t *slackAdapter) postMessage(event *cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	req := messageRequest{}
+	if err := event.DataAs(&req); err != nil {
+		return t.replier.Error(event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	req.setDefault("channel", extension(event, extensionChannel))
+	req.setDefault("thread_ts", extension(event, extensionThreadTS))
+
+	if err := req.require("channel"); err != nil {
+		return t.replier.Error(event, targetce.ErrorCodeRequestValidation, err, nil)
+	}
+	if err := req.validateContent(); err != nil {
+		return t.replier.Error(event, targetce.ErrorCodeRequestValidation, err, nil)
+	}
+
+	return t.doMessageOperation(event, "chat.postMessage", req)
+}
+
+// updateMessage updates the message the event is correlated with, unless the
+// request specifies otherwise.
+func (t *slackAdapter) updateMessage(event *cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	req := messageRequest{}
+	if err := event.DataAs(&req); err != nil {
+		return t.replier.Error(event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	req.setDefault("channel", extension(event, extensionChannel))
+	req.setDefault("ts", extension(event, extensionTS))
+
+	if err := req.require("channel", "ts"); err != nil {
+		return t.replier.Error(event, targetce.ErrorCodeRequestValidation, err, nil)
+	}
+	if err := req.validateContent(); err != nil {
+		return t.replier.Error(event, targetce.ErrorCodeRequestValidation, err, nil)
+	}
+
+	return t.doMessageOperation(event, "chat.update", req)
+}
+
+// deleteMessage deletes the message the event is correlated with, unless the
+// request specifies otherwise.
+func (t *slackAdapter) deleteMessage(event *cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	req := messageRequest{}
+	if data := event.Data(); len(data) != 0 {
+		if err := event.DataAs(&req); err != nil {
+			return t.replier.Error(event, targetce.ErrorCodeRequestParsing, err, nil)
+		}
+	}
+
+	req.setDefault("channel", extension(event, extensionChannel))
+	req.setDefault("ts", extension(event, extensionTS))
+
+	if err := req.require("channel", "ts"); err != nil {
+		return t.replier.Error(event, targetce.ErrorCodeRequestValidation, err, nil)
+	}
+
+	return t.doMessageOperation(event, "chat.delete", req)
+}
+
+// uploadFile uploads a file. Unless the request specifies otherwise, the file
+// is shared in the channel and thread the event is correlated with.
+func (t *slackAdapter) uploadFile(event *cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	fu := &slack.FileUpload{}
+	if err := event.DataAs(fu); err != nil {
+		return t.replier.Error(event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	if fu.Channels == "" {
+		fu.Channels = extension(event, extensionChannel)
+	}
+	if fu.ThreadTS == "" {
+		fu.ThreadTS = extension(event, extensionThreadTS)
+	}
+
+	if len(fu.File) == 0 && fu.Content == "" {
+		return t.replier.Error(event, targetce.ErrorCodeRequestValidation,
+			errors.New("a file upload requires a file or a content"), nil)
+	}
+
+	data, err := json.Marshal(fu)
+	if err != nil {
+		return t.replier.Error(event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	res, err := t.callAPI("files.upload", data)
+	if err != nil {
+		return t.replier.Error(event, targetce.ErrorCodeAdapterProcess, err, nil)
+	}
+
+	return t.replier.Ok(event, res, responseWithCorrelation(fu.Channels, "", fu.ThreadTS))
+}
+
+// doMessageOperation calls the given Slack API method with the request, and
+// replies with the Slack API response, correlated with the resulting message.
+func (t *slackAdapter) doMessageOperation(event *cloudevents.Event, method string, req messageRequest) (*cloudevents.Event, cloudevents.Result) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return t.replier.Error(event, targetce.ErrorCodeRequestParsing, err, nil)
+	}
+
+	res, err := t.callAPI(method, data)
+	if err != nil {
+		return t.replier.Error(event, targetce.ErrorCodeAdapterProcess, err, nil)
+	}
+
+	channel, _ := res["channel"].(string)
+	if channel == "" {
+		channel = req.get("channel")
+	}
+	ts, _ := res["ts"].(string)
+	if ts == "" {
+		ts = req.get("ts")
+	}
+
+	// A message which is not part of a thread is the root of its own
+	// thread, which further replies are posted to.
+	threadTS := req.get("thread_ts")
+	if threadTS == "" {
+		threadTS = ts
+	}
+
+	return t.replier.Ok(event, res, responseWithCorrelation(channel, ts, threadTS))
+}
+
+// callAPI calls a Slack API method and returns its response, or an error if
+// the call failed.
+func (t *slackAdapter) callAPI(method string, data []byte) (slack.Response, error) {
+	res, err := t.slackClient.Do(method, data)
+	if err != nil {
+		return nil, fmt.Errorf("calling Slack method %q: %w", method, err)
+	}
+
+	if res.Warning() != "" {
+		t.logger.Warn(res.Warning())
+	}
+
+	if !res.IsOK() {
+		return nil, fmt.Errorf("slack method %q failed: %s", method, res.Error())
+	}
+
+	return res, nil
+}
+
+// responseWithCorrelation sets the extensions which correlate a response with
+// a Slack message.
+func responseWithCorrelation(channel, ts, threadTS string) targetce.EventResponseOption {
+	return func(_, out *cloudevents.Event) error {
+		exts := map[string]string{
+			extensionChannel:  channel,
+			extensionTS:       ts,
+			extensionThreadTS: threadTS,
+		}
+		for k, v := range exts {
+			if v == "" {
+				continue
+			}
+			if err := out.Context.SetExtension(k, v); err != nil {
+				return fmt.Errorf("setting %q extension: %w", k, err)
+			}
+		}
+		return nil
+	}
+}
+
+// extension returns the value of an event's string extension, or an empty
+// string if the extension is not set.
+func extension(event *cloudevents.Event, name string) string {
+	v, ok := event.Extensions()[name]
+	if !ok {
+		return ""
+	}
+	s, _ := v.(string)
+	return s
+}
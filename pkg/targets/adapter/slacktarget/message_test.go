@@ -0,0 +1,257 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slacktarget
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	zapt "go.uber.org/zap/zaptest"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/slacktarget/slack"
+)
+
+func TestMessageOperations(t *testing.T) {
+	testCases := map[string]struct {
+		eventType  string
+		data       string
+		extensions map[string]string
+		response   slack.Response
+
+		expectMethod     string
+		expectRequest    string
+		expectError      string
+		expectExtensions map[string]string
+	}{
+		"post message in originating thread": {
+			eventType: v1alpha1.EventTypeSlackPostMessage,
+			data:      `{"text":"Hello from TriggerMesh!"}`,
+			extensions: map[string]string{
+				extensionChannel:  "C01112A09FT",
+				extensionTS:       "1593446134.003900",
+				extensionThreadTS: "1593446100.000100",
+			},
+			response: slack.Response{"ok": true, "channel": "C01112A09FT", "ts": "1593446200.000200"},
+
+			expectMethod:  "chat.postMessage",
+			expectRequest: `{"channel":"C01112A09FT","text":"Hello from TriggerMesh!","thread_ts":"1593446100.000100"}`,
+			expectExtensions: map[string]string{
+				extensionChannel:  "C01112A09FT",
+				extensionTS:       "1593446200.000200",
+				extensionThreadTS: "1593446100.000100",
+			},
+		},
+		"post message with explicit channel": {
+			eventType: v1alpha1.EventTypeSlackPostMessage,
+			data:      `{"channel":"C999","blocks":[{"type":"section","text":{"type":"mrkdwn","text":"*Hi*"}}]}`,
+			extensions: map[string]string{
+				extensionChannel: "C01112A09FT",
+			},
+			response: slack.Response{"ok": true, "channel": "C999", "ts": "1593446200.000200"},
+
+			expectMethod:  "chat.postMessage",
+			expectRequest: `{"blocks":[{"type":"section","text":{"type":"mrkdwn","text":"*Hi*"}}],"channel":"C999"}`,
+			expectExtensions: map[string]string{
+				extensionChannel:  "C999",
+				extensionTS:       "1593446200.000200",
+				extensionThreadTS: "1593446200.000200",
+			},
+		},
+		"post message without channel": {
+			eventType:   v1alpha1.EventTypeSlackPostMessage,
+			data:        `{"text":"Hello from TriggerMesh!"}`,
+			expectError: `field "channel" is required`,
+		},
+		"post message without content": {
+			eventType:   v1alpha1.EventTypeSlackPostMessage,
+			data:        `{"channel":"C01112A09FT"}`,
+			expectError: "a message requires a text, blocks or attachments",
+		},
+		"post message with invalid blocks": {
+			eventType:   v1alpha1.EventTypeSlackPostMessage,
+			data:        `{"channel":"C01112A09FT","blocks":[{"type":"header","text":{"type":"mrkdwn","text":"Hi"}}]}`,
+			expectError: `block 0: text: text object must be of type "plain_text"`,
+		},
+		"post message rejected by Slack": {
+			eventType: v1alpha1.EventTypeSlackPostMessage,
+			data:      `{"channel":"C01112A09FT","text":"Hello"}`,
+			response:  slack.Response{"ok": false, "error": "channel_not_found"},
+
+			expectMethod:  "chat.postMessage",
+			expectRequest: `{"channel":"C01112A09FT","text":"Hello"}`,
+			expectError:   `slack method "chat.postMessage" failed: channel_not_found`,
+		},
+		"update correlated message": {
+			eventType: v1alpha1.EventTypeSlackUpdateMessage,
+			data:      `{"text":"Updated"}`,
+			extensions: map[string]string{
+				extensionChannel: "C01112A09FT",
+				extensionTS:      "1593446134.003900",
+			},
+			response: slack.Response{"ok": true, "channel": "C01112A09FT", "ts": "1593446134.003900"},
+
+			expectMethod:  "chat.update",
+			expectRequest: `{"channel":"C01112A09FT","text":"Updated","ts":"1593446134.003900"}`,
+			expectExtensions: map[string]string{
+				extensionChannel:  "C01112A09FT",
+				extensionTS:       "1593446134.003900",
+				extensionThreadTS: "1593446134.003900",
+			},
+		},
+		"update without timestamp": {
+			eventType:   v1alpha1.EventTypeSlackUpdateMessage,
+			data:        `{"channel":"C01112A09FT","text":"Updated"}`,
+			expectError: `field "ts" is required`,
+		},
+		"delete correlated message without data": {
+			eventType: v1alpha1.EventTypeSlackDeleteMessage,
+			extensions: map[string]string{
+				extensionChannel: "C01112A09FT",
+				extensionTS:      "1593446134.003900",
+			},
+			response: slack.Response{"ok": true, "channel": "C01112A09FT", "ts": "1593446134.003900"},
+
+			expectMethod:  "chat.delete",
+			expectRequest: `{"channel":"C01112A09FT","ts":"1593446134.003900"}`,
+			expectExtensions: map[string]string{
+				extensionChannel:  "C01112A09FT",
+				extensionTS:       "1593446134.003900",
+				extensionThreadTS: "1593446134.003900",
+			},
+		},
+		"upload file in originating thread": {
+			eventType: v1alpha1.EventTypeSlackUploadFile,
+			data:      `{"content":"a,b\n1,2","filename":"data.csv"}`,
+			extensions: map[string]string{
+				extensionChannel:  "C01112A09FT",
+				extensionThreadTS: "1593446100.000100",
+			},
+			response: slack.Response{"ok": true, "file": map[string]interface{}{"id": "F0123"}},
+
+			expectMethod:  "files.upload",
+			expectRequest: `{"channels":"C01112A09FT","content":"a,b\n1,2","filename":"data.csv","thread_ts":"1593446100.000100"}`,
+			expectExtensions: map[string]string{
+				extensionChannel:  "C01112A09FT",
+				extensionThreadTS: "1593446100.000100",
+			},
+		},
+		"upload file without content": {
+			eventType:   v1alpha1.EventTypeSlackUploadFile,
+			data:        `{"filename":"data.csv"}`,
+			expectError: "a file upload requires a file or a content",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			client := &fakeWebAPIClient{response: tc.response}
+			a := newTestAdapter(t, client)
+
+			event := cloudevents.NewEvent()
+			event.SetID("1234")
+			event.SetSource("test.source")
+			event.SetType(tc.eventType)
+			if tc.data != "" {
+				require.NoError(t, event.SetData(cloudevents.ApplicationJSON, []byte(tc.data)))
+			}
+			for k, v := range tc.extensions {
+				event.SetExtension(k, v)
+			}
+
+			out, res := a.dispatch(event)
+			require.True(t, cloudevents.IsACK(res))
+			require.NotNil(t, out)
+
+			assert.Equal(t, tc.expectMethod, client.method)
+			if tc.expectRequest != "" {
+				assert.JSONEq(t, tc.expectRequest, string(client.data))
+			}
+
+			if tc.expectError != "" {
+				assert.Equal(t, targetce.ExtensionCategoryValueError, out.Extensions()[targetce.ExtensionCategory])
+				eventErr := &targetce.EventError{}
+				require.NoError(t, out.DataAs(eventErr))
+				assert.Equal(t, tc.expectError, eventErr.Description)
+				return
+			}
+
+			assert.Equal(t, targetce.ExtensionCategoryValueSuccess, out.Extensions()[targetce.ExtensionCategory])
+			for _, ext := range []string{extensionChannel, extensionTS, extensionThreadTS} {
+				if v, ok := tc.expectExtensions[ext]; ok {
+					assert.Equal(t, v, out.Extensions()[ext], "extension %s", ext)
+				} else {
+					assert.NotContains(t, out.Extensions(), ext)
+				}
+			}
+		})
+	}
+}
+
+func TestResponseTypes(t *testing.T) {
+	client := &fakeWebAPIClient{response: slack.Response{"ok": true, "channel": "C01112A09FT", "ts": "1"}}
+	a := newTestAdapter(t, client)
+
+	event := cloudevents.NewEvent()
+	event.SetID("1234")
+	event.SetSource("test.source")
+	event.SetType(v1alpha1.EventTypeSlackPostMessage)
+	require.NoError(t, event.SetData(cloudevents.ApplicationJSON, json.RawMessage(`{"channel":"C01112A09FT","text":"Hi"}`)))
+
+	out, _ := a.dispatch(event)
+	require.NotNil(t, out)
+	assert.Equal(t, v1alpha1.EventTypeSlackMessageResponse, out.Type())
+}
+
+func newTestAdapter(t *testing.T, client slack.WebAPIClient) *slackAdapter {
+	logger := zapt.NewLogger(t).Sugar()
+
+	replier, err := targetce.New("test", logger,
+		targetce.ReplierWithMappedResponseType(map[string]string{
+			v1alpha1.EventTypeSlackPostMessage:   v1alpha1.EventTypeSlackMessageResponse,
+			v1alpha1.EventTypeSlackUpdateMessage: v1alpha1.EventTypeSlackMessageResponse,
+			v1alpha1.EventTypeSlackDeleteMessage: v1alpha1.EventTypeSlackMessageResponse,
+			v1alpha1.EventTypeSlackUploadFile:    v1alpha1.EventTypeSlackFileResponse,
+		}))
+	require.NoError(t, err)
+
+	return &slackAdapter{
+		slackClient: client,
+		replier:     replier,
+		logger:      logger,
+	}
+}
+
+// fakeWebAPIClient records the last call and returns a static response.
+type fakeWebAPIClient struct {
+	response slack.Response
+
+	method string
+	data   []byte
+}
+
+func (c *fakeWebAPIClient) Do(methodURL string, data []byte) (slack.Response, error) {
+	c.method = methodURL
+	c.data = data
+	return c.response, nil
+}
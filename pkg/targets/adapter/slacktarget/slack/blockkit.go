@@ -0,0 +1,186 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slack
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// Block Kit limits, as documented at https://api.slack.com/reference/block-kit/blocks
+const (
+	maxBlocksPerMessage    = 50
+	maxBlockIDLength       = 255
+	maxSectionTextLength   = 3000
+	maxSectionFields       = 10
+	maxSectionFieldLength  = 2000
+	maxHeaderTextLength    = 150
+	maxActionsElements     = 25
+	maxContextElements     = 10
+	maxImageURLLength      = 3000
+	maxImageAltTextLength  = 2000
+	textObjectTypePlain    = "plain_text"
+	textObjectTypeMarkdown = "mrkdwn"
+)
+
+// block contains the Block Kit block properties which are validated.
+type block struct {
+	Type    string `json:"type"`
+	BlockID string `json:"block_id"`
+
+	Text     *textObject       `json:"text"`
+	Fields   []textObject      `json:"fields"`
+	Elements []json.RawMessage `json:"elements"`
+
+	ImageURL string `json:"image_url"`
+	AltText  string `json:"alt_text"`
+
+	Label   *textObject     `json:"label"`
+	Element json.RawMessage `json:"element"`
+}
+
+// textObject is a Block Kit composition object containing text.
+type textObject struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// ValidateBlocks verifies that the given JSON array of Block Kit blocks
+// is well-formed and within the limits enforced by Slack for messages.
+func ValidateBlocks(data json.RawMessage) error {
+	var blocks []block
+	if err := json.Unmarshal(data, &blocks); err != nil {
+		return fmt.Errorf("blocks must be an array of Block Kit blocks: %w", err)
+	}
+
+	if len(blocks) > maxBlocksPerMessage {
+		return fmt.Errorf("a message can contain at most %d blocks, got %d", maxBlocksPerMessage, len(blocks))
+	}
+
+	blockIDs := make(map[string]struct{}, len(blocks))
+
+	for i := range blocks {
+		b := &blocks[i]
+
+		if b.BlockID != "" {
+			if utf8.RuneCountInString(b.BlockID) > maxBlockIDLength {
+				return fmt.Errorf("block %d: block_id exceeds %d characters", i, maxBlockIDLength)
+			}
+			if _, ok := blockIDs[b.BlockID]; ok {
+				return fmt.Errorf("block %d: block_id %q is not unique", i, b.BlockID)
+			}
+			blockIDs[b.BlockID] = struct{}{}
+		}
+
+		if err := b.validate(); err != nil {
+			return fmt.Errorf("block %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validate verifies the properties of a block according to its type.
+func (b *block) validate() error {
+	switch b.Type {
+	case "section":
+		if b.Text == nil && len(b.Fields) == 0 {
+			return errors.New("section blocks require a text or fields")
+		}
+		if b.Text != nil {
+			if err := b.Text.validate(maxSectionTextLength, false); err != nil {
+				return fmt.Errorf("text: %w", err)
+			}
+		}
+		if len(b.Fields) > maxSectionFields {
+			return fmt.Errorf("section blocks can contain at most %d fields", maxSectionFields)
+		}
+		for i := range b.Fields {
+			if err := b.Fields[i].validate(maxSectionFieldLength, false); err != nil {
+				return fmt.Errorf("field %d: %w", i, err)
+			}
+		}
+
+	case "header":
+		if b.Text == nil {
+			return errors.New("header blocks require a text")
+		}
+		if err := b.Text.validate(maxHeaderTextLength, true); err != nil {
+			return fmt.Errorf("text: %w", err)
+		}
+
+	case "actions":
+		if n := len(b.Elements); n == 0 || n > maxActionsElements {
+			return fmt.Errorf("actions blocks must contain between 1 and %d elements", maxActionsElements)
+		}
+
+	case "context":
+		if n := len(b.Elements); n == 0 || n > maxContextElements {
+			return fmt.Errorf("context blocks must contain between 1 and %d elements", maxContextElements)
+		}
+
+	case "image":
+		if b.ImageURL == "" || b.AltText == "" {
+			return errors.New("image blocks require an image_url and an alt_text")
+		}
+		if utf8.RuneCountInString(b.ImageURL) > maxImageURLLength {
+			return fmt.Errorf("image_url exceeds %d characters", maxImageURLLength)
+		}
+		if utf8.RuneCountInString(b.AltText) > maxImageAltTextLength {
+			return fmt.Errorf("alt_text exceeds %d characters", maxImageAltTextLength)
+		}
+
+	case "input":
+		if b.Label == nil || len(b.Element) == 0 {
+			return errors.New("input blocks require a label and an element")
+		}
+
+	case "divider", "file", "rich_text", "video":
+
+	case "":
+		return errors.New("missing block type")
+
+	default:
+		return fmt.Errorf("unsupported block type %q", b.Type)
+	}
+
+	return nil
+}
+
+// validate verifies the type and length of a text object.
+func (t *textObject) validate(maxLength int, plainOnly bool) error {
+	switch t.Type {
+	case textObjectTypePlain:
+	case textObjectTypeMarkdown:
+		if plainOnly {
+			return fmt.Errorf("text object must be of type %q", textObjectTypePlain)
+		}
+	default:
+		return fmt.Errorf("unsupported text object type %q", t.Type)
+	}
+
+	if t.Text == "" {
+		return errors.New("text object must not be empty")
+	}
+	if utf8.RuneCountInString(t.Text) > maxLength {
+		return fmt.Errorf("text exceeds %d characters", maxLength)
+	}
+
+	return nil
+}
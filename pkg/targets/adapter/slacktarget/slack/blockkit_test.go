@@ -0,0 +1,103 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slack
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateBlocks(t *testing.T) {
+	testCases := map[string]struct {
+		blocks      string
+		expectError string
+	}{
+		"valid blocks": {
+			blocks: `[
+				{"type":"header","text":{"type":"plain_text","text":"Deployment"}},
+				{"type":"section","block_id":"summary","text":{"type":"mrkdwn","text":"*Status:* done"},
+				 "fields":[{"type":"mrkdwn","text":"*Env*\nprod"}]},
+				{"type":"divider"},
+				{"type":"actions","elements":[{"type":"button","text":{"type":"plain_text","text":"Approve"},"action_id":"approve"}]},
+				{"type":"context","elements":[{"type":"mrkdwn","text":"by TriggerMesh"}]},
+				{"type":"image","image_url":"https://example.com/a.png","alt_text":"a chart"}
+			]`,
+		},
+		"not an array": {
+			blocks:      `{"type":"divider"}`,
+			expectError: "blocks must be an array of Block Kit blocks",
+		},
+		"too many blocks": {
+			blocks:      "[" + strings.Repeat(`{"type":"divider"},`, 50) + `{"type":"divider"}]`,
+			expectError: "a message can contain at most 50 blocks, got 51",
+		},
+		"missing type": {
+			blocks:      `[{"text":{"type":"plain_text","text":"Hi"}}]`,
+			expectError: "block 0: missing block type",
+		},
+		"unsupported type": {
+			blocks:      `[{"type":"carousel"}]`,
+			expectError: `block 0: unsupported block type "carousel"`,
+		},
+		"duplicate block id": {
+			blocks:      `[{"type":"divider","block_id":"a"},{"type":"divider","block_id":"a"}]`,
+			expectError: `block 1: block_id "a" is not unique`,
+		},
+		"section without text": {
+			blocks:      `[{"type":"section"}]`,
+			expectError: "block 0: section blocks require a text or fields",
+		},
+		"section text too long": {
+			blocks:      fmt.Sprintf(`[{"type":"section","text":{"type":"mrkdwn","text":%q}}]`, strings.Repeat("a", 3001)),
+			expectError: "block 0: text: text exceeds 3000 characters",
+		},
+		"markdown header": {
+			blocks:      `[{"type":"header","text":{"type":"mrkdwn","text":"*Hi*"}}]`,
+			expectError: `block 0: text: text object must be of type "plain_text"`,
+		},
+		"empty actions": {
+			blocks:      `[{"type":"actions","elements":[]}]`,
+			expectError: "block 0: actions blocks must contain between 1 and 25 elements",
+		},
+		"image without alt text": {
+			blocks:      `[{"type":"image","image_url":"https://example.com/a.png"}]`,
+			expectError: "block 0: image blocks require an image_url and an alt_text",
+		},
+		"unsupported text object": {
+			blocks:      `[{"type":"section","text":{"type":"html","text":"<b>Hi</b>"}}]`,
+			expectError: `block 0: text: unsupported text object type "html"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			err := ValidateBlocks([]byte(tc.blocks))
+
+			if tc.expectError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tc.expectError)
+			}
+		})
+	}
+}
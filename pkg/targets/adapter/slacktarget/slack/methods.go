@@ -37,9 +37,11 @@ type method struct {
 // GetFullCatalog returns the collection of all supported methods.
 func GetFullCatalog(enabled bool) Methods {
 	methods := Methods{
+		"chat.delete":          &method{fn: doJSONPost},
 		"chat.postMessage":     &method{fn: doJSONPost},
 		"chat.scheduleMessage": &method{fn: doJSONPost},
 		"chat.update":          &method{fn: doJSONPost},
+		"files.upload":         &method{fn: doFileUpload},
 	}
 
 	for k := range methods {
@@ -19,8 +19,13 @@ package slack
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 // WebAPIClient is an HTTP client for Slack Web API.
@@ -73,6 +78,148 @@ func doJSONPost(data []byte, apiURL, token, method string, client *http.Client)
 	req.Header.Set("Content-Type", "application/json; charset=utf-8")
 	req.Header.Set("Authorization", "Bearer "+token)
 
+	return doRequest(req, client)
+}
+
+// FileUpload is the payload of a file upload request. The file contents are
+// either set as text in Content or as binary data in File, which is
+// base64-encoded in its JSON representation.
+type FileUpload struct {
+	Channels       string `json:"channels,omitempty"`
+	Content        string `json:"content,omitempty"`
+	File           []byte `json:"file,omitempty"`
+	Filename       string `json:"filename,omitempty"`
+	Filetype       string `json:"filetype,omitempty"`
+	Title          string `json:"title,omitempty"`
+	InitialComment string `json:"initial_comment,omitempty"`
+	ThreadTS       string `json:"thread_ts,omitempty"`
+}
+
+// Slack methods which compose the upload of a file.
+const (
+	methodGetUploadURLExternal   = "files.getUploadURLExternal"
+	methodCompleteUploadExternal = "files.completeUploadExternal"
+)
+
+// defaultFilename is the name given to uploaded files which don't have one,
+// since Slack requires it.
+const defaultFilename = "file"
+
+// completeUploadExternal is the payload of a files.completeUploadExternal
+// request.
+type completeUploadExternal struct {
+	Files          []uploadedFile `json:"files"`
+	Channels       string         `json:"channels,omitempty"`
+	InitialComment string         `json:"initial_comment,omitempty"`
+	ThreadTS       string         `json:"thread_ts,omitempty"`
+}
+
+// uploadedFile identifies a file uploaded to Slack.
+type uploadedFile struct {
+	ID    string `json:"id"`
+	Title string `json:"title,omitempty"`
+}
+
+// doFileUpload is the API processing function for file uploads. Since Slack
+// retired the files.upload method, the JSON FileUpload is sent using the
+// external upload flow instead: an upload URL is
+// requested for the file, the file contents are sent to that URL, and the
+// upload is completed by sharing the file in the requested channels. The
+// response is the one of the completion request.
+func doFileUpload(data []byte, apiURL, token, _ string, client *http.Client) (Response, error) {
+	fu := &FileUpload{}
+	if err := json.Unmarshal(data, fu); err != nil {
+		return nil, fmt.Errorf("decoding file upload: %w", err)
+	}
+
+	if len(fu.File) == 0 && fu.Content == "" {
+		return nil, errors.New("file uploads require either a file or a content")
+	}
+
+	content := fu.File
+	if len(content) == 0 {
+		content = []byte(fu.Content)
+	}
+
+	filename := fu.Filename
+	if filename == "" {
+		filename = defaultFilename
+	}
+
+	form := url.Values{
+		"filename": {filename},
+		"length":   {strconv.Itoa(len(content))},
+	}
+	if fu.Filetype != "" {
+		form.Set("snippet_type", fu.Filetype)
+	}
+
+	req, err := http.NewRequest("POST", apiURL+methodGetUploadURLExternal, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	res, err := doRequest(req, client)
+	if err != nil {
+		return nil, err
+	}
+	if !res.IsOK() {
+		return nil, fmt.Errorf("slack method %q failed: %s", methodGetUploadURLExternal, res.Error())
+	}
+
+	uploadURL, _ := res["upload_url"].(string)
+	fileID, _ := res["file_id"].(string)
+	if uploadURL == "" || fileID == "" {
+		return nil, fmt.Errorf("slack method %q did not return an upload URL and a file ID", methodGetUploadURLExternal)
+	}
+
+	if err := uploadFileContent(uploadURL, content, client); err != nil {
+		return nil, err
+	}
+
+	complete, err := json.Marshal(&completeUploadExternal{
+		Files:          []uploadedFile{{ID: fileID, Title: fu.Title}},
+		Channels:       fu.Channels,
+		InitialComment: fu.InitialComment,
+		ThreadTS:       fu.ThreadTS,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return doJSONPost(complete, apiURL, token, methodCompleteUploadExternal, client)
+}
+
+// uploadFileContent sends the contents of a file to the upload URL returned by
+// files.getUploadURLExternal.
+func uploadFileContent(uploadURL string, content []byte, client *http.Client) error {
+	req, err := http.NewRequest("POST", uploadURL, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("uploading file contents: %w", err)
+	}
+	defer res.Body.Close()
+
+	// the response body is informative only, but must be drained for the
+	// connection to be reused
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("uploading file contents: unexpected status %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// doRequest sends a request to the Slack API and decodes its response.
+func doRequest(req *http.Request, client *http.Client) (Response, error) {
 	res, err := client.Do(req)
 	if err != nil {
 		return nil, err
@@ -17,8 +17,11 @@ limitations under the License.
 package slack
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
@@ -123,3 +126,82 @@ func TestWebAPI(t *testing.T) {
 		})
 	}
 }
+
+func TestFileUpload(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var uploadURLForm url.Values
+	var fileContent string
+	var complete map[string]interface{}
+
+	httpmock.RegisterResponder("POST", "http://mocked/api/files.getUploadURLExternal",
+		func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseForm(); err != nil {
+				return nil, err
+			}
+			uploadURLForm = req.PostForm
+
+			return httpmock.NewStringResponse(200, `{"ok":true,"upload_url":"http://mocked/upload/F0123","file_id":"F0123"}`), nil
+		})
+
+	httpmock.RegisterResponder("POST", "http://mocked/upload/F0123",
+		func(req *http.Request) (*http.Response, error) {
+			b, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			fileContent = string(b)
+
+			return httpmock.NewStringResponse(200, "OK - 5"), nil
+		})
+
+	httpmock.RegisterResponder("POST", "http://mocked/api/files.completeUploadExternal",
+		func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&complete); err != nil {
+				return nil, err
+			}
+
+			return httpmock.NewStringResponse(200, `{"ok":true,"files":[{"id":"F0123","title":"hello.txt"}]}`), nil
+		})
+
+	client := NewWebAPIClient("token", "http://mocked/api/", &http.Client{}, GetFullCatalog(true))
+
+	// "aGVsbG8=" is the base64 encoding of "hello"
+	r, err := client.Do("files.upload", []byte(`{"channels":"C01112A09FT","file":"aGVsbG8=","filename":"hello.txt","thread_ts":"1593446134.003900"}`))
+	assert.NoError(t, err)
+	assert.True(t, r.IsOK())
+
+	assert.Equal(t, "hello.txt", uploadURLForm.Get("filename"))
+	assert.Equal(t, "5", uploadURLForm.Get("length"))
+	assert.Equal(t, "hello", fileContent)
+	assert.Equal(t, map[string]interface{}{
+		"files":     []interface{}{map[string]interface{}{"id": "F0123"}},
+		"channels":  "C01112A09FT",
+		"thread_ts": "1593446134.003900",
+	}, complete)
+
+	_, err = client.Do("files.upload", []byte(`{"channels":"C01112A09FT"}`))
+	assert.EqualError(t, err, "file uploads require either a file or a content")
+}
+
+func TestFileUploadFailure(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://mocked/api/files.getUploadURLExternal",
+		httpmock.NewStringResponder(200, `{"ok":false,"error":"invalid_auth"}`))
+
+	client := NewWebAPIClient("token", "http://mocked/api/", &http.Client{}, GetFullCatalog(true))
+
+	_, err := client.Do("files.upload", []byte(`{"content":"hello"}`))
+	assert.EqualError(t, err, `slack method "files.getUploadURLExternal" failed: invalid_auth`)
+
+	httpmock.RegisterResponder("POST", "http://mocked/api/files.getUploadURLExternal",
+		httpmock.NewStringResponder(200, `{"ok":true,"upload_url":"http://mocked/upload/F0123","file_id":"F0123"}`))
+	httpmock.RegisterResponder("POST", "http://mocked/upload/F0123",
+		httpmock.NewStringResponder(500, "Internal Server Error"))
+
+	_, err = client.Do("files.upload", []byte(`{"content":"hello"}`))
+	assert.EqualError(t, err, "uploading file contents: unexpected status 500")
+}
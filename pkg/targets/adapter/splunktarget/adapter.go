@@ -21,6 +21,7 @@ import (
 	"crypto/tls"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -32,6 +33,7 @@ import (
 
 	"github.com/ZachtimusPrime/Go-Splunk-HTTP/splunk/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/fs"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 )
@@ -48,7 +50,14 @@ type adapter struct {
 	logger *zap.SugaredLogger
 
 	ceClient cloudevents.Client
-	spClient SplunkClient
+
+	// spClient is replaced whenever the HEC token is updated. Events which
+	// are being processed at that time keep using the previous client.
+	spClientMu sync.RWMutex
+	spClient   SplunkClient
+
+	// fw watches the file containing the HEC token, if set.
+	fw fs.FileWatcher
 
 	defaultIndex string
 
@@ -62,9 +71,13 @@ type envConfig struct {
 	pkgadapter.EnvConfig
 
 	HECEndpoint string `envconfig:"SPLUNK_HEC_ENDPOINT" required:"true"`
-	HECToken    string `envconfig:"SPLUNK_HEC_TOKEN" required:"true"`
+	HECToken    string `envconfig:"SPLUNK_HEC_TOKEN"`
 	Index       string `envconfig:"SPLUNK_INDEX"`
 
+	// Path of a file containing the HEC token, watched for changes.
+	// Takes precedence over HECToken.
+	HECTokenFile string `envconfig:"SPLUNK_HEC_TOKEN_FILE"`
+
 	SkipTLSVerify bool `envconfig:"SPLUNK_SKIP_TLS_VERIFY"`
 }
 
@@ -97,16 +110,42 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		logger.Panicw("Invalid HEC endpoint URL "+env.HECEndpoint, zap.Error(err))
 	}
 
-	return &adapter{
+	a := &adapter{
 		logger: logger,
 
 		ceClient: ceClient,
-		spClient: newClient(*hecURL, env.HECToken, env.Index, hostname(envAcc), env.SkipTLSVerify),
 
 		defaultIndex: env.Index,
 
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
+
+	newSpClient := func(hecToken string) SplunkClient {
+		return newClient(*hecURL, hecToken, env.Index, hostname(envAcc), env.SkipTLSVerify)
+	}
+
+	switch {
+	case env.HECTokenFile != "":
+		if a.fw, err = fs.NewWatcher(logger); err != nil {
+			logger.Panicw("Could not create a file watcher", zap.Error(err))
+		}
+
+		err := fs.WatchContent(a.fw, env.HECTokenFile, func(hecToken []byte) {
+			logger.Info("Using HEC token read from " + env.HECTokenFile)
+			a.setSpClient(newSpClient(string(hecToken)))
+		}, logger)
+		if err != nil {
+			logger.Panicw("Could not watch the HEC token file", zap.Error(err))
+		}
+
+	case env.HECToken != "":
+		a.spClient = newSpClient(env.HECToken)
+
+	default:
+		logger.Panic("One of SPLUNK_HEC_TOKEN or SPLUNK_HEC_TOKEN_FILE must be set")
+	}
+
+	return a
 }
 
 // newClient returns a Splunk HEC client.
@@ -139,6 +178,10 @@ func hostname(env pkgadapter.EnvConfigAccessor) string {
 
 // Start implements adapter.Adapter.
 func (a *adapter) Start(ctx context.Context) error {
+	if a.fw != nil {
+		a.fw.Start(ctx)
+	}
+
 	errCh := make(chan error)
 	go func() {
 		errCh <- a.ceClient.StartReceiver(ctx, a.receive)
@@ -151,7 +194,9 @@ func (a *adapter) Start(ctx context.Context) error {
 func (a *adapter) receive(ctx context.Context, event cloudevents.Event) cloudevents.Result {
 	a.logger.Debugw("Processing event", zap.Any("event", event))
 
-	e := a.spClient.NewEventWithTime(
+	spClient := a.getSpClient()
+
+	e := spClient.NewEventWithTime(
 		event.Time(),
 		event,
 		event.Source(),
@@ -159,7 +204,7 @@ func (a *adapter) receive(ctx context.Context, event cloudevents.Event) cloudeve
 		a.defaultIndex,
 	)
 
-	err := a.spClient.LogEvent(e)
+	err := spClient.LogEvent(e)
 	if err != nil {
 		a.logger.Debugw("Failed to send event to HEC", zap.Error(err))
 		return cloudevents.NewHTTPResult(a.extractHTTPStatus(err), "failed to send event to HEC: %s", err)
@@ -168,6 +213,20 @@ func (a *adapter) receive(ctx context.Context, event cloudevents.Event) cloudeve
 	return cloudevents.ResultACK
 }
 
+// getSpClient returns the current Splunk HEC client.
+func (a *adapter) getSpClient() SplunkClient {
+	a.spClientMu.RLock()
+	defer a.spClientMu.RUnlock()
+	return a.spClient
+}
+
+// setSpClient replaces the current Splunk HEC client.
+func (a *adapter) setSpClient(c SplunkClient) {
+	a.spClientMu.Lock()
+	defer a.spClientMu.Unlock()
+	a.spClient = c
+}
+
 // extractHTTPStatus attempts to extract the HTTP status code from the given
 // error, returns "400 Bad Request" otherwise.
 func (a *adapter) extractHTTPStatus(err error) int {
@@ -42,9 +42,12 @@ import (
 
 // Expected CloudEvent message reflecting the type of action to perform
 type tektonMsg struct {
-	BuildType string            `json:"buildtype"`
-	Name      string            `json:"name"`
-	Params    map[string]string `json:"params,omitempty"`
+	BuildType string                             `json:"buildtype"`
+	Name      string                             `json:"name"`
+	Params    map[string]tektonapi.ArrayOrString `json:"params,omitempty"`
+	// Names of the workspaces defined in the target's spec to bind to the
+	// run. All of them are bound when empty.
+	Workspaces []string `json:"workspaces,omitempty"`
 }
 
 const (
@@ -84,6 +87,11 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		failAge = &fail
 	}
 
+	tmpls, err := parseRunTemplates(env)
+	if err != nil {
+		logger.Fatal("error: unable to parse run templates: ", zap.Error(err))
+	}
+
 	return &tektonAdapter{
 		tektonClient:   tektoninject.Get(ctx),
 		ceClient:       ceClient,
@@ -91,8 +99,12 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		targetName:     envAcc.GetName(),
 		reapSuccessAge: successAge,
 		reapFailAge:    failAge,
+		templates:      tmpls,
 		logger:         logger,
 
+		sink:   env.Sink,
+		source: eventSource(envAcc),
+
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
 }
@@ -106,6 +118,14 @@ type tektonAdapter struct {
 	reapSuccessAge *time.Duration
 	reapFailAge    *time.Duration
 
+	templates *runTemplates
+
+	// When a sink is set, runs are followed until their completion, and
+	// their outcome is sent to the sink.
+	sink      string
+	source    string
+	reporting runSet
+
 	tektonClient tektonclient.Interface
 	ceClient     cloudevents.Client
 	logger       *zap.SugaredLogger
@@ -117,6 +137,12 @@ type tektonAdapter struct {
 func (t *tektonAdapter) Start(ctx context.Context) error {
 	t.logger.Info("Starting Tekton adapter")
 
+	if t.sink != "" {
+		if err := t.trackRuns(ctx); err != nil {
+			return fmt.Errorf("starting to follow runs: %w", err)
+		}
+	}
+
 	if err := t.ceClient.StartReceiver(ctx, t.dispatch); err != nil {
 		return err
 	}
@@ -138,28 +164,34 @@ func (t *tektonAdapter) dispatch(ctx context.Context, event cloudevents.Event) c
 		return fmt.Errorf("error processing incoming event data: %w", err)
 	}
 
-	switch msg.BuildType {
-	case "task":
-		return t.submitTaskRun(ctx, msg, event.ID())
-	case "pipeline":
-		return t.submitPipelineRun(ctx, msg, event.ID())
-	default:
+	if msg.BuildType != "task" && msg.BuildType != "pipeline" {
 		return fmt.Errorf("unknown build type %q", msg.BuildType)
 	}
+
+	opts, err := t.templates.resolve(&event, msg)
+	if err != nil {
+		return fmt.Errorf("error resolving run options: %w", err)
+	}
+
+	if msg.BuildType == "task" {
+		return t.submitTaskRun(ctx, msg, opts, event.ID())
+	}
+	return t.submitPipelineRun(ctx, msg, opts, event.ID())
 }
 
-func (t *tektonAdapter) submitPipelineRun(ctx context.Context, msg *tektonMsg, id string) cloudevents.Result {
+func (t *tektonAdapter) submitPipelineRun(ctx context.Context, msg *tektonMsg, opts *runOptions, id string) cloudevents.Result {
 	var pipelineRun tektonapi.PipelineRun
 	pipelineRun.SetName(msg.Name + "-" + id)
 	pipelineRun.SetLabels(t.generateTargetLabel())
+	pipelineRun.SetAnnotations(t.generateRunAnnotations(id))
 
 	pipelineRun.Spec.PipelineRef = &tektonapi.PipelineRef{
 		Name: msg.Name,
 	}
 
-	if msg.Params != nil {
-		pipelineRun.Spec.Params = generateParam(msg.Params)
-	}
+	pipelineRun.Spec.Params = opts.params
+	pipelineRun.Spec.Workspaces = opts.workspaces
+	pipelineRun.Spec.ServiceAccountName = opts.serviceAccount
 
 	runJob, err := t.tektonClient.TektonV1beta1().PipelineRuns(t.namespace).Create(ctx, &pipelineRun, metav1.CreateOptions{})
 	if err != nil {
@@ -167,21 +199,23 @@ func (t *tektonAdapter) submitPipelineRun(ctx context.Context, msg *tektonMsg, i
 	}
 
 	t.logger.Infof("Pipeline submitted as: %+v", runJob)
+
 	return cloudevents.ResultACK
 }
 
-func (t *tektonAdapter) submitTaskRun(ctx context.Context, msg *tektonMsg, id string) cloudevents.Result {
+func (t *tektonAdapter) submitTaskRun(ctx context.Context, msg *tektonMsg, opts *runOptions, id string) cloudevents.Result {
 	var taskRun tektonapi.TaskRun
 	taskRun.SetName(msg.Name + "-" + id)
 	taskRun.SetLabels(t.generateTargetLabel())
+	taskRun.SetAnnotations(t.generateRunAnnotations(id))
 
 	taskRun.Spec.TaskRef = &tektonapi.TaskRef{
 		Name: msg.Name,
 	}
 
-	if msg.Params != nil {
-		taskRun.Spec.Params = generateParam(msg.Params)
-	}
+	taskRun.Spec.Params = opts.params
+	taskRun.Spec.Workspaces = opts.workspaces
+	taskRun.Spec.ServiceAccountName = opts.serviceAccount
 
 	runJob, err := t.tektonClient.TektonV1beta1().TaskRuns(t.namespace).Create(ctx, &taskRun, metav1.CreateOptions{})
 	if err != nil {
@@ -189,6 +223,7 @@ func (t *tektonAdapter) submitTaskRun(ctx context.Context, msg *tektonMsg, id st
 	}
 
 	t.logger.Infof("Task submitted as: %+v", runJob)
+
 	return cloudevents.ResultACK
 }
 
@@ -222,6 +257,11 @@ func (t *tektonAdapter) reapRuns(ctx context.Context) cloudevents.Result {
 				continue
 			}
 
+			// Skip jobs whose outcome hasn't been reported yet
+			if t.sink != "" && t.isPendingReport(v.Labels) {
+				continue
+			}
+
 			status := v.Status.Conditions[0]
 			// Skip jobs that have finished, but are still inside the reaping interval
 			if status.Status == corev1.ConditionTrue && t.reapSuccessAge != nil {
@@ -248,6 +288,11 @@ func (t *tektonAdapter) reapRuns(ctx context.Context) cloudevents.Result {
 				continue
 			}
 
+			// Skip jobs whose outcome hasn't been reported yet
+			if t.sink != "" && t.isPendingReport(v.Labels) {
+				continue
+			}
+
 			status := v.Status.Conditions[0]
 			// Skip jobs that have finished, but are still inside the reaping interval
 			if status.Status == corev1.ConditionTrue && t.reapSuccessAge != nil {
@@ -270,18 +315,9 @@ func (t *tektonAdapter) reapRuns(ctx context.Context) cloudevents.Result {
 	return cloudevents.ResultACK
 }
 
-func generateParam(params map[string]string) []tektonapi.Param {
-	tektonParm := make([]tektonapi.Param, 0)
-
-	for k, v := range params {
-		p := tektonapi.Param{
-			Name:  k,
-			Value: tektonapi.ArrayOrString{Type: tektonapi.ParamTypeString, StringVal: v},
-		}
-		tektonParm = append(tektonParm, p)
-	}
-
-	return tektonParm
+// eventSource returns a CloudEvent source for the current target instance.
+func eventSource(env pkgadapter.EnvConfigAccessor) string {
+	return "io.triggermesh.tektontarget." + env.GetNamespace() + "." + env.GetName()
 }
 
 func (t *tektonAdapter) generateTargetLabel() map[string]string {
@@ -289,5 +325,20 @@ func (t *tektonAdapter) generateTargetLabel() map[string]string {
 
 	labels[tektonTargetLabel] = t.targetName
 
+	// runs are followed by informers until their outcome is reported
+	if t.sink != "" {
+		labels[reportLabel] = reportPending
+	}
+
 	return labels
 }
+
+func (t *tektonAdapter) generateRunAnnotations(eventID string) map[string]string {
+	if t.sink == "" {
+		return nil
+	}
+
+	return map[string]string{
+		correlationIDAnnotation: eventID,
+	}
+}
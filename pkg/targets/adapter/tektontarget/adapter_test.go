@@ -0,0 +1,451 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektontarget
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetest "github.com/cloudevents/sdk-go/v2/client/test"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+	logtesting "knative.dev/pkg/logging/testing"
+
+	tektonapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	tektonfake "github.com/tektoncd/pipeline/pkg/client/clientset/versioned/fake"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+const (
+	tNamespace = "test-ns"
+	tTarget    = "test-target"
+	tEventID   = "ce-abcd-0123"
+)
+
+func TestRunOptions(t *testing.T) {
+	testCases := map[string]struct {
+		env        envAccessor
+		data       string
+		expectOpts *runOptions
+		expectErr  bool
+	}{
+		"No option": {
+			data:       `{"buildtype":"task","name":"build"}`,
+			expectOpts: &runOptions{},
+		},
+		"Parameters from the payload": {
+			data: `{"buildtype":"task","name":"build","params":{"tag":"v1","flags":["-a","-b"]}}`,
+			expectOpts: &runOptions{
+				params: []tektonapi.Param{
+					{Name: "flags", Value: *tektonapi.NewArrayOrString("-a", "-b")},
+					{Name: "tag", Value: *tektonapi.NewArrayOrString("v1")},
+				},
+			},
+		},
+		"Templated parameters": {
+			env: envAccessor{
+				Params: params{
+					"repo":  "{{ .Data.repository.url }}",
+					"event": "{{ .Context.id }}",
+					"tag":   "latest",
+				},
+			},
+			data: `{"buildtype":"task","name":"build","repository":{"url":"https://example.com/repo.git"},"params":{"tag":"v1"}}`,
+			expectOpts: &runOptions{
+				params: []tektonapi.Param{
+					{Name: "event", Value: *tektonapi.NewArrayOrString(tEventID)},
+					{Name: "repo", Value: *tektonapi.NewArrayOrString("https://example.com/repo.git")},
+					{Name: "tag", Value: *tektonapi.NewArrayOrString("v1")},
+				},
+			},
+		},
+		"Templated workspaces": {
+			env: envAccessor{
+				Workspaces: workspaces{
+					{Name: "source", PersistentVolumeClaim: strPtr("{{ .Data.name }}-pvc")},
+					{Name: "scratch", EmptyDir: boolPtr(true)},
+					{Name: "config", ConfigMap: strPtr("build-config")},
+				},
+			},
+			data: `{"buildtype":"task","name":"build"}`,
+			expectOpts: &runOptions{
+				workspaces: []tektonapi.WorkspaceBinding{
+					{Name: "source", PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "build-pvc"}},
+					{Name: "scratch", EmptyDir: &corev1.EmptyDirVolumeSource{}},
+					{Name: "config", ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "build-config"},
+					}},
+				},
+			},
+		},
+		"Workspaces selected by the payload": {
+			env: envAccessor{
+				Workspaces: workspaces{
+					{Name: "source", PersistentVolumeClaim: strPtr("{{ .Data.name }}-pvc")},
+					{Name: "scratch", EmptyDir: boolPtr(true)},
+					{Name: "config", ConfigMap: strPtr("build-config")},
+				},
+			},
+			data: `{"buildtype":"task","name":"build","workspaces":["config","source"]}`,
+			expectOpts: &runOptions{
+				workspaces: []tektonapi.WorkspaceBinding{
+					{Name: "source", PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "build-pvc"}},
+					{Name: "config", ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "build-config"},
+					}},
+				},
+			},
+		},
+		"Workspace not defined by the target": {
+			env: envAccessor{
+				Workspaces: workspaces{
+					{Name: "scratch", EmptyDir: boolPtr(true)},
+				},
+			},
+			data:      `{"buildtype":"task","name":"build","workspaces":["scratch","credentials"]}`,
+			expectErr: true,
+		},
+		"Templated service account": {
+			env: envAccessor{
+				ServiceAccountName: "{{ .Data.name }}-runner",
+			},
+			data: `{"buildtype":"task","name":"build"}`,
+			expectOpts: &runOptions{
+				serviceAccount: "build-runner",
+			},
+		},
+		"Service account in the payload is ignored": {
+			env: envAccessor{
+				ServiceAccountName: "{{ .Data.name }}-runner",
+			},
+			data: `{"buildtype":"task","name":"build","serviceaccount":"cluster-admin"}`,
+			expectOpts: &runOptions{
+				serviceAccount: "build-runner",
+			},
+		},
+		"Workspace rendering an empty name": {
+			env: envAccessor{
+				Workspaces: workspaces{
+					{Name: "source", PersistentVolumeClaim: strPtr("{{ .Data.missing }}")},
+				},
+			},
+			data:      `{"buildtype":"task","name":"build","missing":""}`,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			tmpls, err := parseRunTemplates(&tc.env)
+			require.NoError(t, err)
+
+			event := newEvent(t, tc.data)
+
+			msg := &tektonMsg{}
+			require.NoError(t, event.DataAs(msg))
+
+			opts, err := tmpls.resolve(&event, msg)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectOpts, opts)
+		})
+	}
+}
+
+func TestParseRunTemplates(t *testing.T) {
+	_, err := parseRunTemplates(&envAccessor{
+		Workspaces: workspaces{
+			{Name: "source", PersistentVolumeClaim: strPtr("pvc"), ConfigMap: strPtr("cm")},
+		},
+	})
+	assert.EqualError(t, err, `invalid workspace "source": expected exactly one volume source, got 2`)
+
+	_, err = parseRunTemplates(&envAccessor{
+		Params: params{"repo": "{{ .Data.url"},
+	})
+	assert.Error(t, err)
+}
+
+func TestSubmitRun(t *testing.T) {
+	a, client := newTestAdapter(t, nil)
+
+	event := newEvent(t, `{"buildtype":"pipeline","name":"deploy","params":{"env":"prod"},"serviceaccount":"cluster-admin"}`)
+
+	result := a.dispatch(context.Background(), event)
+	require.True(t, cloudevents.IsACK(result), "Unexpected result: %v", result)
+
+	pr, err := client.TektonV1beta1().PipelineRuns(tNamespace).Get(context.Background(), "deploy-"+tEventID, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{tektonTargetLabel: tTarget}, pr.Labels)
+	assert.Equal(t, "deploy", pr.Spec.PipelineRef.Name)
+	assert.Empty(t, pr.Spec.ServiceAccountName, "service account set from the payload")
+	assert.Equal(t, []tektonapi.Param{{Name: "env", Value: *tektonapi.NewArrayOrString("prod")}}, pr.Spec.Params)
+
+	result = a.dispatch(context.Background(), newEvent(t, `{"buildtype":"job","name":"deploy"}`))
+	assert.False(t, cloudevents.IsACK(result), "Expected the event to be rejected")
+}
+
+func TestRunStatusEvents(t *testing.T) {
+	start := metav1.NewTime(time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC))
+	completion := metav1.NewTime(start.Add(90 * time.Second))
+
+	t.Run("Succeeded TaskRun", func(t *testing.T) {
+		ceClient, sent := cetest.NewMockSenderClient(t, 1)
+		a, client := newTestAdapter(t, ceClient)
+		startTracking(t, a)
+
+		result := a.dispatch(context.Background(), newEvent(t, `{"buildtype":"task","name":"build"}`))
+		require.True(t, cloudevents.IsACK(result), "Unexpected result: %v", result)
+
+		tr, err := client.TektonV1beta1().TaskRuns(tNamespace).Get(context.Background(), "build-"+tEventID, metav1.GetOptions{})
+		require.NoError(t, err)
+
+		tr.Status.SetCondition(&apis.Condition{
+			Type:   apis.ConditionSucceeded,
+			Status: corev1.ConditionTrue,
+			Reason: "Succeeded",
+		})
+		tr.Status.StartTime = &start
+		tr.Status.CompletionTime = &completion
+		tr.Status.TaskRunResults = []tektonapi.TaskRunResult{{Name: "digest", Value: "sha256:abcd"}}
+
+		_, err = client.TektonV1beta1().TaskRuns(tNamespace).UpdateStatus(context.Background(), tr, metav1.UpdateOptions{})
+		require.NoError(t, err)
+
+		event := receiveEvent(t, sent)
+		assert.Equal(t, v1alpha1.EventTypeTektonRunSucceeded, event.Type())
+		assert.Equal(t, "io.triggermesh.tektontarget."+tNamespace+"."+tTarget, event.Source())
+		assert.Equal(t, "build-"+tEventID, event.Subject())
+		assert.Equal(t, tEventID, event.Extensions()[correlationIDExtension])
+
+		st := &runStatus{}
+		require.NoError(t, event.DataAs(st))
+		assert.Equal(t, "task", st.BuildType)
+		assert.Equal(t, "build", st.Name)
+		assert.Equal(t, "Succeeded", st.Reason)
+		assert.Equal(t, map[string]string{"digest": "sha256:abcd"}, st.Results)
+		assert.Equal(t, "1m30s", st.Duration)
+
+		assertTaskRunReported(t, client, "build-"+tEventID)
+	})
+
+	t.Run("Failed PipelineRun", func(t *testing.T) {
+		ceClient, sent := cetest.NewMockSenderClient(t, 1)
+		a, client := newTestAdapter(t, ceClient)
+		startTracking(t, a)
+
+		result := a.dispatch(context.Background(), newEvent(t, `{"buildtype":"pipeline","name":"deploy"}`))
+		require.True(t, cloudevents.IsACK(result), "Unexpected result: %v", result)
+
+		pr, err := client.TektonV1beta1().PipelineRuns(tNamespace).Get(context.Background(), "deploy-"+tEventID, metav1.GetOptions{})
+		require.NoError(t, err)
+
+		pr.Status.SetCondition(&apis.Condition{
+			Type:    apis.ConditionSucceeded,
+			Status:  corev1.ConditionFalse,
+			Reason:  "Failed",
+			Message: "Tasks Completed: 1 (Failed: 1, Cancelled 0), Skipped: 0",
+		})
+		pr.Status.StartTime = &start
+		pr.Status.CompletionTime = &completion
+
+		_, err = client.TektonV1beta1().PipelineRuns(tNamespace).UpdateStatus(context.Background(), pr, metav1.UpdateOptions{})
+		require.NoError(t, err)
+
+		event := receiveEvent(t, sent)
+		assert.Equal(t, v1alpha1.EventTypeTektonRunFailed, event.Type())
+
+		st := &runStatus{}
+		require.NoError(t, event.DataAs(st))
+		assert.Equal(t, "Failed", st.Reason)
+		assert.Equal(t, "Tasks Completed: 1 (Failed: 1, Cancelled 0), Skipped: 0", st.Message)
+		assert.Empty(t, st.Results)
+	})
+}
+
+func TestRunStatusAfterRestart(t *testing.T) {
+	ceClient, sent := cetest.NewMockSenderClient(t, 1)
+
+	// The run is created by an adapter which stops before its completion.
+	a, client := newTestAdapter(t, ceClient)
+
+	result := a.dispatch(context.Background(), newEvent(t, `{"buildtype":"task","name":"build"}`))
+	require.True(t, cloudevents.IsACK(result), "Unexpected result: %v", result)
+
+	tr, err := client.TektonV1beta1().TaskRuns(tNamespace).Get(context.Background(), "build-"+tEventID, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	tr.Status.SetCondition(&apis.Condition{
+		Type:   apis.ConditionSucceeded,
+		Status: corev1.ConditionTrue,
+		Reason: "Succeeded",
+	})
+
+	_, err = client.TektonV1beta1().TaskRuns(tNamespace).UpdateStatus(context.Background(), tr, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// A new instance of the adapter reports the run as soon as it starts.
+	restarted, _ := newTestAdapter(t, ceClient)
+	restarted.tektonClient = client
+	startTracking(t, restarted)
+
+	event := receiveEvent(t, sent)
+	assert.Equal(t, v1alpha1.EventTypeTektonRunSucceeded, event.Type())
+	assert.Equal(t, "build-"+tEventID, event.ID())
+	assert.Equal(t, tEventID, event.Extensions()[correlationIDExtension])
+
+	assertTaskRunReported(t, client, "build-"+tEventID)
+}
+
+func TestReportRunMarkFailure(t *testing.T) {
+	ceClient, sent := cetest.NewMockSenderClient(t, 2)
+	a, _ := newTestAdapter(t, ceClient)
+
+	run := &metav1.ObjectMeta{
+		Name:        "build-" + tEventID,
+		UID:         "00000000-0000-0000-0000-000000000001",
+		Annotations: map[string]string{correlationIDAnnotation: tEventID},
+	}
+	st := &runStatus{BuildType: "task", Name: "build", Run: run.Name, Namespace: tNamespace}
+
+	failMark := func(context.Context, []byte) error {
+		return assert.AnError
+	}
+
+	a.reportRun(context.Background(), run, st, true, failMark)
+	assert.Equal(t, run.Name, receiveEvent(t, sent).ID())
+
+	// The run isn't marked as reported, so the event is sent again at the
+	// next delivery of the run by the informers.
+	var patched []byte
+	mark := func(_ context.Context, patch []byte) error {
+		patched = patch
+		return nil
+	}
+
+	a.reportRun(context.Background(), run, st, true, mark)
+	assert.Equal(t, run.Name, receiveEvent(t, sent).ID())
+	assert.Equal(t, reportedPatch, patched)
+
+	// Subsequent deliveries of the run are ignored until it is removed from
+	// the informers' selection.
+	a.reportRun(context.Background(), run, st, true, failMark)
+	select {
+	case event := <-sent:
+		assert.Fail(t, "unexpected event sent to the sink", "event ID: %s", event.ID())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// newTestAdapter returns a tektonAdapter backed by a fake Tekton client.
+// Runs are labeled to be reported when a CloudEvents client is passed.
+func newTestAdapter(t *testing.T, ceClient cloudevents.Client) (*tektonAdapter, *tektonfake.Clientset) {
+	t.Helper()
+
+	tmpls, err := parseRunTemplates(&envAccessor{})
+	require.NoError(t, err)
+
+	client := tektonfake.NewSimpleClientset()
+
+	a := &tektonAdapter{
+		namespace:    tNamespace,
+		targetName:   tTarget,
+		templates:    tmpls,
+		tektonClient: client,
+		ceClient:     ceClient,
+		logger:       logtesting.TestLogger(t),
+
+		source: "io.triggermesh.tektontarget." + tNamespace + "." + tTarget,
+	}
+
+	if ceClient != nil {
+		a.sink = "http://sink.example.com"
+	}
+
+	return a, client
+}
+
+// startTracking starts following the runs of the given adapter until the end
+// of the test.
+func startTracking(t *testing.T, a *tektonAdapter) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	require.NoError(t, a.trackRuns(ctx))
+}
+
+// assertTaskRunReported asserts that the TaskRun with the given name is
+// eventually marked as reported.
+func assertTaskRunReported(t *testing.T, client *tektonfake.Clientset, name string) {
+	t.Helper()
+
+	assert.Eventually(t, func() bool {
+		tr, err := client.TektonV1beta1().TaskRuns(tNamespace).Get(context.Background(), name, metav1.GetOptions{})
+		return err == nil && tr.Labels[reportLabel] == reportSent
+	}, 2*time.Second, 10*time.Millisecond, "run was not marked as reported")
+}
+
+func newEvent(t *testing.T, data string) cloudevents.Event {
+	t.Helper()
+
+	event := cloudevents.NewEvent()
+	event.SetID(tEventID)
+	event.SetType(v1alpha1.EventTypeTektonRun)
+	event.SetSource("ce.test.source")
+	require.NoError(t, event.SetData(cloudevents.ApplicationJSON, json.RawMessage(data)))
+
+	return event
+}
+
+func receiveEvent(t *testing.T, sent <-chan cloudevents.Event) cloudevents.Event {
+	t.Helper()
+
+	select {
+	case event := <-sent:
+		return event
+	case <-time.After(2 * time.Second):
+		require.Fail(t, "expected event was not sent to the sink")
+	}
+
+	return cloudevents.Event{}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -17,7 +17,11 @@ limitations under the License.
 package tektontarget
 
 import (
+	"encoding/json"
+
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 )
 
 // EnvAccessorCtor for configuration parameters
@@ -30,4 +34,27 @@ type envAccessor struct {
 
 	ReapSuccessAge string `envconfig:"TEKTON_REAP_SUCCESS_AGE"`
 	ReapFailAge    string `envconfig:"TEKTON_REAP_FAIL_AGE"`
+
+	// Templates of run parameters, by parameter name.
+	Params params `envconfig:"TEKTON_PARAMS"`
+	// Workspaces bound to runs, with templated volume source names.
+	Workspaces workspaces `envconfig:"TEKTON_WORKSPACES"`
+	// Template of the name of the ServiceAccount runs are executed as.
+	ServiceAccountName string `envconfig:"TEKTON_SERVICE_ACCOUNT_NAME"`
+}
+
+// params contains templates of run parameters, by parameter name.
+type params map[string]string
+
+// Decode implements envconfig.Decoder.
+func (p *params) Decode(value string) error {
+	return json.Unmarshal([]byte(value), p)
+}
+
+// workspaces is a list of workspaces bound to runs.
+type workspaces []v1alpha1.TektonTargetWorkspace
+
+// Decode implements envconfig.Decoder.
+func (w *workspaces) Decode(value string) error {
+	return json.Unmarshal([]byte(value), w)
 }
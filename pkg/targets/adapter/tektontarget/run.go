@@ -0,0 +1,300 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektontarget
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"text/template"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	corev1 "k8s.io/api/core/v1"
+
+	tektonapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/flow/templating"
+)
+
+// runTemplates contains the parsed templates used to parameterize runs.
+// Templates are parsed once, and safe for concurrent executions.
+type runTemplates struct {
+	params         map[string]*template.Template
+	workspaces     []workspaceTemplate
+	serviceAccount *template.Template
+}
+
+// workspaceTemplate is a workspace binding with a templated volume source name.
+type workspaceTemplate struct {
+	name   string
+	source v1alpha1.TektonTargetWorkspace
+	tmpl   *template.Template
+}
+
+// templateInput is the value templates are executed against.
+type templateInput struct {
+	// Parsed data of the event.
+	Data interface{}
+	// Context attributes and extensions of the event.
+	Context map[string]interface{}
+}
+
+// runOptions are the options of a run, resolved from the target's
+// configuration and the incoming event.
+type runOptions struct {
+	params         []tektonapi.Param
+	workspaces     []tektonapi.WorkspaceBinding
+	serviceAccount string
+}
+
+// parseRunTemplates parses the templates contained in the given configuration.
+func parseRunTemplates(env *envAccessor) (*runTemplates, error) {
+	var tmpls runTemplates
+
+	if len(env.Params) > 0 {
+		tmpls.params = make(map[string]*template.Template, len(env.Params))
+		for name, text := range env.Params {
+			t, err := templating.Parse("param-"+name, text)
+			if err != nil {
+				return nil, fmt.Errorf("parsing template of parameter %q: %w", name, err)
+			}
+			tmpls.params[name] = t
+		}
+	}
+
+	for i := range env.Workspaces {
+		ws := &env.Workspaces[i]
+
+		text, err := workspaceSourceName(ws)
+		if err != nil {
+			return nil, fmt.Errorf("invalid workspace %q: %w", ws.Name, err)
+		}
+
+		var t *template.Template
+		if text != nil {
+			if t, err = templating.Parse("workspace-"+ws.Name, *text); err != nil {
+				return nil, fmt.Errorf("parsing template of workspace %q: %w", ws.Name, err)
+			}
+		}
+
+		tmpls.workspaces = append(tmpls.workspaces, workspaceTemplate{
+			name:   ws.Name,
+			source: *ws,
+			tmpl:   t,
+		})
+	}
+
+	if env.ServiceAccountName != "" {
+		t, err := templating.Parse("serviceaccount", env.ServiceAccountName)
+		if err != nil {
+			return nil, fmt.Errorf("parsing template of service account: %w", err)
+		}
+		tmpls.serviceAccount = t
+	}
+
+	return &tmpls, nil
+}
+
+// workspaceSourceName returns the name of the volume source referenced by
+// the given workspace, if any. Exactly one volume source is expected to be set.
+func workspaceSourceName(ws *v1alpha1.TektonTargetWorkspace) (*string, error) {
+	var name *string
+	var sources int
+
+	if ws.PersistentVolumeClaim != nil {
+		name = ws.PersistentVolumeClaim
+		sources++
+	}
+	if ws.ConfigMap != nil {
+		name = ws.ConfigMap
+		sources++
+	}
+	if ws.Secret != nil {
+		name = ws.Secret
+		sources++
+	}
+	if ws.EmptyDir != nil && *ws.EmptyDir {
+		sources++
+	}
+
+	if sources != 1 {
+		return nil, fmt.Errorf("expected exactly one volume source, got %d", sources)
+	}
+
+	return name, nil
+}
+
+// resolve returns the options of a run triggered by the given event.
+// Parameters set in the event's payload take precedence over the ones
+// rendered from the target's templates. Workspaces and the service account
+// are only ever defined by the target, the event can merely select which of
+// the target's workspaces are bound to the run.
+func (r *runTemplates) resolve(event *cloudevents.Event, msg *tektonMsg) (*runOptions, error) {
+	in := &templateInput{
+		Data:    eventData(event),
+		Context: templating.EventContext(event),
+	}
+
+	params := make(map[string]tektonapi.ArrayOrString, len(r.params)+len(msg.Params))
+	for name, t := range r.params {
+		v, err := render(t, in)
+		if err != nil {
+			return nil, fmt.Errorf("rendering parameter %q: %w", name, err)
+		}
+		params[name] = *tektonapi.NewArrayOrString(v)
+	}
+	for name, v := range msg.Params {
+		params[name] = v
+	}
+
+	var opts runOptions
+
+	if len(params) > 0 {
+		opts.params = generateParam(params)
+	}
+
+	wts, err := r.selectWorkspaces(msg.Workspaces)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, wt := range wts {
+		ws, err := wt.binding(in)
+		if err != nil {
+			return nil, fmt.Errorf("rendering workspace %q: %w", wt.name, err)
+		}
+		opts.workspaces = append(opts.workspaces, *ws)
+	}
+
+	if r.serviceAccount != nil {
+		sa, err := render(r.serviceAccount, in)
+		if err != nil {
+			return nil, fmt.Errorf("rendering service account: %w", err)
+		}
+		opts.serviceAccount = sa
+	}
+
+	return &opts, nil
+}
+
+// selectWorkspaces returns the workspace templates with the given names, in
+// the order they are defined in, or all workspace templates if no name is
+// given. Names which don't match any workspace template are rejected.
+func (r *runTemplates) selectWorkspaces(names []string) ([]workspaceTemplate, error) {
+	if len(names) == 0 {
+		return r.workspaces, nil
+	}
+
+	selected := make(map[string]struct{}, len(names))
+	for _, n := range names {
+		selected[n] = struct{}{}
+	}
+
+	wts := make([]workspaceTemplate, 0, len(names))
+	for _, wt := range r.workspaces {
+		if _, ok := selected[wt.name]; ok {
+			wts = append(wts, wt)
+			delete(selected, wt.name)
+		}
+	}
+
+	if len(selected) > 0 {
+		unknown := make([]string, 0, len(selected))
+		for n := range selected {
+			unknown = append(unknown, n)
+		}
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("workspaces %q are not defined by the target", unknown)
+	}
+
+	return wts, nil
+}
+
+// binding returns the workspace binding rendered for the given input.
+func (w *workspaceTemplate) binding(in *templateInput) (*tektonapi.WorkspaceBinding, error) {
+	ws := &tektonapi.WorkspaceBinding{
+		Name: w.name,
+	}
+
+	if w.tmpl == nil {
+		ws.EmptyDir = &corev1.EmptyDirVolumeSource{}
+		return ws, nil
+	}
+
+	name, err := render(w.tmpl, in)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, fmt.Errorf("the template rendered an empty volume source name")
+	}
+
+	switch {
+	case w.source.PersistentVolumeClaim != nil:
+		ws.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{ClaimName: name}
+	case w.source.ConfigMap != nil:
+		ws.ConfigMap = &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: name},
+		}
+	case w.source.Secret != nil:
+		ws.Secret = &corev1.SecretVolumeSource{SecretName: name}
+	}
+
+	return ws, nil
+}
+
+// render executes the given template against the given input.
+func render(t *template.Template, in *templateInput) (string, error) {
+	var out bytes.Buffer
+	if err := t.Execute(&out, in); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+func generateParam(params map[string]tektonapi.ArrayOrString) []tektonapi.Param {
+	names := make([]string, 0, len(params))
+	for k := range params {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	tektonParm := make([]tektonapi.Param, 0, len(params))
+
+	for _, k := range names {
+		p := tektonapi.Param{
+			Name:  k,
+			Value: params[k],
+		}
+		tektonParm = append(tektonParm, p)
+	}
+
+	return tektonParm
+}
+
+// eventData returns the parsed JSON data of the given event, or nil if the
+// event doesn't contain JSON data.
+func eventData(e *cloudevents.Event) interface{} {
+	var data interface{}
+	if err := json.Unmarshal(e.Data(), &data); err != nil {
+		return nil
+	}
+	return data
+}
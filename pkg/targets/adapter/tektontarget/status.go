@@ -0,0 +1,305 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tektontarget
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/pkg/apis"
+	duckv1beta1 "knative.dev/pkg/apis/duck/v1beta1"
+
+	tektonapi "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	tektoninformers "github.com/tektoncd/pipeline/pkg/client/informers/externalversions"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// correlationIDExtension is the CloudEvent extension which correlates
+// status events to the event that triggered the run.
+const correlationIDExtension = "correlationid"
+
+const (
+	// correlationIDAnnotation records the ID of the event that triggered a
+	// run, so that it can be reported after a restart of the adapter.
+	correlationIDAnnotation = "correlationid.tekton.targets.triggermesh.io"
+
+	// reportLabel marks the runs whose outcome must be sent to the sink.
+	// Its value changes from reportPending to reportSent once the status
+	// event has been acknowledged.
+	reportLabel   = "report.tekton.targets.triggermesh.io"
+	reportPending = "pending"
+	reportSent    = "sent"
+)
+
+// runsResyncPeriod is the interval at which the informers re-deliver the
+// runs which are still pending, e.g. after a failure to send their status.
+const runsResyncPeriod = time.Minute
+
+// runStatus is the payload of events reporting the outcome of a run.
+type runStatus struct {
+	BuildType      string            `json:"buildtype"`
+	Name           string            `json:"name"`
+	Run            string            `json:"run"`
+	Namespace      string            `json:"namespace"`
+	Reason         string            `json:"reason,omitempty"`
+	Message        string            `json:"message,omitempty"`
+	Results        map[string]string `json:"results,omitempty"`
+	StartTime      *metav1.Time      `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time      `json:"completionTime,omitempty"`
+	Duration       string            `json:"duration,omitempty"`
+}
+
+// trackRuns starts informers which follow the runs of the target until their
+// completion, and send events reporting their outcome to the sink.
+//
+// Runs are selected by label instead of being followed in memory, so that
+// runs which completed while the adapter was not running (e.g. restarted or
+// scaled to zero) are reported as soon as it starts.
+func (t *tektonAdapter) trackRuns(ctx context.Context) error {
+	factory := tektoninformers.NewSharedInformerFactoryWithOptions(t.tektonClient, runsResyncPeriod,
+		tektoninformers.WithNamespace(t.namespace),
+		tektoninformers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = tektonTargetLabel + "=" + t.targetName + "," + reportLabel + "=" + reportPending
+		}),
+	)
+
+	informers := factory.Tekton().V1beta1()
+
+	informers.TaskRuns().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { t.reportTaskRun(ctx, obj) },
+		UpdateFunc: func(_, obj interface{}) { t.reportTaskRun(ctx, obj) },
+		DeleteFunc: t.reporting.forget,
+	})
+
+	informers.PipelineRuns().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { t.reportPipelineRun(ctx, obj) },
+		UpdateFunc: func(_, obj interface{}) { t.reportPipelineRun(ctx, obj) },
+		DeleteFunc: t.reporting.forget,
+	})
+
+	factory.Start(ctx.Done())
+
+	for typ, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("failed to sync the informer for %s", typ)
+		}
+	}
+
+	return nil
+}
+
+// reportTaskRun sends the outcome of the given TaskRun if it has completed.
+func (t *tektonAdapter) reportTaskRun(ctx context.Context, obj interface{}) {
+	tr, ok := obj.(*tektonapi.TaskRun)
+	if !ok || !t.isPendingReport(tr.Labels) || tr.Spec.TaskRef == nil {
+		return
+	}
+
+	st, succeeded := newRunStatus("task", tr.Spec.TaskRef.Name, tr.Name, tr.Namespace,
+		&tr.Status.Status, tr.Status.StartTime, tr.Status.CompletionTime)
+	if st == nil {
+		return
+	}
+
+	for _, r := range tr.Status.TaskRunResults {
+		st.Results[r.Name] = r.Value
+	}
+
+	t.reportRun(ctx, &tr.ObjectMeta, st, succeeded, func(ctx context.Context, patch []byte) error {
+		_, err := t.tektonClient.TektonV1beta1().TaskRuns(tr.Namespace).Patch(ctx,
+			tr.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	})
+}
+
+// reportPipelineRun sends the outcome of the given PipelineRun if it has completed.
+func (t *tektonAdapter) reportPipelineRun(ctx context.Context, obj interface{}) {
+	pr, ok := obj.(*tektonapi.PipelineRun)
+	if !ok || !t.isPendingReport(pr.Labels) || pr.Spec.PipelineRef == nil {
+		return
+	}
+
+	st, succeeded := newRunStatus("pipeline", pr.Spec.PipelineRef.Name, pr.Name, pr.Namespace,
+		&pr.Status.Status, pr.Status.StartTime, pr.Status.CompletionTime)
+	if st == nil {
+		return
+	}
+
+	for _, r := range pr.Status.PipelineResults {
+		st.Results[r.Name] = r.Value
+	}
+
+	t.reportRun(ctx, &pr.ObjectMeta, st, succeeded, func(ctx context.Context, patch []byte) error {
+		_, err := t.tektonClient.TektonV1beta1().PipelineRuns(pr.Namespace).Patch(ctx,
+			pr.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	})
+}
+
+// isPendingReport returns whether the run with the given labels belongs to
+// the target and its outcome hasn't been reported yet. Informers already
+// filter runs by label, this is also a safeguard against objects delivered
+// outside of the selector.
+func (t *tektonAdapter) isPendingReport(runLabels map[string]string) bool {
+	return runLabels[tektonTargetLabel] == t.targetName && runLabels[reportLabel] == reportPending
+}
+
+// reportedPatch is the merge patch which marks a run as reported.
+var reportedPatch = []byte(`{"metadata":{"labels":{"` + reportLabel + `":"` + reportSent + `"}}}`)
+
+// reportRun sends an event reporting the given run status to the sink, then
+// marks the run as reported.
+//
+// Status events are delivered at least once: an event may be sent again if
+// the adapter restarts before the run is marked. Their ID is the name of the
+// run, which allows consumers to discard duplicates.
+func (t *tektonAdapter) reportRun(ctx context.Context, run *metav1.ObjectMeta, st *runStatus, succeeded bool,
+	markReported func(ctx context.Context, patch []byte) error) {
+
+	// informers deliver updates of a run before it is marked as reported
+	if !t.reporting.claim(run.UID) {
+		return
+	}
+
+	event, err := t.statusEvent(st, succeeded, run.Annotations[correlationIDAnnotation])
+	if err != nil {
+		t.logger.Errorw("Failed to create status event for run "+run.Name, "error", err)
+		// retried at the next resync of the informer
+		t.reporting.release(run.UID)
+		return
+	}
+
+	if result := t.ceClient.Send(ctx, *event); !cloudevents.IsACK(result) {
+		t.logger.Errorw("Failed to send status event for run "+run.Name, "error", result)
+		// retried at the next resync of the informer
+		t.reporting.release(run.UID)
+		return
+	}
+
+	if err := markReported(ctx, reportedPatch); err != nil {
+		t.logger.Errorw("Failed to mark run "+run.Name+" as reported", "error", err)
+		// the event is sent again at the next resync of the informer, with
+		// the same ID
+		t.reporting.release(run.UID)
+	}
+}
+
+// runSet is a set of runs whose outcome is being reported.
+type runSet struct {
+	mu   sync.Mutex
+	uids map[types.UID]struct{}
+}
+
+// claim adds the run with the given UID to the set, and returns whether it
+// wasn't already part of it.
+func (s *runSet) claim(uid types.UID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, claimed := s.uids[uid]; claimed {
+		return false
+	}
+
+	if s.uids == nil {
+		s.uids = make(map[types.UID]struct{})
+	}
+	s.uids[uid] = struct{}{}
+
+	return true
+}
+
+// release removes the run with the given UID from the set.
+func (s *runSet) release(uid types.UID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.uids, uid)
+}
+
+// forget removes a run which is no longer selected by the informers from the
+// set. It is meant to be used as an informer's DeleteFunc.
+func (s *runSet) forget(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	if run, ok := obj.(metav1.Object); ok {
+		s.release(run.GetUID())
+	}
+}
+
+// newRunStatus returns the status of a completed run and whether it
+// succeeded, or a nil status if the run is still in progress.
+func newRunStatus(buildType, name, run, ns string, s *duckv1beta1.Status, start, completion *metav1.Time) (*runStatus, bool) {
+	cond := s.GetCondition(apis.ConditionSucceeded)
+	if cond == nil || cond.IsUnknown() {
+		return nil, false
+	}
+
+	st := &runStatus{
+		BuildType:      buildType,
+		Name:           name,
+		Run:            run,
+		Namespace:      ns,
+		Reason:         cond.Reason,
+		Message:        cond.Message,
+		Results:        make(map[string]string),
+		StartTime:      start,
+		CompletionTime: completion,
+	}
+
+	if start != nil && completion != nil {
+		st.Duration = completion.Sub(start.Time).String()
+	}
+
+	return st, cond.IsTrue()
+}
+
+// statusEvent returns a CloudEvent reporting the given run status.
+func (t *tektonAdapter) statusEvent(st *runStatus, succeeded bool, eventID string) (*cloudevents.Event, error) {
+	typ := v1alpha1.EventTypeTektonRunFailed
+	if succeeded {
+		typ = v1alpha1.EventTypeTektonRunSucceeded
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID(st.Run)
+	event.SetType(typ)
+	event.SetSource(t.source)
+	event.SetSubject(st.Run)
+	if eventID != "" {
+		event.SetExtension(correlationIDExtension, eventID)
+	}
+	if st.CompletionTime != nil {
+		event.SetTime(st.CompletionTime.Time)
+	}
+
+	if err := event.SetData(cloudevents.ApplicationJSON, st); err != nil {
+		return nil, fmt.Errorf("setting event data: %w", err)
+	}
+
+	return &event, nil
+}
@@ -19,6 +19,8 @@ package twiliotarget
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"sync"
 
 	"go.uber.org/zap"
 
@@ -29,6 +31,7 @@ import (
 
 	twilio "github.com/kevinburke/twilio-go"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/fs"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
@@ -57,27 +60,86 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		logger.Panicf("Error creating CloudEvents replier: %v", err)
 	}
 
+	var statusCallbackURL *url.URL
+	if env.StatusCallbackURL != "" {
+		if statusCallbackURL, err = url.Parse(env.StatusCallbackURL); err != nil {
+			logger.Panicw("Invalid status callback URL", zap.Error(err))
+		}
+	}
+
 	// TODO custom port
-	return &twilioAdapter{
-		client:      twilio.NewClient(env.AccountSID, env.Token, nil),
+	a := &twilioAdapter{
+		accountSID:  env.AccountSID,
+		token:       env.Token,
 		defaultFrom: env.PhoneFrom,
 		defaultTo:   env.PhoneTo,
 
+		defaultMessagingServiceSID: env.MessagingServiceSID,
+		statusCallbackURL:          statusCallbackURL,
+
 		replier:  replier,
 		ceClient: ceClient,
 		logger:   logger,
 
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
+
+	if env.AccountSIDFile != "" || env.TokenFile != "" {
+		if a.fw, err = fs.NewWatcher(logger); err != nil {
+			logger.Panicw("Could not create a file watcher", zap.Error(err))
+		}
+	}
+
+	if env.AccountSIDFile != "" {
+		err := fs.WatchContent(a.fw, env.AccountSIDFile, func(sid []byte) {
+			logger.Info("Using account SID read from " + env.AccountSIDFile)
+			a.updateCredentials(string(sid), "")
+		}, logger)
+		if err != nil {
+			logger.Panicw("Could not watch the account SID file", zap.Error(err))
+		}
+	}
+
+	if env.TokenFile != "" {
+		err := fs.WatchContent(a.fw, env.TokenFile, func(token []byte) {
+			logger.Info("Using token read from " + env.TokenFile)
+			a.updateCredentials("", string(token))
+		}, logger)
+		if err != nil {
+			logger.Panicw("Could not watch the token file", zap.Error(err))
+		}
+	}
+
+	if a.accountSID == "" || a.token == "" {
+		logger.Panic("The Twilio account SID and token must be set")
+	}
+
+	a.updateCredentials("", "")
+
+	return a
 }
 
 var _ pkgadapter.Adapter = (*twilioAdapter)(nil)
 
 type twilioAdapter struct {
-	client      *twilio.Client
+	// client is replaced whenever the credentials are updated. Events
+	// which are being processed at that time keep using the previous
+	// client.
+	clientMu   sync.RWMutex
+	client     *twilio.Client
+	accountSID string
+	token      string
+
+	// fw watches the files containing the credentials, if set.
+	fw fs.FileWatcher
+
 	defaultFrom string
 	defaultTo   string
 
+	defaultMessagingServiceSID string
+	// statusCallbackURL receives status updates of sent messages, if set.
+	statusCallbackURL *url.URL
+
 	replier  *targetce.Replier
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
@@ -89,13 +151,17 @@ type twilioAdapter struct {
 func (a *twilioAdapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting Twilio adapter")
 
+	if a.fw != nil {
+		a.fw.Start(ctx)
+	}
+
 	if err := a.ceClient.StartReceiver(ctx, a.dispatch); err != nil {
 		a.logger.Fatalw("Error listening to cloud events", zap.Error(err))
 	}
 	return nil
 }
 
-func (a *twilioAdapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+func (a *twilioAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	if typ := event.Type(); typ != v1alpha1.EventTypeTwilioSMSSend {
 		return a.replier.Error(&event, targetce.ErrorCodeEventContext, fmt.Errorf("event type %q is not supported", typ), nil)
 	}
@@ -105,18 +171,46 @@ func (a *twilioAdapter) dispatch(event cloudevents.Event) (*cloudevents.Event, c
 		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
 	}
 
-	if sms.From == "" {
-		sms.From = a.defaultFrom
-	}
-	if sms.To == "" {
-		sms.To = a.defaultTo
+	params, err := a.messageParams(&event, sms)
+	if err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation, err, nil)
 	}
 
-	if _, err := a.client.Messages.SendMessage(sms.From, sms.To, sms.Message, sms.MediaURLs); err != nil {
+	msg, err := a.getClient().Messages.Create(ctx, params)
+	if err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 	}
 
-	info := fmt.Sprint("message sent to: ", sms.To)
-	a.logger.Debug(info)
-	return a.replier.Ok(&event, info)
+	a.logger.Debugw("Message sent", zap.String("sid", msg.Sid), zap.String("to", string(msg.To)))
+
+	return a.replier.Ok(&event, &SendResponse{
+		SID:                 msg.Sid,
+		Status:              string(msg.Status),
+		From:                string(msg.From),
+		To:                  string(msg.To),
+		MessagingServiceSID: msg.MessagingServiceSid.String,
+	})
+}
+
+// getClient returns the current Twilio client.
+func (a *twilioAdapter) getClient() *twilio.Client {
+	a.clientMu.RLock()
+	defer a.clientMu.RUnlock()
+	return a.client
+}
+
+// updateCredentials replaces the non-empty credentials passed as arguments
+// and creates a new Twilio client.
+func (a *twilioAdapter) updateCredentials(accountSID, token string) {
+	a.clientMu.Lock()
+	defer a.clientMu.Unlock()
+
+	if accountSID != "" {
+		a.accountSID = accountSID
+	}
+	if token != "" {
+		a.token = token
+	}
+
+	a.client = twilio.NewClient(a.accountSID, a.token, nil)
 }
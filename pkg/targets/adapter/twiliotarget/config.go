@@ -28,13 +28,25 @@ func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
 type envAccessor struct {
 	pkgadapter.EnvConfig
 	// AccountSID is the Twilio account SID
-	AccountSID string `envconfig:"TWILIO_SID" required:"true"`
+	AccountSID string `envconfig:"TWILIO_SID"`
 	// Token is the API key for the Twilio account.
-	Token string `envconfig:"TWILIO_TOKEN" required:"true"`
+	Token string `envconfig:"TWILIO_TOKEN"`
+	// AccountSIDFile is the path of a file containing the Twilio account
+	// SID, watched for changes. Takes precedence over AccountSID.
+	AccountSIDFile string `envconfig:"TWILIO_SID_FILE"`
+	// TokenFile is the path of a file containing the API key for the
+	// Twilio account, watched for changes. Takes precedence over Token.
+	TokenFile string `envconfig:"TWILIO_TOKEN_FILE"`
 	// PhoneFrom is the phone number to use as the sender of the SMS
 	PhoneFrom string `envconfig:"TWILIO_DEFAULT_FROM" required:"false"`
 	// PhoneTo is the phone number to send the message to
 	PhoneTo string `envconfig:"TWILIO_DEFAULT_TO" required:"false"`
+	// MessagingServiceSID is the SID of the Messaging Service to use when
+	// messages have no sender
+	MessagingServiceSID string `envconfig:"TWILIO_DEFAULT_MESSAGING_SERVICE_SID" required:"false"`
+	// StatusCallbackURL is the URL Twilio reports the status of sent
+	// messages to
+	StatusCallbackURL string `envconfig:"TWILIO_STATUS_CALLBACK_URL" required:"false"`
 
 	// CloudEvents responses parametrization
 	CloudEventPayloadPolicy string `envconfig:"EVENTS_PAYLOAD_POLICY" default:"always"`
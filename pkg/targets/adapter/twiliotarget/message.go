@@ -0,0 +1,115 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package twiliotarget
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+const (
+	// whatsAppPrefix denotes WhatsApp addresses.
+	whatsAppPrefix = "whatsapp:"
+
+	// correlationIDParam is the query parameter of status callback URLs
+	// which carries the ID of the event that triggered a message.
+	correlationIDParam = "ceid"
+)
+
+// messageParams returns the parameters of the request which sends the given
+// message, after applying the target's defaults.
+// See https://www.twilio.com/docs/sms/api/message-resource#create-a-message-resource
+func (a *twilioAdapter) messageParams(event *cloudevents.Event, sms *SMSMessage) (url.Values, error) {
+	if sms.To == "" {
+		sms.To = a.defaultTo
+	}
+	if sms.From == "" && sms.MessagingServiceSID == "" {
+		sms.From = a.defaultFrom
+		sms.MessagingServiceSID = a.defaultMessagingServiceSID
+	}
+
+	switch {
+	case sms.To == "":
+		return nil, errors.New("the message has no recipient")
+	case sms.From == "" && sms.MessagingServiceSID == "":
+		return nil, errors.New("the message has no sender nor Messaging Service")
+	case sms.Message == "" && len(sms.MediaURLs) == 0:
+		return nil, errors.New("the message has no body nor media")
+	}
+
+	if sms.WhatsApp {
+		sms.To = whatsAppAddress(sms.To)
+		if sms.From != "" {
+			sms.From = whatsAppAddress(sms.From)
+		}
+	}
+
+	v := url.Values{}
+	v.Set("To", sms.To)
+
+	if sms.From != "" {
+		v.Set("From", sms.From)
+	}
+	if sms.MessagingServiceSID != "" {
+		v.Set("MessagingServiceSid", sms.MessagingServiceSID)
+	}
+
+	if sms.Message != "" {
+		v.Set("Body", sms.Message)
+	}
+
+	for _, m := range sms.MediaURLs {
+		u, err := url.Parse(m)
+		if err != nil {
+			return nil, fmt.Errorf("invalid media URL %q: %w", m, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, fmt.Errorf("media URL %q is not a HTTP(S) URL", m)
+		}
+		v.Add("MediaUrl", m)
+	}
+
+	if a.statusCallbackURL != nil {
+		v.Set("StatusCallback", a.statusCallback(event.ID()))
+	}
+
+	return v, nil
+}
+
+// statusCallback returns the status callback URL for a message triggered by
+// the event with the given ID.
+func (a *twilioAdapter) statusCallback(eventID string) string {
+	u := *a.statusCallbackURL
+
+	q := u.Query()
+	q.Set(correlationIDParam, eventID)
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+// whatsAppAddress returns the WhatsApp address of the given phone number.
+func whatsAppAddress(phone string) string {
+	if strings.HasPrefix(phone, whatsAppPrefix) {
+		return phone
+	}
+	return whatsAppPrefix + phone
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package twiliotarget
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	logtesting "knative.dev/pkg/logging/testing"
+
+	twilio "github.com/kevinburke/twilio-go"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+const tEventID = "1234"
+
+func TestMessageParams(t *testing.T) {
+	statusCallbackURL, _ := url.Parse("https://twiliosource.example.com/?tenant=a")
+
+	testCases := map[string]struct {
+		adapter *twilioAdapter
+		sms     SMSMessage
+
+		expectParams url.Values
+		expectErr    string
+	}{
+		"Defaults": {
+			adapter: &twilioAdapter{defaultFrom: "+15550001", defaultTo: "+15550002"},
+			sms:     SMSMessage{Message: "Hello"},
+			expectParams: url.Values{
+				"From": {"+15550001"},
+				"To":   {"+15550002"},
+				"Body": {"Hello"},
+			},
+		},
+		"Media message": {
+			adapter: &twilioAdapter{defaultFrom: "+15550001"},
+			sms: SMSMessage{
+				To:        "+15550002",
+				MediaURLs: []string{"https://example.com/a.png", "https://example.com/b.png"},
+			},
+			expectParams: url.Values{
+				"From":     {"+15550001"},
+				"To":       {"+15550002"},
+				"MediaUrl": {"https://example.com/a.png", "https://example.com/b.png"},
+			},
+		},
+		"WhatsApp": {
+			adapter: &twilioAdapter{defaultFrom: "+15550001"},
+			sms:     SMSMessage{To: "whatsapp:+15550002", Message: "Hello", WhatsApp: true},
+			expectParams: url.Values{
+				"From": {"whatsapp:+15550001"},
+				"To":   {"whatsapp:+15550002"},
+				"Body": {"Hello"},
+			},
+		},
+		"Messaging Service": {
+			adapter: &twilioAdapter{defaultFrom: "+15550001", defaultMessagingServiceSID: "MG1"},
+			sms:     SMSMessage{To: "+15550002", Message: "Hello", MessagingServiceSID: "MG2"},
+			expectParams: url.Values{
+				"MessagingServiceSid": {"MG2"},
+				"To":                  {"+15550002"},
+				"Body":                {"Hello"},
+			},
+		},
+		"Status callback": {
+			adapter: &twilioAdapter{defaultFrom: "+15550001", statusCallbackURL: statusCallbackURL},
+			sms:     SMSMessage{To: "+15550002", Message: "Hello"},
+			expectParams: url.Values{
+				"From":           {"+15550001"},
+				"To":             {"+15550002"},
+				"Body":           {"Hello"},
+				"StatusCallback": {"https://twiliosource.example.com/?ceid=" + tEventID + "&tenant=a"},
+			},
+		},
+		"No recipient": {
+			adapter:   &twilioAdapter{defaultFrom: "+15550001"},
+			sms:       SMSMessage{Message: "Hello"},
+			expectErr: "the message has no recipient",
+		},
+		"No sender": {
+			adapter:   &twilioAdapter{},
+			sms:       SMSMessage{To: "+15550002", Message: "Hello"},
+			expectErr: "the message has no sender nor Messaging Service",
+		},
+		"No content": {
+			adapter:   &twilioAdapter{defaultFrom: "+15550001"},
+			sms:       SMSMessage{To: "+15550002"},
+			expectErr: "the message has no body nor media",
+		},
+		"Invalid media URL": {
+			adapter:   &twilioAdapter{defaultFrom: "+15550001"},
+			sms:       SMSMessage{To: "+15550002", MediaURLs: []string{"ftp://example.com/a.png"}},
+			expectErr: `media URL "ftp://example.com/a.png" is not a HTTP(S) URL`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			event := newEvent(t, nil)
+
+			params, err := tc.adapter.messageParams(&event, &tc.sms)
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectParams, params)
+		})
+	}
+}
+
+func TestSendMessage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/2010-04-01/Accounts/AC123/Messages.json", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+
+		if r.PostForm.Get("To") == "+15559999" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":21211,"message":"Invalid 'To' Phone Number","status":400}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"sid":    "SM1",
+			"status": "queued",
+			"from":   r.PostForm.Get("From"),
+			"to":     r.PostForm.Get("To"),
+		})
+	}))
+	defer srv.Close()
+
+	client := twilio.NewClient("AC123", "token", srv.Client())
+	client.Base = srv.URL
+
+	replier, err := targetce.New("test", logtesting.TestLogger(t),
+		targetce.ReplierWithStaticResponseType(v1alpha1.EventTypeTwilioSMSSendResponse))
+	require.NoError(t, err)
+
+	a := &twilioAdapter{
+		client:      client,
+		defaultFrom: "+15550001",
+		replier:     replier,
+		logger:      logtesting.TestLogger(t),
+	}
+
+	t.Run("Message is sent", func(t *testing.T) {
+		out, _ := a.dispatch(context.Background(), newEvent(t, &SMSMessage{To: "+15550002", Message: "Hello"}))
+		require.NotNil(t, out)
+
+		resp := &SendResponse{}
+		require.NoError(t, out.DataAs(resp))
+		assert.Equal(t, &SendResponse{SID: "SM1", Status: "queued", From: "+15550001", To: "+15550002"}, resp)
+	})
+
+	t.Run("Invalid message", func(t *testing.T) {
+		out, _ := a.dispatch(context.Background(), newEvent(t, &SMSMessage{To: "+15550002"}))
+		require.NotNil(t, out)
+		assertErrorCode(t, out, targetce.ErrorCodeRequestValidation)
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		out, _ := a.dispatch(context.Background(), newEvent(t, &SMSMessage{To: "+15559999", Message: "Hello"}))
+		require.NotNil(t, out)
+		assertErrorCode(t, out, targetce.ErrorCodeAdapterProcess)
+	})
+}
+
+// newEvent returns a CloudEvent of type io.triggermesh.twilio.sms.send with
+// the given message as data.
+func newEvent(t *testing.T, sms *SMSMessage) cloudevents.Event {
+	t.Helper()
+
+	event := cloudevents.NewEvent()
+	event.SetID(tEventID)
+	event.SetSource("test")
+	event.SetType(v1alpha1.EventTypeTwilioSMSSend)
+
+	if sms != nil {
+		require.NoError(t, event.SetData(cloudevents.ApplicationJSON, sms))
+	}
+
+	return event
+}
+
+// assertErrorCode asserts that the given response event reports an error
+// with the given code.
+func assertErrorCode(t *testing.T, event *cloudevents.Event, code string) {
+	t.Helper()
+
+	evErr := &targetce.EventError{}
+	require.NoError(t, event.DataAs(evErr))
+	assert.Equal(t, code, evErr.Code)
+}
@@ -16,12 +16,25 @@ limitations under the License.
 
 package twiliotarget
 
-import "net/url"
-
 // SMSMessage targeting Twilio
 type SMSMessage struct {
-	Message   string     `json:"message,omitempty"`
-	MediaURLs []*url.URL `json:"media_urls,omitempty"`
-	From      string     `json:"from,omitempty"`
-	To        string     `json:"to,omitempty"`
+	Message   string   `json:"message,omitempty"`
+	MediaURLs []string `json:"media_urls,omitempty"`
+	From      string   `json:"from,omitempty"`
+	To        string   `json:"to,omitempty"`
+
+	// MessagingServiceSID is the SID of the Messaging Service used to
+	// send the message, in which case From can be omitted.
+	MessagingServiceSID string `json:"messaging_service_sid,omitempty"`
+	// WhatsApp sends the message over WhatsApp instead of SMS/MMS.
+	WhatsApp bool `json:"whatsapp,omitempty"`
+}
+
+// SendResponse is the response to a sent message.
+type SendResponse struct {
+	SID                 string `json:"sid"`
+	Status              string `json:"status"`
+	From                string `json:"from,omitempty"`
+	To                  string `json:"to"`
+	MessagingServiceSID string `json:"messaging_service_sid,omitempty"`
 }
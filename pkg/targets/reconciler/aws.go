@@ -0,0 +1,125 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/reconciler"
+)
+
+// MakeAWSAuthEnvVars returns environment variables for the given AWS
+// authentication method.
+//
+// The given API key and secret are only used when no authentication method is
+// set, for compatibility with targets which predate AWSAuth.
+func MakeAWSAuthEnvVars(auth *v1alpha1.AWSAuth, apiKey, apiSecret v1alpha1.SecretValueFromSource) ([]corev1.EnvVar, error) {
+	if auth == nil {
+		return makeLegacyAWSAuthEnvVars(apiKey, apiSecret), nil
+	}
+
+	var authEnvVars []corev1.EnvVar
+
+	if creds := auth.Credentials; creds != nil {
+		authEnvVars = reconciler.MaybeAppendValueFromEnvVar(authEnvVars, reconciler.EnvAccessKeyID, creds.AccessKeyID)
+		authEnvVars = reconciler.MaybeAppendValueFromEnvVar(authEnvVars, reconciler.EnvSecretAccessKey, creds.SecretAccessKey)
+	}
+
+	if ar := auth.AssumeRole; ar != nil {
+		authEnvVars = append(authEnvVars, corev1.EnvVar{
+			Name:  reconciler.EnvAssumeRoleARN,
+			Value: ar.RoleARN.String(),
+		})
+
+		if id := ar.ExternalID; id != nil {
+			authEnvVars = append(authEnvVars, corev1.EnvVar{
+				Name:  reconciler.EnvAssumeRoleExternalID,
+				Value: *id,
+			})
+		}
+
+		if sn := ar.SessionName; sn != nil {
+			authEnvVars = append(authEnvVars, corev1.EnvVar{
+				Name:  reconciler.EnvAssumeRoleSessionName,
+				Value: *sn,
+			})
+		}
+
+		if len(ar.SessionTags) > 0 {
+			tags, err := json.Marshal(ar.SessionTags)
+			if err != nil {
+				return nil, fmt.Errorf("serializing session tags to JSON: %w", err)
+			}
+
+			authEnvVars = append(authEnvVars, corev1.EnvVar{
+				Name:  reconciler.EnvAssumeRoleSessionTags,
+				Value: string(tags),
+			})
+		}
+	}
+
+	return authEnvVars, nil
+}
+
+// makeLegacyAWSAuthEnvVars returns environment variables for the given AWS
+// API key and secret.
+func makeLegacyAWSAuthEnvVars(apiKey, apiSecret v1alpha1.SecretValueFromSource) []corev1.EnvVar {
+	var authEnvVars []corev1.EnvVar
+
+	if ref := apiKey.SecretKeyRef; ref != nil {
+		authEnvVars = append(authEnvVars, corev1.EnvVar{
+			Name: reconciler.EnvAccessKeyID,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: ref,
+			},
+		})
+	}
+
+	if ref := apiSecret.SecretKeyRef; ref != nil {
+		authEnvVars = append(authEnvVars, corev1.EnvVar{
+			Name: reconciler.EnvSecretAccessKey,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: ref,
+			},
+		})
+	}
+
+	return authEnvVars
+}
+
+// MakeAWSEndpointEnvVars returns environment variables for the given AWS
+// endpoint parameters.
+func MakeAWSEndpointEnvVars(endpoint *v1alpha1.AWSEndpoint) []corev1.EnvVar {
+	if endpoint == nil {
+		return nil
+	}
+
+	var endpointEnvVars []corev1.EnvVar
+
+	if url := endpoint.URL; url != nil {
+		endpointEnvVars = append(endpointEnvVars, corev1.EnvVar{
+			Name:  reconciler.EnvEndpointURL,
+			Value: url.String(),
+		})
+	}
+
+	return endpointEnvVars
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	pkgapis "knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+func TestMakeAWSAuthEnvVars(t *testing.T) {
+	secretRef := func(key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "aws"},
+			Key:                  key,
+		}
+	}
+
+	legacyKey := v1alpha1.SecretValueFromSource{SecretKeyRef: secretRef("legacy-key")}
+	legacySecret := v1alpha1.SecretValueFromSource{SecretKeyRef: secretRef("legacy-secret")}
+
+	roleARN := apis.ARN{
+		Partition: "aws",
+		Service:   "iam",
+		AccountID: "123456789012",
+		Resource:  "role/test",
+	}
+
+	testCases := map[string]struct {
+		auth      *v1alpha1.AWSAuth
+		expectEnv []corev1.EnvVar
+	}{
+		"Legacy API key and secret": {
+			auth: nil,
+			expectEnv: []corev1.EnvVar{
+				{Name: "AWS_ACCESS_KEY_ID", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretRef("legacy-key")}},
+				{Name: "AWS_SECRET_ACCESS_KEY", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretRef("legacy-secret")}},
+			},
+		},
+		"Security credentials take precedence": {
+			auth: &v1alpha1.AWSAuth{
+				Credentials: &v1alpha1.AWSSecurityCredentials{
+					AccessKeyID:     commonv1alpha1.ValueFromField{Value: "key"},
+					SecretAccessKey: commonv1alpha1.ValueFromField{ValueFromSecret: secretRef("secret")},
+				},
+			},
+			expectEnv: []corev1.EnvVar{
+				{Name: "AWS_ACCESS_KEY_ID", Value: "key"},
+				{Name: "AWS_SECRET_ACCESS_KEY", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretRef("secret")}},
+			},
+		},
+		"IAM role for service account": {
+			auth: &v1alpha1.AWSAuth{
+				EksIAMRole: &roleARN,
+			},
+			expectEnv: nil,
+		},
+		"Assumed role": {
+			auth: &v1alpha1.AWSAuth{
+				AssumeRole: &v1alpha1.AWSAssumeRole{
+					RoleARN:     roleARN,
+					ExternalID:  strPtr("ext-id"),
+					SessionName: strPtr("session"),
+					SessionTags: map[string]string{"team": "a"},
+				},
+			},
+			expectEnv: []corev1.EnvVar{
+				{Name: "AWS_ASSUME_ROLE_ARN", Value: "arn:aws:iam::123456789012:role/test"},
+				{Name: "AWS_ASSUME_ROLE_EXTERNAL_ID", Value: "ext-id"},
+				{Name: "AWS_ASSUME_ROLE_SESSION_NAME", Value: "session"},
+				{Name: "AWS_ASSUME_ROLE_SESSION_TAGS", Value: `{"team":"a"}`},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			env, err := MakeAWSAuthEnvVars(tc.auth, legacyKey, legacySecret)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectEnv, env)
+		})
+	}
+}
+
+func TestMakeAWSEndpointEnvVars(t *testing.T) {
+	assert.Nil(t, MakeAWSEndpointEnvVars(nil))
+
+	u, err := pkgapis.ParseURL("http://localhost:4566")
+	require.NoError(t, err)
+
+	env := MakeAWSEndpointEnvVars(&v1alpha1.AWSEndpoint{URL: u})
+	assert.Equal(t, []corev1.EnvVar{{Name: "AWS_ENDPOINT_URL", Value: "http://localhost:4566"}}, env)
+}
+
+func strPtr(s string) *string {
+	return &s
+}
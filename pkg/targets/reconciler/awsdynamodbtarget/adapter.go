@@ -17,6 +17,9 @@ limitations under the License.
 package awsdynamodbtarget
 
 import (
+	"encoding/json"
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
@@ -27,8 +30,11 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	common "github.com/triggermesh/triggermesh/pkg/reconciler"
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
+	"github.com/triggermesh/triggermesh/pkg/targets/reconciler"
 )
 
+const envOperations = "AWS_DYNAMODB_OPERATIONS"
+
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
 type adapterConfig struct {
@@ -45,30 +51,44 @@ var _ common.AdapterBuilder[*servingv1.Service] = (*Reconciler)(nil)
 func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL) (*servingv1.Service, error) {
 	typedTrg := trg.(*v1alpha1.AWSDynamoDBTarget)
 
+	authEnvs, err := reconciler.MakeAWSAuthEnvVars(typedTrg.Spec.Auth, typedTrg.Spec.AWSApiKey, typedTrg.Spec.AWSApiSecret)
+	if err != nil {
+		return nil, fmt.Errorf("creating AWS authentication environment variables: %w", err)
+	}
+
+	appEnv, err := makeAppEnv(typedTrg)
+	if err != nil {
+		return nil, fmt.Errorf("creating adapter environment: %w", err)
+	}
+
 	return common.NewAdapterKnService(trg, nil,
 		resource.Image(r.adapterCfg.Image),
-		resource.EnvVars(makeAppEnv(typedTrg)...),
+		resource.EnvVars(appEnv...),
+		resource.EnvVars(authEnvs...),
+		resource.EnvVars(reconciler.MakeAWSEndpointEnvVars(typedTrg.Spec.Endpoint)...),
 		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
 	), nil
 }
 
-func makeAppEnv(o *v1alpha1.AWSDynamoDBTarget) []corev1.EnvVar {
+func makeAppEnv(o *v1alpha1.AWSDynamoDBTarget) ([]corev1.EnvVar, error) {
 	envs := []corev1.EnvVar{
 		{
-			Name: common.EnvAccessKeyID,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.AWSApiKey.SecretKeyRef,
-			},
-		}, {
-			Name: common.EnvSecretAccessKey,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.AWSApiSecret.SecretKeyRef,
-			},
-		}, {
 			Name:  common.EnvARN,
 			Value: o.Spec.ARN,
 		},
 	}
 
-	return envs
+	if len(o.Spec.Operations) > 0 {
+		ops, err := json.Marshal(o.Spec.Operations)
+		if err != nil {
+			return nil, fmt.Errorf("serializing operations to JSON: %w", err)
+		}
+
+		envs = append(envs, corev1.EnvVar{
+			Name:  envOperations,
+			Value: string(ops),
+		})
+	}
+
+	return envs, nil
 }
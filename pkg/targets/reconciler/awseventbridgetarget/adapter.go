@@ -17,6 +17,7 @@ limitations under the License.
 package awseventbridgetarget
 
 import (
+	"fmt"
 	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
@@ -29,6 +30,7 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	common "github.com/triggermesh/triggermesh/pkg/reconciler"
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
+	"github.com/triggermesh/triggermesh/pkg/targets/reconciler"
 )
 
 // adapterConfig contains properties used to configure the target's adapter.
@@ -47,9 +49,16 @@ var _ common.AdapterBuilder[*servingv1.Service] = (*Reconciler)(nil)
 func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL) (*servingv1.Service, error) {
 	typedTrg := trg.(*v1alpha1.AWSEventBridgeTarget)
 
+	authEnvs, err := reconciler.MakeAWSAuthEnvVars(typedTrg.Spec.Auth, typedTrg.Spec.AWSApiKey, typedTrg.Spec.AWSApiSecret)
+	if err != nil {
+		return nil, fmt.Errorf("creating AWS authentication environment variables: %w", err)
+	}
+
 	return common.NewAdapterKnService(trg, nil,
 		resource.Image(r.adapterCfg.Image),
 		resource.EnvVars(makeAppEnv(typedTrg)...),
+		resource.EnvVars(authEnvs...),
+		resource.EnvVars(reconciler.MakeAWSEndpointEnvVars(typedTrg.Spec.Endpoint)...),
 		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
 	), nil
 }
@@ -57,16 +66,6 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 func makeAppEnv(o *v1alpha1.AWSEventBridgeTarget) []corev1.EnvVar {
 	envs := []corev1.EnvVar{
 		{
-			Name: common.EnvAccessKeyID,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.AWSApiKey.SecretKeyRef,
-			},
-		}, {
-			Name: common.EnvSecretAccessKey,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.AWSApiSecret.SecretKeyRef,
-			},
-		}, {
 			Name:  common.EnvARN,
 			Value: o.Spec.ARN,
 		}, {
@@ -52,20 +52,14 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 
 	return common.NewAdapterKnService(trg, nil,
 		resource.Image(r.adapterCfg.Image),
+		common.SecretEnvVar(trg, envDatadogAPIKey, typedTrg.Spec.DatadogAPIKey.SecretKeyRef),
 		resource.EnvVars(makeAppEnv(typedTrg)...),
 		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
 	), nil
 }
 
 func makeAppEnv(o *v1alpha1.DatadogTarget) []corev1.EnvVar {
-	env := []corev1.EnvVar{
-		{
-			Name: envDatadogAPIKey,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.DatadogAPIKey.SecretKeyRef,
-			},
-		},
-	}
+	var env []corev1.EnvVar
 
 	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
 		env = append(env, corev1.EnvVar{
@@ -17,6 +17,8 @@ limitations under the License.
 package googlecloudfirestoretarget
 
 import (
+	"encoding/json"
+	"fmt"
 	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
@@ -35,6 +37,7 @@ const (
 	envDefaultCollection   = "GOOGLE_FIRESTORE_DEFAULT_COLLECTION"
 	envProjectID           = "GOOGLE_FIRESTORE_PROJECT_ID"
 	envDiscardCEContext    = "DISCARD_CE_CONTEXT"
+	envOperations          = "GOOGLE_FIRESTORE_OPERATIONS"
 	envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
 )
 
@@ -54,14 +57,19 @@ var _ common.AdapterBuilder[*servingv1.Service] = (*Reconciler)(nil)
 func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL) (*servingv1.Service, error) {
 	typedTrg := trg.(*v1alpha1.GoogleCloudFirestoreTarget)
 
+	appEnv, err := makeAppEnv(typedTrg)
+	if err != nil {
+		return nil, fmt.Errorf("creating adapter environment: %w", err)
+	}
+
 	return common.NewAdapterKnService(trg, nil,
 		resource.Image(r.adapterCfg.Image),
-		resource.EnvVars(makeAppEnv(typedTrg)...),
+		resource.EnvVars(appEnv...),
 		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
 	), nil
 }
 
-func makeAppEnv(o *v1alpha1.GoogleCloudFirestoreTarget) []corev1.EnvVar {
+func makeAppEnv(o *v1alpha1.GoogleCloudFirestoreTarget) ([]corev1.EnvVar, error) {
 	env := []corev1.EnvVar{
 		{
 			Name:  envDefaultCollection,
@@ -90,5 +98,17 @@ func makeAppEnv(o *v1alpha1.GoogleCloudFirestoreTarget) []corev1.EnvVar {
 		})
 	}
 
-	return env
+	if len(o.Spec.Operations) > 0 {
+		ops, err := json.Marshal(o.Spec.Operations)
+		if err != nil {
+			return nil, fmt.Errorf("serializing operations to JSON: %w", err)
+		}
+
+		env = append(env, corev1.EnvVar{
+			Name:  envOperations,
+			Value: string(ops),
+		})
+	}
+
+	return env, nil
 }
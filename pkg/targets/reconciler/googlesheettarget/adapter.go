@@ -17,6 +17,8 @@ limitations under the License.
 package googlesheettarget
 
 import (
+	"strconv"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
@@ -30,8 +32,11 @@ import (
 )
 
 const (
-	envSheetID       = "SHEET_ID"
-	envDefaultPrefix = "DEFAULT_SHEET_PREFIX"
+	envSheetID             = "SHEET_ID"
+	envDefaultPrefix       = "DEFAULT_SHEET_PREFIX"
+	envBatchMaxRows        = "SHEET_BATCH_MAX_ROWS"
+	envBatchInterval       = "SHEET_BATCH_INTERVAL"
+	envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
 )
 
 // adapterConfig contains properties used to configure the target's adapter.
@@ -58,7 +63,7 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 }
 
 func makeAppEnv(o *v1alpha1.GoogleSheetTarget) []corev1.EnvVar {
-	return []corev1.EnvVar{
+	env := []corev1.EnvVar{
 		{
 			Name: common.EnvGCloudSAKey,
 			ValueFrom: &corev1.EnvVarSource{
@@ -70,6 +75,32 @@ func makeAppEnv(o *v1alpha1.GoogleSheetTarget) []corev1.EnvVar {
 		}, {
 			Name:  envDefaultPrefix,
 			Value: o.Spec.DefaultPrefix,
+		}, {
+			Name:  common.EnvBridgeID,
+			Value: common.GetStatefulBridgeID(o),
 		},
 	}
+
+	if b := o.Spec.Batching; b != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envBatchMaxRows,
+			Value: strconv.Itoa(b.MaxRows),
+		})
+
+		if b.Interval != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envBatchInterval,
+				Value: b.Interval.String(),
+			})
+		}
+	}
+
+	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envEventsPayloadPolicy,
+			Value: string(*o.Spec.EventOptions.PayloadPolicy),
+		})
+	}
+
+	return env
 }
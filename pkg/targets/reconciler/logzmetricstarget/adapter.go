@@ -18,6 +18,7 @@ package logzmetricstarget
 
 import (
 	"encoding/json"
+	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -35,6 +36,12 @@ const (
 	envCortexEndpoint           = "OPENTELEMETRY_CORTEX_ENDPOINT"
 	envCortexBearerToken        = "OPENTELEMETRY_CORTEX_BEARER_TOKEN"
 	envOpenTelemetryInstruments = "OPENTELEMETRY_INSTRUMENTS"
+	envOTLPEndpoint             = "OPENTELEMETRY_OTLP_ENDPOINT"
+	envOTLPProtocol             = "OPENTELEMETRY_OTLP_PROTOCOL"
+	envOTLPInsecure             = "OPENTELEMETRY_OTLP_INSECURE"
+	envOTLPHeaders              = "OPENTELEMETRY_OTLP_HEADERS"
+	envOTLPHeaderPrefix         = "OPENTELEMETRY_OTLP_HEADER_"
+	envServiceName              = "OPENTELEMETRY_SERVICE_NAME"
 
 	envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
 )
@@ -66,26 +73,36 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 func makeAppEnv(o *v1alpha1.LogzMetricsTarget) []corev1.EnvVar {
 	env := []corev1.EnvVar{
 		{
-			Name: envCortexBearerToken,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.Connection.Token.SecretKeyRef,
-			},
-		}, {
-			Name:  envCortexEndpoint,
-			Value: o.Spec.Connection.ListenerURL,
-		}, {
 			Name:  common.EnvBridgeID,
 			Value: common.GetStatefulBridgeID(o),
 		},
 	}
 
-	if instruments, err := json.Marshal(o.Spec.Instruments); err == nil {
+	if conn := o.Spec.Connection; conn != nil {
 		env = append(env, corev1.EnvVar{
-			Name:  envOpenTelemetryInstruments,
-			Value: string(instruments),
+			Name: envCortexBearerToken,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: conn.Token.SecretKeyRef,
+			},
+		}, corev1.EnvVar{
+			Name:  envCortexEndpoint,
+			Value: conn.ListenerURL,
 		})
 	}
 
+	if len(o.Spec.Instruments) > 0 {
+		if instruments, err := json.Marshal(o.Spec.Instruments); err == nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envOpenTelemetryInstruments,
+				Value: string(instruments),
+			})
+		}
+	}
+
+	if otlp := o.Spec.OTLP; otlp != nil {
+		env = append(env, makeOTLPEnv(otlp)...)
+	}
+
 	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
 		env = append(env, corev1.EnvVar{
 			Name:  envEventsPayloadPolicy,
@@ -95,3 +112,65 @@ func makeAppEnv(o *v1alpha1.LogzMetricsTarget) []corev1.EnvVar {
 
 	return env
 }
+
+// makeOTLPEnv returns the environment variables which configure the export
+// of spans and log records to an OTLP collector.
+func makeOTLPEnv(otlp *v1alpha1.OTLPConnection) []corev1.EnvVar {
+	env := []corev1.EnvVar{{
+		Name:  envOTLPEndpoint,
+		Value: otlp.Endpoint,
+	}}
+
+	if otlp.Protocol != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envOTLPProtocol,
+			Value: *otlp.Protocol,
+		})
+	}
+
+	if otlp.Insecure != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envOTLPInsecure,
+			Value: strconv.FormatBool(*otlp.Insecure),
+		})
+	}
+
+	// The value of each header is passed in its own environment variable,
+	// so that it can be read from a Secret, and the names of these
+	// variables are passed along with the names of the headers.
+	if len(otlp.Headers) > 0 {
+		headerVars := make(map[string]string, len(otlp.Headers))
+
+		for i, h := range otlp.Headers {
+			headerEnv := corev1.EnvVar{
+				Name: envOTLPHeaderPrefix + strconv.Itoa(i),
+			}
+			if h.Value.ValueFromSecret != nil {
+				headerEnv.ValueFrom = &corev1.EnvVarSource{
+					SecretKeyRef: h.Value.ValueFromSecret,
+				}
+			} else {
+				headerEnv.Value = h.Value.Value
+			}
+
+			env = append(env, headerEnv)
+			headerVars[h.Name] = headerEnv.Name
+		}
+
+		if headers, err := json.Marshal(headerVars); err == nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envOTLPHeaders,
+				Value: string(headers),
+			})
+		}
+	}
+
+	if otlp.ServiceName != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envServiceName,
+			Value: *otlp.ServiceName,
+		})
+	}
+
+	return env
+}
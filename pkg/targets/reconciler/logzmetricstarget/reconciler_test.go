@@ -20,6 +20,8 @@ import (
 	"context"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
+
 	"knative.dev/eventing/pkg/reconciler/source"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
@@ -27,6 +29,7 @@ import (
 	rt "knative.dev/pkg/reconciler/testing"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	fakeinjectionclient "github.com/triggermesh/triggermesh/pkg/client/generated/injection/client/fake"
 	reconcilerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/reconciler/targets/v1alpha1/logzmetricstarget"
@@ -68,7 +71,7 @@ func reconcilerCtor(cfg *adapterConfig) Ctor {
 func newTarget() *v1alpha1.LogzMetricsTarget {
 	trg := &v1alpha1.LogzMetricsTarget{
 		Spec: v1alpha1.LogzMetricsTargetSpec{
-			Connection: v1alpha1.LogzMetricsConnection{
+			Connection: &v1alpha1.LogzMetricsConnection{
 				ListenerURL: "http://listener.example.com:8052",
 			},
 			Instruments: []v1alpha1.Instrument{{
@@ -77,6 +80,22 @@ func newTarget() *v1alpha1.LogzMetricsTarget {
 				Instrument:  v1alpha1.InstrumentKindCounter,
 				Number:      v1alpha1.NumberKindInt64,
 			}},
+			OTLP: &v1alpha1.OTLPConnection{
+				Endpoint: "otel-collector:4317",
+				Insecure: ptr.Bool(true),
+				Headers: []v1alpha1.OTLPHeader{{
+					Name: "Authorization",
+					Value: commonv1alpha1.ValueFromField{
+						ValueFromSecret: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "otlp"},
+							Key:                  "token",
+						},
+					},
+				}, {
+					Name:  "X-Tenant",
+					Value: commonv1alpha1.ValueFromField{Value: "test"},
+				}},
+			},
 		},
 	}
 
@@ -0,0 +1,154 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheustarget
+
+import (
+	"encoding/json"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/eventing/pkg/reconciler/source"
+	"knative.dev/pkg/apis"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	common "github.com/triggermesh/triggermesh/pkg/reconciler"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
+)
+
+const (
+	envInstruments = "PROMETHEUS_INSTRUMENTS"
+
+	envRemoteWriteURL         = "PROMETHEUS_REMOTE_WRITE_URL"
+	envRemoteWriteBearerToken = "PROMETHEUS_REMOTE_WRITE_BEARER_TOKEN"
+	envRemoteWriteUsername    = "PROMETHEUS_REMOTE_WRITE_USERNAME"
+	envRemoteWritePassword    = "PROMETHEUS_REMOTE_WRITE_PASSWORD"
+	envRemoteWriteInterval    = "PROMETHEUS_REMOTE_WRITE_INTERVAL"
+
+	envMaxSeriesPerInstrument = "PROMETHEUS_MAX_SERIES_PER_INSTRUMENT"
+	envMaxLabelsPerSeries     = "PROMETHEUS_MAX_LABELS_PER_SERIES"
+
+	envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
+)
+
+// Series are held in the adapter's memory, so the adapter must run as exactly
+// one replica for scrapes and remote writes to reflect all recorded events.
+const (
+	annotationMinScale = "autoscaling.knative.dev/min-scale"
+	annotationMaxScale = "autoscaling.knative.dev/max-scale"
+
+	adapterScale = "1"
+)
+
+// adapterConfig contains properties used to configure the target's adapter.
+// Public fields are automatically populated by envconfig.
+type adapterConfig struct {
+	// Configuration accessor for logging/metrics/tracing
+	obsConfig source.ConfigAccessor
+	// Container image
+	Image string `default:"gcr.io/triggermesh/prometheustarget-adapter"`
+}
+
+// Verify that Reconciler implements common.AdapterBuilder.
+var _ common.AdapterBuilder[*servingv1.Service] = (*Reconciler)(nil)
+
+// BuildAdapter implements common.AdapterBuilder.
+func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL) (*servingv1.Service, error) {
+	typedTrg := trg.(*v1alpha1.PrometheusTarget)
+
+	return common.NewAdapterKnService(trg, nil,
+		resource.Image(r.adapterCfg.Image),
+		resource.PodAnnotation(annotationMinScale, adapterScale),
+		resource.PodAnnotation(annotationMaxScale, adapterScale),
+		resource.EnvVars(makeAppEnv(typedTrg)...),
+		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
+	), nil
+}
+
+func makeAppEnv(o *v1alpha1.PrometheusTarget) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{
+			Name:  common.EnvBridgeID,
+			Value: common.GetStatefulBridgeID(o),
+		},
+	}
+
+	if instruments, err := json.Marshal(o.Spec.Instruments); err == nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envInstruments,
+			Value: string(instruments),
+		})
+	}
+
+	if rw := o.Spec.RemoteWrite; rw != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envRemoteWriteURL,
+			Value: rw.URL.String(),
+		})
+
+		if rw.BearerToken != nil {
+			env = append(env, corev1.EnvVar{
+				Name:      envRemoteWriteBearerToken,
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: rw.BearerToken.SecretKeyRef},
+			})
+		}
+
+		if rw.BasicAuth != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envRemoteWriteUsername,
+				Value: rw.BasicAuth.Username,
+			}, corev1.EnvVar{
+				Name:      envRemoteWritePassword,
+				ValueFrom: &corev1.EnvVarSource{SecretKeyRef: rw.BasicAuth.Password.SecretKeyRef},
+			})
+		}
+
+		if rw.Interval != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envRemoteWriteInterval,
+				Value: rw.Interval.String(),
+			})
+		}
+	}
+
+	if l := o.Spec.Limits; l != nil {
+		if l.MaxSeriesPerInstrument != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envMaxSeriesPerInstrument,
+				Value: strconv.Itoa(*l.MaxSeriesPerInstrument),
+			})
+		}
+
+		if l.MaxLabelsPerSeries != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envMaxLabelsPerSeries,
+				Value: strconv.Itoa(*l.MaxLabelsPerSeries),
+			})
+		}
+	}
+
+	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envEventsPayloadPolicy,
+			Value: string(*o.Spec.EventOptions.PayloadPolicy),
+		})
+	}
+
+	return env
+}
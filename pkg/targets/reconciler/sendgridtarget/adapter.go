@@ -17,6 +17,9 @@ limitations under the License.
 package sendgridtarget
 
 import (
+	"sort"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
@@ -47,20 +50,14 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 
 	return common.NewAdapterKnService(trg, nil,
 		resource.Image(r.adapterCfg.Image),
+		common.SecretEnvVar(trg, "SENDGRID_API_KEY", typedTrg.Spec.APIKey.SecretKeyRef),
 		resource.EnvVars(makeAppEnv(typedTrg)...),
 		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
 	), nil
 }
 
 func makeAppEnv(o *v1alpha1.SendGridTarget) []corev1.EnvVar {
-	env := []corev1.EnvVar{
-		{
-			Name: "SENDGRID_API_KEY",
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.APIKey.SecretKeyRef,
-			},
-		},
-	}
+	var env []corev1.EnvVar
 
 	if o.Spec.DefaultFromEmail != nil {
 		env = append(env, corev1.EnvVar{
@@ -104,6 +101,48 @@ func makeAppEnv(o *v1alpha1.SendGridTarget) []corev1.EnvVar {
 		})
 	}
 
+	if o.Spec.DefaultTemplateID != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "SENDGRID_DEFAULT_TEMPLATE_ID",
+			Value: *o.Spec.DefaultTemplateID,
+		})
+	}
+
+	if len(o.Spec.CategoryAttributes) > 0 {
+		env = append(env, corev1.EnvVar{
+			Name:  "SENDGRID_CATEGORY_ATTRIBUTES",
+			Value: strings.Join(o.Spec.CategoryAttributes, ","),
+		})
+	}
+
+	if len(o.Spec.CustomArgAttributes) > 0 {
+		args := make([]string, 0, len(o.Spec.CustomArgAttributes))
+		for name, attr := range o.Spec.CustomArgAttributes {
+			args = append(args, name+":"+attr)
+		}
+		// sorted for deterministic env values across reconciliations
+		sort.Strings(args)
+
+		env = append(env, corev1.EnvVar{
+			Name:  "SENDGRID_CUSTOM_ARG_ATTRIBUTES",
+			Value: strings.Join(args, ","),
+		})
+	}
+
+	if u := o.Spec.AttachmentURLs; u != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "SENDGRID_ATTACHMENT_ALLOWED_HOSTS",
+			Value: strings.Join(u.AllowedHosts, ","),
+		})
+
+		if len(u.AllowedSchemes) > 0 {
+			env = append(env, corev1.EnvVar{
+				Name:  "SENDGRID_ATTACHMENT_ALLOWED_SCHEMES",
+				Value: strings.Join(u.AllowedSchemes, ","),
+			})
+		}
+	}
+
 	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
 		env = append(env, corev1.EnvVar{
 			Name:  "EVENTS_PAYLOAD_POLICY",
@@ -29,6 +29,8 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
+const envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
+
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
 type adapterConfig struct {
@@ -53,12 +55,24 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 }
 
 func makeAppEnv(o *v1alpha1.SlackTarget) []corev1.EnvVar {
-	return []corev1.EnvVar{
+	env := []corev1.EnvVar{
 		{
 			Name: "SLACK_TOKEN",
 			ValueFrom: &corev1.EnvVarSource{
 				SecretKeyRef: o.Spec.Token.SecretKeyRef,
 			},
+		}, {
+			Name:  common.EnvBridgeID,
+			Value: common.GetStatefulBridgeID(o),
 		},
 	}
+
+	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envEventsPayloadPolicy,
+			Value: string(*o.Spec.EventOptions.PayloadPolicy),
+		})
+	}
+
+	return env
 }
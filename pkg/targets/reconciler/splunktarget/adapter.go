@@ -56,6 +56,7 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 
 	return common.NewAdapterKnService(trg, nil,
 		resource.Image(r.adapterCfg.Image),
+		common.ValueFromEnvVar(trg, envHECToken, typedTrg.Spec.Token),
 		resource.EnvVars(makeAppEnv(typedTrg)...),
 		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
 	), nil
@@ -74,8 +75,6 @@ func makeAppEnv(o *v1alpha1.SplunkTarget) []corev1.EnvVar {
 		},
 	}
 
-	env = common.MaybeAppendValueFromEnvVar(env, envHECToken, o.Spec.Token)
-
 	if idx := o.Spec.Index; idx != nil && *idx != "" {
 		env = append(env, corev1.EnvVar{
 			Name:  envIndex,
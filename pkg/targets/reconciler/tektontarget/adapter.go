@@ -17,6 +17,9 @@ limitations under the License.
 package tektontarget
 
 import (
+	"encoding/json"
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
@@ -29,6 +32,14 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
+const (
+	envReapSuccessAge     = "TEKTON_REAP_SUCCESS_AGE"
+	envReapFailAge        = "TEKTON_REAP_FAIL_AGE"
+	envParams             = "TEKTON_PARAMS"
+	envWorkspaces         = "TEKTON_WORKSPACES"
+	envServiceAccountName = "TEKTON_SERVICE_ACCOUNT_NAME"
+)
+
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
 type adapterConfig struct {
@@ -44,34 +55,70 @@ type adapterConfig struct {
 var _ common.AdapterBuilder[*servingv1.Service] = (*Reconciler)(nil)
 
 // BuildAdapter implements common.AdapterBuilder.
-func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL) (*servingv1.Service, error) {
+func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, sinkURI *apis.URL) (*servingv1.Service, error) {
 	typedTrg := trg.(*v1alpha1.TektonTarget)
 
-	return common.NewAdapterKnService(trg, nil,
+	appEnv, err := makeAppEnv(typedTrg)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.NewAdapterKnService(trg, sinkURI,
 		resource.Image(r.adapterCfg.Image),
-		resource.EnvVars(makeAppEnv(typedTrg)...),
+		resource.EnvVars(appEnv...),
 		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
 	), nil
 }
 
-func makeAppEnv(o *v1alpha1.TektonTarget) []corev1.EnvVar {
+func makeAppEnv(o *v1alpha1.TektonTarget) ([]corev1.EnvVar, error) {
 	var envVar []corev1.EnvVar
 
 	if o.Spec.ReapPolicy != nil {
 		if o.Spec.ReapPolicy.ReapSuccessAge != nil {
 			envVar = append(envVar, corev1.EnvVar{
-				Name:  "TEKTON_REAP_SUCCESS_AGE",
+				Name:  envReapSuccessAge,
 				Value: *o.Spec.ReapPolicy.ReapSuccessAge,
 			})
 		}
 
-		if o.Spec.ReapPolicy.ReapSuccessAge != nil {
+		if o.Spec.ReapPolicy.ReapFailAge != nil {
 			envVar = append(envVar, corev1.EnvVar{
-				Name:  "TEKTON_REAP_FAIL_AGE",
+				Name:  envReapFailAge,
 				Value: *o.Spec.ReapPolicy.ReapFailAge,
 			})
 		}
 	}
 
-	return envVar
+	if len(o.Spec.Params) > 0 {
+		s, err := json.Marshal(o.Spec.Params)
+		if err != nil {
+			return nil, fmt.Errorf("serializing run parameters to JSON: %w", err)
+		}
+
+		envVar = append(envVar, corev1.EnvVar{
+			Name:  envParams,
+			Value: string(s),
+		})
+	}
+
+	if len(o.Spec.Workspaces) > 0 {
+		s, err := json.Marshal(o.Spec.Workspaces)
+		if err != nil {
+			return nil, fmt.Errorf("serializing run workspaces to JSON: %w", err)
+		}
+
+		envVar = append(envVar, corev1.EnvVar{
+			Name:  envWorkspaces,
+			Value: string(s),
+		})
+	}
+
+	if sa := o.Spec.ServiceAccountName; sa != nil && *sa != "" {
+		envVar = append(envVar, corev1.EnvVar{
+			Name:  envServiceAccountName,
+			Value: *sa,
+		})
+	}
+
+	return envVar, nil
 }
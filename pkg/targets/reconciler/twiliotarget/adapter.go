@@ -34,6 +34,8 @@ const (
 	envTwilioToken         = "TWILIO_TOKEN"
 	envTwilioDefaultFrom   = "TWILIO_DEFAULT_FROM"
 	envTwilioDefaultTo     = "TWILIO_DEFAULT_TO"
+	envTwilioDefaultMsgSvc = "TWILIO_DEFAULT_MESSAGING_SERVICE_SID"
+	envTwilioStatusCbURL   = "TWILIO_STATUS_CALLBACK_URL"
 	envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
 )
 
@@ -55,6 +57,8 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 
 	return common.NewAdapterKnService(trg, nil,
 		resource.Image(r.adapterCfg.Image),
+		common.SecretEnvVar(trg, envTwilioSID, typedTrg.Spec.AccountSID.SecretKeyRef),
+		common.SecretEnvVar(trg, envTwilioToken, typedTrg.Spec.Token.SecretKeyRef),
 		resource.EnvVars(makeAppEnv(typedTrg)...),
 		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
 	), nil
@@ -63,16 +67,6 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 func makeAppEnv(o *v1alpha1.TwilioTarget) []corev1.EnvVar {
 	env := []corev1.EnvVar{
 		{
-			Name: envTwilioSID,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.AccountSID.SecretKeyRef,
-			},
-		}, {
-			Name: envTwilioToken,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.Token.SecretKeyRef,
-			},
-		}, {
 			Name:  common.EnvBridgeID,
 			Value: common.GetStatefulBridgeID(o),
 		},
@@ -92,6 +86,20 @@ func makeAppEnv(o *v1alpha1.TwilioTarget) []corev1.EnvVar {
 		})
 	}
 
+	if o.Spec.DefaultMessagingServiceSID != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envTwilioDefaultMsgSvc,
+			Value: *o.Spec.DefaultMessagingServiceSID,
+		})
+	}
+
+	if o.Spec.StatusCallbackURL != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envTwilioStatusCbURL,
+			Value: o.Spec.StatusCallbackURL.String(),
+		})
+	}
+
 	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
 		env = append(env, corev1.EnvVar{
 			Name:  envEventsPayloadPolicy,